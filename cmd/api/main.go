@@ -2,21 +2,31 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
-	"os"
-	"os/signal"
-	"syscall"
+	"net/http"
+	"strings"
+	"time"
 
 	"go.uber.org/fx"
 
+	"contract-pro-suite/internal/gateway"
+	"contract-pro-suite/internal/grpcweb"
 	"contract-pro-suite/internal/interceptor"
 	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/db"
 	sharedfx "contract-pro-suite/internal/shared/fx"
-	pbauth "contract-pro-suite/proto/auth"
+	"contract-pro-suite/internal/shared/health"
+	"contract-pro-suite/internal/shared/legacyapi"
+	"contract-pro-suite/internal/shared/ratelimit"
+	"contract-pro-suite/migrations"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	pbauthv2 "contract-pro-suite/proto/contractpro/auth/v2"
 	authfx "contract-pro-suite/services/auth/fx"
 	"contract-pro-suite/services/auth/repository"
+	"contract-pro-suite/services/auth/scim"
 	"contract-pro-suite/services/auth/server"
 	"contract-pro-suite/services/auth/usecase"
 
@@ -30,36 +40,97 @@ func main() {
 		sharedfx.NewSharedModule(),
 		// 認証サービスモジュール
 		authfx.NewAuthModule(),
+		// インターセプター（gRPCサーバーとHTTP/JSONゲートウェイで共通）
+		fx.Provide(newServerInterceptors),
+		// ヘルスチェック（gRPCのgrpc.health.v1とHTTPの/livez・/readyzで共通）
+		fx.Provide(newHealthChecker),
 		// gRPCサーバーの起動
 		fx.Invoke(startGRPCServer),
-		// グレースフルシャットダウン
+		// HTTPサーバー（SCIM、HTTP/JSONゲートウェイ）の起動
+		fx.Invoke(startHTTPServer),
+		// グレースフルシャットダウン（最後に登録し、停止時は最初にNOT_SERVINGにする）
 		fx.Invoke(registerShutdown),
 	)
 
 	app.Run()
 }
 
+// serverInterceptors gRPCサーバーとHTTP/JSONゲートウェイに共通のインターセプター（適用順）
+type serverInterceptors struct {
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+}
+
+// newServerInterceptors インターセプターを作成
+func newServerInterceptors(
+	cfg *config.Config,
+	authUsecase usecase.AuthUsecase,
+	clientRepo repository.ClientRepository,
+	identityProviderRepo repository.IdentityProviderRepository,
+	apiKeyRepo repository.APIKeyRepository,
+	ipAllowlistUsecase usecase.IPAllowlistUsecase,
+) (*serverInterceptors, error) {
+	// レート制限の設定（無効の場合はpolicyがnilのままとなり、インターセプターは何もしない）
+	var rateLimitPolicy *ratelimit.Policy
+	if cfg.RateLimitEnabled {
+		policy, err := ratelimit.ParsePolicy(cfg.RateLimitDefault, cfg.RateLimitPublicDefault, cfg.RateLimitMethodsStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit config: %w", err)
+		}
+		if policy.PreAuth, err = ratelimit.ParseLimit(cfg.RateLimitPreAuth); err != nil {
+			return nil, fmt.Errorf("invalid rate limit config: %w", err)
+		}
+		rateLimitPolicy = policy
+	}
+	// 認証前・認証後のレート制限で同じストアを使用する（キーで区別）
+	rateLimitStore := ratelimit.NewMemoryStore()
+
+	// インターセプターの適用順序が重要
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		// 1. 監査ログインターセプター（最初に適用）
+		interceptor.AuditInterceptor(),
+		// 2. エラー変換インターセプター（ドメインエラーをgRPCステータスに変換し、内部エラーの詳細を伏せる）
+		interceptor.ErrorInterceptor(),
+		// 3. 認証前のレート制限インターセプター（IPアドレス単位、無効なトークン・APIキーの総当たりと認証時のDB参照を制限）
+		interceptor.PreAuthRateLimitInterceptor(cfg, rateLimitPolicy, rateLimitStore),
+		// 4. JWT検証インターセプター（Supabase / クライアントの外部IdP / サービスアカウントのAPIキー）
+		interceptor.AuthInterceptor(cfg, identityProviderRepo, apiKeyRepo),
+		// 5. ユーザー情報取得インターセプター
+		interceptor.EnhancedAuthInterceptor(authUsecase),
+		// 6. テナント検証インターセプター（クライアントのIPアドレス許可リストを含む）
+		interceptor.TenantInterceptor(cfg, clientRepo, authUsecase, ipAllowlistUsecase),
+		// 7. レート制限インターセプター（公開メソッドはIPアドレス単位、認証済みメソッドはユーザー単位、gRPCとHTTPで共有）
+		interceptor.RateLimitInterceptor(cfg, rateLimitPolicy, rateLimitStore),
+	}
+	// ストリーミングRPC（ExportClientUsers等）にも同じ順序で適用する
+	streamInterceptors := make([]grpc.StreamServerInterceptor, len(unaryInterceptors))
+	for i, unary := range unaryInterceptors {
+		streamInterceptors[i] = interceptor.StreamInterceptor(unary)
+	}
+	// 8. リクエストバリデーションインターセプター（auth.protoで宣言したルール、ストリーミングRPCは受信時に検証）
+	unaryInterceptors = append(unaryInterceptors, interceptor.ValidationInterceptor())
+	streamInterceptors = append(streamInterceptors, interceptor.ValidationStreamInterceptor())
+	// 9. 最終アクティビティ記録インターセプター（すべての検証を通過したリクエストのみ記録）
+	activity := interceptor.ActivityInterceptor(authUsecase)
+	unaryInterceptors = append(unaryInterceptors, activity)
+	streamInterceptors = append(streamInterceptors, interceptor.StreamInterceptor(activity))
+
+	return &serverInterceptors{unary: unaryInterceptors, stream: streamInterceptors}, nil
+}
+
 // startGRPCServer gRPCサーバーを起動
 func startGRPCServer(
 	lc fx.Lifecycle,
 	cfg *config.Config,
+	interceptors *serverInterceptors,
+	checker *health.Checker,
 	authServer *server.AuthServer,
-	authUsecase usecase.AuthUsecase,
-	clientRepo repository.ClientRepository,
+	authServerV2 *server.AuthServerV2,
 ) error {
 	// gRPCサーバーの作成
 	grpcServer := grpc.NewServer(
-		// インターセプターの適用順序が重要
-		grpc.ChainUnaryInterceptor(
-			// 1. 監査ログインターセプター（最初に適用）
-			interceptor.AuditInterceptor(),
-			// 2. JWT検証インターセプター
-			interceptor.AuthInterceptor(cfg),
-			// 3. ユーザー情報取得インターセプター
-			interceptor.EnhancedAuthInterceptor(authUsecase),
-			// 4. テナント検証インターセプター
-			interceptor.TenantInterceptor(cfg, clientRepo, authUsecase),
-		),
+		grpc.ChainUnaryInterceptor(interceptors.unary...),
+		grpc.ChainStreamInterceptor(interceptors.stream...),
 	)
 
 	// 認証サービスを登録
@@ -67,10 +138,33 @@ func startGRPCServer(
 		return fmt.Errorf("authServer is nil")
 	}
 	pbauth.RegisterAuthServiceServer(grpcServer, authServer)
+	// v2（移行期間中はv1と並行して提供）
+	pbauthv2.RegisterAuthServiceServer(grpcServer, authServerV2)
+	// 旧サービス名（パッケージ名の変更前のauth.AuthService、auth.v2.AuthService）でも移行期間中は受け付ける
+	legacyapi.Register(grpcServer, &pbauth.AuthService_ServiceDesc, authServer)
+	legacyapi.Register(grpcServer, &pbauthv2.AuthService_ServiceDesc, authServerV2)
+
+	// ヘルスチェック（登録済みのサービスごとの状態、依存先が利用できない場合・シャットダウン中はNOT_SERVING）
+	services := make([]string, 0, len(grpcServer.GetServiceInfo()))
+	for service := range grpcServer.GetServiceInfo() {
+		services = append(services, service)
+	}
+	checker.Register(grpcServer, services...)
 
 	// gRPCリフレクションを有効化（開発環境用、テスト用）
 	reflection.Register(grpcServer)
 
+	// ネイティブのgRPCに加えて、ブラウザからのgRPC-Web・Connectのリクエストを同じポートで受け付ける
+	// （h2cでHTTP/1.1とHTTP/2を多重化し、いずれもgrpcServerで処理するためインターセプターは共通）
+	protocols := &http.Protocols{}
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	httpServer := &http.Server{
+		Handler:           grpcweb.CORSPolicy(cfg.CORSOrigins()).Handler(grpcweb.NewHandler(grpcServer)),
+		Protocols:         protocols,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
 	// リスナーの作成
 	lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
 	if err != nil {
@@ -78,11 +172,13 @@ func startGRPCServer(
 	}
 
 	// ライフサイクル管理
+	checkerCtx, stopChecker := context.WithCancel(context.Background())
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			log.Printf("gRPC server starting on port %s", cfg.GRPCPort)
+			go checker.Run(checkerCtx, cfg.HealthCheckInterval)
 			go func() {
-				if err := grpcServer.Serve(lis); err != nil {
+				if err := httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
 					log.Fatalf("gRPC server failed to serve: %v", err)
 				}
 			}()
@@ -90,27 +186,121 @@ func startGRPCServer(
 		},
 		OnStop: func(ctx context.Context) error {
 			log.Println("Shutting down gRPC server...")
-			grpcServer.GracefulStop()
-			return nil
+			// 処理中のRPCの完了を待ってから停止する
+			stopChecker()
+			err := httpServer.Shutdown(ctx)
+			grpcServer.Stop()
+			return err
 		},
 	})
 
 	return nil
 }
 
-// registerShutdown グレースフルシャットダウンを登録
-func registerShutdown(lc fx.Lifecycle) {
+// startHTTPServer HTTPサーバーを起動（SCIM 2.0エンドポイント、AuthServiceのHTTP/JSONゲートウェイ、OpenAPIのドキュメント、ヘルスチェック）
+func startHTTPServer(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	interceptors *serverInterceptors,
+	checker *health.Checker,
+	scimHandler *scim.Handler,
+	authServer *server.AuthServer,
+	authServerV2 *server.AuthServerV2,
+) error {
+	// ゲートウェイ（google.api.httpアノテーションのルート、gRPCサーバーと同じインターセプターを経由して呼び出す）
+	gw := gateway.New(interceptors.unary, interceptors.stream)
+	pbauth.RegisterAuthServiceServer(gw, authServer)
+	pbauthv2.RegisterAuthServiceServer(gw, authServerV2)
+
+	// OpenAPIのドキュメント（ゲートウェイに登録したルートから作成）
+	openAPI, err := gw.OpenAPI(interceptor.IsPublicMethod)
+	if err != nil {
+		return fmt.Errorf("failed to generate openapi document: %w", err)
+	}
+
+	corsPolicy := gateway.CORSPolicy(cfg.CORSOrigins())
+	mux := http.NewServeMux()
+	mux.Handle(scim.BasePath+"/", scimHandler)
+	mux.Handle("GET /livez", checker.LivezHandler())
+	mux.Handle("GET /readyz", checker.ReadyzHandler())
+	mux.Handle("GET /openapi.json", corsPolicy.Handler(gateway.OpenAPIHandler(openAPI)))
+	mux.Handle("/", corsPolicy.Handler(gw))
+
+	httpServer := &http.Server{
+		Addr:              ":" + cfg.AppPort,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// ライフサイクル管理
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
-			// シグナル待機を別goroutineで実行
+			lis, err := net.Listen("tcp", httpServer.Addr)
+			if err != nil {
+				return fmt.Errorf("failed to listen: %w", err)
+			}
+			log.Printf("HTTP server starting on port %s", cfg.AppPort)
 			go func() {
-				quit := make(chan os.Signal, 1)
-				signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-				<-quit
-				log.Println("Received shutdown signal")
-				os.Exit(0)
+				if err := httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					log.Fatalf("HTTP server failed to serve: %v", err)
+				}
 			}()
 			return nil
 		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Shutting down HTTP server...")
+			return httpServer.Shutdown(ctx)
+		},
+	})
+
+	return nil
+}
+
+// newHealthChecker ヘルスチェックを作成（Postgresへの接続、マイグレーションのバージョン、Supabase Authの到達性）
+func newHealthChecker(cfg *config.Config, database *db.DB) (*health.Checker, error) {
+	checker := health.NewChecker(cfg.HealthCheckTimeout)
+	checker.AddCheck("postgres", database.HealthCheck)
+
+	// 適用済みのマイグレーションがバイナリに含まれる最新のバージョン以上であること
+	// （ローリングアップデート中に新しいバージョンのマイグレーションが適用されていても準備完了とする）
+	if cfg.HealthCheckMigrations {
+		required, err := migrations.LatestVersion()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get migration version: %w", err)
+		}
+		checker.AddCheck("migrations", func(ctx context.Context) error {
+			applied, err := database.MigrationVersion(ctx)
+			if err != nil {
+				return err
+			}
+			if applied < required {
+				return fmt.Errorf("migration version %d is older than required %d", applied, required)
+			}
+			return nil
+		})
+	}
+
+	checker.AddCheck("identity_provider", health.HTTPCheck(
+		&http.Client{Timeout: cfg.HealthCheckTimeout},
+		strings.TrimSuffix(cfg.SupabaseURL, "/")+"/auth/v1/health",
+		http.Header{"Apikey": []string{cfg.SupabaseServiceRoleKey}},
+	))
+	return checker, nil
+}
+
+// registerShutdown グレースフルシャットダウンを登録
+// SIGINT・SIGTERMはapp.Runが受け取り、OnStopを登録と逆の順序で実行する（最後に登録したこのフックが最初に実行される）
+// ヘルスチェックをNOT_SERVINGにして、ロードバランサーが振り分けを止めるまで待ってからサーバーを停止する
+func registerShutdown(lc fx.Lifecycle, cfg *config.Config, checker *health.Checker) {
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			log.Println("Received shutdown signal")
+			checker.Shutdown()
+			select {
+			case <-time.After(cfg.ShutdownDrainDelay):
+			case <-ctx.Done():
+			}
+			return nil
+		},
 	})
 }
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/health"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+)
+
+func TestServerInterceptors_HealthWithoutToken(t *testing.T) {
+	// 認証前のレート制限を1回/分にしても、プローブは制限されない
+	cfg := &config.Config{
+		RateLimitEnabled:       true,
+		RateLimitDefault:       "1/m",
+		RateLimitPublicDefault: "1/m",
+		RateLimitPreAuth:       "1/m",
+	}
+	interceptors, err := newServerInterceptors(cfg, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors.unary...),
+		grpc.ChainStreamInterceptor(interceptors.stream...),
+	)
+	pbauth.RegisterAuthServiceServer(grpcServer, pbauth.UnimplementedAuthServiceServer{})
+	checker := health.NewChecker(time.Second)
+	checker.Register(grpcServer, pbauth.AuthService_ServiceDesc.ServiceName)
+	checker.Update(context.Background())
+	reflection.Register(grpcServer)
+
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	ctx := context.Background()
+
+	// ヘルスチェックはトークンなしでSERVINGを返す（Unary・Watchのストリーム）
+	healthClient := healthpb.NewHealthClient(conn)
+	for i := 0; i < 3; i++ {
+		resp, err := healthClient.Check(ctx, &healthpb.HealthCheckRequest{Service: pbauth.AuthService_ServiceDesc.ServiceName})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	}
+	watch, err := healthClient.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	resp, err := watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	// リフレクションもトークンなしで利用できる
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	// 認証が必要なメソッドはトークンなしでは拒否される
+	_, err = pbauth.NewAuthServiceClient(conn).GetMe(ctx, &pbauth.GetMeRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,259 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"contract-pro-suite/internal/shared/cors"
+)
+
+// Gateway gRPCサービスをHTTP/JSONで提供するゲートウェイ
+// google.api.httpアノテーションに従ってHTTPリクエストをリクエストメッセージに変換し、
+// gRPCサーバーと同じインターセプターを経由してサービスの実装をプロセス内で呼び出す
+type Gateway struct {
+	mux               *http.ServeMux
+	routes            []*route // 登録順（OpenAPIのドキュメントの作成に使用）
+	unaryInterceptor  grpc.UnaryServerInterceptor
+	streamInterceptor grpc.StreamServerInterceptor
+}
+
+// route google.api.httpアノテーションの1つのバインディング
+type route struct {
+	fullMethod   string
+	method       protoreflect.MethodDescriptor
+	httpMethod   string
+	path         string // パスのテンプレート（テンプレート変数は{name}の形式）
+	impl         any
+	unary        *grpc.MethodDesc
+	stream       *grpc.StreamDesc
+	pathParams   []string // パスのテンプレート変数（リクエストメッセージのフィールド名）
+	body         string   // リクエストボディを割り当てるフィールド（"*"はメッセージ全体、空の場合はボディなし）
+	responseBody string   // レスポンスとして返すフィールド（空の場合はメッセージ全体）
+}
+
+// New ゲートウェイを作成（インターセプターはgRPCサーバーと同じものを同じ順序で指定する）
+func New(unaryInterceptors []grpc.UnaryServerInterceptor, streamInterceptors []grpc.StreamServerInterceptor) *Gateway {
+	g := &Gateway{
+		mux:               http.NewServeMux(),
+		unaryInterceptor:  chainUnaryInterceptors(unaryInterceptors),
+		streamInterceptor: chainStreamInterceptors(streamInterceptors),
+	}
+	g.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, status.Errorf(codes.NotFound, "no route for %s %s", r.Method, r.URL.Path))
+	})
+	return g
+}
+
+// CORSPolicy ゲートウェイのCORS設定（ブラウザのSPAから呼び出すためのメソッド・ヘッダー）
+func CORSPolicy(allowedOrigins []string) cors.Policy {
+	return cors.Policy{
+		AllowedOrigins: allowedOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowedHeaders: []string{"Authorization", "Content-Type", "X-Client-Id"},
+		ExposedHeaders: []string{"Retry-After", "Content-Disposition"},
+		MaxAge:         600,
+	}
+}
+
+// RegisterService grpc.ServiceRegistrarの実装。google.api.httpアノテーションを持つメソッドをルートとして登録する
+// （アノテーションのないメソッド・クライアントストリーミングRPCはHTTPでは提供しない）
+// アノテーションが不正な場合はgrpc.Serverと同じく起動時の設定誤りとしてpanicする
+func (g *Gateway) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	found, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(desc.ServiceName))
+	if err != nil {
+		panic(fmt.Sprintf("gateway: service %s not found: %v", desc.ServiceName, err))
+	}
+	service, ok := found.(protoreflect.ServiceDescriptor)
+	if !ok {
+		panic(fmt.Sprintf("gateway: %s is not a service", desc.ServiceName))
+	}
+
+	for i := range desc.Methods {
+		method := &desc.Methods[i]
+		g.registerMethod(service.Methods().ByName(protoreflect.Name(method.MethodName)), route{
+			fullMethod: "/" + desc.ServiceName + "/" + method.MethodName,
+			impl:       impl,
+			unary:      method,
+		})
+	}
+	for i := range desc.Streams {
+		stream := &desc.Streams[i]
+		if stream.ClientStreams {
+			continue
+		}
+		g.registerMethod(service.Methods().ByName(protoreflect.Name(stream.StreamName)), route{
+			fullMethod: "/" + desc.ServiceName + "/" + stream.StreamName,
+			impl:       impl,
+			stream:     stream,
+		})
+	}
+}
+
+// registerMethod メソッドのアノテーション（additional_bindingsを含む）をルートとして登録
+func (g *Gateway) registerMethod(method protoreflect.MethodDescriptor, base route) {
+	if method == nil {
+		panic(fmt.Sprintf("gateway: %s not found in the service descriptor", base.fullMethod))
+	}
+	rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return
+	}
+	bindings := append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...)
+	for _, binding := range bindings {
+		rt := base
+		rt.method = method
+		if err := rt.bind(method, binding); err != nil {
+			panic(fmt.Sprintf("gateway: invalid google.api.http annotation of %s: %v", base.fullMethod, err))
+		}
+		pattern := rt.httpMethod + " " + rt.path
+		if rt.unary != nil {
+			g.mux.HandleFunc(pattern, g.serveUnary(&rt))
+		} else {
+			g.mux.HandleFunc(pattern, g.serveStream(&rt))
+		}
+		g.routes = append(g.routes, &rt)
+	}
+}
+
+// bind アノテーションを検証してルートに設定（http.ServeMuxのパターンは"<httpMethod> <path>"）
+func (rt *route) bind(method protoreflect.MethodDescriptor, rule *annotations.HttpRule) error {
+	var httpMethod, template string
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		httpMethod, template = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		httpMethod, template = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		httpMethod, template = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		httpMethod, template = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		httpMethod, template = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		httpMethod, template = strings.ToUpper(pattern.Custom.GetKind()), pattern.Custom.GetPath()
+	default:
+		return fmt.Errorf("no http method")
+	}
+	if !strings.HasPrefix(template, "/") {
+		return fmt.Errorf("path %q must start with /", template)
+	}
+
+	// テンプレート変数は単一のセグメントに対応するフィールド（{name}または{name=*}）のみ対応
+	input := method.Input()
+	segments := strings.Split(template, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, "{") {
+			continue
+		}
+		name, pattern, _ := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}"), "=")
+		if pattern != "" && pattern != "*" {
+			return fmt.Errorf("unsupported path pattern %q", segment)
+		}
+		field := input.Fields().ByName(protoreflect.Name(name))
+		if field == nil || field.IsList() || field.IsMap() || field.Kind() == protoreflect.MessageKind {
+			return fmt.Errorf("path variable %q must be a singular scalar field of %s", name, input.FullName())
+		}
+		segments[i] = "{" + name + "}"
+		rt.pathParams = append(rt.pathParams, name)
+	}
+
+	rt.body = rule.GetBody()
+	if rt.body != "" && rt.body != "*" {
+		field := input.Fields().ByName(protoreflect.Name(rt.body))
+		if field == nil || field.IsList() || field.Kind() != protoreflect.MessageKind {
+			return fmt.Errorf("body %q must be a message field of %s", rt.body, input.FullName())
+		}
+	}
+	rt.responseBody = rule.GetResponseBody()
+	if rt.responseBody != "" {
+		field := method.Output().Fields().ByName(protoreflect.Name(rt.responseBody))
+		if field == nil || field.IsList() || field.Kind() != protoreflect.BytesKind {
+			return fmt.Errorf("response_body %q must be a bytes field of %s", rt.responseBody, method.Output().FullName())
+		}
+	}
+	rt.httpMethod, rt.path = httpMethod, strings.Join(segments, "/")
+	return nil
+}
+
+// ServeHTTP ルートに対応するRPCを呼び出す（対応するルートがない場合はNOT_FOUND）
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// serveUnary Unary RPCを呼び出し、レスポンスメッセージをJSONで返す
+func (g *Gateway) serveUnary(rt *route) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, transport := newContext(r, rt.fullMethod)
+		decode := func(req any) error {
+			return decodeRequest(r, rt, req.(proto.Message))
+		}
+		resp, err := rt.unary.Handler(rt.impl, ctx, decode, g.unaryInterceptor)
+		transport.writeHeader(w)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeMessage(w, resp.(proto.Message))
+	}
+}
+
+// serveStream サーバーストリーミングRPCを呼び出す
+// response_bodyを指定したルートはフィールドの内容をそのまま、それ以外は改行区切りのJSON（{"result": ...}）で返す
+func (g *Gateway) serveStream(rt *route) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, transport := newContext(r, rt.fullMethod)
+		stream := &serverStream{ctx: ctx, transport: transport, w: w, r: r, route: rt}
+		info := &grpc.StreamServerInfo{FullMethod: rt.fullMethod, IsServerStream: true}
+		err := g.streamInterceptor(rt.impl, stream, info, rt.stream.Handler)
+		if err == nil {
+			if !stream.started {
+				stream.start(nil)
+			}
+			return
+		}
+		if !stream.started {
+			transport.writeHeader(w)
+			writeError(w, err)
+			return
+		}
+		stream.abort(err)
+	}
+}
+
+// chainUnaryInterceptors インターセプターを順に適用する1つのインターセプターを作成（grpc.ChainUnaryInterceptorと同じ順序）
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// chainStreamInterceptors インターセプターを順に適用する1つのインターセプターを作成（grpc.ChainStreamInterceptorと同じ順序）
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(srv any, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, inner)
+			}
+		}
+		return next(srv, ss)
+	}
+}
@@ -0,0 +1,235 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	pbauthv2 "contract-pro-suite/proto/contractpro/auth/v2"
+)
+
+// fakeAuthServer 受信したリクエスト・メタデータを記録するAuthServiceの実装
+type fakeAuthServer struct {
+	pbauth.UnimplementedAuthServiceServer
+	md   metadata.MD
+	peer string
+	req  any
+	err  error
+}
+
+func (s *fakeAuthServer) record(ctx context.Context, req any) {
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	if p, ok := peer.FromContext(ctx); ok {
+		s.peer = p.Addr.String()
+	}
+	s.req = req
+}
+
+func (s *fakeAuthServer) GetClientUser(ctx context.Context, req *pbauth.GetClientUserRequest) (*pbauth.GetClientUserResponse, error) {
+	s.record(ctx, req)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &pbauth.GetClientUserResponse{User: &pbauth.ClientUser{ClientUserId: req.GetClientUserId(), Email: "user@example.com"}}, nil
+}
+
+func (s *fakeAuthServer) ListClientUsers(ctx context.Context, req *pbauth.ListClientUsersRequest) (*pbauth.ListClientUsersResponse, error) {
+	s.record(ctx, req)
+	return &pbauth.ListClientUsersResponse{Total: 1}, nil
+}
+
+func (s *fakeAuthServer) CreateClientUser(ctx context.Context, req *pbauth.CreateClientUserRequest) (*pbauth.CreateClientUserResponse, error) {
+	s.record(ctx, req)
+	return &pbauth.CreateClientUserResponse{User: &pbauth.ClientUser{Email: req.GetEmail()}}, nil
+}
+
+func (s *fakeAuthServer) ExportClientUsers(req *pbauth.ExportClientUsersRequest, stream grpc.ServerStreamingServer[pbauth.ExportClientUsersResponse]) error {
+	s.record(stream.Context(), req)
+	if s.err != nil {
+		return s.err
+	}
+	if err := stream.Send(&pbauth.ExportClientUsersResponse{Chunk: []byte("email\n"), ContentType: "text/csv", Filename: "users.csv"}); err != nil {
+		return err
+	}
+	return stream.Send(&pbauth.ExportClientUsersResponse{Chunk: []byte("user@example.com\n")})
+}
+
+func newTestGateway(t *testing.T, unary ...grpc.UnaryServerInterceptor) (*Gateway, *fakeAuthServer) {
+	t.Helper()
+	var stream []grpc.StreamServerInterceptor
+	for _, interceptor := range unary {
+		stream = append(stream, func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			_, err := interceptor(ss.Context(), nil, &grpc.UnaryServerInfo{FullMethod: info.FullMethod}, func(context.Context, any) (any, error) {
+				return nil, handler(srv, ss)
+			})
+			return err
+		})
+	}
+	gw := New(unary, stream)
+	server := &fakeAuthServer{}
+	pbauth.RegisterAuthServiceServer(gw, server)
+	pbauthv2.RegisterAuthServiceServer(gw, pbauthv2.UnimplementedAuthServiceServer{})
+	return gw, server
+}
+
+func serve(gw *Gateway, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	gw.ServeHTTP(rec, req)
+	return rec
+}
+
+// decodeStatus エラーレスポンス（google.rpc.Status）を取得
+func decodeStatus(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	t.Helper()
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode error body: %v", err)
+	}
+	return body
+}
+
+func TestGateway_Unary(t *testing.T) {
+	var fullMethod string
+	gw, server := newTestGateway(t, func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		fullMethod = info.FullMethod
+		return handler(ctx, req)
+	})
+
+	// パスのテンプレート変数とヘッダーがリクエスト・メタデータに変換される
+	req := httptest.NewRequest(http.MethodGet, "/v1/client-users/8a6e0804-2bd0-4672-b79d-d97027f9071a", nil)
+	req.Host = "acme.contractprosuite.com"
+	req.RemoteAddr = "203.0.113.10:54321"
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("X-Client-Id", "11111111-1111-1111-1111-111111111111")
+	rec := serve(gw, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, pbauth.AuthService_GetClientUser_FullMethodName, fullMethod)
+	assert.Equal(t, "8a6e0804-2bd0-4672-b79d-d97027f9071a", server.req.(*pbauth.GetClientUserRequest).GetClientUserId())
+	assert.Equal(t, []string{"Bearer token"}, server.md.Get("authorization"))
+	assert.Equal(t, []string{"11111111-1111-1111-1111-111111111111"}, server.md.Get("x-client-id"))
+	assert.Equal(t, []string{"acme.contractprosuite.com"}, server.md.Get(":authority"))
+	assert.Equal(t, "203.0.113.10:54321", server.peer)
+	var resp map[string]map[string]any
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "user@example.com", resp["user"]["email"])
+	assert.Equal(t, "8a6e0804-2bd0-4672-b79d-d97027f9071a", resp["user"]["clientUserId"])
+
+	// body: "*"はボディ全体をリクエストに割り当てる（フィールド名・JSON名の両方を受け付ける）
+	req = httptest.NewRequest(http.MethodPost, "/v1/client-users", strings.NewReader(`{"email":"new@example.com","first_name":"太郎","lastName":"山田","unknown":1}`))
+	rec = serve(gw, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	created := server.req.(*pbauth.CreateClientUserRequest)
+	assert.Equal(t, "new@example.com", created.GetEmail())
+	assert.Equal(t, "太郎", created.GetFirstName())
+	assert.Equal(t, "山田", created.GetLastName())
+
+	// クエリパラメータ（列挙型は名前、存在しないパラメータは無視）
+	req = httptest.NewRequest(http.MethodGet, "/v1/client-users?limit=10&pageToken=abc&state=USER_STATUS_ACTIVE&order_by=name&_=1", nil)
+	rec = serve(gw, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	listed := server.req.(*pbauth.ListClientUsersRequest)
+	assert.Equal(t, int32(10), listed.GetLimit())
+	assert.Equal(t, "abc", listed.GetPageToken())
+	assert.Equal(t, pbauth.UserStatus_USER_STATUS_ACTIVE, listed.GetState())
+	assert.Equal(t, "name", listed.GetOrderBy())
+}
+
+func TestGateway_Errors(t *testing.T) {
+	gw, server := newTestGateway(t)
+
+	tests := []struct {
+		name       string
+		req        *http.Request
+		err        error
+		wantStatus int
+		wantCode   codes.Code
+	}{
+		{"不正なJSON", httptest.NewRequest(http.MethodPost, "/v1/client-users", strings.NewReader(`{"email":`)), nil, http.StatusBadRequest, codes.InvalidArgument},
+		{"不正なクエリパラメータ", httptest.NewRequest(http.MethodGet, "/v1/client-users?limit=abc", nil), nil, http.StatusBadRequest, codes.InvalidArgument},
+		{"存在しないルート", httptest.NewRequest(http.MethodGet, "/v1/unknown", nil), nil, http.StatusNotFound, codes.NotFound},
+		{"ハンドラーのエラー", httptest.NewRequest(http.MethodGet, "/v1/client-users/1", nil), status.Error(codes.FailedPrecondition, "etag mismatch"), http.StatusBadRequest, codes.FailedPrecondition},
+		{"未実装のメソッド", httptest.NewRequest(http.MethodGet, "/v1/me", nil), nil, http.StatusNotImplemented, codes.Unimplemented},
+		{"v2のルート", httptest.NewRequest(http.MethodGet, "/v2/client-users", nil), nil, http.StatusNotImplemented, codes.Unimplemented},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.err = tt.err
+			rec := serve(gw, tt.req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			body := decodeStatus(t, rec)
+			assert.Equal(t, float64(tt.wantCode), body["code"])
+			assert.NotEmpty(t, body["message"])
+		})
+	}
+}
+
+func TestGateway_ResponseHeaders(t *testing.T) {
+	// インターセプターが設定したメタデータはレスポンスのヘッダーとして返す（エラーの場合も同じ）
+	gw, _ := newTestGateway(t, func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", "30", "x-request-id", "abc"))
+		return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	})
+
+	rec := serve(gw, httptest.NewRequest(http.MethodGet, "/v1/client-users", nil))
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "30", rec.Header().Get("Retry-After"))
+	assert.Equal(t, "abc", rec.Header().Get("Grpc-Metadata-X-Request-Id"))
+	assert.Equal(t, float64(codes.ResourceExhausted), decodeStatus(t, rec)["code"])
+}
+
+func TestGateway_Stream(t *testing.T) {
+	var fullMethod string
+	gw, server := newTestGateway(t, func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		fullMethod = info.FullMethod
+		return handler(ctx, req)
+	})
+
+	// response_bodyのフィールドをそのまま返し、最初のメッセージからContent-Type・ファイル名を設定
+	rec := serve(gw, httptest.NewRequest(http.MethodGet, "/v1/client-users/export?format=csv&query=yamada", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, pbauth.AuthService_ExportClientUsers_FullMethodName, fullMethod)
+	assert.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=users.csv`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "email\nuser@example.com\n", rec.Body.String())
+	exported := server.req.(*pbauth.ExportClientUsersRequest)
+	assert.Equal(t, "csv", exported.GetFormat())
+	assert.Equal(t, "yamada", exported.GetQuery())
+
+	// 送信前のエラーは通常のエラーレスポンス
+	server.err = status.Error(codes.PermissionDenied, "permission denied")
+	rec = serve(gw, httptest.NewRequest(http.MethodGet, "/v1/client-users/export", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, float64(codes.PermissionDenied), decodeStatus(t, rec)["code"])
+}
+
+func TestChainUnaryInterceptors(t *testing.T) {
+	var calls []string
+	interceptor := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			calls = append(calls, name)
+			return handler(ctx, req)
+		}
+	}
+	chain := chainUnaryInterceptors([]grpc.UnaryServerInterceptor{interceptor("first"), interceptor("second")})
+	resp, err := chain(context.Background(), "req", &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		calls = append(calls, "handler")
+		return req, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "req", resp)
+	assert.Equal(t, []string{"first", "second", "handler"}, calls)
+}
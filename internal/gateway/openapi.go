@@ -0,0 +1,611 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	pbvalidate "contract-pro-suite/proto/validate"
+)
+
+// OpenAPIのドキュメントの情報（info）
+const (
+	openAPIVersion  = "3.1.0"
+	openAPITitle    = "ContractProSuite API"
+	openAPIDocument = "1.0.0"
+)
+
+// exampleMaxDepth 例の値を作成するメッセージの入れ子の深さの上限（再帰的なメッセージの対策）
+const exampleMaxDepth = 3
+
+// 例の値（フィールド名から推測する）
+const (
+	exampleID        = "8a6e0804-2bd0-4672-b79d-d97027f9071a"
+	exampleEmail     = "user@example.com"
+	exampleTimestamp = "2025-01-01T00:00:00Z"
+)
+
+// OpenAPI 登録済みのルートからOpenAPI 3.1のドキュメント（JSON）を作成
+// スキーマはリクエスト・レスポンスのメッセージの定義（protojsonの表現）から作成し、
+// 認証の要否はisPublic（認証不要のメソッドの判定、interceptor.IsPublicMethod）で決める
+func (g *Gateway) OpenAPI(isPublic func(fullMethod string) bool) ([]byte, error) {
+	b := &openAPIBuilder{schemas: map[string]any{}}
+	paths := map[string]map[string]any{}
+	services := map[string]bool{}
+	operationIDs := map[string]int{}
+	for _, rt := range g.routes {
+		service := string(rt.method.Parent().FullName())
+		services[service] = true
+
+		// additional_bindingsのルートはoperationIdに連番を付ける
+		operationID := service + "." + string(rt.method.Name())
+		if n := operationIDs[operationID]; n > 0 {
+			operationIDs[operationID]++
+			operationID = fmt.Sprintf("%s_%d", operationID, n)
+		} else {
+			operationIDs[operationID] = 1
+		}
+
+		if paths[rt.path] == nil {
+			paths[rt.path] = map[string]any{}
+		}
+		paths[rt.path][strings.ToLower(rt.httpMethod)] = b.operation(rt, operationID, isPublic(rt.fullMethod))
+	}
+
+	var tags []any
+	for _, service := range sortedKeys(services) {
+		tags = append(tags, map[string]any{"name": service})
+	}
+
+	// エラーレスポンスのスキーマ（google.rpc.Status）
+	statusSchema := b.schemaRef((&spb.Status{}).ProtoReflect().Descriptor())
+
+	doc := map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":   openAPITitle,
+			"version": openAPIDocument,
+			"description": "auth.protoのgoogle.api.httpアノテーションから生成したHTTP/JSONのAPI。" +
+				"フィールド名はlowerCamelCase（protojson）で、レスポンスは未設定のフィールドも含む。",
+		},
+		"tags":     tags,
+		"paths":    paths,
+		"security": authenticated,
+		"components": map[string]any{
+			"schemas":         b.schemas,
+			"responses":       errorResponses(statusSchema),
+			"parameters":      map[string]any{"ClientId": clientIDParameter},
+			"securitySchemes": securitySchemes,
+		},
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal openapi document: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// OpenAPIHandler OpenAPIのドキュメントを返すハンドラー
+func OpenAPIHandler(document []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(document)
+	})
+}
+
+// securitySchemes AuthInterceptorが受け付ける認証方式
+var securitySchemes = map[string]any{
+	"bearerAuth": map[string]any{
+		"type":         "http",
+		"scheme":       "bearer",
+		"bearerFormat": "JWT",
+		"description":  "Supabase Auth・クライアントに登録された外部IdPのJWT、またはサービスアカウントのAPIキー（接頭辞cps_）",
+	},
+	"apiKeyAuth": map[string]any{
+		"type":        "apiKey",
+		"in":          "header",
+		"name":        "Authorization",
+		"description": "サービスアカウントのAPIキー（\"ApiKey <key>\"の形式）",
+	},
+}
+
+// authenticated 認証が必要なメソッドのsecurity（いずれかの認証方式）
+var authenticated = []any{
+	map[string]any{"bearerAuth": []any{}},
+	map[string]any{"apiKeyAuth": []any{}},
+}
+
+// clientIDParameter 操作対象のクライアント（テナント）を指定するヘッダー
+var clientIDParameter = map[string]any{
+	"name":        "X-Client-Id",
+	"in":          "header",
+	"required":    false,
+	"description": "操作対象のクライアントID（オペレーターがクライアントのデータを操作する場合）",
+	"schema":      map[string]any{"type": "string", "format": "uuid"},
+}
+
+// errorResponse エラーレスポンスの定義
+type errorResponse struct {
+	name        string
+	status      string
+	description string
+	example     map[string]any
+}
+
+// errorResponseDefs ルートに応じて返すエラーレスポンス（gRPCステータスコードに対応するHTTPステータス）
+var errorResponseDefs = []errorResponse{
+	{"InvalidArgument", "400", "リクエストが不正（INVALID_ARGUMENT、FAILED_PRECONDITION、OUT_OF_RANGE）", map[string]any{
+		"code":    3,
+		"message": "invalid request: email must be a valid email address",
+		"details": []any{
+			map[string]any{
+				"@type": "type.googleapis.com/google.rpc.ErrorInfo", "reason": "INVALID_REQUEST", "domain": "auth.contract-pro-suite", "metadata": map[string]any{},
+			},
+			map[string]any{
+				"@type":           "type.googleapis.com/google.rpc.BadRequest",
+				"fieldViolations": []any{map[string]any{"field": "email", "description": "must be a valid email address", "reason": "INVALID_EMAIL"}},
+			},
+		},
+	}},
+	{"Unauthenticated", "401", "認証情報がない、または無効（UNAUTHENTICATED）", map[string]any{
+		"code": 16, "message": "authorization header required", "details": []any{},
+	}},
+	{"PermissionDenied", "403", "権限がない（PERMISSION_DENIED）", map[string]any{
+		"code": 7, "message": "client access denied", "details": []any{},
+	}},
+	{"NotFound", "404", "リソースが存在しない（NOT_FOUND）", map[string]any{
+		"code":    5,
+		"message": "client user not found",
+		"details": []any{map[string]any{
+			"@type": "type.googleapis.com/google.rpc.ErrorInfo", "reason": "CLIENT_USER_NOT_FOUND", "domain": "auth.contract-pro-suite", "metadata": map[string]any{},
+		}},
+	}},
+	{"ResourceExhausted", "429", "レート制限を超過（RESOURCE_EXHAUSTED、Retry-Afterの秒数後に再試行する）", map[string]any{
+		"code":    8,
+		"message": "rate limit exceeded",
+		"details": []any{map[string]any{"@type": "type.googleapis.com/google.rpc.RetryInfo", "retryDelay": "30s"}},
+	}},
+	{"Internal", "500", "サーバーのエラー（INTERNAL、UNKNOWN）", map[string]any{
+		"code": 13, "message": "internal error", "details": []any{},
+	}},
+	{"Error", "default", "その他のエラー（google.rpc.Status）", map[string]any{
+		"code": 14, "message": "service unavailable", "details": []any{},
+	}},
+}
+
+// errorResponses components.responsesのエラーレスポンス
+func errorResponses(statusSchema map[string]any) map[string]any {
+	responses := map[string]any{}
+	for _, def := range errorResponseDefs {
+		response := map[string]any{
+			"description": def.description,
+			"content": map[string]any{
+				"application/json": map[string]any{"schema": statusSchema, "example": def.example},
+			},
+		}
+		if def.status == "429" {
+			response["headers"] = map[string]any{
+				"Retry-After": map[string]any{
+					"description": "再試行までの秒数",
+					"schema":      map[string]any{"type": "integer"},
+				},
+			}
+		}
+		responses[def.name] = response
+	}
+	return responses
+}
+
+// openAPIBuilder メッセージ・列挙型のスキーマ（components.schemas）を作成しながらドキュメントを組み立てる
+type openAPIBuilder struct {
+	schemas map[string]any
+}
+
+// operation ルートの操作（パラメータ・リクエストボディ・レスポンス）
+func (b *openAPIBuilder) operation(rt *route, operationID string, public bool) map[string]any {
+	input := rt.method.Input()
+	op := map[string]any{
+		"operationId": operationID,
+		"summary":     string(rt.method.Name()),
+		"tags":        []any{string(rt.method.Parent().FullName())},
+	}
+	if options, ok := rt.method.Options().(*descriptorpb.MethodOptions); ok && options.GetDeprecated() {
+		op["deprecated"] = true
+	}
+
+	parameters := []any{}
+	for _, name := range rt.pathParams {
+		parameters = append(parameters, map[string]any{
+			"name":     name,
+			"in":       "path",
+			"required": true,
+			"schema":   b.fieldSchema(input.Fields().ByName(protoreflect.Name(name))),
+		})
+	}
+	if rt.body != "*" {
+		exclude := map[string]bool{rt.body: true}
+		for _, name := range rt.pathParams {
+			exclude[name] = true
+		}
+		parameters = append(parameters, b.queryParameters(input, "", exclude, 0)...)
+	}
+	if public {
+		op["security"] = []any{}
+	} else {
+		parameters = append(parameters, map[string]any{"$ref": "#/components/parameters/ClientId"})
+	}
+	op["parameters"] = parameters
+
+	switch rt.body {
+	case "":
+	case "*":
+		op["requestBody"] = jsonContent("", b.schemaRef(input), example(input, 0))
+	default:
+		field := input.Fields().ByName(protoreflect.Name(rt.body)).Message()
+		op["requestBody"] = jsonContent("", b.schemaRef(field), example(field, 0))
+	}
+
+	responses := map[string]any{"200": b.successResponse(rt)}
+	for _, def := range errorResponseDefs {
+		switch {
+		case (def.status == "401" || def.status == "403") && public:
+			continue
+		case def.status == "404" && len(rt.pathParams) == 0:
+			continue
+		}
+		responses[def.status] = map[string]any{"$ref": "#/components/responses/" + def.name}
+	}
+	op["responses"] = responses
+	return op
+}
+
+// successResponse 成功時のレスポンス
+// サーバーストリーミングRPCはresponse_bodyのフィールドの内容をそのまま、それ以外は改行区切りのJSONで返す
+func (b *openAPIBuilder) successResponse(rt *route) map[string]any {
+	output := rt.method.Output()
+	switch {
+	case rt.unary != nil:
+		return jsonContent("OK", b.schemaRef(output), example(output, 0))
+	case rt.responseBody != "":
+		return map[string]any{
+			"description": fmt.Sprintf("%sの内容（Content-Type・ファイル名は最初のメッセージのcontent_type・filenameから決める）", rt.responseBody),
+			"headers": map[string]any{
+				"Content-Disposition": map[string]any{"schema": map[string]any{"type": "string"}},
+			},
+			"content": map[string]any{"application/octet-stream": map[string]any{}},
+		}
+	default:
+		return map[string]any{
+			"description": "改行区切りのJSON（メッセージごとに{\"result\": ...}、途中でエラーが発生した場合は{\"error\": ...}）",
+			"content": map[string]any{
+				"application/x-ndjson": map[string]any{
+					"schema": map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"result": b.schemaRef(output),
+							"error":  b.schemaRef((&spb.Status{}).ProtoReflect().Descriptor()),
+						},
+					},
+				},
+			},
+		}
+	}
+}
+
+// queryParameters クエリパラメータ（メッセージのフィールドは"."区切りのパスで展開する）
+func (b *openAPIBuilder) queryParameters(msg protoreflect.MessageDescriptor, prefix string, exclude map[string]bool, depth int) []any {
+	var parameters []any
+	fields := msg.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := prefix + string(field.Name())
+		if exclude[name] || field.IsMap() {
+			continue
+		}
+		if field.Kind() == protoreflect.MessageKind && !field.IsList() && wellKnownSchema(field.Message().FullName()) == nil {
+			if depth < exampleMaxDepth {
+				parameters = append(parameters, b.queryParameters(field.Message(), name+".", exclude, depth+1)...)
+			}
+			continue
+		}
+		parameters = append(parameters, map[string]any{
+			"name":   name,
+			"in":     "query",
+			"schema": b.fieldSchema(field),
+		})
+	}
+	return parameters
+}
+
+// schemaRef メッセージのスキーマの参照（Well-Known Typesはスキーマそのもの）
+func (b *openAPIBuilder) schemaRef(msg protoreflect.MessageDescriptor) map[string]any {
+	if schema := wellKnownSchema(msg.FullName()); schema != nil {
+		return schema
+	}
+	name := string(msg.FullName())
+	if _, ok := b.schemas[name]; !ok {
+		b.schemas[name] = nil // 再帰的なメッセージのため先に登録する
+		properties := map[string]any{}
+		var required []any
+		fields := msg.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			schema := b.fieldSchema(field)
+			if options, ok := field.Options().(*descriptorpb.FieldOptions); ok && options.GetDeprecated() {
+				schema["deprecated"] = true
+			}
+			properties[field.JSONName()] = schema
+			if fieldRules(field).GetRequired() {
+				required = append(required, field.JSONName())
+			}
+		}
+		schema := map[string]any{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		b.schemas[name] = schema
+	}
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// enumRef 列挙型のスキーマの参照（値の名前）
+func (b *openAPIBuilder) enumRef(enum protoreflect.EnumDescriptor) map[string]any {
+	if enum.FullName() == "google.protobuf.NullValue" {
+		return map[string]any{"type": "null"}
+	}
+	name := string(enum.FullName())
+	if _, ok := b.schemas[name]; !ok {
+		var names []any
+		values := enum.Values()
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		b.schemas[name] = map[string]any{"type": "string", "enum": names}
+	}
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// fieldSchema フィールドのスキーマ（repeatedは配列、mapはオブジェクト）
+// 文字列のフィールドはauth.protoで宣言したルール（validate.field）を制約として設定する
+func (b *openAPIBuilder) fieldSchema(field protoreflect.FieldDescriptor) map[string]any {
+	if field.IsMap() {
+		return map[string]any{"type": "object", "additionalProperties": b.valueSchema(field.MapValue())}
+	}
+	schema := b.valueSchema(field)
+	if rules := fieldRules(field); rules.GetString_() != nil && field.Kind() == protoreflect.StringKind {
+		applyStringRules(schema, rules.GetString_(), rules.GetRequired())
+	}
+	if field.IsList() {
+		return map[string]any{"type": "array", "items": schema}
+	}
+	return schema
+}
+
+// applyStringRules 文字列のルールをJSON Schemaの制約として設定
+func applyStringRules(schema map[string]any, rules *pbvalidate.StringRules, required bool) {
+	if rules.MinLen != nil {
+		schema["minLength"] = rules.GetMinLen()
+	}
+	if rules.MaxLen != nil {
+		schema["maxLength"] = rules.GetMaxLen()
+	}
+	switch {
+	case rules.GetEmail():
+		schema["format"] = "email"
+	case rules.GetUuid():
+		schema["format"] = "uuid"
+	case rules.GetDateTime():
+		schema["format"] = "date-time"
+	case rules.GetJson(), rules.GetJsonObject():
+		schema["contentMediaType"] = "application/json"
+	}
+	if len(rules.GetIn()) > 0 {
+		values := make([]any, 0, len(rules.GetIn())+1)
+		for _, value := range rules.GetIn() {
+			values = append(values, value)
+		}
+		// 必須でないフィールドの空文字は未指定として扱う
+		if !required {
+			values = append(values, "")
+		}
+		schema["enum"] = values
+	}
+	if rules.GetPattern() != "" {
+		schema["pattern"] = "^(?:" + rules.GetPattern() + ")$"
+	}
+}
+
+// fieldRules フィールドに宣言されたルール（宣言されていない場合はnil）
+func fieldRules(field protoreflect.FieldDescriptor) *pbvalidate.FieldRules {
+	options, ok := field.Options().(*descriptorpb.FieldOptions)
+	if !ok || options == nil || !proto.HasExtension(options, pbvalidate.E_Field) {
+		return nil
+	}
+	rules, _ := proto.GetExtension(options, pbvalidate.E_Field).(*pbvalidate.FieldRules)
+	return rules
+}
+
+// valueSchema 単一の値のスキーマ（protojsonの表現、64ビット整数は文字列）
+func (b *openAPIBuilder) valueSchema(field protoreflect.FieldDescriptor) map[string]any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "contentEncoding": "base64"}
+	case protoreflect.EnumKind:
+		return b.enumRef(field.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.schemaRef(field.Message())
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
+
+// wellKnownSchema Well-Known Typesのスキーマ（protojsonの表現、該当しない場合はnil）
+func wellKnownSchema(name protoreflect.FullName) map[string]any {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "pattern": `^-?[0-9]+(\.[0-9]+)?s$`}
+	case "google.protobuf.FieldMask":
+		return map[string]any{"type": "string", "description": "カンマ区切りのフィールドのパス（lowerCamelCase）"}
+	case "google.protobuf.Struct":
+		return map[string]any{"type": "object"}
+	case "google.protobuf.Value":
+		return map[string]any{}
+	case "google.protobuf.ListValue":
+		return map[string]any{"type": "array"}
+	case "google.protobuf.Empty":
+		return map[string]any{"type": "object"}
+	case "google.protobuf.Any":
+		return map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"@type": map[string]any{"type": "string"}},
+			"required":   []any{"@type"},
+		}
+	case "google.protobuf.StringValue":
+		return map[string]any{"type": []any{"string", "null"}}
+	case "google.protobuf.BytesValue":
+		return map[string]any{"type": []any{"string", "null"}, "contentEncoding": "base64"}
+	case "google.protobuf.BoolValue":
+		return map[string]any{"type": []any{"boolean", "null"}}
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return map[string]any{"type": []any{"integer", "null"}}
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return map[string]any{"type": []any{"string", "null"}}
+	case "google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		return map[string]any{"type": []any{"number", "null"}}
+	default:
+		return nil
+	}
+}
+
+// jsonContent application/jsonのリクエストボディ・レスポンス
+func jsonContent(description string, schema map[string]any, example any) map[string]any {
+	content := map[string]any{
+		"content": map[string]any{
+			"application/json": map[string]any{"schema": schema, "example": example},
+		},
+	}
+	if description != "" {
+		content["description"] = description
+	}
+	return content
+}
+
+// example メッセージの例の値（IDはUUID、メールアドレス・日時・許可する値はルールまたはフィールド名から推測し、それ以外はゼロ値）
+func example(msg protoreflect.MessageDescriptor, depth int) any {
+	switch msg.FullName() {
+	case "google.protobuf.Timestamp":
+		return exampleTimestamp
+	case "google.protobuf.Duration":
+		return "0s"
+	case "google.protobuf.FieldMask":
+		return ""
+	case "google.protobuf.Struct", "google.protobuf.Empty":
+		return map[string]any{}
+	case "google.protobuf.Value":
+		return nil
+	case "google.protobuf.ListValue":
+		return []any{}
+	}
+	if wellKnownSchema(msg.FullName()) != nil {
+		return nil
+	}
+
+	value := map[string]any{}
+	if depth >= exampleMaxDepth {
+		return value
+	}
+	fields := msg.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		switch {
+		case field.IsMap():
+			value[field.JSONName()] = map[string]any{}
+		case field.IsList():
+			value[field.JSONName()] = []any{exampleValue(field, depth)}
+		default:
+			value[field.JSONName()] = exampleValue(field, depth)
+		}
+	}
+	return value
+}
+
+// exampleValue フィールドの単一の値の例
+func exampleValue(field protoreflect.FieldDescriptor, depth int) any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return false
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		return 0
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "0"
+	case protoreflect.BytesKind:
+		return ""
+	case protoreflect.EnumKind:
+		// 未指定（0）の次の値を例とする
+		values := field.Enum().Values()
+		if values.Len() > 1 {
+			return string(values.Get(1).Name())
+		}
+		return string(values.Get(0).Name())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return example(field.Message(), depth+1)
+	}
+
+	rules := fieldRules(field).GetString_()
+	required := fieldRules(field).GetRequired()
+	name := string(field.Name())
+	switch {
+	case len(rules.GetIn()) > 0:
+		return rules.GetIn()[0]
+	case rules.GetDateTime():
+		return exampleTimestamp
+	case rules.GetJsonObject():
+		return "{}"
+	case rules.GetEmail():
+		return exampleEmail
+	case rules.GetUuid(), name == "id" || strings.HasSuffix(name, "_id") || strings.HasSuffix(name, "_ids"):
+		return exampleID
+	case strings.Contains(name, "email"):
+		return exampleEmail
+	case required:
+		return "string"
+	default:
+		return ""
+	}
+}
+
+// sortedKeys mapのキーを昇順で取得
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,154 @@
+package gateway
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	pbauthv2 "contract-pro-suite/proto/contractpro/auth/v2"
+)
+
+// update コミット済みのドキュメントを現在の定義で更新する（auth.protoのHTTPのルートを変更した場合に使用）
+// go test ./internal/gateway -run TestOpenAPI_Document -update
+var update = flag.Bool("update", false, "update the openapi document")
+
+// openAPIPath コミット済みのOpenAPIのドキュメント（パートナー向けに配布する）
+const openAPIPath = "../../docu/openapi.json"
+
+// newOpenAPIGateway cmd/apiと同じサービスを登録したゲートウェイ
+func newOpenAPIGateway() *Gateway {
+	gw := New(nil, nil)
+	pbauth.RegisterAuthServiceServer(gw, pbauth.UnimplementedAuthServiceServer{})
+	pbauthv2.RegisterAuthServiceServer(gw, pbauthv2.UnimplementedAuthServiceServer{})
+	return gw
+}
+
+// TestOpenAPI_Document 生成したドキュメントがコミット済みのドキュメントと一致することを確認
+func TestOpenAPI_Document(t *testing.T) {
+	document, err := newOpenAPIGateway().OpenAPI(interceptor.IsPublicMethod)
+	require.NoError(t, err)
+	if *update {
+		require.NoError(t, os.WriteFile(openAPIPath, document, 0o644))
+		return
+	}
+
+	committed, err := os.ReadFile(openAPIPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(committed), string(document),
+		"docu/openapi.json is out of date; run: go test ./internal/gateway -run TestOpenAPI_Document -update")
+}
+
+// TestOpenAPI_Operations 登録済みのサービスのすべてのRPCがドキュメントに含まれ、認証の要否がIsPublicMethodと一致することを確認
+func TestOpenAPI_Operations(t *testing.T) {
+	document, err := newOpenAPIGateway().OpenAPI(interceptor.IsPublicMethod)
+	require.NoError(t, err)
+
+	var doc struct {
+		OpenAPI string                               `json:"openapi"`
+		Paths   map[string]map[string]map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(document, &doc))
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+
+	operations := map[string]map[string]any{}
+	for _, methods := range doc.Paths {
+		for _, op := range methods {
+			operations[op["operationId"].(string)] = op
+		}
+	}
+
+	for _, desc := range []*grpc.ServiceDesc{&pbauth.AuthService_ServiceDesc, &pbauthv2.AuthService_ServiceDesc} {
+		var methods []string
+		for _, method := range desc.Methods {
+			methods = append(methods, method.MethodName)
+		}
+		for _, stream := range desc.Streams {
+			methods = append(methods, stream.StreamName)
+		}
+		for _, method := range methods {
+			fullMethod := "/" + desc.ServiceName + "/" + method
+			op, ok := operations[desc.ServiceName+"."+method]
+			if !assert.True(t, ok, "%s has no operation (missing google.api.http annotation?)", fullMethod) {
+				continue
+			}
+			security, hasSecurity := op["security"]
+			if interceptor.IsPublicMethod(fullMethod) {
+				assert.Equal(t, []any{}, security, "%s is public", fullMethod)
+				assert.NotContains(t, op["responses"], "401")
+			} else {
+				assert.False(t, hasSecurity, "%s requires authentication", fullMethod)
+				assert.Contains(t, op["responses"], "401")
+			}
+		}
+	}
+}
+
+func TestOpenAPI_Schemas(t *testing.T) {
+	document, err := newOpenAPIGateway().OpenAPI(interceptor.IsPublicMethod)
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(document, &doc))
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+	paths := doc["paths"].(map[string]any)
+
+	// パスのテンプレート変数はpathパラメータ、それ以外のフィールドはクエリパラメータ
+	getClientUser := paths["/v1/client-users/{client_user_id}"].(map[string]any)["get"].(map[string]any)
+	parameter := getClientUser["parameters"].([]any)[0].(map[string]any)
+	assert.Equal(t, "client_user_id", parameter["name"])
+	assert.Equal(t, "path", parameter["in"])
+	assert.Contains(t, getClientUser["responses"], "404")
+
+	// エラーはgoogle.rpc.Statusのスキーマと例
+	responses := doc["components"].(map[string]any)["responses"].(map[string]any)
+	tooManyRequests := responses["ResourceExhausted"].(map[string]any)
+	assert.Contains(t, tooManyRequests["headers"], "Retry-After")
+	content := tooManyRequests["content"].(map[string]any)["application/json"].(map[string]any)
+	assert.Equal(t, "#/components/schemas/google.rpc.Status", content["schema"].(map[string]any)["$ref"])
+	assert.Equal(t, "rate limit exceeded", content["example"].(map[string]any)["message"])
+	assert.Contains(t, schemas, "google.rpc.Status")
+
+	// v2のTimestampは日時の文字列、フィールド名はlowerCamelCase
+	user := schemas["contractpro.auth.v2.ClientUser"].(map[string]any)["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "string", "format": "date-time"}, user["createdAt"])
+
+	// ストリーミング（response_body）はファイルの内容
+	export := paths["/v1/client-users/export"].(map[string]any)["get"].(map[string]any)
+	exportContent := export["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+	assert.Contains(t, exportContent, "application/octet-stream")
+
+	// すべての参照先のスキーマが定義されている
+	for _, ref := range findRefs(doc) {
+		name, ok := strings.CutPrefix(ref, "#/components/schemas/")
+		if ok {
+			assert.Contains(t, schemas, name)
+		}
+	}
+}
+
+// findRefs ドキュメント内の$refをすべて取得
+func findRefs(v any) []string {
+	var refs []string
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				refs = append(refs, ref)
+			}
+			refs = append(refs, findRefs(value)...)
+		}
+	case []any:
+		for _, value := range v {
+			refs = append(refs, findRefs(value)...)
+		}
+	}
+	return refs
+}
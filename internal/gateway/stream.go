@@ -0,0 +1,119 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ストリーミングRPCのレスポンスのフィールド（response_bodyを指定したルートでは最初のメッセージの値をヘッダーとして返す）
+const (
+	contentTypeField = "content_type"
+	filenameField    = "filename"
+)
+
+// serverStream grpc.ServerStreamの実装。リクエストメッセージをHTTPリクエストから作成し、送信したメッセージをHTTPレスポンスに書き込む
+type serverStream struct {
+	ctx       context.Context
+	transport *transportStream
+	w         http.ResponseWriter
+	r         *http.Request
+	route     *route
+	received  bool
+	started   bool
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	return s.transport.SetHeader(md)
+}
+
+func (s *serverStream) SendHeader(md metadata.MD) error {
+	return s.transport.SendHeader(md)
+}
+
+func (s *serverStream) SetTrailer(md metadata.MD) {
+	_ = s.transport.SetTrailer(md)
+}
+
+// RecvMsg サーバーストリーミングRPCのリクエストメッセージ（1件のみ）
+func (s *serverStream) RecvMsg(m any) error {
+	if s.received {
+		return io.EOF
+	}
+	s.received = true
+	return decodeRequest(s.r, s.route, m.(proto.Message))
+}
+
+// SendMsg メッセージをHTTPレスポンスに書き込み、クライアントに送信する
+func (s *serverStream) SendMsg(m any) error {
+	msg := m.(proto.Message).ProtoReflect()
+	if !s.started {
+		s.start(msg)
+	}
+
+	var data []byte
+	if s.route.responseBody != "" {
+		data = msg.Get(msg.Descriptor().Fields().ByName(protoreflect.Name(s.route.responseBody))).Bytes()
+	} else {
+		result, err := marshalOptions.Marshal(msg.Interface())
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to marshal response: %v", err)
+		}
+		data = append(append([]byte(`{"result":`), result...), "}\n"...)
+	}
+	if _, err := s.w.Write(data); err != nil {
+		return status.Errorf(codes.Canceled, "failed to write response: %v", err)
+	}
+	_ = http.NewResponseController(s.w).Flush()
+	return nil
+}
+
+// start レスポンスのヘッダーを送信（response_bodyを指定したルートは最初のメッセージからContent-Type・ファイル名を設定）
+func (s *serverStream) start(first protoreflect.Message) {
+	s.started = true
+	s.transport.writeHeader(s.w)
+
+	header := s.w.Header()
+	if s.route.responseBody == "" {
+		header.Set("Content-Type", "application/x-ndjson")
+	} else {
+		header.Set("Content-Type", "application/octet-stream")
+		if first != nil {
+			fields := first.Descriptor().Fields()
+			if field := fields.ByName(contentTypeField); field != nil && first.Get(field).String() != "" {
+				header.Set("Content-Type", first.Get(field).String())
+			}
+			if field := fields.ByName(filenameField); field != nil && first.Get(field).String() != "" {
+				header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": first.Get(field).String()}))
+			}
+		}
+	}
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// abort レスポンスの送信中に発生したエラーを通知
+// 改行区切りのJSONはエラー（{"error": ...}）を書き込み、それ以外は途中までの内容を正常な応答と区別できるよう接続を中断する
+func (s *serverStream) abort(err error) {
+	if s.route.responseBody == "" {
+		data, marshalErr := protojson.Marshal(status.Convert(err).Proto())
+		if marshalErr == nil {
+			_, _ = s.w.Write(append(append([]byte(`{"error":`), data...), "}\n"...))
+			return
+		}
+	}
+	log.Printf("gateway: stream aborted: method=%s, error=%v", s.route.fullMethod, err)
+	panic(http.ErrAbortHandler)
+}
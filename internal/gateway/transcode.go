@@ -0,0 +1,331 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maxBodySize リクエストボディの最大サイズ
+const maxBodySize = 1 << 20
+
+// forwardedHeaders gRPCのメタデータとして転送するHTTPヘッダー（Hostは:authorityとして転送する）
+var forwardedHeaders = map[string]string{
+	"Authorization":   "authorization",
+	"X-Client-Id":     "x-client-id",
+	"X-Forwarded-For": "x-forwarded-for",
+	"User-Agent":      "user-agent",
+}
+
+// responseHeaders HTTPヘッダーとしてそのまま返すメタデータ（それ以外はGrpc-Metadata-<キー>として返す）
+var responseHeaders = map[string]string{
+	"retry-after": "Retry-After",
+}
+
+var (
+	marshalOptions   = protojson.MarshalOptions{EmitUnpopulated: true}
+	unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// newContext HTTPリクエストからRPCを呼び出すコンテキストを作成
+// gRPCサーバーで受信した場合と同じく、メタデータ・接続元アドレス・grpc.SetHeader用のストリームを設定する
+func newContext(r *http.Request, fullMethod string) (context.Context, *transportStream) {
+	md := metadata.MD{}
+	md.Set(":authority", r.Host)
+	for header, key := range forwardedHeaders {
+		if values := r.Header.Values(header); len(values) > 0 {
+			md.Set(key, values...)
+		}
+	}
+
+	transport := &transportStream{method: fullMethod, header: metadata.MD{}}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: remoteAddr(r.RemoteAddr)})
+	ctx = grpc.NewContextWithServerTransportStream(ctx, transport)
+	return ctx, transport
+}
+
+// remoteAddr HTTPリクエストの接続元アドレス（"ip:port"）
+type remoteAddr string
+
+func (a remoteAddr) Network() string { return "tcp" }
+func (a remoteAddr) String() string  { return string(a) }
+
+// transportStream grpc.ServerTransportStreamの実装。ハンドラー・インターセプターが設定したメタデータを保持する
+// HTTPではトレーラーを送信しないため、レスポンスの開始前に設定されたトレーラーはヘッダーとして返す
+type transportStream struct {
+	method string
+	header metadata.MD
+	sent   bool
+}
+
+func (t *transportStream) Method() string {
+	return t.method
+}
+
+func (t *transportStream) SetHeader(md metadata.MD) error {
+	if t.sent {
+		return status.Errorf(codes.Internal, "header already sent")
+	}
+	t.header = metadata.Join(t.header, md)
+	return nil
+}
+
+func (t *transportStream) SendHeader(md metadata.MD) error {
+	return t.SetHeader(md)
+}
+
+func (t *transportStream) SetTrailer(md metadata.MD) error {
+	if t.sent {
+		return nil
+	}
+	t.header = metadata.Join(t.header, md)
+	return nil
+}
+
+// writeHeader 保持しているメタデータをHTTPヘッダーに設定
+func (t *transportStream) writeHeader(w http.ResponseWriter) {
+	t.sent = true
+	for key, values := range t.header {
+		header, ok := responseHeaders[key]
+		if !ok {
+			header = "Grpc-Metadata-" + key
+		}
+		for _, value := range values {
+			w.Header().Add(header, value)
+		}
+	}
+}
+
+// decodeRequest HTTPリクエストのボディ・クエリパラメータ・パスのテンプレート変数をリクエストメッセージに設定
+// ボディ全体をメッセージに割り当てる場合（body: "*"）はクエリパラメータを使用しない
+func decodeRequest(r *http.Request, rt *route, req proto.Message) error {
+	msg := req.ProtoReflect()
+	if rt.body != "" {
+		data, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodySize))
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to read request body: %v", err)
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			target := req
+			if rt.body != "*" {
+				target = msg.Mutable(msg.Descriptor().Fields().ByName(protoreflect.Name(rt.body))).Message().Interface()
+			}
+			if err := unmarshalOptions.Unmarshal(data, target); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+			}
+		}
+	}
+
+	if rt.body != "*" {
+		for key, values := range r.URL.Query() {
+			if isPathParam(rt, key) {
+				continue
+			}
+			if err := setField(msg, key, values); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, name := range rt.pathParams {
+		if err := setField(msg, name, []string{r.PathValue(name)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isPathParam パスのテンプレート変数かどうかを判定
+func isPathParam(rt *route, name string) bool {
+	for _, param := range rt.pathParams {
+		if param == name {
+			return true
+		}
+	}
+	return false
+}
+
+// setField フィールドのパス（"."区切り、フィールド名またはJSON名）に値を設定
+// 存在しないフィールドは無視する（キャッシュ回避用のパラメータ等）
+func setField(msg protoreflect.Message, path string, values []string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		field := findField(msg.Descriptor(), name)
+		if field == nil {
+			return nil
+		}
+		if i < len(names)-1 {
+			if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+				return status.Errorf(codes.InvalidArgument, "invalid parameter %s: %s is not a message", path, name)
+			}
+			msg = msg.Mutable(field).Message()
+			continue
+		}
+
+		if field.IsMap() {
+			return status.Errorf(codes.InvalidArgument, "invalid parameter %s: map fields are not supported", path)
+		}
+		if field.IsList() {
+			list := msg.Mutable(field).List()
+			for _, value := range values {
+				parsed, err := parseValue(msg, field, value)
+				if err != nil {
+					return status.Errorf(codes.InvalidArgument, "invalid parameter %s: %v", path, err)
+				}
+				list.Append(parsed)
+			}
+			return nil
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		parsed, err := parseValue(msg, field, values[len(values)-1])
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid parameter %s: %v", path, err)
+		}
+		msg.Set(field, parsed)
+	}
+	return nil
+}
+
+// findField フィールド名またはJSON名でフィールドを取得
+func findField(desc protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if field := desc.Fields().ByName(protoreflect.Name(name)); field != nil {
+		return field
+	}
+	return desc.Fields().ByJSONName(name)
+}
+
+// parseValue 文字列をフィールドの型の値に変換
+// 列挙型は値の名前または番号、bytesはbase64、FieldMaskはカンマ区切りのパス、
+// その他のメッセージ（Timestamp等のWell-Known Types）はJSONの文字列表現を受け付ける
+func parseValue(msg protoreflect.Message, field protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.BytesKind:
+		v, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			v, err = base64.URLEncoding.DecodeString(value)
+		}
+		return protoreflect.ValueOfBytes(v), err
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByName(protoreflect.Name(value)); enumValue != nil {
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+		v, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown enum value %q", value)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+	case protoreflect.MessageKind:
+		if field.Message().FullName() == "google.protobuf.FieldMask" {
+			mask := &fieldmaskpb.FieldMask{}
+			for _, path := range strings.Split(value, ",") {
+				if path = strings.TrimSpace(path); path != "" {
+					mask.Paths = append(mask.Paths, path)
+				}
+			}
+			return protoreflect.ValueOfMessage(mask.ProtoReflect()), nil
+		}
+		v := msg.NewField(field).Message()
+		if err := protojson.Unmarshal([]byte(strconv.Quote(value)), v.Interface()); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfMessage(v), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported type %s", field.Kind())
+	}
+}
+
+// writeMessage レスポンスメッセージをJSONで返す
+func writeMessage(w http.ResponseWriter, msg proto.Message) {
+	data, err := marshalOptions.Marshal(msg)
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "failed to marshal response: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// writeError エラーをgoogle.rpc.Status（code・message・details）のJSONで返す
+// HTTPステータスはgRPCステータスコードから決める（google/rpc/code.protoの対応と同じ）
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	data, marshalErr := protojson.Marshal(st.Proto())
+	if marshalErr != nil {
+		data = []byte(`{"code":13,"message":"internal error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	_, _ = w.Write(data)
+}
+
+// httpStatus gRPCステータスコードに対応するHTTPステータス
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
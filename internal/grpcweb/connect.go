@@ -0,0 +1,243 @@
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	// ConnectのJSON（application/json、application/connect+json）はgRPCのapplication/grpc+jsonとして処理する
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// jsonCodec Protocol BuffersのメッセージをJSON（protojson）で変換するgRPCのコーデック
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("failed to marshal: %T is not a proto.Message", v)
+	}
+	return protojson.Marshal(msg)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal: %T is not a proto.Message", v)
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, msg)
+}
+
+// connectCodes gRPCステータスコードに対応するConnectのエラーコードとHTTPステータス
+var connectCodes = map[codes.Code]struct {
+	name       string
+	httpStatus int
+}{
+	codes.Canceled:           {"canceled", 499},
+	codes.Unknown:            {"unknown", http.StatusInternalServerError},
+	codes.InvalidArgument:    {"invalid_argument", http.StatusBadRequest},
+	codes.DeadlineExceeded:   {"deadline_exceeded", http.StatusGatewayTimeout},
+	codes.NotFound:           {"not_found", http.StatusNotFound},
+	codes.AlreadyExists:      {"already_exists", http.StatusConflict},
+	codes.PermissionDenied:   {"permission_denied", http.StatusForbidden},
+	codes.ResourceExhausted:  {"resource_exhausted", http.StatusTooManyRequests},
+	codes.FailedPrecondition: {"failed_precondition", http.StatusBadRequest},
+	codes.Aborted:            {"aborted", http.StatusConflict},
+	codes.OutOfRange:         {"out_of_range", http.StatusBadRequest},
+	codes.Unimplemented:      {"unimplemented", http.StatusNotImplemented},
+	codes.Internal:           {"internal", http.StatusInternalServerError},
+	codes.Unavailable:        {"unavailable", http.StatusServiceUnavailable},
+	codes.DataLoss:           {"data_loss", http.StatusInternalServerError},
+	codes.Unauthenticated:    {"unauthenticated", http.StatusUnauthorized},
+}
+
+// connectError Connectのエラー（JSON）
+type connectError struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message,omitempty"`
+	Details []connectErrorDetail `json:"details,omitempty"`
+}
+
+// connectErrorDetail エラーの詳細（typeはメッセージの完全修飾名、valueはパディングなしのbase64）
+type connectErrorDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// newConnectError gRPCステータスをConnectのエラーに変換
+func newConnectError(st *status.Status) *connectError {
+	code, ok := connectCodes[st.Code()]
+	if !ok {
+		code = connectCodes[codes.Unknown]
+	}
+	connectErr := &connectError{Code: code.name, Message: st.Message()}
+	for _, detail := range st.Proto().GetDetails() {
+		connectErr.Details = append(connectErr.Details, connectErrorDetail{
+			Type:  detail.GetTypeUrl()[strings.LastIndex(detail.GetTypeUrl(), "/")+1:],
+			Value: base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+		})
+	}
+	return connectErr
+}
+
+// connectRequest ConnectのヘッダーをgRPCのヘッダーに変換したリクエストを作成
+func connectRequest(r *http.Request, subtype string, body io.Reader) (*http.Request, error) {
+	req := grpcRequest(r, subtype, body)
+	if timeout := req.Header.Get("Connect-Timeout-Ms"); timeout != "" {
+		ms, err := strconv.ParseInt(timeout, 10, 64)
+		if err != nil || ms < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid Connect-Timeout-Ms: %q", timeout)
+		}
+		req.Header.Set("Grpc-Timeout", strconv.FormatInt(ms, 10)+"m")
+	}
+	if encoding := req.Header.Get("Content-Encoding"); encoding != "" && encoding != "identity" {
+		return nil, status.Errorf(codes.Unimplemented, "unsupported Content-Encoding: %s", encoding)
+	}
+	req.Header.Del("Connect-Timeout-Ms")
+	req.Header.Del("Connect-Protocol-Version")
+	return req, nil
+}
+
+// serveConnectUnary ConnectのUnary RPCを処理
+// リクエスト・レスポンスの本文はメッセージそのもの（エンベロープなし）で、
+// エラーはHTTPステータスとJSON（code・message・details）、トレーラーは"Trailer-"を付けたヘッダーで返す
+func (h *Handler) serveConnectUnary(w http.ResponseWriter, r *http.Request, mediaType string) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeConnectError(w, status.New(codes.Unimplemented, "connect unary requires POST"))
+		return
+	}
+	subtype := strings.TrimPrefix(mediaType, "application/")
+
+	message, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxMessageSize))
+	if err != nil {
+		writeConnectError(w, status.Newf(codes.ResourceExhausted, "failed to read request: %v", err))
+		return
+	}
+	req, err := connectRequest(r, subtype, bytes.NewReader(envelope(0, message)))
+	if err != nil {
+		writeConnectError(w, status.Convert(err))
+		return
+	}
+
+	// レスポンスは1件のため、すべて受け取ってから変換する
+	rec := &bufferedWriter{header: http.Header{}}
+	h.grpc.ServeHTTP(rec, req)
+
+	trailer := extractTrailer(rec.header)
+	for key, values := range rec.header {
+		if isReservedHeader(key) || strings.HasPrefix(key, http.TrailerPrefix) || trailer.Get(key) != "" {
+			continue
+		}
+		w.Header()[key] = values
+	}
+	for key, values := range trailer {
+		if !isReservedHeader(key) {
+			w.Header()["Trailer-"+key] = values
+		}
+	}
+
+	st := statusFromTrailer(trailer)
+	if st.Code() != codes.OK {
+		writeConnectError(w, st)
+		return
+	}
+	data, flags, err := unenvelope(rec.body.Bytes())
+	if err != nil || flags&flagCompressed != 0 {
+		writeConnectError(w, status.New(codes.Internal, "invalid response message"))
+		return
+	}
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// serveConnectStream Connectのストリーミング RPCを処理
+// メッセージはgRPCと同じエンベロープで、最後にエラー・トレーラーをJSONのメッセージ（フラグ0x02）として返す
+func (h *Handler) serveConnectStream(w http.ResponseWriter, r *http.Request, mediaType string) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "connect streaming requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	subtype := strings.TrimPrefix(mediaType, "application/connect+")
+
+	tw := &trailerWriter{w: w, header: http.Header{}, contentType: mediaType}
+	req, err := connectRequest(r, subtype, r.Body)
+	if err == nil {
+		h.grpc.ServeHTTP(tw, req)
+	}
+
+	// 終端のメッセージ（エラーはOK以外の場合のみ）
+	end := struct {
+		Error    *connectError       `json:"error,omitempty"`
+		Metadata map[string][]string `json:"metadata,omitempty"`
+	}{}
+	st := status.Convert(err)
+	if err == nil {
+		trailer := tw.trailer()
+		st = statusFromTrailer(trailer)
+		for key, values := range trailer {
+			if !isReservedHeader(key) {
+				if end.Metadata == nil {
+					end.Metadata = map[string][]string{}
+				}
+				end.Metadata[strings.ToLower(key)] = values
+			}
+		}
+	}
+	if st.Code() != codes.OK {
+		end.Error = newConnectError(st)
+	}
+	data, _ := json.Marshal(end)
+	_, _ = tw.Write(envelope(flagConnectEnd, data))
+	tw.Flush()
+}
+
+// writeConnectError ConnectのUnary RPCのエラーを返す
+func writeConnectError(w http.ResponseWriter, st *status.Status) {
+	connectErr := newConnectError(st)
+	code, ok := connectCodes[st.Code()]
+	if !ok {
+		code = connectCodes[codes.Unknown]
+	}
+	data, _ := json.Marshal(connectErr)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code.httpStatus)
+	_, _ = w.Write(data)
+}
+
+// bufferedWriter レスポンスをメモリに保持するResponseWriter（ConnectのUnary RPC用）
+type bufferedWriter struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (b *bufferedWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedWriter) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+func (b *bufferedWriter) WriteHeader(int) {}
+
+func (b *bufferedWriter) Flush() {}
@@ -0,0 +1,47 @@
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// serveGRPCWeb gRPC-Webのリクエストを処理
+// リクエスト・レスポンスのメッセージはgRPCと同じ形式で、トレーラーは本文の最後のメッセージ（フラグ0x80）として返す
+// application/grpc-web-textの場合は本文をbase64で変換する
+func (h *Handler) serveGRPCWeb(w http.ResponseWriter, r *http.Request, mediaType string) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "gRPC-Web requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	protocol, subtype, _ := strings.Cut(mediaType, "+")
+	if subtype == "" {
+		subtype = "proto"
+	}
+	text := protocol == "application/grpc-web-text"
+
+	body := io.Reader(r.Body)
+	tw := &trailerWriter{w: w, header: http.Header{}, contentType: mediaType}
+	if text {
+		body = base64.NewDecoder(base64.StdEncoding, r.Body)
+		tw.encode = func(data []byte) []byte {
+			return []byte(base64.StdEncoding.EncodeToString(data))
+		}
+	}
+
+	h.grpc.ServeHTTP(tw, grpcRequest(r, subtype, body))
+
+	// トレーラーを"key: value\r\n"（キーは小文字）の形式で本文の最後に書き込む
+	var trailer bytes.Buffer
+	for key, values := range tw.trailer() {
+		for _, value := range values {
+			trailer.WriteString(strings.ToLower(key) + ": " + value + "\r\n")
+		}
+	}
+	_, _ = tw.Write(envelope(flagGRPCWebTrailer, trailer.Bytes()))
+	tw.Flush()
+}
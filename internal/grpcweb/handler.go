@@ -0,0 +1,220 @@
+package grpcweb
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"contract-pro-suite/internal/shared/cors"
+)
+
+// エンベロープ（gRPC・gRPC-Web・Connectのストリーミングで共通の5バイトのヘッダー付きメッセージ）のフラグ
+const (
+	flagCompressed     = 0x01 // メッセージが圧縮されている
+	flagConnectEnd     = 0x02 // Connectのストリームの終端（JSON）
+	flagGRPCWebTrailer = 0x80 // gRPC-Webのトレーラー
+)
+
+// envelopeHeaderSize エンベロープのヘッダー（フラグ1バイト + 長さ4バイト）のサイズ
+const envelopeHeaderSize = 5
+
+// maxMessageSize ConnectのUnaryリクエストの最大サイズ（gRPCサーバーの既定の受信サイズの上限と同じ）
+const maxMessageSize = 4 << 20
+
+// Handler gRPC・gRPC-Web・Connectのリクエストを同じgRPCサーバーで処理するハンドラー
+// gRPC-Web・ConnectのリクエストはgRPCのリクエストに変換してgrpc.Server.ServeHTTPに渡すため、
+// インターセプター・登録済みのサービス（旧サービス名を含む）は3つのプロトコルで共通となる
+type Handler struct {
+	grpc http.Handler
+}
+
+// NewHandler ハンドラーを作成（grpcServerは*grpc.Server）
+// ネイティブのgRPCはHTTP/2が必要なため、サーバーはh2c（暗号化なしのHTTP/2）を有効にすること
+func NewHandler(grpcServer http.Handler) *Handler {
+	return &Handler{grpc: grpcServer}
+}
+
+// CORSPolicy ブラウザからgRPC-Web・Connectで呼び出すためのCORS設定
+func CORSPolicy(allowedOrigins []string) cors.Policy {
+	return cors.Policy{
+		AllowedOrigins: allowedOrigins,
+		AllowedMethods: []string{http.MethodPost},
+		AllowedHeaders: []string{
+			"Authorization", "Content-Type", "X-Client-Id",
+			"X-Grpc-Web", "X-User-Agent", "Grpc-Timeout",
+			"Connect-Protocol-Version", "Connect-Timeout-Ms",
+		},
+		ExposedHeaders: []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin", "Retry-After"},
+		MaxAge:         600,
+	}
+}
+
+// ServeHTTP Content-Typeでプロトコルを判定して処理する
+//   - application/grpc-web[-text][+proto|+json]: gRPC-Web
+//   - application/proto, application/json: ConnectのUnary RPC
+//   - application/connect+proto, application/connect+json: Connectのストリーミング RPC
+//   - それ以外: ネイティブのgRPC（gRPC以外のリクエストはgrpc.Serverがエラーを返す）
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch {
+	case strings.HasPrefix(mediaType, "application/grpc-web"):
+		h.serveGRPCWeb(w, r, mediaType)
+	case mediaType == "application/proto" || mediaType == "application/json":
+		h.serveConnectUnary(w, r, mediaType)
+	case strings.HasPrefix(mediaType, "application/connect+"):
+		h.serveConnectStream(w, r, mediaType)
+	default:
+		h.grpc.ServeHTTP(w, r)
+	}
+}
+
+// grpcRequest gRPC-Web・ConnectのリクエストをgRPCのリクエスト（HTTP/2、application/grpc+<subtype>）に変換
+func grpcRequest(r *http.Request, subtype string, body io.Reader) *http.Request {
+	req := r.Clone(r.Context())
+	req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2.0", 2, 0
+	req.Header.Set("Content-Type", "application/grpc+"+subtype)
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+	req.Body = io.NopCloser(body)
+	return req
+}
+
+// envelope メッセージにエンベロープのヘッダーを付与
+func envelope(flags byte, data []byte) []byte {
+	frame := make([]byte, envelopeHeaderSize, envelopeHeaderSize+len(data))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	return append(frame, data...)
+}
+
+// unenvelope 最初のメッセージを取り出す（メッセージがない場合はnil）
+func unenvelope(data []byte) ([]byte, byte, error) {
+	if len(data) == 0 {
+		return nil, 0, nil
+	}
+	if len(data) < envelopeHeaderSize {
+		return nil, 0, fmt.Errorf("truncated message header")
+	}
+	size := binary.BigEndian.Uint32(data[1:envelopeHeaderSize])
+	if uint32(len(data)-envelopeHeaderSize) < size {
+		return nil, 0, fmt.Errorf("truncated message")
+	}
+	return data[envelopeHeaderSize : envelopeHeaderSize+size], data[0], nil
+}
+
+// trailerWriter grpc.Server.ServeHTTPのレスポンスを中継し、gRPCのトレーラーを取り出すResponseWriter
+// gRPCのトレーラーはHTTPのトレーラー（宣言済みのヘッダー・http.TrailerPrefix）として設定されるため、
+// レスポンスの開始時に送信したヘッダーと区別して保持する
+type trailerWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	contentType string
+	encode      func([]byte) []byte // 本文の変換（gRPC-Web textのbase64等、nilの場合はそのまま）
+	wroteHeader bool
+}
+
+func (tw *trailerWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *trailerWriter) WriteHeader(code int) {
+	if tw.wroteHeader {
+		return
+	}
+	tw.wroteHeader = true
+	header := tw.w.Header()
+	for key, values := range tw.header {
+		if key == "Trailer" || key == "Content-Type" || strings.HasPrefix(key, http.TrailerPrefix) {
+			continue
+		}
+		header[key] = values
+	}
+	header.Set("Content-Type", tw.contentType)
+	tw.w.WriteHeader(code)
+}
+
+func (tw *trailerWriter) Write(data []byte) (int, error) {
+	if !tw.wroteHeader {
+		tw.WriteHeader(http.StatusOK)
+	}
+	if _, err := tw.w.Write(tw.transform(data)); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (tw *trailerWriter) Flush() {
+	if !tw.wroteHeader {
+		tw.WriteHeader(http.StatusOK)
+	}
+	_ = http.NewResponseController(tw.w).Flush()
+}
+
+func (tw *trailerWriter) transform(data []byte) []byte {
+	if tw.encode == nil {
+		return data
+	}
+	return tw.encode(data)
+}
+
+// trailer gRPCのトレーラー
+func (tw *trailerWriter) trailer() http.Header {
+	return extractTrailer(tw.header)
+}
+
+// extractTrailer レスポンスのヘッダーからgRPCのトレーラーを取り出す
+func extractTrailer(header http.Header) http.Header {
+	trailer := http.Header{}
+	for _, key := range header.Values("Trailer") {
+		if values := header.Values(key); len(values) > 0 {
+			trailer[http.CanonicalHeaderKey(key)] = values
+		}
+	}
+	for key, values := range header {
+		if name, ok := strings.CutPrefix(key, http.TrailerPrefix); ok {
+			trailer[http.CanonicalHeaderKey(name)] = values
+		}
+	}
+	return trailer
+}
+
+// statusFromTrailer トレーラーのgrpc-status・grpc-message・grpc-status-details-binからステータスを取得
+func statusFromTrailer(trailer http.Header) *status.Status {
+	value := trailer.Get("grpc-status")
+	if value == "" {
+		return status.New(codes.Internal, "missing grpc-status")
+	}
+	code, err := strconv.Atoi(value)
+	if err != nil {
+		return status.New(codes.Internal, "invalid grpc-status")
+	}
+	if details := trailer.Get("grpc-status-details-bin"); details != "" {
+		data, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(details, "="))
+		st := &spb.Status{}
+		if err == nil && proto.Unmarshal(data, st) == nil {
+			return status.FromProto(st)
+		}
+	}
+	message, err := url.PathUnescape(trailer.Get("grpc-message"))
+	if err != nil {
+		message = trailer.Get("grpc-message")
+	}
+	return status.New(codes.Code(code), message)
+}
+
+// isReservedHeader gRPCのプロトコルのヘッダー（メタデータとして転送しない）
+func isReservedHeader(key string) bool {
+	key = strings.ToLower(key)
+	return strings.HasPrefix(key, "grpc-") || key == "content-type" || key == "trailer"
+}
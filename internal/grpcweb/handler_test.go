@@ -0,0 +1,314 @@
+package grpcweb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+)
+
+const testClientUserID = "8a6e0804-2bd0-4672-b79d-d97027f9071a"
+
+// fakeAuthServer GetClientUser・ExportClientUsersのみ実装したAuthService
+// 存在しないユーザーの場合はNotFoundとRetry-Afterのメタデータを返す
+type fakeAuthServer struct {
+	pbauth.UnimplementedAuthServiceServer
+}
+
+func (fakeAuthServer) GetClientUser(ctx context.Context, req *pbauth.GetClientUserRequest) (*pbauth.GetClientUserResponse, error) {
+	if req.GetClientUserId() != testClientUserID {
+		_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", "30"))
+		return nil, status.Error(codes.NotFound, "client user not found")
+	}
+	return &pbauth.GetClientUserResponse{User: &pbauth.ClientUser{ClientUserId: req.GetClientUserId(), Email: "user@example.com"}}, nil
+}
+
+func (fakeAuthServer) ExportClientUsers(req *pbauth.ExportClientUsersRequest, stream grpc.ServerStreamingServer[pbauth.ExportClientUsersResponse]) error {
+	if err := stream.Send(&pbauth.ExportClientUsersResponse{Chunk: []byte("email\n")}); err != nil {
+		return err
+	}
+	if err := stream.Send(&pbauth.ExportClientUsersResponse{Chunk: []byte("user@example.com\n")}); err != nil {
+		return err
+	}
+	return status.Error(codes.ResourceExhausted, "export limit exceeded")
+}
+
+// recorder インターセプターで受信したメソッド名・認証ヘッダーを記録する
+type recorder struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (r *recorder) record(ctx context.Context, fullMethod string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, fullMethod+" "+strings.Join(md.Get("authorization"), ","))
+}
+
+func (r *recorder) last() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.calls) == 0 {
+		return ""
+	}
+	return r.calls[len(r.calls)-1]
+}
+
+// newTestServer gRPCサーバーをh2c（HTTP/1.1と暗号化なしのHTTP/2）で起動
+func newTestServer(t *testing.T) (*httptest.Server, *recorder) {
+	t.Helper()
+	rec := &recorder{}
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			rec.record(ctx, info.FullMethod)
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			rec.record(ss.Context(), info.FullMethod)
+			return handler(srv, ss)
+		}),
+	)
+	pbauth.RegisterAuthServiceServer(grpcServer, fakeAuthServer{})
+
+	server := httptest.NewUnstartedServer(NewHandler(grpcServer))
+	server.Config.Protocols = &http.Protocols{}
+	server.Config.Protocols.SetHTTP1(true)
+	server.Config.Protocols.SetUnencryptedHTTP2(true)
+	server.Start()
+	t.Cleanup(func() {
+		server.Close()
+		grpcServer.Stop()
+	})
+	return server, rec
+}
+
+func post(t *testing.T, server *httptest.Server, method, contentType string, body []byte) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/"+pbauth.AuthService_ServiceDesc.ServiceName+"/"+method, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func marshal(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return data
+}
+
+// readFrames エンベロープ形式の本文をメッセージごとに分割
+func readFrames(t *testing.T, body io.Reader) (flags []byte, messages [][]byte) {
+	t.Helper()
+	r := bufio.NewReader(body)
+	for {
+		header := make([]byte, envelopeHeaderSize)
+		if _, err := io.ReadFull(r, header); err == io.EOF {
+			return flags, messages
+		} else {
+			require.NoError(t, err)
+		}
+		message := make([]byte, binary.BigEndian.Uint32(header[1:]))
+		_, err := io.ReadFull(r, message)
+		require.NoError(t, err)
+		flags = append(flags, header[0])
+		messages = append(messages, message)
+	}
+}
+
+func TestHandler_GRPC(t *testing.T) {
+	server, rec := newTestServer(t)
+	conn, err := grpc.NewClient(strings.TrimPrefix(server.URL, "http://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+	resp, err := pbauth.NewAuthServiceClient(conn).GetClientUser(ctx, &pbauth.GetClientUserRequest{ClientUserId: testClientUserID})
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", resp.GetUser().GetEmail())
+	assert.Equal(t, pbauth.AuthService_GetClientUser_FullMethodName+" Bearer token", rec.last())
+}
+
+func TestHandler_GRPCWeb(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		id          string
+		wantStatus  string
+		wantMessage string
+	}{
+		{name: "バイナリ形式で成功", contentType: "application/grpc-web+proto", id: testClientUserID, wantStatus: "0"},
+		{name: "Content-Typeのサブタイプ省略", contentType: "application/grpc-web", id: testClientUserID, wantStatus: "0"},
+		{name: "テキスト形式で成功", contentType: "application/grpc-web-text", id: testClientUserID, wantStatus: "0"},
+		{name: "エラーはトレーラーで返す", contentType: "application/grpc-web+proto", id: "unknown", wantStatus: "5", wantMessage: "client user not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, rec := newTestServer(t)
+			text := strings.HasPrefix(tt.contentType, "application/grpc-web-text")
+
+			body := envelope(0, marshal(t, &pbauth.GetClientUserRequest{ClientUserId: tt.id}))
+			if text {
+				body = []byte(base64.StdEncoding.EncodeToString(body))
+			}
+			resp := post(t, server, "GetClientUser", tt.contentType, body)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, tt.contentType, resp.Header.Get("Content-Type"))
+			assert.Equal(t, pbauth.AuthService_GetClientUser_FullMethodName+" Bearer token", rec.last())
+
+			var reader io.Reader = resp.Body
+			if text {
+				// テキスト形式はメッセージごとにbase64で変換されるため、パディングで区切って復号する
+				raw, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				var decoded []byte
+				for _, chunk := range splitBase64(string(raw)) {
+					data, err := base64.StdEncoding.DecodeString(chunk)
+					require.NoError(t, err)
+					decoded = append(decoded, data...)
+				}
+				reader = bytes.NewReader(decoded)
+			}
+			flags, messages := readFrames(t, reader)
+			require.NotEmpty(t, flags)
+
+			// 最後のメッセージがトレーラー
+			assert.Equal(t, byte(flagGRPCWebTrailer), flags[len(flags)-1])
+			trailer := string(messages[len(messages)-1])
+			assert.Contains(t, trailer, "grpc-status: "+tt.wantStatus+"\r\n")
+			if tt.wantMessage != "" {
+				assert.Contains(t, trailer, "grpc-message: "+tt.wantMessage+"\r\n")
+				return
+			}
+			require.Len(t, messages, 2)
+			got := &pbauth.GetClientUserResponse{}
+			require.NoError(t, proto.Unmarshal(messages[0], got))
+			assert.Equal(t, "user@example.com", got.GetUser().GetEmail())
+		})
+	}
+}
+
+// splitBase64 連結されたbase64の文字列をパディングの位置で分割
+func splitBase64(s string) []string {
+	var chunks []string
+	for len(s) > 0 {
+		end := len(s)
+		if i := strings.Index(s, "="); i >= 0 {
+			end = i
+			for end < len(s) && s[end] == '=' {
+				end++
+			}
+		}
+		chunks = append(chunks, s[:end])
+		s = s[end:]
+	}
+	return chunks
+}
+
+func TestHandler_ConnectUnary(t *testing.T) {
+	server, rec := newTestServer(t)
+
+	t.Run("JSONで成功", func(t *testing.T) {
+		resp := post(t, server, "GetClientUser", "application/json", []byte(`{"clientUserId":"`+testClientUserID+`"}`))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, "user@example.com", body["user"].(map[string]any)["email"])
+		assert.Equal(t, pbauth.AuthService_GetClientUser_FullMethodName+" Bearer token", rec.last())
+	})
+
+	t.Run("Protocol Buffersで成功", func(t *testing.T) {
+		resp := post(t, server, "GetClientUser", "application/proto", marshal(t, &pbauth.GetClientUserRequest{ClientUserId: testClientUserID}))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/proto", resp.Header.Get("Content-Type"))
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		got := &pbauth.GetClientUserResponse{}
+		require.NoError(t, proto.Unmarshal(data, got))
+		assert.Equal(t, testClientUserID, got.GetUser().GetClientUserId())
+	})
+
+	t.Run("エラーはHTTPステータスとJSONで返す", func(t *testing.T) {
+		resp := post(t, server, "GetClientUser", "application/json", []byte(`{"clientUserId":"unknown"}`))
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "30", resp.Header.Get("Retry-After"))
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, "not_found", body["code"])
+		assert.Equal(t, "client user not found", body["message"])
+	})
+
+	t.Run("不正なタイムアウト", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, server.URL+pbauth.AuthService_GetClientUser_FullMethodName, strings.NewReader(`{}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Connect-Timeout-Ms", "soon")
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestHandler_ConnectStream(t *testing.T) {
+	server, rec := newTestServer(t)
+
+	resp := post(t, server, "ExportClientUsers", "application/connect+json", envelope(0, []byte(`{}`)))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/connect+json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, pbauth.AuthService_ExportClientUsers_FullMethodName+" Bearer token", rec.last())
+
+	flags, messages := readFrames(t, resp.Body)
+	require.Len(t, messages, 3)
+	assert.Equal(t, []byte{0, 0, flagConnectEnd}, flags)
+	assert.JSONEq(t, `{"chunk":"ZW1haWwK"}`, string(messages[0]))
+
+	// 終端のメッセージでエラーを返す
+	var end struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(messages[2], &end))
+	assert.Equal(t, "resource_exhausted", end.Error.Code)
+	assert.Equal(t, "export limit exceeded", end.Error.Message)
+}
+
+func TestNewConnectError(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid email").WithDetails(&pbauth.GetClientUserRequest{ClientUserId: "x"})
+	require.NoError(t, err)
+
+	got := newConnectError(st)
+	assert.Equal(t, "invalid_argument", got.Code)
+	assert.Equal(t, "invalid email", got.Message)
+	require.Len(t, got.Details, 1)
+	assert.Equal(t, "contractpro.auth.v1.GetClientUserRequest", got.Details[0].Type)
+	assert.NotContains(t, got.Details[0].Value, "=")
+}
@@ -0,0 +1,29 @@
+package interceptor
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc"
+
+	"contract-pro-suite/services/auth/usecase"
+)
+
+// ActivityInterceptor 最終アクティビティ日時を記録するインターセプター
+// 失効・MFA・テナント・IPアドレス許可リスト等で拒否されたリクエストを記録しないよう、チェーンの最後に適用する
+func ActivityInterceptor(authUsecase usecase.AuthUsecase) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if userCtx, ok := GetEnhancedUserContext(ctx); ok {
+			// 記録に失敗してもリクエストは継続する
+			if err := authUsecase.RecordActivity(ctx, userCtx); err != nil {
+				log.Printf("Failed to record activity: %v", err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
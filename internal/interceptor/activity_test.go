@@ -0,0 +1,60 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"contract-pro-suite/services/auth/domain"
+)
+
+func TestActivityInterceptor(t *testing.T) {
+	userCtx := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: uuid.New()}
+	info := &grpc.UnaryServerInfo{FullMethod: "/contractpro.auth.v1.AuthService/GetMe"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "success", nil
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		setupMock func(*MockAuthUsecase)
+	}{
+		{
+			name: "認証済みのリクエストは記録する",
+			ctx:  SetEnhancedUserContextForTest(context.Background(), userCtx),
+			setupMock: func(m *MockAuthUsecase) {
+				m.On("RecordActivity", mock.Anything, userCtx).Return(nil)
+			},
+		},
+		{
+			name: "記録に失敗してもリクエストは継続する",
+			ctx:  SetEnhancedUserContextForTest(context.Background(), userCtx),
+			setupMock: func(m *MockAuthUsecase) {
+				m.On("RecordActivity", mock.Anything, userCtx).Return(assert.AnError)
+			},
+		},
+		{
+			name:      "公開メソッド（ユーザー情報なし）は記録しない",
+			ctx:       context.Background(),
+			setupMock: func(m *MockAuthUsecase) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockAuthUsecase)
+			tt.setupMock(mockUsecase)
+
+			resp, err := ActivityInterceptor(mockUsecase)(tt.ctx, nil, info, handler)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "success", resp)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
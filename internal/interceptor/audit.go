@@ -14,16 +14,26 @@ import (
 
 // AuditLog 監査ログの構造
 type AuditLog struct {
-	Timestamp  time.Time `json:"timestamp"`
-	UserID     string    `json:"user_id,omitempty"`
-	ClientID   string    `json:"client_id,omitempty"`
-	Method     string    `json:"method"`
-	Path       string    `json:"path"`
-	StatusCode int       `json:"status_code"`
-	UserType   string    `json:"user_type,omitempty"`
-	Error      string    `json:"error,omitempty"`
+	Timestamp  time.Time      `json:"timestamp"`
+	UserID     string         `json:"user_id,omitempty"`
+	ClientID   string         `json:"client_id,omitempty"`
+	Method     string         `json:"method"`
+	Path       string         `json:"path"`
+	StatusCode int            `json:"status_code"`
+	UserType   string         `json:"user_type,omitempty"`
+	Error      string         `json:"error,omitempty"`
+	Event      string         `json:"event,omitempty"`     // セキュリティイベントの種別（通常のリクエストログでは空）
+	RemoteIP   string         `json:"remote_ip,omitempty"` // リクエスト元のIPアドレス（判明している場合のみ）
+	Details    map[string]any `json:"details,omitempty"`   // イベントの補足情報（出力形式・件数等）
 }
 
+const (
+	// AuditEventIPNotAllowed IPアドレス許可リストによりアクセスを拒否した
+	AuditEventIPNotAllowed = "IP_NOT_ALLOWED"
+	// AuditEventClientUsersExported クライアントユーザーをエクスポートした
+	AuditEventClientUsersExported = "CLIENT_USERS_EXPORTED"
+)
+
 // AuditInterceptor 認証・認可のログを記録するインターセプター
 func AuditInterceptor() grpc.UnaryServerInterceptor {
 	return func(
@@ -42,7 +52,7 @@ func AuditInterceptor() grpc.UnaryServerInterceptor {
 			Timestamp:  start,
 			Method:     "gRPC",
 			Path:       info.FullMethod,
-			StatusCode: getStatusCode(err),
+			StatusCode: StatusCode(err),
 		}
 
 		// ユーザー情報を取得（利用可能な場合）
@@ -75,8 +85,8 @@ func AuditInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-// getStatusCode gRPCエラーからHTTPステータスコード相当の値を取得
-func getStatusCode(err error) int {
+// StatusCode gRPCエラーからHTTPステータスコード相当の値を取得
+func StatusCode(err error) int {
 	if err == nil {
 		return 200 // OK
 	}
@@ -101,6 +111,11 @@ func getStatusCode(err error) int {
 	return 500
 }
 
+// LogAudit gRPC以外の経路（SCIM等のHTTPエンドポイント）から監査ログを記録
+func LogAudit(auditLog AuditLog) {
+	logJSON(auditLog)
+}
+
 // logJSON 構造化ログをJSON形式で出力
 func logJSON(auditLog AuditLog) {
 	logBytes, err := json.Marshal(auditLog)
@@ -110,4 +125,3 @@ func logJSON(auditLog AuditLog) {
 	}
 	log.Println(string(logBytes))
 }
-
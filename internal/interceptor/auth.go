@@ -4,19 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"net/http"
+	"net/netip"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/oidc"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
 	"contract-pro-suite/services/auth/domain"
 	"contract-pro-suite/services/auth/repository"
 	"contract-pro-suite/services/auth/usecase"
+	dbgen "contract-pro-suite/sqlc"
 )
 
 type contextKey string
@@ -27,10 +35,12 @@ const (
 	clientIDContextKey     contextKey = "client_id"
 )
 
-// isPublicMethod 認証不要の公開メソッドかどうかを判定
-func isPublicMethod(methodName string) bool {
+// IsPublicMethod 認証不要の公開メソッドかどうかを判定（OpenAPIのドキュメントの認証要否にも使用する）
+func IsPublicMethod(methodName string) bool {
 	publicMethods := []string{
-		"/auth.AuthService/SignupClient",
+		pbauth.AuthService_SignupClient_FullMethodName,
+		pbauth.AuthService_VerifySignup_FullMethodName,
+		pbauth.AuthService_ConfirmMyEmailChange_FullMethodName,
 	}
 	for _, publicMethod := range publicMethods {
 		if methodName == publicMethod {
@@ -40,16 +50,69 @@ func isPublicMethod(methodName string) bool {
 	return false
 }
 
+// infrastructureServicePrefixes 認証・テナント・レート制限の対象外とするインフラ用のサービス
+// （Kubernetesのプローブ・grpc_health_probe・grpcurl等はトークンを付けずに呼び出すため）
+var infrastructureServicePrefixes = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// IsInfrastructureMethod ヘルスチェック・リフレクションのメソッドかどうかを判定
+func IsInfrastructureMethod(methodName string) bool {
+	for _, prefix := range infrastructureServicePrefixes {
+		if strings.HasPrefix(methodName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMFAExemptMethod MFAポリシーの対象外のメソッドかどうかを判定（MFA未完了でもログアウトは可能）
+func isMFAExemptMethod(methodName string) bool {
+	return methodName == pbauth.AuthService_Logout_FullMethodName
+}
+
+// MFARequiredReason MFAが必要な場合にErrorInfoのreasonとして返す値（フロントエンドはこれを受けてステップアップ認証を行う）
+const MFARequiredReason = "MFA_REQUIRED"
+
+// mfaRequiredError MFAが必要であることを示すgRPCエラー（ErrorInfoの詳細付き）
+func mfaRequiredError() error {
+	st := status.New(codes.PermissionDenied, "mfa required")
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: MFARequiredReason,
+		Domain: domain.ErrorDomain,
+		Metadata: map[string]string{
+			"required_aal": usecase.MFARequiredAAL,
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
 // AuthInterceptor JWT検証インターセプター
-func AuthInterceptor(cfg *config.Config) grpc.UnaryServerInterceptor {
+// JWTのiss（発行者）に応じて検証鍵を選択する:
+//   - Supabase Auth（issなし、またはSupabaseのiss）: SUPABASE_JWT_SECRETによるHMAC検証
+//   - クライアントに登録された外部IdP（Entra ID、Okta等）: IdPのJWKSによる署名検証
+//
+// システム間連携用に、サービスアカウントのAPIキー（"ApiKey <key>"、または接頭辞cps_付きの"Bearer <key>"）も受け付ける
+func AuthInterceptor(
+	cfg *config.Config,
+	idpRepo repository.IdentityProviderRepository,
+	apiKeyRepo repository.APIKeyRepository,
+) grpc.UnaryServerInterceptor {
+	keySets := oidc.NewKeySetCache(&http.Client{Timeout: cfg.OIDCHTTPTimeout}, cfg.OIDCJWKSCacheTTL)
+
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// 認証不要の公開メソッドの場合はスキップ
-		if isPublicMethod(info.FullMethod) {
+		// 認証不要の公開メソッド・ヘルスチェック等の場合はスキップ
+		if IsPublicMethod(info.FullMethod) || IsInfrastructureMethod(info.FullMethod) {
 			return handler(ctx, req)
 		}
 
@@ -69,51 +132,206 @@ func AuthInterceptor(cfg *config.Config) grpc.UnaryServerInterceptor {
 			return nil, status.Errorf(codes.Unauthenticated, "authorization header required")
 		}
 
-		// "Bearer <token>"形式からトークンを抽出
+		// "Bearer <token>"または"ApiKey <key>"形式からトークンを抽出
 		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
+		if len(parts) != 2 || (parts[0] != "Bearer" && parts[0] != "ApiKey") {
 			return nil, status.Errorf(codes.Unauthenticated, "invalid authorization header format")
 		}
 
 		tokenString := parts[1]
 
-		// JWTトークンを検証
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// 署名アルゴリズムを確認
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, errors.New("unexpected signing method")
-			}
-			// Supabase JWT Secretを使用
-			return []byte(cfg.SupabaseJWTSecret), nil
-		})
-
-		if err != nil || !token.Valid {
+		var userCtx *UserContext
+		var err error
+		if parts[0] == "ApiKey" || strings.HasPrefix(tokenString, usecase.APIKeyPrefix) {
+			// APIキーを検証（ハッシュで照合）
+			userCtx, err = verifyAPIKey(ctx, tokenString, apiKeyRepo)
+		} else {
+			// JWTトークンを検証（issに応じて鍵を選択）
+			userCtx, err = verifyToken(ctx, tokenString, cfg, idpRepo, keySets)
+		}
+		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "invalid token")
 		}
 
-		// クレームからユーザー情報を取得
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid token claims")
+		// ユーザー情報をコンテキストに追加
+		ctx = context.WithValue(ctx, userContextKey, userCtx)
+		return handler(ctx, req)
+	}
+}
+
+// verifyToken JWTのissから検証方法を選択してトークンを検証
+func verifyToken(
+	ctx context.Context,
+	tokenString string,
+	cfg *config.Config,
+	idpRepo repository.IdentityProviderRepository,
+	keySets *oidc.KeySetCache,
+) (*UserContext, error) {
+	// 署名検証前にissだけを読み取る（鍵の選択にのみ使用し、値は検証後のクレームで再確認する）
+	unverified, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return nil, err
+	}
+	issuer, _ := unverified.Claims.GetIssuer()
+
+	if issuer == "" || issuer == cfg.SupabaseIssuer() || idpRepo == nil {
+		return verifySupabaseToken(tokenString, cfg)
+	}
+
+	provider, err := idpRepo.GetByIssuer(ctx, issuer)
+	if err != nil {
+		// 未登録のissuerはSupabaseトークンとして扱う（HMACシークレットを知らない限り検証に失敗する）
+		return verifySupabaseToken(tokenString, cfg)
+	}
+
+	return verifyExternalToken(ctx, tokenString, provider, keySets)
+}
+
+// verifySupabaseToken Supabase Authが発行したJWTを検証
+func verifySupabaseToken(tokenString string, cfg *config.Config) (*UserContext, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		// 署名アルゴリズムを確認
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
 		}
+		// Supabase JWT Secretを使用
+		return []byte(cfg.SupabaseJWTSecret), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
 
-		// ユーザー情報をコンテキストに追加
-		userCtx := &UserContext{
-			UserID: getStringClaim(claims, "sub"),
-			Email:  getStringClaim(claims, "email"),
-			Role:   getStringClaim(claims, "role"),
+	// クレームからユーザー情報を取得
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid token claims")
+	}
+
+	return &UserContext{
+		UserID: getStringClaim(claims, "sub"),
+		Email:  getStringClaim(claims, "email"),
+		Role:   getStringClaim(claims, "role"),
+		Issuer: getStringClaim(claims, "iss"),
+		Token:  tokenMetadata(claims),
+	}, nil
+}
+
+// externalSigningMethods 外部IdPのトークンで受け付ける署名アルゴリズム（非対称鍵のみ）
+var externalSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// verifyExternalToken クライアントに登録された外部IdPが発行したJWTを検証
+func verifyExternalToken(
+	ctx context.Context,
+	tokenString string,
+	provider dbgen.ClientIdentityProvider,
+	keySets *oidc.KeySetCache,
+) (*UserContext, error) {
+	if provider.Status != string(domain.IdentityProviderStatusActive) {
+		return nil, errors.New("identity provider is not active")
+	}
+
+	jwksURI := provider.JwksUri.String
+	if !provider.JwksUri.Valid || jwksURI == "" {
+		uri, err := keySets.DiscoverJWKSURI(ctx, provider.Issuer)
+		if err != nil {
+			return nil, err
 		}
+		jwksURI = uri
+	}
 
-		ctx = context.WithValue(ctx, userContextKey, userCtx)
-		return handler(ctx, req)
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return keySets.GetKey(ctx, jwksURI, kid)
+	},
+		jwt.WithValidMethods(externalSigningMethods),
+		jwt.WithIssuer(provider.Issuer),
+		jwt.WithAudience(provider.Audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid token claims")
+	}
+
+	subject := getStringClaim(claims, "sub")
+	if subject == "" {
+		return nil, errors.New("sub claim is required")
+	}
+
+	// Entra IDはemailクレームを返さない場合があるため、preferred_usernameで補完
+	email := getStringClaim(claims, "email")
+	if email == "" {
+		if username := getStringClaim(claims, "preferred_username"); strings.Contains(username, "@") {
+			email = username
+		}
+	}
+	emailVerified, _ := claims["email_verified"].(bool)
+
+	return &UserContext{
+		UserID: subject,
+		Email:  email,
+		Issuer: provider.Issuer,
+		Token:  tokenMetadata(claims),
+		ExternalIdentity: &domain.ExternalIdentity{
+			Issuer:        provider.Issuer,
+			Subject:       subject,
+			Email:         email,
+			EmailVerified: emailVerified,
+			FirstName:     getStringClaim(claims, "given_name"),
+			LastName:      getStringClaim(claims, "family_name"),
+		},
+	}, nil
+}
+
+// verifyAPIKey サービスアカウントのAPIキーを検証
+func verifyAPIKey(ctx context.Context, key string, apiKeyRepo repository.APIKeyRepository) (*UserContext, error) {
+	if apiKeyRepo == nil || !strings.HasPrefix(key, usecase.APIKeyPrefix) {
+		return nil, errors.New("invalid api key")
+	}
+
+	// 取り消し済みのキーは取得されない
+	apiKey, err := apiKeyRepo.GetByHash(ctx, usecase.HashAPIKey(key))
+	if err != nil {
+		return nil, errors.New("invalid api key")
 	}
+	if apiKey.ExpiresAt.Valid && time.Now().After(apiKey.ExpiresAt.Time) {
+		return nil, errors.New("api key expired")
+	}
+
+	apiKeyID := uuid.UUID(apiKey.ApiKeyID.Bytes)
+	// 最終利用日時の更新に失敗してもリクエストは継続する
+	_ = apiKeyRepo.Touch(ctx, apiKeyID)
+
+	serviceAccountID := uuid.UUID(apiKey.ServiceAccountID.Bytes)
+	return &UserContext{
+		UserID: serviceAccountID.String(),
+		APIKey: &domain.APIKeyIdentity{
+			APIKeyID:         apiKeyID,
+			ServiceAccountID: serviceAccountID,
+			ClientID:         uuid.UUID(apiKey.ClientID.Bytes),
+		},
+	}, nil
 }
 
 // UserContext JWTから取得したユーザー情報
 type UserContext struct {
-	UserID string // Supabase AuthのユーザーID（sub）
+	UserID string // Supabase AuthのユーザーID（sub）。外部IdPの場合はIdP内のsubject、APIキーの場合はサービスアカウントID
 	Email  string
 	Role   string // Supabase Authのロール
+	Issuer string // JWTの発行者（iss）
+
+	// ExternalIdentity 外部IdPで認証された場合のみ設定（Supabaseトークンの場合はnil）
+	ExternalIdentity *domain.ExternalIdentity
+
+	// APIKey サービスアカウントのAPIキーで認証された場合のみ設定
+	APIKey *domain.APIKeyIdentity
+
+	// Token 失効判定に使用するトークンの属性（JWTの場合のみ設定）
+	Token domain.TokenMetadata
 }
 
 // GetUserContext コンテキストからユーザー情報を取得
@@ -130,8 +348,8 @@ func EnhancedAuthInterceptor(authUsecase usecase.AuthUsecase) grpc.UnaryServerIn
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// 認証不要の公開メソッドの場合はスキップ
-		if isPublicMethod(info.FullMethod) {
+		// 認証不要の公開メソッド・ヘルスチェック等の場合はスキップ
+		if IsPublicMethod(info.FullMethod) || IsInfrastructureMethod(info.FullMethod) {
 			return handler(ctx, req)
 		}
 
@@ -142,11 +360,43 @@ func EnhancedAuthInterceptor(authUsecase usecase.AuthUsecase) grpc.UnaryServerIn
 		}
 
 		// データベースからユーザー情報と権限を取得
-		userCtx, err := authUsecase.GetUserContext(ctx, jwtUserCtx.UserID)
+		// 外部IdPのトークンは (issuer, subject) の紐付けから、Supabaseトークンはsubから、APIキーはサービスアカウントから解決する
+		var userCtx *domain.UserContext
+		var err error
+		if jwtUserCtx.APIKey != nil {
+			userCtx, err = authUsecase.GetUserContextByAPIKey(ctx, *jwtUserCtx.APIKey)
+		} else if jwtUserCtx.ExternalIdentity != nil {
+			userCtx, err = authUsecase.GetUserContextByExternalIdentity(ctx, *jwtUserCtx.ExternalIdentity)
+		} else {
+			userCtx, err = authUsecase.GetUserContext(ctx, jwtUserCtx.UserID)
+		}
 		if err != nil {
+			if errors.Is(err, usecase.ErrUserNotActive) {
+				return nil, status.Errorf(codes.PermissionDenied, "user is not active")
+			}
 			return nil, status.Errorf(codes.PermissionDenied, "forbidden")
 		}
 
+		// JWTの失効確認（強制ログアウト、ユーザー削除・停止等）。APIキーは取り消し・有効期限で管理する
+		if jwtUserCtx.APIKey == nil {
+			if err := authUsecase.CheckTokenRevocation(ctx, userCtx, jwtUserCtx.Token); err != nil {
+				if errors.Is(err, usecase.ErrTokenRevoked) {
+					return nil, status.Errorf(codes.Unauthenticated, "token revoked")
+				}
+				return nil, status.Errorf(codes.Internal, "failed to check token revocation")
+			}
+
+			// MFAポリシーの確認（クライアント設定・ロール、オペレーターのmfa_enabled）
+			if !isMFAExemptMethod(info.FullMethod) {
+				if err := authUsecase.CheckMFA(ctx, userCtx, jwtUserCtx.Token); err != nil {
+					if errors.Is(err, usecase.ErrMFARequired) {
+						return nil, mfaRequiredError()
+					}
+					return nil, status.Errorf(codes.Internal, "failed to check mfa policy")
+				}
+			}
+		}
+
 		// 拡張されたユーザーコンテキストを設定
 		ctx = context.WithValue(ctx, enhancedUserContextKey, userCtx)
 		return handler(ctx, req)
@@ -165,19 +415,27 @@ func SetEnhancedUserContextForTest(ctx context.Context, userCtx *domain.UserCont
 }
 
 // TenantInterceptor テナント検証インターセプター
+// クライアントにIPアドレス許可リストが登録されている場合は、リクエスト元のIPアドレスも検証する
 func TenantInterceptor(
 	cfg *config.Config,
 	clientRepo repository.ClientRepository,
 	authUsecase usecase.AuthUsecase,
+	ipAllowlistUsecase usecase.IPAllowlistUsecase,
 ) grpc.UnaryServerInterceptor {
+	trustedProxies, err := cfg.TrustedProxies()
+	if err != nil {
+		// 不正な設定の場合はx-forwarded-forを信頼せず、接続元アドレスのみを使用する
+		log.Printf("Ignoring TRUSTED_PROXIES: %v", err)
+	}
+
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// 認証不要の公開メソッドの場合はスキップ（クライアント登録時はclient_idが存在しないため）
-		if isPublicMethod(info.FullMethod) {
+		// 認証不要の公開メソッド・ヘルスチェック等の場合はスキップ（クライアント登録時はclient_idが存在しないため）
+		if IsPublicMethod(info.FullMethod) || IsInfrastructureMethod(info.FullMethod) {
 			return handler(ctx, req)
 		}
 
@@ -218,7 +476,7 @@ func TenantInterceptor(
 		}
 
 		// クライアントのステータスチェック（ACTIVEのみ許可）
-		if client.Status != "ACTIVE" {
+		if client.Status != string(domain.ClientStatusActive) {
 			return nil, status.Errorf(codes.PermissionDenied, "client is not active")
 		}
 
@@ -234,10 +492,43 @@ func TenantInterceptor(
 			}
 		}
 
+		// IPアドレス許可リストの検証
+		if ipAllowlistUsecase != nil {
+			remoteIP, _ := ClientIP(ctx, md, trustedProxies)
+			if err := ipAllowlistUsecase.CheckIPAllowed(ctx, userCtx, clientID, remoteIP); err != nil {
+				if errors.Is(err, usecase.ErrIPNotAllowed) {
+					logIPNotAllowed(info.FullMethod, userCtx, clientID, remoteIP)
+					return nil, status.Errorf(codes.PermissionDenied, "ip address not allowed")
+				}
+				return nil, status.Errorf(codes.Internal, "failed to check ip allowlist")
+			}
+		}
+
 		return handler(ctx, req)
 	}
 }
 
+// logIPNotAllowed IPアドレス許可リストにより拒否したアクセスを監査ログに記録
+func logIPNotAllowed(fullMethod string, userCtx *domain.UserContext, clientID uuid.UUID, remoteIP netip.Addr) {
+	auditLog := AuditLog{
+		Timestamp:  time.Now(),
+		ClientID:   clientID.String(),
+		Method:     "gRPC",
+		Path:       fullMethod,
+		StatusCode: 403,
+		Event:      AuditEventIPNotAllowed,
+		Error:      usecase.ErrIPNotAllowed.Error(),
+	}
+	if remoteIP.IsValid() {
+		auditLog.RemoteIP = remoteIP.String()
+	}
+	if userCtx != nil {
+		auditLog.UserID = userCtx.UserID.String()
+		auditLog.UserType = string(userCtx.UserType)
+	}
+	LogAudit(auditLog)
+}
+
 // GetClientIDFromContext コンテキストからclient_idを取得
 func GetClientIDFromContext(ctx context.Context) (uuid.UUID, bool) {
 	clientID, ok := ctx.Value(clientIDContextKey).(uuid.UUID)
@@ -343,6 +634,49 @@ func extractClientIDFromSubdomain(
 	return ExtractClientIDFromSubdomain(ctx, md, cfg, clientRepo)
 }
 
+// tokenMetadata クレームから失効判定用の属性を取得
+// Supabase Authのアクセストークンはjtiを含まないため、session_idを識別子として使用する
+func tokenMetadata(claims jwt.MapClaims) domain.TokenMetadata {
+	meta := domain.TokenMetadata{
+		ID: getStringClaim(claims, "jti"),
+	}
+	if meta.ID == "" {
+		if sessionID := getStringClaim(claims, "session_id"); sessionID != "" {
+			meta.ID = "session:" + sessionID
+		}
+	}
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		meta.IssuedAt = iat.Time
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		meta.ExpiresAt = exp.Time
+	}
+	meta.AuthenticatorAssuranceLevel = getStringClaim(claims, "aal")
+	meta.AuthenticationMethods = getAMRClaim(claims)
+	return meta
+}
+
+// getAMRClaim amrクレームから認証方式を取得
+// OIDC（RFC 8176）は文字列の配列、Supabase Authは{"method": "...", "timestamp": ...}の配列で返す
+func getAMRClaim(claims jwt.MapClaims) []string {
+	values, ok := claims["amr"].([]interface{})
+	if !ok {
+		return nil
+	}
+	methods := make([]string, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			methods = append(methods, v)
+		case map[string]interface{}:
+			if method, ok := v["method"].(string); ok {
+				methods = append(methods, method)
+			}
+		}
+	}
+	return methods
+}
+
 // getStringClaim クレームから文字列値を取得
 func getStringClaim(claims jwt.MapClaims, key string) string {
 	if val, ok := claims[key].(string); ok {
@@ -2,18 +2,30 @@ package interceptor
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/tabular"
 	"contract-pro-suite/services/auth/domain"
 	"contract-pro-suite/services/auth/repository"
 	"contract-pro-suite/services/auth/usecase"
@@ -34,6 +46,22 @@ func (m *MockAuthUsecase) GetUserContext(ctx context.Context, jwtUserID string)
 	return args.Get(0).(*domain.UserContext), args.Error(1)
 }
 
+func (m *MockAuthUsecase) GetUserContextByExternalIdentity(ctx context.Context, identity domain.ExternalIdentity) (*domain.UserContext, error) {
+	args := m.Called(ctx, identity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserContext), args.Error(1)
+}
+
+func (m *MockAuthUsecase) GetUserContextByAPIKey(ctx context.Context, identity domain.APIKeyIdentity) (*domain.UserContext, error) {
+	args := m.Called(ctx, identity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserContext), args.Error(1)
+}
+
 func (m *MockAuthUsecase) ValidateClientAccess(ctx context.Context, userCtx *domain.UserContext, clientID uuid.UUID) error {
 	args := m.Called(ctx, userCtx, clientID)
 	return args.Error(0)
@@ -44,6 +72,44 @@ func (m *MockAuthUsecase) CheckPermission(ctx context.Context, userCtx *domain.U
 	return args.Error(0)
 }
 
+func (m *MockAuthUsecase) CheckTokenRevocation(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	args := m.Called(ctx, userCtx, token)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) CheckMFA(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	args := m.Called(ctx, userCtx, token)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) RecordActivity(ctx context.Context, userCtx *domain.UserContext) error {
+	args := m.Called(ctx, userCtx)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) Logout(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	args := m.Called(ctx, userCtx, token)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) ForceLogout(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) error {
+	args := m.Called(ctx, userCtx, clientUserID)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) ForceLogoutTenant(ctx context.Context, userCtx *domain.UserContext) error {
+	args := m.Called(ctx, userCtx)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) GetMe(ctx context.Context, userCtx *domain.UserContext) (*usecase.MeResult, error) {
+	args := m.Called(ctx, userCtx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.MeResult), args.Error(1)
+}
+
 func (m *MockAuthUsecase) SignupClient(ctx context.Context, params usecase.SignupClientParams) (*usecase.SignupClientResult, error) {
 	args := m.Called(ctx, params)
 	if args.Get(0) == nil {
@@ -52,6 +118,101 @@ func (m *MockAuthUsecase) SignupClient(ctx context.Context, params usecase.Signu
 	return args.Get(0).(*usecase.SignupClientResult), args.Error(1)
 }
 
+func (m *MockAuthUsecase) VerifySignup(ctx context.Context, token string) (*usecase.VerifySignupResult, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.VerifySignupResult), args.Error(1)
+}
+
+func (m *MockAuthUsecase) UpdateMe(ctx context.Context, userCtx *domain.UserContext, params usecase.UpdateMeParams) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, userCtx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientUser{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ChangeMyPassword(ctx context.Context, userCtx *domain.UserContext, currentPassword, newPassword string) error {
+	args := m.Called(ctx, userCtx, currentPassword, newPassword)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) ChangeMyEmail(ctx context.Context, userCtx *domain.UserContext, newEmail string) error {
+	args := m.Called(ctx, userCtx, newEmail)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) ConfirmMyEmailChange(ctx context.Context, token string) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return dbgen.ClientUser{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ListClientUsers(ctx context.Context, userCtx *domain.UserContext, filter usecase.ClientUserFilter, page usecase.PageRequest) (usecase.Page[dbgen.ClientUser], error) {
+	args := m.Called(ctx, userCtx, filter, page)
+	if args.Get(0) == nil {
+		return usecase.Page[dbgen.ClientUser]{}, args.Error(1)
+	}
+	return args.Get(0).(usecase.Page[dbgen.ClientUser]), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ExportClientUsers(ctx context.Context, userCtx *domain.UserContext, filter usecase.ClientUserFilter, format tabular.Format, w io.Writer) (*usecase.ExportClientUsersResult, error) {
+	args := m.Called(ctx, userCtx, filter, format, w)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.ExportClientUsersResult), args.Error(1)
+}
+
+func (m *MockAuthUsecase) GetClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, userCtx, clientUserID)
+	if args.Get(0) == nil {
+		return dbgen.ClientUser{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockAuthUsecase) GetClientUserDetail(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) (usecase.ClientUserDetail, error) {
+	args := m.Called(ctx, userCtx, clientUserID)
+	if args.Get(0) == nil {
+		return usecase.ClientUserDetail{}, args.Error(1)
+	}
+	return args.Get(0).(usecase.ClientUserDetail), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ListClientUserDetails(ctx context.Context, userCtx *domain.UserContext, filter usecase.ClientUserFilter, page usecase.PageRequest) (usecase.Page[usecase.ClientUserDetail], error) {
+	args := m.Called(ctx, userCtx, filter, page)
+	if args.Get(0) == nil {
+		return usecase.Page[usecase.ClientUserDetail]{}, args.Error(1)
+	}
+	return args.Get(0).(usecase.Page[usecase.ClientUserDetail]), args.Error(1)
+}
+
+func (m *MockAuthUsecase) CreateClientUser(ctx context.Context, userCtx *domain.UserContext, params usecase.CreateClientUserParams) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, userCtx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientUser{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockAuthUsecase) UpdateClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID, params usecase.UpdateClientUserParams) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, userCtx, clientUserID, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientUser{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockAuthUsecase) DeleteClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID, etag string) error {
+	args := m.Called(ctx, userCtx, clientUserID, etag)
+	return args.Error(0)
+}
+
 // MockClientRepository モックClientRepository
 type MockClientRepository struct {
 	mock.Mock
@@ -105,9 +266,14 @@ func (m *MockClientRepository) Update(ctx context.Context, params dbgen.UpdateCl
 	return args.Get(0).(dbgen.Client), args.Error(1)
 }
 
-func (m *MockClientRepository) Delete(ctx context.Context, clientID uuid.UUID, deletedBy uuid.UUID) error {
-	args := m.Called(ctx, clientID, deletedBy)
-	return args.Error(0)
+func (m *MockClientRepository) Delete(ctx context.Context, clientID uuid.UUID, deletedBy uuid.UUID, updatedAt time.Time) (int64, error) {
+	args := m.Called(ctx, clientID, deletedBy, updatedAt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockClientRepository) RenewSignupVerification(ctx context.Context, clientID uuid.UUID, email string, tokenHash string, expiresAt time.Time) (dbgen.ClientSignupVerification, error) {
+	args := m.Called(ctx, clientID, email, tokenHash, expiresAt)
+	return args.Get(0).(dbgen.ClientSignupVerification), args.Error(1)
 }
 
 // createTestJWT テスト用のJWTトークンを作成
@@ -185,7 +351,7 @@ func TestAuthInterceptor(t *testing.T) {
 			ctx := metadata.NewIncomingContext(context.Background(), md)
 
 			// インターセプターを適用
-			interceptor := AuthInterceptor(cfg)
+			interceptor := AuthInterceptor(cfg, nil, nil)
 			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 				// コンテキストからユーザー情報を取得
 				userCtx, ok := GetUserContext(ctx)
@@ -218,6 +384,352 @@ func TestAuthInterceptor(t *testing.T) {
 	}
 }
 
+// MockIdentityProviderRepository モックIdentityProviderRepository
+type MockIdentityProviderRepository struct {
+	mock.Mock
+}
+
+func (m *MockIdentityProviderRepository) GetByIssuer(ctx context.Context, issuer string) (dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, issuer)
+	if args.Get(0) == nil {
+		return dbgen.ClientIdentityProvider{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) ListByClientID(ctx context.Context, clientID uuid.UUID) ([]dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) Create(ctx context.Context, params dbgen.CreateIdentityProviderParams) (dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientIdentityProvider{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) Get(ctx context.Context, clientID uuid.UUID, providerID uuid.UUID) (dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, clientID, providerID)
+	if args.Get(0) == nil {
+		return dbgen.ClientIdentityProvider{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) Update(ctx context.Context, params dbgen.UpdateIdentityProviderParams) (dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientIdentityProvider{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) Delete(ctx context.Context, clientID uuid.UUID, providerID uuid.UUID, deletedBy uuid.UUID) (int64, error) {
+	args := m.Called(ctx, clientID, providerID, deletedBy)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestAuthInterceptor_ExternalIssuer(t *testing.T) {
+	const issuer = "https://idp.example.com"
+	const audience = "contract-pro-suite"
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	// JWKSエンドポイント
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.PublicKey.E)).Bytes()),
+		}},
+	})
+	assert.NoError(t, err)
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(jwks)
+	}))
+	defer jwksServer.Close()
+
+	provider := dbgen.ClientIdentityProvider{
+		ProviderID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		ClientID:   pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		Issuer:     issuer,
+		Audience:   audience,
+		JwksUri:    pgtype.Text{String: jwksServer.URL, Valid: true},
+		Status:     "ACTIVE",
+	}
+
+	signRS256 := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "key-1"
+		tokenString, err := token.SignedString(privateKey)
+		assert.NoError(t, err)
+		return tokenString
+	}
+
+	tests := []struct {
+		name          string
+		claims        jwt.MapClaims
+		expectedError bool
+	}{
+		{
+			name: "成功: 登録済みIdPのトークン",
+			claims: jwt.MapClaims{
+				"iss":            issuer,
+				"aud":            audience,
+				"sub":            "external-subject",
+				"email":          "sso@example.com",
+				"email_verified": true,
+				"exp":            time.Now().Add(time.Hour).Unix(),
+			},
+			expectedError: false,
+		},
+		{
+			name: "失敗: audienceが一致しない",
+			claims: jwt.MapClaims{
+				"iss": issuer,
+				"aud": "other-app",
+				"sub": "external-subject",
+				"exp": time.Now().Add(time.Hour).Unix(),
+			},
+			expectedError: true,
+		},
+		{
+			name: "失敗: 有効期限切れ",
+			claims: jwt.MapClaims{
+				"iss": issuer,
+				"aud": audience,
+				"sub": "external-subject",
+				"exp": time.Now().Add(-time.Hour).Unix(),
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idpRepo := new(MockIdentityProviderRepository)
+			idpRepo.On("GetByIssuer", mock.Anything, issuer).Return(provider, nil)
+
+			cfg := &config.Config{
+				SupabaseURL:       "https://test.supabase.co",
+				SupabaseJWTSecret: "test-secret",
+				OIDCJWKSCacheTTL:  time.Hour,
+				OIDCHTTPTimeout:   time.Second,
+			}
+
+			md := metadata.New(map[string]string{
+				"authorization": "Bearer " + signRS256(tt.claims),
+			})
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			interceptor := AuthInterceptor(cfg, idpRepo, nil)
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				userCtx, ok := GetUserContext(ctx)
+				if !ok {
+					return nil, status.Errorf(codes.Internal, "user context not found")
+				}
+				return userCtx, nil
+			}
+
+			resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{
+				FullMethod: "/test.Test/Test",
+			}, handler)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Unauthenticated, st.Code())
+				return
+			}
+
+			assert.NoError(t, err)
+			userCtx, ok := resp.(*UserContext)
+			assert.True(t, ok)
+			assert.Equal(t, issuer, userCtx.Issuer)
+			if assert.NotNil(t, userCtx.ExternalIdentity) {
+				assert.Equal(t, "external-subject", userCtx.ExternalIdentity.Subject)
+				assert.Equal(t, "sso@example.com", userCtx.ExternalIdentity.Email)
+				assert.True(t, userCtx.ExternalIdentity.EmailVerified)
+			}
+		})
+	}
+}
+
+// MockAPIKeyRepository モックAPIKeyRepository
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) GetByHash(ctx context.Context, keyHash string) (dbgen.ClientApiKey, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return dbgen.ClientApiKey{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientApiKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) GetByID(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID) (dbgen.ClientApiKey, error) {
+	args := m.Called(ctx, clientID, apiKeyID)
+	if args.Get(0) == nil {
+		return dbgen.ClientApiKey{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientApiKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) List(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID) ([]dbgen.ClientApiKey, error) {
+	args := m.Called(ctx, clientID, serviceAccountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientApiKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Create(ctx context.Context, params dbgen.CreateApiKeyParams) (dbgen.ClientApiKey, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientApiKey{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientApiKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Touch(ctx context.Context, apiKeyID uuid.UUID) error {
+	args := m.Called(ctx, apiKeyID)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) SetExpiry(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID, expiresAt time.Time) (int64, error) {
+	args := m.Called(ctx, clientID, apiKeyID, expiresAt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Revoke(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, clientID, apiKeyID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestAuthInterceptor_APIKey(t *testing.T) {
+	const key = "cps_abcdefghijklmnopqrstuvwxyz012345"
+	apiKeyID := uuid.New()
+	serviceAccountID := uuid.New()
+	clientID := uuid.New()
+
+	apiKey := func(expiresAt pgtype.Timestamptz) dbgen.ClientApiKey {
+		return dbgen.ClientApiKey{
+			ApiKeyID:         pgtype.UUID{Bytes: apiKeyID, Valid: true},
+			ClientID:         pgtype.UUID{Bytes: clientID, Valid: true},
+			ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+			KeyHash:          usecase.HashAPIKey(key),
+			ExpiresAt:        expiresAt,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		authHeader    string
+		setupMock     func(*MockAPIKeyRepository)
+		expectedError bool
+	}{
+		{
+			name:       "成功: ApiKeyスキーム",
+			authHeader: "ApiKey " + key,
+			setupMock: func(m *MockAPIKeyRepository) {
+				m.On("GetByHash", mock.Anything, usecase.HashAPIKey(key)).Return(apiKey(pgtype.Timestamptz{}), nil)
+				m.On("Touch", mock.Anything, apiKeyID).Return(nil)
+			},
+			expectedError: false,
+		},
+		{
+			name:       "成功: Bearerスキーム（接頭辞で判別）",
+			authHeader: "Bearer " + key,
+			setupMock: func(m *MockAPIKeyRepository) {
+				m.On("GetByHash", mock.Anything, usecase.HashAPIKey(key)).Return(apiKey(pgtype.Timestamptz{}), nil)
+				m.On("Touch", mock.Anything, apiKeyID).Return(nil)
+			},
+			expectedError: false,
+		},
+		{
+			name:          "失敗: 接頭辞なし（データベースを参照しない）",
+			authHeader:    "ApiKey invalid-key",
+			setupMock:     func(m *MockAPIKeyRepository) {},
+			expectedError: true,
+		},
+		{
+			name:       "失敗: 存在しないまたは取り消し済み",
+			authHeader: "ApiKey " + key,
+			setupMock: func(m *MockAPIKeyRepository) {
+				m.On("GetByHash", mock.Anything, usecase.HashAPIKey(key)).Return(nil, errors.New("no rows in result set"))
+			},
+			expectedError: true,
+		},
+		{
+			name:       "失敗: 有効期限切れ",
+			authHeader: "ApiKey " + key,
+			setupMock: func(m *MockAPIKeyRepository) {
+				expired := pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+				m.On("GetByHash", mock.Anything, usecase.HashAPIKey(key)).Return(apiKey(expired), nil)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyRepo := new(MockAPIKeyRepository)
+			tt.setupMock(apiKeyRepo)
+
+			cfg := &config.Config{
+				SupabaseURL:       "https://test.supabase.co",
+				SupabaseJWTSecret: "test-secret",
+			}
+
+			md := metadata.New(map[string]string{
+				"authorization": tt.authHeader,
+			})
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			interceptor := AuthInterceptor(cfg, nil, apiKeyRepo)
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				userCtx, ok := GetUserContext(ctx)
+				if !ok {
+					return nil, status.Errorf(codes.Internal, "user context not found")
+				}
+				return userCtx, nil
+			}
+
+			resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{
+				FullMethod: "/test.Test/Test",
+			}, handler)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Unauthenticated, st.Code())
+			} else {
+				assert.NoError(t, err)
+				userCtx, ok := resp.(*UserContext)
+				assert.True(t, ok)
+				assert.Equal(t, serviceAccountID.String(), userCtx.UserID)
+				if assert.NotNil(t, userCtx.APIKey) {
+					assert.Equal(t, apiKeyID, userCtx.APIKey.APIKeyID)
+					assert.Equal(t, clientID, userCtx.APIKey.ClientID)
+				}
+			}
+
+			apiKeyRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestEnhancedAuthInterceptor(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -225,6 +737,8 @@ func TestEnhancedAuthInterceptor(t *testing.T) {
 		userCtx        *UserContext
 		expectedUserCtx *domain.UserContext
 		expectedError  error
+		revocationError error
+		mfaError       error
 		expectedStatus codes.Code
 	}{
 		{
@@ -243,6 +757,52 @@ func TestEnhancedAuthInterceptor(t *testing.T) {
 			expectedError:  nil,
 			expectedStatus: codes.OK,
 		},
+		{
+			name:          "失敗: 失効済みトークン",
+			userCtxExists: true,
+			userCtx: &UserContext{
+				UserID: "123e4567-e89b-12d3-a456-426614174000",
+				Email:  "test@example.com",
+				Role:   "authenticated",
+				Token:  domain.TokenMetadata{ID: "session:revoked", IssuedAt: time.Now().Add(-time.Hour)},
+			},
+			expectedUserCtx: &domain.UserContext{
+				UserID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				UserType: domain.UserTypeClientUser,
+				Email:    "test@example.com",
+			},
+			revocationError: usecase.ErrTokenRevoked,
+			expectedStatus:  codes.Unauthenticated,
+		},
+		{
+			name:          "失敗: MFA未完了",
+			userCtxExists: true,
+			userCtx: &UserContext{
+				UserID: "123e4567-e89b-12d3-a456-426614174000",
+				Email:  "test@example.com",
+				Role:   "authenticated",
+				Token:  domain.TokenMetadata{AuthenticatorAssuranceLevel: "aal1"},
+			},
+			expectedUserCtx: &domain.UserContext{
+				UserID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				UserType: domain.UserTypeClientUser,
+				Email:    "test@example.com",
+			},
+			mfaError:       usecase.ErrMFARequired,
+			expectedStatus: codes.PermissionDenied,
+		},
+		{
+			name:          "失敗: 停止中のユーザー（停止後に発行されたトークン）",
+			userCtxExists: true,
+			userCtx: &UserContext{
+				UserID: "123e4567-e89b-12d3-a456-426614174000",
+				Email:  "test@example.com",
+				Role:   "authenticated",
+				Token:  domain.TokenMetadata{ID: "session:fresh", IssuedAt: time.Now()},
+			},
+			expectedError:  usecase.ErrUserNotActive,
+			expectedStatus: codes.PermissionDenied,
+		},
 		{
 			name:           "失敗: ユーザーコンテキストなし",
 			userCtxExists:  false,
@@ -260,8 +820,14 @@ func TestEnhancedAuthInterceptor(t *testing.T) {
 
 			if tt.userCtxExists {
 				ctx = context.WithValue(ctx, userContextKey, tt.userCtx)
-				if tt.expectedUserCtx != nil {
+				if tt.expectedError != nil {
+					mockUsecase.On("GetUserContext", ctx, tt.userCtx.UserID).Return((*domain.UserContext)(nil), tt.expectedError)
+				} else if tt.expectedUserCtx != nil {
 					mockUsecase.On("GetUserContext", ctx, tt.userCtx.UserID).Return(tt.expectedUserCtx, tt.expectedError)
+					mockUsecase.On("CheckTokenRevocation", ctx, tt.expectedUserCtx, tt.userCtx.Token).Return(tt.revocationError)
+					if tt.revocationError == nil {
+						mockUsecase.On("CheckMFA", ctx, tt.expectedUserCtx, tt.userCtx.Token).Return(tt.mfaError)
+					}
 				}
 			}
 
@@ -283,6 +849,16 @@ func TestEnhancedAuthInterceptor(t *testing.T) {
 				st, ok := status.FromError(err)
 				assert.True(t, ok)
 				assert.Equal(t, tt.expectedStatus, st.Code())
+				if errors.Is(tt.expectedError, usecase.ErrUserNotActive) {
+					assert.Equal(t, "user is not active", st.Message())
+				}
+				if tt.mfaError != nil {
+					// フロントエンドがステップアップ認証を判断できるようにErrorInfoを返す
+					assert.Len(t, st.Details(), 1)
+					info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+					assert.True(t, ok)
+					assert.Equal(t, MFARequiredReason, info.GetReason())
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, resp)
@@ -357,7 +933,7 @@ func TestTenantInterceptor(t *testing.T) {
 			var _ repository.ClientRepository = mockClientRepo
 			var _ usecase.AuthUsecase = mockUsecase
 
-			interceptor := TenantInterceptor(cfg, mockClientRepo, mockUsecase)
+			interceptor := TenantInterceptor(cfg, mockClientRepo, mockUsecase, nil)
 			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 				return "success", nil
 			}
@@ -0,0 +1,69 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientIP リクエスト元のIPアドレスを取得
+// 接続元が信頼済みプロキシの場合のみx-forwarded-forを右から辿り、信頼済みプロキシ以外の最初のアドレスを採用する
+// （クライアントが任意に付与できる左側の値は信頼しない）
+func ClientIP(ctx context.Context, md metadata.MD, trustedProxies []netip.Prefix) (netip.Addr, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return netip.Addr{}, errors.New("peer address not found")
+	}
+	addr, err := parseAddr(p.Addr.String())
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if !isTrustedProxy(addr, trustedProxies) {
+		return addr, nil
+	}
+
+	// x-forwarded-forは複数ヘッダー・カンマ区切りの両方があり得るため、連結してから右から辿る
+	var hops []string
+	for _, value := range md.Get("x-forwarded-for") {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := parseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			// 解析できない値より左はプロキシが付与したものと判断できないため、直前のアドレスを採用する
+			return addr, nil
+		}
+		addr = hop
+		if !isTrustedProxy(addr, trustedProxies) {
+			return addr, nil
+		}
+	}
+	return addr, nil
+}
+
+// parseAddr "ip:port"またはipの文字列からアドレスを取得
+func parseAddr(value string) (netip.Addr, error) {
+	if addrPort, err := netip.ParseAddrPort(value); err == nil {
+		return addrPort.Addr().Unmap(), nil
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.Unmap(), nil
+}
+
+// isTrustedProxy 信頼済みプロキシのアドレスかどうかを判定
+func isTrustedProxy(addr netip.Addr, trustedProxies []netip.Prefix) bool {
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIP(t *testing.T) {
+	trustedProxies := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+	}
+
+	tests := []struct {
+		name         string
+		peerAddr     string
+		forwardedFor []string
+		expectedIP   string
+	}{
+		{
+			name:       "プロキシなし: 接続元アドレス",
+			peerAddr:   "203.0.113.10:51234",
+			expectedIP: "203.0.113.10",
+		},
+		{
+			name:         "信頼されていない接続元のx-forwarded-forは無視",
+			peerAddr:     "203.0.113.10:51234",
+			forwardedFor: []string{"198.51.100.1"},
+			expectedIP:   "203.0.113.10",
+		},
+		{
+			name:         "信頼済みプロキシ経由: 右から最初の信頼されていないアドレス",
+			peerAddr:     "10.0.0.5:51234",
+			forwardedFor: []string{"192.0.2.1, 198.51.100.7, 10.0.0.9"},
+			expectedIP:   "198.51.100.7",
+		},
+		{
+			name:         "複数のx-forwarded-forヘッダー",
+			peerAddr:     "10.0.0.5:51234",
+			forwardedFor: []string{"192.0.2.1", "198.51.100.7"},
+			expectedIP:   "198.51.100.7",
+		},
+		{
+			name:         "解析できない値は採用しない",
+			peerAddr:     "10.0.0.5:51234",
+			forwardedFor: []string{"192.0.2.1, unknown"},
+			expectedIP:   "10.0.0.5",
+		},
+		{
+			name:       "IPv4射影IPv6アドレス",
+			peerAddr:   "[::ffff:203.0.113.10]:51234",
+			expectedIP: "203.0.113.10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := net.ResolveTCPAddr("tcp", tt.peerAddr)
+			assert.NoError(t, err)
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+
+			md := metadata.MD{}
+			for _, value := range tt.forwardedFor {
+				md.Append("x-forwarded-for", value)
+			}
+
+			ip, err := ClientIP(ctx, md, trustedProxies)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedIP, ip.String())
+		})
+	}
+}
+
+func TestClientIP_NoPeer(t *testing.T) {
+	_, err := ClientIP(context.Background(), metadata.MD{}, nil)
+	assert.Error(t, err)
+}
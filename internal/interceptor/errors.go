@@ -0,0 +1,119 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"contract-pro-suite/services/auth/domain"
+)
+
+// internalErrorMessage 内部エラーの場合にクライアントに返すメッセージ（SQLエラー等の詳細は返さない）
+const internalErrorMessage = "internal error"
+
+// errorKindCodes ドメインエラーの種類とgRPCステータスコードの対応
+var errorKindCodes = map[domain.ErrorKind]codes.Code{
+	domain.ErrorKindNotFound:           codes.NotFound,
+	domain.ErrorKindConflict:           codes.AlreadyExists,
+	domain.ErrorKindAborted:            codes.Aborted,
+	domain.ErrorKindPermissionDenied:   codes.PermissionDenied,
+	domain.ErrorKindValidation:         codes.InvalidArgument,
+	domain.ErrorKindPreconditionFailed: codes.FailedPrecondition,
+}
+
+// ToStatus ハンドラーが返したエラーをgRPCステータスに変換
+//   - ドメインエラー（domain.Error）: 種類に応じたコードに、ErrorInfo（reason）とBadRequest（違反フィールド）の詳細を付与
+//   - gRPCステータス: そのまま返す（Internal/Unknownはメッセージを伏せる）
+//   - コンテキストのキャンセル・タイムアウト: Canceled/DeadlineExceeded
+//   - それ以外: Internal（メッセージは伏せる）
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+
+	var domainErr *domain.Error
+	if errors.As(err, &domainErr) {
+		code, ok := errorKindCodes[domainErr.Kind]
+		if !ok {
+			return status.New(codes.Internal, internalErrorMessage)
+		}
+		return withErrorDetails(status.New(code, err.Error()), domainErr, fieldViolations(err, domainErr))
+	}
+
+	if st, ok := status.FromError(err); ok {
+		if st.Code() == codes.Internal || st.Code() == codes.Unknown {
+			return status.New(codes.Internal, internalErrorMessage)
+		}
+		return st
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.New(codes.Internal, internalErrorMessage)
+	}
+}
+
+// fieldViolations エラーチェーンの違反フィールドを取得（詳細なエラー型の違反を優先し、なければドメインエラーの違反）
+func fieldViolations(err error, domainErr *domain.Error) []domain.FieldViolation {
+	var violator domain.FieldViolator
+	if errors.As(err, &violator) {
+		return violator.FieldViolations()
+	}
+	return domainErr.Violations
+}
+
+// withErrorDetails ステータスにErrorInfoとBadRequestの詳細を付与
+func withErrorDetails(st *status.Status, domainErr *domain.Error, violations []domain.FieldViolation) *status.Status {
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: string(domainErr.Reason),
+		Domain: domain.ErrorDomain,
+	}}
+	if len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+				Reason:      violation.Reason,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// ErrorInterceptor ハンドラーが返したエラーをgRPCステータスに変換するインターセプター
+// 内部エラーは詳細をログにのみ出力し、クライアントには伏せたメッセージを返す
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		st := ToStatus(err)
+		if st.Code() == codes.Internal {
+			log.Printf("internal error: method=%s, error=%v", info.FullMethod, err)
+		}
+		return nil, st.Err()
+	}
+}
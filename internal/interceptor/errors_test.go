@@ -0,0 +1,127 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/usecase"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+		reason  string
+	}{
+		{"NotFound", fmt.Errorf("%w: 123", usecase.ErrClientUserNotFound), codes.NotFound, "client user not found: 123", "CLIENT_USER_NOT_FOUND"},
+		{"Conflict", usecase.ErrEmailAlreadyExists, codes.AlreadyExists, "email already exists", "EMAIL_ALREADY_EXISTS"},
+		{"Aborted", usecase.ErrConcurrentModification, codes.Aborted, "resource was modified concurrently", "CONCURRENT_MODIFICATION"},
+		{"PermissionDenied", fmt.Errorf("%w: viewer can only read", usecase.ErrPermissionDenied), codes.PermissionDenied, "permission denied: viewer can only read", "PERMISSION_DENIED"},
+		{"PreconditionFailed", usecase.ErrETagMismatch, codes.FailedPrecondition, "etag does not match the current version", "ETAG_MISMATCH"},
+		{"Validation", usecase.ErrInvalidPageToken, codes.InvalidArgument, "invalid page token", "INVALID_PAGE_TOKEN"},
+		{"既存のステータスはそのまま", status.Error(codes.Unauthenticated, "unauthorized"), codes.Unauthenticated, "unauthorized", ""},
+		{"内部エラーのステータスは伏せる", status.Error(codes.Internal, "failed to query: syntax error at or near"), codes.Internal, "internal error", ""},
+		{"キャンセル", fmt.Errorf("failed to get client user: %w", context.Canceled), codes.Canceled, "failed to get client user: context canceled", ""},
+		{"タイムアウト", context.DeadlineExceeded, codes.DeadlineExceeded, "context deadline exceeded", ""},
+		{"SQLエラー等は伏せる", fmt.Errorf("failed to get client user: %w", pgx.ErrNoRows), codes.Internal, "internal error", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := ToStatus(tt.err)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+
+			var info *errdetails.ErrorInfo
+			for _, detail := range st.Details() {
+				if d, ok := detail.(*errdetails.ErrorInfo); ok {
+					info = d
+				}
+			}
+			if tt.reason == "" {
+				assert.Nil(t, info)
+				return
+			}
+			if assert.NotNil(t, info) {
+				assert.Equal(t, tt.reason, info.Reason)
+				assert.Equal(t, domain.ErrorDomain, info.Domain)
+			}
+		})
+	}
+
+	assert.Nil(t, ToStatus(nil))
+}
+
+func TestToStatus_FieldViolations(t *testing.T) {
+	badRequestOf := func(st *status.Status) *errdetails.BadRequest {
+		for _, detail := range st.Details() {
+			if d, ok := detail.(*errdetails.BadRequest); ok {
+				return d
+			}
+		}
+		return nil
+	}
+
+	t.Run("ドメインエラーの違反フィールド", func(t *testing.T) {
+		badRequest := badRequestOf(ToStatus(usecase.ErrETagRequired))
+		if assert.NotNil(t, badRequest) && assert.Len(t, badRequest.FieldViolations, 1) {
+			assert.Equal(t, "etag", badRequest.FieldViolations[0].Field)
+			assert.Equal(t, "ETAG_REQUIRED", badRequest.FieldViolations[0].Reason)
+		}
+	})
+
+	t.Run("詳細なエラー型の違反を優先する", func(t *testing.T) {
+		err := fmt.Errorf("failed to create client user: %w", &usecase.PasswordPolicyError{
+			Field: "admin_password",
+			Violations: []usecase.PasswordViolation{
+				{Code: usecase.PasswordViolationTooShort, Description: "must be at least 12 characters"},
+				{Code: usecase.PasswordViolationMissingDigit, Description: "must contain a digit"},
+			},
+		})
+		st := ToStatus(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		badRequest := badRequestOf(st)
+		if assert.NotNil(t, badRequest) && assert.Len(t, badRequest.FieldViolations, 2) {
+			assert.Equal(t, "admin_password", badRequest.FieldViolations[0].Field)
+			assert.Equal(t, "TOO_SHORT", badRequest.FieldViolations[0].Reason)
+			assert.Equal(t, "MISSING_DIGIT", badRequest.FieldViolations[1].Reason)
+		}
+	})
+
+	t.Run("違反フィールドがない場合はBadRequestを付与しない", func(t *testing.T) {
+		assert.Nil(t, badRequestOf(ToStatus(usecase.ErrClientUserNotFound)))
+	})
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	interceptor := ErrorInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/contractpro.auth.v1.AuthService/GetClientUser"}
+
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("%w: 123", usecase.ErrClientUserNotFound)
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New(`ERROR: relation "client_users" does not exist (SQLSTATE 42P01)`)
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, status.Convert(err).Message(), "client_users")
+}
@@ -0,0 +1,150 @@
+package interceptor
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/ratelimit"
+)
+
+// RateLimitInterceptor レート制限インターセプター（トークンバケット）
+// 公開メソッドはIPアドレス単位、認証済みメソッドは(client_id, ユーザーID)単位で制限する
+// ユーザー・クライアントを参照するため、TenantInterceptorの後に適用する
+func RateLimitInterceptor(
+	cfg *config.Config,
+	policy *ratelimit.Policy,
+	store ratelimit.Store,
+) grpc.UnaryServerInterceptor {
+	trustedProxies, err := cfg.TrustedProxies()
+	if err != nil {
+		// 不正な設定の場合はx-forwarded-forを信頼せず、接続元アドレスのみを使用する
+		log.Printf("Ignoring TRUSTED_PROXIES: %v", err)
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// レート制限が無効の場合・ヘルスチェック等の場合はスキップ
+		if policy == nil || store == nil || IsInfrastructureMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		public := IsPublicMethod(info.FullMethod)
+		limit, scope := policy.LimitFor(info.FullMethod, public)
+		if limit.Unlimited() {
+			return handler(ctx, req)
+		}
+
+		key := rateLimitKey(ctx, scope, public, trustedProxies)
+		result, err := store.Allow(ctx, key, limit)
+		if err != nil {
+			// ストアの障害でサービス全体を停止させないため、制限せずに処理を継続する
+			log.Printf("Rate limit store error: %v", err)
+			return handler(ctx, req)
+		}
+		if !result.Allowed {
+			return nil, rateLimitExceededError(ctx, result.RetryAfter)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// PreAuthRateLimitInterceptor 認証前のレート制限インターセプター（IPアドレス単位、全メソッド共通）
+// 無効なJWT・APIキーによる総当たりと、認証時のデータベース参照（APIキー・IdP・失効・クライアント）を制限するため、
+// AuthInterceptorの前に適用する。ユーザー単位の制限は認証後のRateLimitInterceptorで行う
+func PreAuthRateLimitInterceptor(
+	cfg *config.Config,
+	policy *ratelimit.Policy,
+	store ratelimit.Store,
+) grpc.UnaryServerInterceptor {
+	trustedProxies, err := cfg.TrustedProxies()
+	if err != nil {
+		// 不正な設定の場合はx-forwarded-forを信頼せず、接続元アドレスのみを使用する
+		log.Printf("Ignoring TRUSTED_PROXIES: %v", err)
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// レート制限が無効の場合・ヘルスチェック等の場合はスキップ
+		if policy == nil || store == nil || policy.PreAuth.Unlimited() || IsInfrastructureMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		result, err := store.Allow(ctx, ipRateLimitKey(ctx, "preauth", trustedProxies), policy.PreAuth)
+		if err != nil {
+			// ストアの障害でサービス全体を停止させないため、制限せずに処理を継続する
+			log.Printf("Rate limit store error: %v", err)
+			return handler(ctx, req)
+		}
+		if !result.Allowed {
+			return nil, rateLimitExceededError(ctx, result.RetryAfter)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitKey バケットのキーを作成
+func rateLimitKey(ctx context.Context, scope string, public bool, trustedProxies []netip.Prefix) string {
+	if !public {
+		if userCtx, ok := GetEnhancedUserContext(ctx); ok {
+			clientID := userCtx.ClientID
+			if tenantClientID, ok := GetClientIDFromContext(ctx); ok {
+				clientID = tenantClientID
+			}
+			return "user:" + scope + ":" + clientID.String() + ":" + userCtx.UserID.String()
+		}
+	}
+
+	// 公開メソッド（またはユーザーが特定できない場合）はIPアドレス単位
+	return ipRateLimitKey(ctx, scope, trustedProxies)
+}
+
+// ipRateLimitKey IPアドレス単位のバケットのキーを作成
+func ipRateLimitKey(ctx context.Context, scope string, trustedProxies []netip.Prefix) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	remoteIP, err := ClientIP(ctx, md, trustedProxies)
+	if err != nil {
+		return "ip:" + scope + ":unknown"
+	}
+	return "ip:" + scope + ":" + remoteIP.String()
+}
+
+// rateLimitExceededError レート制限超過のgRPCエラー（retry-afterヘッダーとRetryInfoの詳細付き）
+func rateLimitExceededError(ctx context.Context, retryAfter time.Duration) error {
+	// retry-afterは秒単位（切り上げ、最低1秒）
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.FormatInt(seconds, 10)))
+
+	st := status.New(codes.ResourceExhausted, "rate limit exceeded")
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Duration(seconds) * time.Second),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
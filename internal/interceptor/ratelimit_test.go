@@ -0,0 +1,115 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/ratelimit"
+	"contract-pro-suite/services/auth/domain"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	policy, err := ratelimit.ParsePolicy("2/m", "1/m", "")
+	assert.NoError(t, err)
+
+	interceptor := RateLimitInterceptor(&config.Config{}, policy, ratelimit.NewMemoryStore())
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "success", nil
+	}
+
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	withPeer := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+	}
+
+	t.Run("公開メソッドはIPアドレス単位", func(t *testing.T) {
+		assert.NoError(t, call(withPeer("203.0.113.1"), "/contractpro.auth.v1.AuthService/SignupClient"))
+
+		err := call(withPeer("203.0.113.1"), "/contractpro.auth.v1.AuthService/SignupClient")
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		// クライアントが再試行のタイミングを判断できるようにRetryInfoを返す
+		if assert.Len(t, st.Details(), 1) {
+			info, ok := st.Details()[0].(*errdetails.RetryInfo)
+			assert.True(t, ok)
+			assert.Equal(t, int64(60), info.GetRetryDelay().GetSeconds())
+		}
+
+		// 別のIPアドレスは別のバケット
+		assert.NoError(t, call(withPeer("203.0.113.2"), "/contractpro.auth.v1.AuthService/SignupClient"))
+	})
+
+	t.Run("認証済みメソッドはユーザー単位", func(t *testing.T) {
+		clientID := uuid.New()
+		userA := SetEnhancedUserContextForTest(withPeer("203.0.113.3"), &domain.UserContext{UserID: uuid.New(), ClientID: clientID})
+		userB := SetEnhancedUserContextForTest(withPeer("203.0.113.3"), &domain.UserContext{UserID: uuid.New(), ClientID: clientID})
+
+		assert.NoError(t, call(userA, "/contractpro.auth.v1.AuthService/GetMe"))
+		assert.NoError(t, call(userA, "/contractpro.auth.v1.AuthService/ListClientUsers"))
+		err := call(userA, "/contractpro.auth.v1.AuthService/GetMe")
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// 同じIPアドレスでもユーザーが異なれば別のバケット
+		assert.NoError(t, call(userB, "/contractpro.auth.v1.AuthService/GetMe"))
+	})
+
+	t.Run("無効の場合は制限しない", func(t *testing.T) {
+		disabled := RateLimitInterceptor(&config.Config{}, nil, nil)
+		for i := 0; i < 5; i++ {
+			_, err := disabled(withPeer("203.0.113.4"), nil, &grpc.UnaryServerInfo{FullMethod: "/contractpro.auth.v1.AuthService/SignupClient"}, handler)
+			assert.NoError(t, err)
+		}
+	})
+}
+
+func TestPreAuthRateLimitInterceptor(t *testing.T) {
+	policy, err := ratelimit.ParsePolicy("100/m", "100/m", "")
+	assert.NoError(t, err)
+	policy.PreAuth, err = ratelimit.ParseLimit("2/m")
+	assert.NoError(t, err)
+
+	// 認証前に適用するため、ハンドラー（後続のAuthInterceptor）は制限を超えたリクエストでは呼ばれない
+	calls := 0
+	interceptor := PreAuthRateLimitInterceptor(&config.Config{}, policy, ratelimit.NewMemoryStore())
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	call := func(ip, method string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	t.Run("無効なトークンのリクエストもIPアドレス単位で制限", func(t *testing.T) {
+		assert.Equal(t, codes.Unauthenticated, status.Code(call("203.0.113.10", "/contractpro.auth.v1.AuthService/GetMe")))
+		assert.Equal(t, codes.Unauthenticated, status.Code(call("203.0.113.10", "/contractpro.auth.v1.AuthService/ListClientUsers")))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(call("203.0.113.10", "/contractpro.auth.v1.AuthService/GetMe")))
+		assert.Equal(t, 2, calls)
+
+		// 別のIPアドレスは別のバケット
+		assert.Equal(t, codes.Unauthenticated, status.Code(call("203.0.113.11", "/contractpro.auth.v1.AuthService/GetMe")))
+	})
+
+	t.Run("無効の場合は制限しない", func(t *testing.T) {
+		disabled := PreAuthRateLimitInterceptor(&config.Config{}, &ratelimit.Policy{}, ratelimit.NewMemoryStore())
+		for i := 0; i < 5; i++ {
+			_, err := disabled(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/contractpro.auth.v1.AuthService/GetMe"}, handler)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"contract-pro-suite/internal/shared/legacyapi"
+)
+
+// StreamInterceptor Unaryインターセプターをストリーミング（サーバーストリーミング）RPCに適用するインターセプターに変換
+// 既存のインターセプターはリクエストの内容を参照せず、コンテキストの検証・付与のみを行うため、
+// ハンドラーにはインターセプターが設定したコンテキストを持つストリームを渡す
+func StreamInterceptor(unary grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// 旧サービス名で呼び出された場合もUnary RPCと同じく新しいフルメソッド名で判定する
+		unaryInfo := &grpc.UnaryServerInfo{Server: srv, FullMethod: legacyapi.CanonicalMethod(info.FullMethod)}
+		_, err := unary(ss.Context(), nil, unaryInfo, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+		})
+		return err
+	}
+}
+
+// contextServerStream コンテキストを差し替えたServerStream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,53 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ctxKey struct{}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	// Unaryインターセプターが設定したコンテキストがストリームのハンドラーに渡される
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == "/contractpro.auth.v1.AuthService/Denied" {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		return handler(context.WithValue(ctx, ctxKey{}, info.FullMethod), req)
+	}
+	stream := StreamInterceptor(unary)
+
+	var got interface{}
+	err := stream(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/auth.AuthService/ExportClientUsers"},
+		func(srv interface{}, ss grpc.ServerStream) error {
+			got = ss.Context().Value(ctxKey{})
+			return nil
+		})
+	assert.NoError(t, err)
+	// 旧サービス名で呼び出された場合は新しいフルメソッド名でUnaryインターセプターに渡す
+	assert.Equal(t, "/contractpro.auth.v1.AuthService/ExportClientUsers", got)
+
+	// インターセプターが拒否した場合はハンドラーを呼び出さない
+	called := false
+	err = stream(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/contractpro.auth.v1.AuthService/Denied"},
+		func(srv interface{}, ss grpc.ServerStream) error {
+			called = true
+			return nil
+		})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.False(t, called)
+}
@@ -0,0 +1,80 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"contract-pro-suite/internal/shared/validation"
+	"contract-pro-suite/services/auth/domain"
+)
+
+// ValidationInterceptor auth.protoで宣言したルール（validate.field）でリクエストを検証するインターセプター
+// すべての違反をまとめてドメインエラー（ErrorKindValidation）として返し、ErrorInterceptorがBadRequestの詳細付きのInvalidArgumentに変換する
+func ValidationInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := validateRequest(msg); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamInterceptor ストリーミングRPCのリクエストを受信時に検証するインターセプター
+// （StreamInterceptorで変換したインターセプターにはリクエストが渡されないため、受信したメッセージを検証する）
+func ValidationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss})
+	}
+}
+
+// validatingServerStream 受信したメッセージを検証するServerStream
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return validateRequest(msg)
+	}
+	return nil
+}
+
+// validateRequest ルールの違反をドメインエラーに変換（違反がない場合はnil）
+func validateRequest(msg proto.Message) error {
+	violations := validation.Validate(msg)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	err := &domain.Error{Kind: domain.ErrorKindValidation, Reason: domain.ReasonInvalidRequest}
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		err.Violations = append(err.Violations, domain.FieldViolation{
+			Field:       violation.Field,
+			Reason:      violation.Reason,
+			Description: violation.Description,
+		})
+		messages = append(messages, violation.Field+" "+violation.Description)
+	}
+	err.Message = fmt.Sprintf("invalid request: %s", strings.Join(messages, "; "))
+	return err
+}
@@ -0,0 +1,83 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+)
+
+func TestValidationInterceptor(t *testing.T) {
+	interceptor := ValidationInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: pbauth.AuthService_CreateClientUser_FullMethodName}
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return &pbauth.CreateClientUserResponse{}, nil
+	}
+
+	// すべての違反をまとめてBadRequestとして返し、ハンドラーは呼び出さない
+	_, err := interceptor(context.Background(), &pbauth.CreateClientUserRequest{
+		Email:     "not-an-email",
+		FirstName: "Taro",
+	}, info, handler)
+	assert.False(t, called)
+	st := ToStatus(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field+":"+violation.Reason)
+			}
+		}
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			assert.Equal(t, "INVALID_REQUEST", info.Reason)
+		}
+	}
+	assert.Equal(t, []string{"email:INVALID_EMAIL", "password:REQUIRED", "last_name:REQUIRED"}, fields)
+
+	// 違反がなければハンドラーを呼び出す
+	resp, err := interceptor(context.Background(), &pbauth.CreateClientUserRequest{
+		Email:     "user@example.com",
+		Password:  "Password123!",
+		FirstName: "Taro",
+		LastName:  "Yamada",
+	}, info, handler)
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.True(t, called)
+}
+
+// recvServerStream RecvMsgで指定したリクエストを返すServerStream
+type recvServerStream struct {
+	fakeServerStream
+	req *pbauth.ExportClientUsersRequest
+}
+
+func (s *recvServerStream) RecvMsg(m interface{}) error {
+	*m.(*pbauth.ExportClientUsersRequest) = pbauth.ExportClientUsersRequest{Status: s.req.Status}
+	return nil
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	stream := ValidationStreamInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: pbauth.AuthService_ExportClientUsers_FullMethodName}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		// 生成コードのハンドラーと同様に、リクエストを受信してからサーバーを呼び出す
+		return ss.RecvMsg(new(pbauth.ExportClientUsersRequest))
+	}
+
+	ss := &recvServerStream{fakeServerStream: fakeServerStream{ctx: context.Background()}}
+	ss.req = &pbauth.ExportClientUsersRequest{Status: "DELETED"}
+	err := stream(nil, ss, info, handler)
+	assert.Equal(t, codes.InvalidArgument, ToStatus(err).Code())
+
+	ss.req = &pbauth.ExportClientUsersRequest{Status: "ACTIVE"}
+	assert.NoError(t, stream(nil, ss, info, handler))
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"net/netip"
 	"os"
 	"strings"
 	"time"
@@ -14,7 +15,7 @@ import (
 type Config struct {
 	// アプリケーション設定
 	AppEnv   string `envconfig:"APP_ENV" default:"development"`
-	AppPort  string `envconfig:"APP_PORT" default:"8080"` // 非推奨: HTTP REST API用（将来削除予定）
+	AppPort  string `envconfig:"APP_PORT" default:"8080"` // HTTP用（SCIMエンドポイント、HTTP/JSONゲートウェイ）
 	GRPCPort string `envconfig:"GRPC_PORT" default:"8081"`
 
 	// Supabase設定
@@ -23,10 +24,14 @@ type Config struct {
 	SupabaseJWTSecret      string `envconfig:"SUPABASE_JWT_SECRET" required:"true"`
 	SupabaseURL            string `envconfig:"SUPABASE_URL" required:"true"`
 
+	// 外部IdP（OIDC）設定
+	OIDCJWKSCacheTTL time.Duration `envconfig:"OIDC_JWKS_CACHE_TTL" default:"1h"`
+	OIDCHTTPTimeout  time.Duration `envconfig:"OIDC_HTTP_TIMEOUT" default:"5s"`
+
 	// テナント設定
 	DefaultClientID string `envconfig:"DEFAULT_CLIENT_ID" default:"00000000-0000-0000-0000-000000000000"`
 
-	// CORS設定
+	// CORS設定（HTTP/JSONゲートウェイ・gRPC-Web・Connect、カンマ区切りで複数指定可能、"*"は全オリジン）
 	CORSOrigin string `envconfig:"CORS_ORIGIN" default:"http://localhost:3001"`
 
 	// データベース接続設定
@@ -39,6 +44,54 @@ type Config struct {
 	BaseDomain                string `envconfig:"BASE_DOMAIN" default:"contractprosuite.com"`
 	AllowedDomainsStr         string `envconfig:"ALLOWED_DOMAINS" default:"contractprosuite.com,localhost"`
 	EnableSubdomainValidation bool   `envconfig:"ENABLE_SUBDOMAIN_VALIDATION" default:"true"`
+
+	// IPアドレス許可リスト設定
+	TrustedProxiesStr         string `envconfig:"TRUSTED_PROXIES" default:""`                  // x-forwarded-forを信頼するプロキシ（CIDR、カンマ区切り）。未設定の場合は接続元アドレスのみを使用
+	IPAllowlistOperatorBypass bool   `envconfig:"IP_ALLOWLIST_OPERATOR_BYPASS" default:"true"` // オペレーターはクライアントのIPアドレス許可リストの対象外とするか
+
+	// レート制限設定（形式: <回数>/<s|m|h>[:<バースト>]、"0"は無制限）
+	RateLimitEnabled       bool   `envconfig:"RATE_LIMIT_ENABLED" default:"true"`
+	RateLimitDefault       string `envconfig:"RATE_LIMIT_DEFAULT" default:"20/s:40"`                                                                       // 認証済みメソッドの既定値（client_id・ユーザー単位）
+	RateLimitPreAuth       string `envconfig:"RATE_LIMIT_PRE_AUTH" default:"10/s:50"`                                                                       // 認証前に全メソッドに適用する上限（IPアドレス単位）
+	RateLimitPublicDefault string `envconfig:"RATE_LIMIT_PUBLIC_DEFAULT" default:"30/m:10"`                                                                // 公開メソッドの既定値（IPアドレス単位）
+	RateLimitMethodsStr    string `envconfig:"RATE_LIMIT_METHODS" default:"/contractpro.auth.v1.AuthService/SignupClient=5/h:3,/contractpro.auth.v1.AuthService/ChangeMyPassword=5/m:5"` // メソッドごとの上書き（<フルメソッド名>=<制限>のカンマ区切り）
+
+	// サインアップ（ボット・不正利用対策）設定
+	SignupVerificationURL     string        `envconfig:"SIGNUP_VERIFICATION_URL" default:"http://localhost:3001/signup/verify"` // 登録確認メールのリンク先（?token=<トークン>を付与）
+	SignupVerificationTTL     time.Duration `envconfig:"SIGNUP_VERIFICATION_TTL" default:"24h"`                                 // 登録確認トークンの有効期間
+	DisposableEmailDomainsStr string        `envconfig:"DISPOSABLE_EMAIL_DOMAINS" default:""`                                   // 組み込みリストに追加する使い捨てメールドメイン（カンマ区切り）
+	SignupChallengeLocalToken string        `envconfig:"SIGNUP_CHALLENGE_LOCAL_TOKEN" default:""`                               // ローカル開発用のチャレンジトークン。未設定の場合はチャレンジ検証を行わない
+
+	// パスワードポリシー設定（クライアント設定のpassword_policyキーで強化のみ可能）
+	PasswordMinLength          int    `envconfig:"PASSWORD_MIN_LENGTH" default:"12"`
+	PasswordRequireUppercase   bool   `envconfig:"PASSWORD_REQUIRE_UPPERCASE" default:"true"`
+	PasswordRequireLowercase   bool   `envconfig:"PASSWORD_REQUIRE_LOWERCASE" default:"true"`
+	PasswordRequireDigit       bool   `envconfig:"PASSWORD_REQUIRE_DIGIT" default:"true"`
+	PasswordRequireSymbol      bool   `envconfig:"PASSWORD_REQUIRE_SYMBOL" default:"false"`
+	PasswordBreachedHashesFile string `envconfig:"PASSWORD_BREACHED_HASHES_FILE" default:""` // 漏洩済みパスワードのSHA-1ハッシュリスト。未設定の場合は漏洩チェックを行わない
+
+	// メールアドレス変更（セルフサービス）設定
+	EmailChangeVerificationURL string        `envconfig:"EMAIL_CHANGE_VERIFICATION_URL" default:"http://localhost:3001/me/email/confirm"` // 確認メールのリンク先（?token=<トークン>を付与）
+	EmailChangeVerificationTTL time.Duration `envconfig:"EMAIL_CHANGE_VERIFICATION_TTL" default:"24h"`                                    // 確認トークンの有効期間
+
+	// メール送信（SMTP）設定（登録確認・メールアドレス変更の確認メール）
+	// 未設定の場合、確認URLのログ出力はAPP_ENV=developmentでのみ許可し、それ以外の環境では起動しない
+	SMTPHost     string `envconfig:"SMTP_HOST" default:""`
+	SMTPPort     string `envconfig:"SMTP_PORT" default:"587"`
+	SMTPUsername string `envconfig:"SMTP_USERNAME" default:""` // 未設定の場合はSMTP認証を行わない
+	SMTPPassword string `envconfig:"SMTP_PASSWORD" default:""`
+	MailFrom     string `envconfig:"MAIL_FROM" default:""` // 送信元のメールアドレス（SMTP_HOSTを設定した場合は必須）
+
+	// ヘルスチェック・シャットダウン設定
+	HealthCheckTimeout    time.Duration `envconfig:"HEALTH_CHECK_TIMEOUT" default:"2s"`      // 依存先（Postgres・マイグレーション・IdP）ごとの確認のタイムアウト
+	HealthCheckInterval   time.Duration `envconfig:"HEALTH_CHECK_INTERVAL" default:"10s"`    // gRPCのヘルスチェック（grpc.health.v1）の状態を更新する間隔
+	HealthCheckMigrations bool          `envconfig:"HEALTH_CHECK_MIGRATIONS" default:"true"` // 適用済みのマイグレーションのバージョン（schema_versions）を確認するか
+	ShutdownDrainDelay    time.Duration `envconfig:"SHUTDOWN_DRAIN_DELAY" default:"5s"`      // NOT_SERVINGにしてからサーバーを停止するまでの待ち時間（ロードバランサーの振り分け停止を待つ）
+}
+
+// IsDevelopment 開発環境（APP_ENV=development）か判定
+func (c *Config) IsDevelopment() bool {
+	return c.AppEnv == "development"
 }
 
 // AllowedDomains 許可されたドメインのリストを取得
@@ -57,6 +110,72 @@ func (c *Config) AllowedDomains() []string {
 	return result
 }
 
+// CORSOrigins CORSで許可するオリジンのリストを取得
+func (c *Config) CORSOrigins() []string {
+	if c.CORSOrigin == "" {
+		return nil
+	}
+	origins := strings.Split(c.CORSOrigin, ",")
+	result := make([]string, 0, len(origins))
+	for _, origin := range origins {
+		origin = strings.TrimSuffix(strings.TrimSpace(origin), "/")
+		if origin != "" {
+			result = append(result, origin)
+		}
+	}
+	return result
+}
+
+// DisposableEmailDomains 組み込みリストに追加する使い捨てメールドメインのリストを取得（小文字に正規化）
+func (c *Config) DisposableEmailDomains() []string {
+	if c.DisposableEmailDomainsStr == "" {
+		return nil
+	}
+	domains := strings.Split(c.DisposableEmailDomainsStr, ",")
+	result := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain != "" {
+			result = append(result, domain)
+		}
+	}
+	return result
+}
+
+// TrustedProxies x-forwarded-forを信頼するプロキシのアドレス範囲を取得（単一アドレスも指定可能）
+func (c *Config) TrustedProxies() ([]netip.Prefix, error) {
+	if c.TrustedProxiesStr == "" {
+		return nil, nil
+	}
+	values := strings.Split(c.TrustedProxiesStr, ",")
+	result := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !strings.Contains(value, "/") {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid TRUSTED_PROXIES entry %q: %w", value, err)
+			}
+			result = append(result, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRUSTED_PROXIES entry %q: %w", value, err)
+		}
+		result = append(result, prefix.Masked())
+	}
+	return result, nil
+}
+
+// SupabaseIssuer Supabase Authが発行するJWTのiss（例: https://<ref>.supabase.co/auth/v1）
+func (c *Config) SupabaseIssuer() string {
+	return strings.TrimSuffix(c.SupabaseURL, "/") + "/auth/v1"
+}
+
 // Load 環境変数から設定を読み込む
 func Load() (*Config, error) {
 	var cfg Config
@@ -91,5 +210,8 @@ func (c *Config) Validate() error {
 	if c.SupabaseURL == "" {
 		return fmt.Errorf("SUPABASE_URL is required")
 	}
+	if _, err := c.TrustedProxies(); err != nil {
+		return err
+	}
 	return nil
 }
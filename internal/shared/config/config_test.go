@@ -147,3 +147,71 @@ func TestAllowedDomains(t *testing.T) {
 	}
 }
 
+
+func TestTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "未設定", value: "", want: []string{}},
+		{name: "CIDRと単一アドレス", value: "10.0.0.0/8, 192.168.1.10 ,2001:db8::/32", want: []string{"10.0.0.0/8", "192.168.1.10/32", "2001:db8::/32"}},
+		{name: "ホストビットはマスク", value: "10.1.2.3/8", want: []string{"10.0.0.0/8"}},
+		{name: "不正な値", value: "10.0.0.0/8,proxy.internal", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{TrustedProxiesStr: tt.value}
+
+			got, err := cfg.TrustedProxies()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("TrustedProxies() expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TrustedProxies() failed: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("TrustedProxies() length = %d, want %d", len(got), len(tt.want))
+			}
+			for i, prefix := range got {
+				if prefix.String() != tt.want[i] {
+					t.Errorf("TrustedProxies()[%d] = %s, want %s", i, prefix, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCORSOrigins(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "未設定", value: "", want: nil},
+		{name: "単一オリジン", value: "http://localhost:3001", want: []string{"http://localhost:3001"}},
+		{name: "複数オリジン（空白・末尾のスラッシュは除去）", value: "https://app.example.com/, https://admin.example.com ,", want: []string{"https://app.example.com", "https://admin.example.com"}},
+		{name: "全オリジン", value: "*", want: []string{"*"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{CORSOrigin: tt.value}
+
+			got := cfg.CORSOrigins()
+			if len(got) != len(tt.want) {
+				t.Fatalf("CORSOrigins() length = %d, want %d", len(got), len(tt.want))
+			}
+			for i, origin := range got {
+				if origin != tt.want[i] {
+					t.Errorf("CORSOrigins()[%d] = %s, want %s", i, origin, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package cors
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Policy CORSの設定
+type Policy struct {
+	AllowedOrigins []string // 許可するオリジン（"*"は全オリジン、この場合は資格情報付きのリクエストを許可しない）
+	AllowedMethods []string // プリフライトで許可するメソッド
+	AllowedHeaders []string // プリフライトで許可するリクエストヘッダー
+	ExposedHeaders []string // ブラウザのスクリプトに公開するレスポンスヘッダー
+	MaxAge         int      // プリフライトの結果をキャッシュする秒数（0の場合は指定しない）
+}
+
+// Handler nextの前にCORSのヘッダーを付与するハンドラーを作成
+// プリフライト（OPTIONSかつAccess-Control-Request-Methodあり）はnextを呼び出さずに204を返す
+// 許可されていないオリジンにはCORSのヘッダーを付与しない（ブラウザがレスポンスを拒否する）
+func (p Policy) Handler(next http.Handler) http.Handler {
+	allowMethods := strings.Join(p.AllowedMethods, ", ")
+	allowHeaders := strings.Join(p.AllowedHeaders, ", ")
+	exposeHeaders := strings.Join(p.ExposedHeaders, ", ")
+	wildcard := slices.Contains(p.AllowedOrigins, "*")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+		// オリジンによってレスポンスが変わるため、キャッシュのキーに含める
+		w.Header().Add("Vary", "Origin")
+		if origin != "" && (wildcard || slices.Contains(p.AllowedOrigins, origin)) {
+			header := w.Header()
+			if wildcard {
+				header.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				header.Set("Access-Control-Allow-Origin", origin)
+				header.Set("Access-Control-Allow-Credentials", "true")
+			}
+			if preflight {
+				header.Set("Access-Control-Allow-Methods", allowMethods)
+				header.Set("Access-Control-Allow-Headers", allowHeaders)
+				if p.MaxAge > 0 {
+					header.Set("Access-Control-Max-Age", strconv.Itoa(p.MaxAge))
+				}
+			} else if exposeHeaders != "" {
+				header.Set("Access-Control-Expose-Headers", exposeHeaders)
+			}
+		}
+
+		if preflight {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,78 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_Handler(t *testing.T) {
+	policy := Policy{
+		AllowedOrigins: []string{"https://app.example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		ExposedHeaders: []string{"Retry-After"},
+		MaxAge:         600,
+	}
+	called := false
+	handler := policy.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name       string
+		method     string
+		origin     string
+		preflight  bool
+		wantStatus int
+		wantOrigin string
+		wantCalled bool
+	}{
+		{"許可されたオリジン", http.MethodGet, "https://app.example.com", false, http.StatusOK, "https://app.example.com", true},
+		{"許可されていないオリジン", http.MethodGet, "https://evil.example.com", false, http.StatusOK, "", true},
+		{"Originなし（ブラウザ以外）", http.MethodPost, "", false, http.StatusOK, "", true},
+		{"プリフライト", http.MethodOptions, "https://app.example.com", true, http.StatusNoContent, "https://app.example.com", false},
+		{"許可されていないオリジンのプリフライト", http.MethodOptions, "https://evil.example.com", true, http.StatusNoContent, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			req := httptest.NewRequest(tt.method, "/v1/me", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.preflight {
+				req.Header.Set("Access-Control-Request-Method", "POST")
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.wantCalled, called)
+			if tt.wantOrigin == "" {
+				return
+			}
+			assert.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+			if tt.preflight {
+				assert.Equal(t, "GET, POST", rec.Header().Get("Access-Control-Allow-Methods"))
+				assert.Equal(t, "Authorization, Content-Type", rec.Header().Get("Access-Control-Allow-Headers"))
+				assert.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+			} else {
+				assert.Equal(t, "Retry-After", rec.Header().Get("Access-Control-Expose-Headers"))
+			}
+		})
+	}
+
+	// "*"は全オリジンを許可するが、資格情報付きのリクエストは許可しない
+	wildcard := Policy{AllowedOrigins: []string{"*"}}.Handler(http.NotFoundHandler())
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Origin", "https://any.example.com")
+	rec := httptest.NewRecorder()
+	wildcard.ServeHTTP(rec, req)
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+}
@@ -64,3 +64,12 @@ func (db *DB) HealthCheck(ctx context.Context) error {
 	return db.Pool.Ping(ctx)
 }
 
+// MigrationVersion 適用済みのマイグレーションの最新のバージョン
+// マイグレーションが記録するschema_versionsから取得する（SQL Editor・supabase db pushのどちらで適用した場合も記録される、記録がない場合は0）
+func (db *DB) MigrationVersion(ctx context.Context) (int, error) {
+	var version int
+	if err := db.Pool.QueryRow(ctx, "SELECT coalesce(max(version), 0) FROM schema_versions").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to query migration version: %w", err)
+	}
+	return version, nil
+}
@@ -0,0 +1,194 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check 依存先の状態を確認する関数（利用できない場合はエラー）
+type Check func(ctx context.Context) error
+
+// namedCheck 名前付きの確認
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker 依存先（Postgres・IdP等）の状態からサービスの準備状態を判定する
+// HTTPの/livez・/readyzとgRPCのヘルスチェック（grpc.health.v1）で同じ確認の結果（Updateで更新）を使用し、
+// シャットダウンの開始後はすべてNOT_SERVING（/readyzは503）を返す
+type Checker struct {
+	timeout      time.Duration
+	checks       []namedCheck
+	server       *grpchealth.Server
+	services     []string
+	shuttingDown atomic.Bool
+
+	mu      sync.RWMutex
+	results map[string]error // 最後の確認の結果（最初の確認まではnil）
+}
+
+// NewChecker チェッカーを作成（timeoutは確認ごとのタイムアウト）
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout, server: grpchealth.NewServer()}
+}
+
+// AddCheck 確認を追加
+func (c *Checker) AddCheck(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Register gRPCサーバーにヘルスチェックのサービスを登録
+// servicesは依存先の状態に応じてSERVING/NOT_SERVINGを返すサービス（サーバー全体の状態は空文字のサービス名）
+// 最初の確認（Update）まではNOT_SERVINGとする
+func (c *Checker) Register(s grpc.ServiceRegistrar, services ...string) {
+	healthpb.RegisterHealthServer(s, c.server)
+	c.services = append([]string{""}, services...)
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// Check すべての確認を並行して実行し、確認ごとの結果を返す（成功した確認はnil）
+func (c *Checker) Check(ctx context.Context) map[string]error {
+	results := make(map[string]error, len(c.checks))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			err := check.check(ctx)
+			mu.Lock()
+			results[check.name] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// Update 確認を実行して結果を保持し、gRPCのヘルスチェックの状態を更新
+func (c *Checker) Update(ctx context.Context) {
+	results := c.Check(ctx)
+	status := healthpb.HealthCheckResponse_SERVING
+	for name, err := range results {
+		if err != nil {
+			log.Printf("Health check %s failed: %v", name, err)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	c.mu.Lock()
+	c.results = results
+	c.mu.Unlock()
+	// シャットダウン後の更新はgrpchealth.Serverが無視する
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Run intervalごとにUpdateを実行（ctxがキャンセルされるまで）
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.Update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown シャットダウンの開始（以降はすべてNOT_SERVING、/readyzは503を返す）
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+	c.server.Shutdown()
+}
+
+// LivezHandler プロセスが応答できることを返すハンドラー（依存先は確認しない、シャットダウン中も200）
+func (c *Checker) LivezHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
+
+// ReadyzHandler リクエストを受け付けられるかを返すハンドラー
+// リクエストごとに依存先へ問い合わせないよう、最後のUpdate（Runで定期的に実行）の結果を返す
+// すべての確認が成功した場合は200、失敗した確認がある場合・最初の確認の前・シャットダウン中は503を返す
+// エラーの詳細（接続先等）はUpdateでログにのみ出力し、レスポンスには確認ごとの結果（ok/failed）のみを含める
+func (c *Checker) ReadyzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := struct {
+			Status string            `json:"status"`
+			Checks map[string]string `json:"checks,omitempty"`
+		}{Status: "ok"}
+		code := http.StatusOK
+
+		c.mu.RLock()
+		results := c.results
+		c.mu.RUnlock()
+
+		switch {
+		case c.shuttingDown.Load():
+			response.Status = "shutting_down"
+			code = http.StatusServiceUnavailable
+		case results == nil:
+			response.Status = "unavailable"
+			code = http.StatusServiceUnavailable
+		default:
+			response.Checks = map[string]string{}
+			for name, err := range results {
+				response.Checks[name] = "ok"
+				if err != nil {
+					response.Checks[name] = "failed"
+					response.Status = "unavailable"
+					code = http.StatusServiceUnavailable
+				}
+			}
+		}
+
+		data, _ := json.Marshal(response)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(code)
+		_, _ = w.Write(data)
+	})
+}
+
+// HTTPCheck URLにGETリクエストを送信し、2xxの応答を確認する（IdP等の到達性の確認用）
+func HTTPCheck(client *http.Client, url string, header http.Header) Check {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to request %s: %w", url, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("unexpected status from %s: %d", url, resp.StatusCode)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const testService = "contractpro.auth.v1.AuthService"
+
+// newTestChecker 結果を切り替えられる確認を1つ持つチェッカー
+func newTestChecker(t *testing.T, err *error) *Checker {
+	t.Helper()
+	checker := NewChecker(time.Second)
+	checker.AddCheck("postgres", func(ctx context.Context) error { return *err })
+	checker.AddCheck("identity_provider", func(ctx context.Context) error { return nil })
+	checker.Register(grpc.NewServer(), testService)
+	return checker
+}
+
+// servingStatus gRPCのヘルスチェックの状態
+func servingStatus(t *testing.T, checker *Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := checker.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.GetStatus()
+}
+
+// readyz /readyzのステータスコードとレスポンス
+func readyz(t *testing.T, checker *Checker) (int, map[string]any) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	checker.ReadyzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return rec.Code, body
+}
+
+func TestChecker_Update(t *testing.T) {
+	var checkErr error
+	checker := newTestChecker(t, &checkErr)
+
+	// 最初の確認まではNOT_SERVING
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, testService))
+
+	checker.Update(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, checker, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, checker, testService))
+
+	// 依存先が利用できない場合はNOT_SERVING
+	checkErr = errors.New("connection refused")
+	checker.Update(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, testService))
+}
+
+func TestChecker_Readyz(t *testing.T) {
+	var checkErr error
+	checker := newTestChecker(t, &checkErr)
+
+	// 最初の確認までは503
+	code, body := readyz(t, checker)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "unavailable", body["status"])
+	assert.Nil(t, body["checks"])
+
+	checker.Update(context.Background())
+	code, body = readyz(t, checker)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", body["status"])
+	assert.Equal(t, map[string]any{"postgres": "ok", "identity_provider": "ok"}, body["checks"])
+
+	// リクエストごとには確認せず、最後のUpdateの結果を返す
+	checkErr = errors.New("dial tcp 10.0.0.1:5432: connection refused")
+	code, _ = readyz(t, checker)
+	assert.Equal(t, http.StatusOK, code)
+
+	// 失敗した確認は"failed"（エラーの詳細は返さない）
+	checker.Update(context.Background())
+	code, body = readyz(t, checker)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "unavailable", body["status"])
+	assert.Equal(t, map[string]any{"postgres": "failed", "identity_provider": "ok"}, body["checks"])
+}
+
+func TestChecker_Shutdown(t *testing.T) {
+	var checkErr error
+	checker := newTestChecker(t, &checkErr)
+	checker.Update(context.Background())
+
+	checker.Shutdown()
+	code, body := readyz(t, checker)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "shutting_down", body["status"])
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, testService))
+
+	// シャットダウン後は確認が成功してもSERVINGに戻らない
+	checker.Update(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker, testService))
+
+	// livezはシャットダウン中も200
+	rec := httptest.NewRecorder()
+	checker.LivezHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestChecker_Timeout(t *testing.T) {
+	checker := NewChecker(10 * time.Millisecond)
+	checker.AddCheck("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	start := time.Now()
+	results := checker.Check(context.Background())
+	assert.Less(t, time.Since(start), time.Second)
+	assert.ErrorIs(t, results["slow"], context.DeadlineExceeded)
+}
+
+func TestHTTPCheck(t *testing.T) {
+	var gotKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.Header.Get("Apikey")
+		if r.URL.Path != "/auth/v1/health" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	header := http.Header{"Apikey": []string{"key"}}
+
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{"2xxの応答", server.URL + "/auth/v1/health", false},
+		{"2xx以外の応答", server.URL + "/unknown", true},
+		{"接続できない", "http://127.0.0.1:1/auth/v1/health", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := HTTPCheck(server.Client(), tt.url, header)(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "key", gotKey)
+		})
+	}
+}
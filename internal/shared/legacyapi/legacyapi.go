@@ -0,0 +1,44 @@
+package legacyapi
+
+import (
+	"strings"
+
+	"google.golang.org/grpc"
+
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	pbauthv2 "contract-pro-suite/proto/contractpro/auth/v2"
+)
+
+// services 旧サービス名（protoパッケージ名の変更前）から新サービス名への対応
+// 旧サービス名は移行期間中のみ受け付け、フロントエンド等の移行が完了したら削除する
+var services = map[string]string{
+	"auth.AuthService":    pbauth.AuthService_ServiceDesc.ServiceName,
+	"auth.v2.AuthService": pbauthv2.AuthService_ServiceDesc.ServiceName,
+}
+
+// Register 新しいサービスの実装を旧サービス名でも登録（旧サービス名がない場合は何もしない）
+// メッセージのフィールド番号・型は旧パッケージと同じため、旧パッケージで生成したクライアントからそのまま呼び出せる
+func Register(registrar grpc.ServiceRegistrar, desc *grpc.ServiceDesc, impl any) {
+	for legacy, current := range services {
+		if current != desc.ServiceName {
+			continue
+		}
+		legacyDesc := *desc
+		legacyDesc.ServiceName = legacy
+		registrar.RegisterService(&legacyDesc, impl)
+	}
+}
+
+// CanonicalMethod フルメソッド名（"/<サービス名>/<メソッド名>"）の旧サービス名を新サービス名に置き換え
+// Unary RPCの生成コードは常に新しいフルメソッド名をインターセプターに渡すが、
+// ストリーミングRPCは呼び出されたパスをそのまま渡すため、メソッド名で判定する前に変換する
+func CanonicalMethod(fullMethod string) string {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return fullMethod
+	}
+	if current, ok := services[service]; ok {
+		return "/" + current + "/" + method
+	}
+	return fullMethod
+}
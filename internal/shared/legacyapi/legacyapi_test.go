@@ -0,0 +1,61 @@
+package legacyapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+)
+
+func TestCanonicalMethod(t *testing.T) {
+	tests := []struct {
+		fullMethod string
+		want       string
+	}{
+		{"/auth.AuthService/GetMe", "/contractpro.auth.v1.AuthService/GetMe"},
+		{"/auth.v2.AuthService/ListClientUsers", "/contractpro.auth.v2.AuthService/ListClientUsers"},
+		{"/contractpro.auth.v1.AuthService/GetMe", "/contractpro.auth.v1.AuthService/GetMe"},
+		{"/grpc.health.v1.Health/Check", "/grpc.health.v1.Health/Check"},
+		{"invalid", "invalid"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CanonicalMethod(tt.fullMethod))
+	}
+}
+
+func TestRegister(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	impl := pbauth.UnimplementedAuthServiceServer{}
+	pbauth.RegisterAuthServiceServer(grpcServer, impl)
+	Register(grpcServer, &pbauth.AuthService_ServiceDesc, impl)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	// 旧サービス名・新サービス名のどちらでも同じ実装が呼び出される（未登録のサービスは"unknown service"）
+	for _, method := range []string{"/auth.AuthService/GetMe", pbauth.AuthService_GetMe_FullMethodName} {
+		err := conn.Invoke(context.Background(), method, &pbauth.GetMeRequest{}, &pbauth.GetMeResponse{})
+		st := status.Convert(err)
+		assert.Equal(t, codes.Unimplemented, st.Code(), "method=%s", method)
+		assert.Equal(t, "method GetMe not implemented", st.Message(), "method=%s", method)
+	}
+	err = conn.Invoke(context.Background(), "/auth.v2.AuthService/GetClientUser", &pbauth.GetClientUserRequest{}, &pbauth.GetClientUserResponse{})
+	assert.Contains(t, status.Convert(err).Message(), "unknown service")
+}
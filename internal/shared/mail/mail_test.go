@@ -0,0 +1,117 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"contract-pro-suite/internal/shared/config"
+)
+
+// fakeSMTPServer 1通のメールを受信して内容を返すSMTPサーバー（STARTTLS・認証なし）
+func fakeSMTPServer(t *testing.T) (addr string, received <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	ch := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		text := textproto.NewConn(conn)
+		_ = text.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+			switch verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); verb {
+			case "EHLO", "HELO":
+				_ = text.PrintfLine("250 localhost")
+			case "DATA":
+				_ = text.PrintfLine("354 start mail input")
+				data, err := text.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				ch <- string(data)
+				_ = text.PrintfLine("250 ok")
+			case "QUIT":
+				_ = text.PrintfLine("221 bye")
+				return
+			default:
+				_ = text.PrintfLine("250 ok")
+			}
+		}
+	}()
+	return listener.Addr().String(), ch
+}
+
+func TestNewSender(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "SMTP_HOST未設定", cfg: &config.Config{}, wantNil: true},
+		{name: "MAIL_FROM未設定", cfg: &config.Config{SMTPHost: "smtp.example.com", SMTPPort: "587"}, wantNil: true, wantErr: true},
+		{name: "MAIL_FROMが不正", cfg: &config.Config{SMTPHost: "smtp.example.com", SMTPPort: "587", MailFrom: "invalid"}, wantNil: true, wantErr: true},
+		{name: "設定済み", cfg: &config.Config{SMTPHost: "smtp.example.com", SMTPPort: "587", MailFrom: "ContractProSuite <noreply@example.com>"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender, err := NewSender(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantNil, sender == nil)
+		})
+	}
+}
+
+func TestSender_Send(t *testing.T) {
+	addr, received := fakeSMTPServer(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+	sender, err := NewSender(&config.Config{SMTPHost: host, SMTPPort: port, MailFrom: "noreply@example.com"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, sender.Send(ctx, "user@example.com", "登録確認", "以下のURLを開いてください\nhttps://app.example.com/verify?token=abc"))
+
+	message := <-received
+	header, body, ok := strings.Cut(message, "\n\n")
+	require.True(t, ok)
+	reader := textproto.NewReader(bufio.NewReader(strings.NewReader(header + "\n\n")))
+	mimeHeader, err := reader.ReadMIMEHeader()
+	require.NoError(t, err)
+	assert.Equal(t, "<noreply@example.com>", mimeHeader.Get("From"))
+	assert.Equal(t, "<user@example.com>", mimeHeader.Get("To"))
+	assert.Equal(t, "=?UTF-8?b?55m76Yyy56K66KqN?=", mimeHeader.Get("Subject"))
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(strings.TrimSpace(body), "\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "以下のURLを開いてください\nhttps://app.example.com/verify?token=abc", string(decoded))
+}
+
+func TestSender_SendRejectsHeaderInjection(t *testing.T) {
+	sender := &Sender{addr: "127.0.0.1:1", host: "127.0.0.1", from: "noreply@example.com"}
+	err := sender.Send(context.Background(), "user@example.com", "subject\r\nBcc: attacker@example.com", "body")
+	assert.Error(t, err)
+}
@@ -0,0 +1,267 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrKeyNotFound 指定されたkidの公開鍵がJWKSに存在しない
+var ErrKeyNotFound = errors.New("signing key not found")
+
+// minRefreshInterval 未知のkidによるJWKS再取得の最小間隔（IdPへの過剰リクエスト防止）
+const minRefreshInterval = 30 * time.Second
+
+// KeySetCache 発行者ごとのJWKS（公開鍵セット）キャッシュ
+type KeySetCache struct {
+	httpClient *http.Client
+	ttl        time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*keySetEntry // キー: JWKS URI
+	jwksURI map[string]string       // キー: issuer（Discovery結果のキャッシュ）
+}
+
+type keySetEntry struct {
+	keys      map[string]interface{}
+	fetchedAt time.Time
+}
+
+// NewKeySetCache JWKSキャッシュを作成
+func NewKeySetCache(httpClient *http.Client, ttl time.Duration) *KeySetCache {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &KeySetCache{
+		httpClient: httpClient,
+		ttl:        ttl,
+		entries:    make(map[string]*keySetEntry),
+		jwksURI:    make(map[string]string),
+	}
+}
+
+// GetKey JWKS URIとkidから署名検証用の公開鍵を取得
+// キャッシュが期限切れ、またはkidが見つからない場合（鍵ローテーション）はJWKSを再取得する
+func (c *KeySetCache) GetKey(ctx context.Context, jwksURI, kid string) (interface{}, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[jwksURI]
+	c.mu.Unlock()
+
+	now := time.Now()
+	if ok && now.Sub(entry.fetchedAt) < c.ttl {
+		if key, found := lookupKey(entry.keys, kid); found {
+			return key, nil
+		}
+		// 鍵ローテーション直後の可能性があるため、一定間隔をあけて再取得
+		if now.Sub(entry.fetchedAt) < minRefreshInterval {
+			return nil, ErrKeyNotFound
+		}
+	}
+
+	keys, err := c.fetchKeySet(ctx, jwksURI)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[jwksURI] = &keySetEntry{keys: keys, fetchedAt: now}
+	c.mu.Unlock()
+
+	if key, found := lookupKey(keys, kid); found {
+		return key, nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+// DiscoverJWKSURI OpenID Connect DiscoveryでissuerのJWKS URIを取得
+func (c *KeySetCache) DiscoverJWKSURI(ctx context.Context, issuer string) (string, error) {
+	c.mu.Lock()
+	uri, ok := c.jwksURI[issuer]
+	c.mu.Unlock()
+	if ok {
+		return uri, nil
+	}
+
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	body, err := c.get(ctx, wellKnown)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch openid configuration: %w", err)
+	}
+
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return "", fmt.Errorf("failed to unmarshal openid configuration: %w", err)
+	}
+	// OpenID Connect Discovery 1.0 §4.3: issuerは設定値と完全一致しなければならない
+	if doc.Issuer != issuer {
+		return "", fmt.Errorf("issuer mismatch in openid configuration: %s", doc.Issuer)
+	}
+	if doc.JWKSURI == "" {
+		return "", errors.New("jwks_uri not found in openid configuration")
+	}
+
+	c.mu.Lock()
+	c.jwksURI[issuer] = doc.JWKSURI
+	c.mu.Unlock()
+
+	return doc.JWKSURI, nil
+}
+
+// fetchKeySet JWKSを取得して公開鍵にパース
+func (c *KeySetCache) fetchKeySet(ctx context.Context, jwksURI string) (map[string]interface{}, error) {
+	body, err := c.get(ctx, jwksURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	return ParseKeySet(body)
+}
+
+func (c *KeySetCache) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 異常に大きなレスポンスを拒否
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return body, nil
+}
+
+// jsonWebKey JWK（RFC 7517）のうち署名検証に必要な項目
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// ParseKeySet JWKS JSONをkidごとの公開鍵（*rsa.PublicKey または *ecdsa.PublicKey）にパース
+// 未対応の鍵種別や暗号化用（use=enc）の鍵は無視する
+func ParseKeySet(data []byte) (map[string]interface{}, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal jwks: %w", err)
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var (
+			key interface{}
+			err error
+		)
+		switch jwk.Kty {
+		case "RSA":
+			key, err = parseRSAKey(jwk)
+		case "EC":
+			key, err = parseECKey(jwk)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func parseRSAKey(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := decodeBigInt(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	if !e.IsInt64() || e.Int64() > 1<<31-1 {
+		return nil, errors.New("exponent too large")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func parseECKey(jwk jsonWebKey) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+	}
+	x, err := decodeBigInt(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x coordinate: %w", err)
+	}
+	y, err := decodeBigInt(jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y coordinate: %w", err)
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, errors.New("point is not on curve")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// lookupKey kidで鍵を検索（kidが空で鍵が1つだけの場合はその鍵を返す）
+func lookupKey(keys map[string]interface{}, kid string) (interface{}, bool) {
+	if key, ok := keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
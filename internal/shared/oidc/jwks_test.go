@@ -0,0 +1,116 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rsaJWKS テスト用にRSA公開鍵をJWKS形式にエンコード
+func rsaJWKS(t *testing.T, kid string, pub *rsa.PublicKey) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			},
+			{
+				"kty": "RSA",
+				"kid": "enc-key",
+				"use": "enc",
+				"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			},
+		},
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func TestParseKeySet(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys, err := ParseKeySet(rsaJWKS(t, "key-1", &privateKey.PublicKey))
+	require.NoError(t, err)
+
+	// 署名用の鍵のみ取り込まれる
+	assert.Len(t, keys, 1)
+	pub, ok := keys["key-1"].(*rsa.PublicKey)
+	require.True(t, ok)
+	assert.Equal(t, privateKey.PublicKey.N, pub.N)
+	assert.Equal(t, privateKey.PublicKey.E, pub.E)
+
+	_, err = ParseKeySet([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestKeySetCache_GetKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks := rsaJWKS(t, "key-1", &privateKey.PublicKey)
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(jwks)
+	}))
+	defer srv.Close()
+
+	cache := NewKeySetCache(srv.Client(), time.Hour)
+	ctx := context.Background()
+
+	key, err := cache.GetKey(ctx, srv.URL, "key-1")
+	require.NoError(t, err)
+	assert.IsType(t, &rsa.PublicKey{}, key)
+
+	// TTL内はキャッシュから取得される
+	_, err = cache.GetKey(ctx, srv.URL, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// 未知のkidは再取得間隔内であれば再取得せずにエラー
+	_, err = cache.GetKey(ctx, srv.URL, "unknown")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.Equal(t, 1, requests)
+}
+
+func TestKeySetCache_DiscoverJWKSURI(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   srv.URL,
+			"jwks_uri": srv.URL + "/keys",
+		})
+	}))
+	defer srv.Close()
+
+	cache := NewKeySetCache(srv.Client(), time.Hour)
+
+	uri, err := cache.DiscoverJWKSURI(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/keys", uri)
+
+	// Discoveryドキュメントのissuerと一致しない場合はエラー
+	_, err = cache.DiscoverJWKSURI(context.Background(), srv.URL+"/other")
+	assert.Error(t, err)
+}
@@ -0,0 +1,235 @@
+package protocompat
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ルール名（buf breakingのWIREカテゴリのルール名に合わせる）
+const (
+	RuleServiceNoDelete       = "SERVICE_NO_DELETE"
+	RuleRPCNoDelete           = "RPC_NO_DELETE"
+	RuleRPCSameRequestType    = "RPC_SAME_REQUEST_TYPE"
+	RuleRPCSameResponseType   = "RPC_SAME_RESPONSE_TYPE"
+	RuleRPCSameStreaming      = "RPC_SAME_STREAMING"
+	RuleFieldNoDelete         = "FIELD_NO_DELETE_UNLESS_NUMBER_RESERVED"
+	RuleFieldSameCardinality  = "FIELD_SAME_CARDINALITY"
+	RuleFieldWireCompatible   = "FIELD_WIRE_COMPATIBLE_TYPE"
+	RuleFieldSameType         = "FIELD_SAME_TYPE"
+	RuleFieldSameOneof        = "FIELD_SAME_ONEOF"
+	RuleReservedNumberNoReuse = "RESERVED_NUMBER_NO_REUSE"
+	RuleEnumValueNoDelete     = "ENUM_VALUE_NO_DELETE_UNLESS_NUMBER_RESERVED"
+)
+
+// wellKnownPackagePrefix 比較の対象外とするパッケージ（Well-Known Types）
+const wellKnownPackagePrefix = "google."
+
+// Violation ワイヤーフォーマットの互換性を壊す変更
+type Violation struct {
+	Rule    string // ルール名
+	Element string // 対象の完全修飾名（例: contractpro.auth.v1.ClientUser.email）
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s (%s)", v.Element, v.Message, v.Rule)
+}
+
+// Image ファイルと依存ファイル（推移的）をbuf imageと同じ形式（依存関係の順のFileDescriptorSet）で作成
+func Image(files ...protoreflect.FileDescriptor) *descriptorpb.FileDescriptorSet {
+	image := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(file protoreflect.FileDescriptor)
+	add = func(file protoreflect.FileDescriptor) {
+		if seen[file.Path()] {
+			return
+		}
+		seen[file.Path()] = true
+		imports := file.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		image.File = append(image.File, protodesc.ToFileDescriptorProto(file))
+	}
+	for _, file := range files {
+		add(file)
+	}
+	return image
+}
+
+// Check baselineからcurrentへの変更のうち、ワイヤーフォーマットの互換性を壊すものを返す
+// google.*のパッケージ（Well-Known Types）は対象外。型の移動（ファイル間）は型の完全修飾名で追跡する
+func Check(baseline, current *protoregistry.Files) []Violation {
+	c := &checker{current: current}
+	baseline.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		if strings.HasPrefix(string(file.Package()), wellKnownPackagePrefix) {
+			return true
+		}
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			c.checkService(services.Get(i))
+		}
+		c.checkMessages(file.Messages())
+		c.checkEnums(file.Enums())
+		return true
+	})
+	return c.violations
+}
+
+type checker struct {
+	current    *protoregistry.Files
+	violations []Violation
+}
+
+func (c *checker) violate(rule string, element protoreflect.FullName, format string, args ...any) {
+	c.violations = append(c.violations, Violation{Rule: rule, Element: string(element), Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *checker) checkService(service protoreflect.ServiceDescriptor) {
+	found, _ := c.current.FindDescriptorByName(service.FullName())
+	current, ok := found.(protoreflect.ServiceDescriptor)
+	if !ok {
+		c.violate(RuleServiceNoDelete, service.FullName(), "service was deleted")
+		return
+	}
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		currentMethod := current.Methods().ByName(method.Name())
+		if currentMethod == nil {
+			c.violate(RuleRPCNoDelete, method.FullName(), "rpc was deleted")
+			continue
+		}
+		if method.Input().FullName() != currentMethod.Input().FullName() {
+			c.violate(RuleRPCSameRequestType, method.FullName(), "request type changed from %s to %s", method.Input().FullName(), currentMethod.Input().FullName())
+		}
+		if method.Output().FullName() != currentMethod.Output().FullName() {
+			c.violate(RuleRPCSameResponseType, method.FullName(), "response type changed from %s to %s", method.Output().FullName(), currentMethod.Output().FullName())
+		}
+		if method.IsStreamingClient() != currentMethod.IsStreamingClient() || method.IsStreamingServer() != currentMethod.IsStreamingServer() {
+			c.violate(RuleRPCSameStreaming, method.FullName(), "streaming changed")
+		}
+	}
+}
+
+func (c *checker) checkMessages(messages protoreflect.MessageDescriptors) {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		c.checkMessage(message)
+		c.checkMessages(message.Messages())
+		c.checkEnums(message.Enums())
+	}
+}
+
+// checkMessage フィールドを番号で対応付けて比較（削除された型は参照元のフィールド・RPCで検出する）
+func (c *checker) checkMessage(message protoreflect.MessageDescriptor) {
+	found, _ := c.current.FindDescriptorByName(message.FullName())
+	current, ok := found.(protoreflect.MessageDescriptor)
+	if !ok {
+		return
+	}
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		currentField := current.Fields().ByNumber(field.Number())
+		if currentField == nil {
+			if !current.ReservedRanges().Has(field.Number()) {
+				c.violate(RuleFieldNoDelete, field.FullName(), "field %d was deleted without reserving the number", field.Number())
+			}
+			continue
+		}
+		c.checkField(field, currentField)
+	}
+	reserved := message.ReservedRanges()
+	currentFields := current.Fields()
+	for i := 0; i < currentFields.Len(); i++ {
+		if field := currentFields.Get(i); reserved.Has(field.Number()) {
+			c.violate(RuleReservedNumberNoReuse, field.FullName(), "field uses reserved number %d", field.Number())
+		}
+	}
+}
+
+func (c *checker) checkField(field, current protoreflect.FieldDescriptor) {
+	if field.IsMap() != current.IsMap() || field.IsList() != current.IsList() {
+		c.violate(RuleFieldSameCardinality, field.FullName(), "cardinality changed")
+		return
+	}
+	if field.IsMap() {
+		c.checkFieldType(field.FullName(), field.MapKey(), current.MapKey())
+		c.checkFieldType(field.FullName(), field.MapValue(), current.MapValue())
+	} else {
+		c.checkFieldType(field.FullName(), field, current)
+	}
+	if realOneof(field) != realOneof(current) {
+		c.violate(RuleFieldSameOneof, field.FullName(), "oneof changed from %q to %q", realOneof(field), realOneof(current))
+	}
+}
+
+func (c *checker) checkFieldType(name protoreflect.FullName, field, current protoreflect.FieldDescriptor) {
+	if wireType(field.Kind()) != wireType(current.Kind()) {
+		c.violate(RuleFieldWireCompatible, name, "type changed from %s to %s", field.Kind(), current.Kind())
+		return
+	}
+	switch {
+	case field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind:
+		if field.Message().FullName() != current.Message().FullName() {
+			c.violate(RuleFieldSameType, name, "type changed from %s to %s", field.Message().FullName(), current.Message().FullName())
+		}
+	case field.Kind() == protoreflect.EnumKind && current.Kind() == protoreflect.EnumKind:
+		if field.Enum().FullName() != current.Enum().FullName() {
+			c.violate(RuleFieldSameType, name, "type changed from %s to %s", field.Enum().FullName(), current.Enum().FullName())
+		}
+	}
+}
+
+func (c *checker) checkEnums(enums protoreflect.EnumDescriptors) {
+	for i := 0; i < enums.Len(); i++ {
+		enum := enums.Get(i)
+		found, _ := c.current.FindDescriptorByName(enum.FullName())
+		current, ok := found.(protoreflect.EnumDescriptor)
+		if !ok {
+			continue
+		}
+		values := enum.Values()
+		for j := 0; j < values.Len(); j++ {
+			value := values.Get(j)
+			if current.Values().ByNumber(value.Number()) == nil && !current.ReservedRanges().Has(value.Number()) {
+				c.violate(RuleEnumValueNoDelete, value.FullName(), "enum value %d was deleted without reserving the number", value.Number())
+			}
+		}
+	}
+}
+
+// realOneof フィールドが属するoneofの名前（proto3のoptionalによる合成oneofは除く）
+func realOneof(field protoreflect.FieldDescriptor) protoreflect.Name {
+	if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		return oneof.Name()
+	}
+	return ""
+}
+
+// wireType 相互にデコードできる型のグループ（buf breakingのFIELD_WIRE_COMPATIBLE_TYPEと同じ分類）
+func wireType(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.BoolKind, protoreflect.EnumKind:
+		return "varint"
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return "zigzag"
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+		return "fixed32"
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+		return "fixed64"
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return "bytes"
+	default:
+		return kind.String()
+	}
+}
@@ -0,0 +1,171 @@
+package protocompat
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	pbauthv2 "contract-pro-suite/proto/contractpro/auth/v2"
+)
+
+// update ベースラインを現在の定義で更新する（互換性のない変更を意図的に行う場合のみ使用）
+// go test ./internal/shared/protocompat -run TestAPIBaseline -update
+var update = flag.Bool("update", false, "update the baseline image")
+
+// baselinePath コミット済みのベースライン（buf imageと同じ形式）
+const baselinePath = "../../../proto/baseline.binpb"
+
+// TestAPIBaseline 生成済みのprotoの定義がベースラインとワイヤーフォーマットの互換性を保っていることを確認
+func TestAPIBaseline(t *testing.T) {
+	image := Image(pbauth.File_proto_contractpro_auth_v1_auth_proto, pbauthv2.File_proto_contractpro_auth_v2_auth_proto)
+	if *update {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(image)
+		if err != nil {
+			t.Fatalf("failed to marshal image: %v", err)
+		}
+		if err := os.WriteFile(baselinePath, data, 0o644); err != nil {
+			t.Fatalf("failed to write baseline: %v", err)
+		}
+		return
+	}
+
+	data, err := os.ReadFile(baselinePath)
+	if err != nil {
+		t.Fatalf("failed to read baseline: %v", err)
+	}
+	baselineImage := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, baselineImage); err != nil {
+		t.Fatalf("failed to unmarshal baseline: %v", err)
+	}
+	baseline, err := protodesc.NewFiles(baselineImage)
+	if err != nil {
+		t.Fatalf("failed to load baseline: %v", err)
+	}
+	current, err := protodesc.NewFiles(image)
+	if err != nil {
+		t.Fatalf("failed to load current: %v", err)
+	}
+	for _, violation := range Check(baseline, current) {
+		t.Errorf("breaking change: %s", violation)
+	}
+}
+
+// testFile 比較用の最小限の定義
+func testFile() *descriptorpb.FileDescriptorProto {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{Name: proto.String(name), Number: proto.Int32(number), Type: typ.Enum(), Label: label.Enum(), JsonName: proto.String(name)}
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("User"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional),
+				field("age", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, optional),
+				field("tags", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+			},
+			ReservedRange: []*descriptorpb.DescriptorProto_ReservedRange{{Start: proto.Int32(10), End: proto.Int32(11)}},
+		}},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Status"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("STATUS_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("STATUS_ACTIVE"), Number: proto.Int32(1)},
+			},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("UserService"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				{Name: proto.String("GetUser"), InputType: proto.String(".test.v1.User"), OutputType: proto.String(".test.v1.User")},
+			},
+		}},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(file *descriptorpb.FileDescriptorProto)
+		rules  []string
+	}{
+		{"フィールドの追加", func(file *descriptorpb.FileDescriptorProto) {
+			user := file.MessageType[0]
+			user.Field = append(user.Field, &descriptorpb.FieldDescriptorProto{
+				Name: proto.String("email"), Number: proto.Int32(4), JsonName: proto.String("email"),
+				Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			})
+		}, nil},
+		{"フィールド名の変更", func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[0].Field[0].Name = proto.String("user_id")
+		}, nil},
+		{"互換性のある型の変更（int32→int64）", func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[0].Field[1].Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+		}, nil},
+		{"番号を予約したフィールドの削除", func(file *descriptorpb.FileDescriptorProto) {
+			user := file.MessageType[0]
+			user.Field = user.Field[:2]
+			user.ReservedRange = append(user.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{Start: proto.Int32(3), End: proto.Int32(4)})
+		}, nil},
+		{"番号を予約しないフィールドの削除", func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[0].Field = file.MessageType[0].Field[:2]
+		}, []string{RuleFieldNoDelete}},
+		{"互換性のない型の変更（string→int32）", func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[0].Field[0].Type = descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum()
+		}, []string{RuleFieldWireCompatible}},
+		{"repeatedの変更", func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[0].Field[2].Label = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+		}, []string{RuleFieldSameCardinality}},
+		{"予約済みの番号の再利用", func(file *descriptorpb.FileDescriptorProto) {
+			user := file.MessageType[0]
+			user.ReservedRange = nil
+			user.Field = append(user.Field, &descriptorpb.FieldDescriptorProto{
+				Name: proto.String("legacy"), Number: proto.Int32(10), JsonName: proto.String("legacy"),
+				Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			})
+		}, []string{RuleReservedNumberNoReuse}},
+		{"列挙値の削除", func(file *descriptorpb.FileDescriptorProto) {
+			file.EnumType[0].Value = file.EnumType[0].Value[:1]
+		}, []string{RuleEnumValueNoDelete}},
+		{"RPCの削除", func(file *descriptorpb.FileDescriptorProto) {
+			file.Service[0].Method = nil
+		}, []string{RuleRPCNoDelete}},
+		{"サービス名の変更", func(file *descriptorpb.FileDescriptorProto) {
+			file.Service[0].Name = proto.String("UserServiceV2")
+		}, []string{RuleServiceNoDelete}},
+		{"ストリーミングへの変更", func(file *descriptorpb.FileDescriptorProto) {
+			file.Service[0].Method[0].ServerStreaming = proto.Bool(true)
+		}, []string{RuleRPCSameStreaming}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseline := testFiles(t, testFile())
+			changed := testFile()
+			tt.change(changed)
+
+			var rules []string
+			for _, violation := range Check(baseline, testFiles(t, changed)) {
+				rules = append(rules, violation.Rule)
+			}
+			assert.Equal(t, tt.rules, rules)
+		})
+	}
+}
+
+func testFiles(t *testing.T, file *descriptorpb.FileDescriptorProto) *protoregistry.Files {
+	t.Helper()
+	files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	if err != nil {
+		t.Fatalf("failed to build files: %v", err)
+	}
+	return files
+}
@@ -0,0 +1,88 @@
+package pwned
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// prefixLength k-anonymity方式で問い合わせるSHA-1ハッシュの先頭文字数（Have I Been Pwnedのrange APIと同じ5文字）
+const prefixLength = 5
+
+// HashList 漏洩済みパスワードのSHA-1ハッシュリスト
+// ハッシュの先頭5文字（prefix）ごとに残り（suffix）を保持し、range APIと同じ方式で照合する
+// （外部APIに置き換える場合もパスワード・完全なハッシュを送信しない）
+type HashList struct {
+	ranges map[string]map[string]struct{}
+}
+
+// LoadHashList ハッシュリストのファイルを読み込む
+// 1行に1件、"<SHA-1ハッシュ（16進40文字）>[:<出現回数>]"の形式（Have I Been Pwnedの配布形式）
+func LoadHashList(path string) (*HashList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open hash list: %w", err)
+	}
+	defer file.Close()
+
+	list := &HashList{ranges: make(map[string]map[string]struct{})}
+	scanner := bufio.NewScanner(file)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hash, _, _ := strings.Cut(line, ":")
+		hash = strings.ToUpper(strings.TrimSpace(hash))
+		if len(hash) != sha1.Size*2 {
+			return nil, fmt.Errorf("invalid hash at line %d", lineNo)
+		}
+		if _, err := hex.DecodeString(hash); err != nil {
+			return nil, fmt.Errorf("invalid hash at line %d: %w", lineNo, err)
+		}
+		list.add(hash)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read hash list: %w", err)
+	}
+	return list, nil
+}
+
+// add ハッシュ（大文字の16進）を追加
+func (l *HashList) add(hash string) {
+	prefix, suffix := hash[:prefixLength], hash[prefixLength:]
+	suffixes, ok := l.ranges[prefix]
+	if !ok {
+		suffixes = make(map[string]struct{})
+		l.ranges[prefix] = suffixes
+	}
+	suffixes[suffix] = struct{}{}
+}
+
+// Range 先頭5文字が一致するハッシュの残り（suffix）を取得
+func (l *HashList) Range(prefix string) []string {
+	suffixes := l.ranges[strings.ToUpper(prefix)]
+	result := make([]string, 0, len(suffixes))
+	for suffix := range suffixes {
+		result = append(result, suffix)
+	}
+	return result
+}
+
+// IsBreached パスワードが漏洩済みリストに含まれるか判定
+func (l *HashList) IsBreached(ctx context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	for _, suffix := range l.Range(hash[:prefixLength]) {
+		if suffix == hash[prefixLength:] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
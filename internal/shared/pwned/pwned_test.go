@@ -0,0 +1,63 @@
+package pwned
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sha1Hex(value string) string {
+	sum := sha1.Sum([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeHashList(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "hashes.txt")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadHashList(t *testing.T) {
+	// 大文字・小文字、出現回数の有無、コメント行が混在していても読み込める
+	content := strings.Join([]string{
+		"# breached passwords",
+		strings.ToUpper(sha1Hex("Password123!")) + ":52",
+		sha1Hex("correct horse battery staple"),
+		"",
+	}, "\n")
+	list, err := LoadHashList(writeHashList(t, content))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	breached, err := list.IsBreached(ctx, "Password123!")
+	assert.NoError(t, err)
+	assert.True(t, breached)
+
+	breached, err = list.IsBreached(ctx, "correct horse battery staple")
+	assert.NoError(t, err)
+	assert.True(t, breached)
+
+	breached, err = list.IsBreached(ctx, "Tr0ub4dor&3-unlisted")
+	assert.NoError(t, err)
+	assert.False(t, breached)
+
+	// range（先頭5文字）単位で残りのハッシュを返す
+	hash := strings.ToUpper(sha1Hex("Password123!"))
+	assert.Equal(t, []string{hash[5:]}, list.Range(strings.ToLower(hash[:5])))
+}
+
+func TestLoadHashList_Invalid(t *testing.T) {
+	_, err := LoadHashList(writeHashList(t, "not-a-hash:1\n"))
+	assert.Error(t, err)
+
+	_, err = LoadHashList(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"contract-pro-suite/internal/shared/legacyapi"
+)
+
+// Limit トークンバケットの設定
+type Limit struct {
+	Rate  float64 // 1秒あたりの補充トークン数（0以下は無制限）
+	Burst int     // バケットの容量（連続して許可されるリクエスト数）
+}
+
+// Unlimited 制限なしかどうか
+func (l Limit) Unlimited() bool {
+	return l.Rate <= 0 || l.Burst <= 0
+}
+
+// Result レート制限の判定結果
+type Result struct {
+	Allowed    bool
+	Remaining  int           // 残りトークン数
+	RetryAfter time.Duration // 拒否された場合に次のトークンが補充されるまでの時間
+}
+
+// Store レート制限の状態（キーごとのトークンバケット）を保持するストア
+// 現在はインメモリのみ。複数インスタンスで共有する場合はPostgres・Redis等で同じインターフェースを実装する
+type Store interface {
+	// Allow keyのバケットからトークンを1つ消費する
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Policy メソッドごとのレート制限
+type Policy struct {
+	Authenticated Limit            // 認証済みメソッドの既定値（client_id・ユーザー単位）
+	Public        Limit            // 公開メソッドの既定値（IPアドレス単位）
+	PreAuth       Limit            // 認証前に全メソッドに適用する上限（IPアドレス単位、無効なトークン・APIキーの総当たり対策）
+	Methods       map[string]Limit // メソッドごとの上書き（キー: gRPCのフルメソッド名）
+}
+
+// LimitFor メソッドに適用する制限と、バケットを共有する範囲を取得
+// メソッドごとの上書きがある場合はメソッド単位、ない場合は既定値のバケットを全メソッドで共有する
+func (p *Policy) LimitFor(fullMethod string, public bool) (Limit, string) {
+	if limit, ok := p.Methods[fullMethod]; ok {
+		return limit, fullMethod
+	}
+	if public {
+		return p.Public, "public"
+	}
+	return p.Authenticated, "default"
+}
+
+// ParsePolicy 設定値からポリシーを作成
+// methodsは"<フルメソッド名>=<制限>"のカンマ区切り（例: "/contractpro.auth.v1.AuthService/SignupClient=5/h:3"）
+// 旧サービス名（"/auth.AuthService/..."）で指定された場合は新サービス名に読み替える
+func ParsePolicy(authenticated, public, methods string) (*Policy, error) {
+	policy := &Policy{Methods: map[string]Limit{}}
+
+	var err error
+	if policy.Authenticated, err = ParseLimit(authenticated); err != nil {
+		return nil, err
+	}
+	if policy.Public, err = ParseLimit(public); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range strings.Split(methods, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		method, value, ok := strings.Cut(entry, "=")
+		if !ok || !strings.HasPrefix(method, "/") {
+			return nil, fmt.Errorf("invalid method rate limit %q", entry)
+		}
+		limit, err := ParseLimit(value)
+		if err != nil {
+			return nil, err
+		}
+		policy.Methods[legacyapi.CanonicalMethod(strings.TrimSpace(method))] = limit
+	}
+
+	return policy, nil
+}
+
+// ParseLimit "<回数>/<s|m|h>[:<バースト>]"形式の制限を解析（例: "20/s:40"、"5/h"）
+// バーストを省略した場合は回数と同じ。"0"または空文字は無制限
+func ParseLimit(value string) (Limit, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "0" {
+		return Limit{}, nil
+	}
+
+	rateStr, burstStr, hasBurst := strings.Cut(value, ":")
+	countStr, unit, ok := strings.Cut(rateStr, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q", value)
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count < 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q", value)
+	}
+
+	var period time.Duration
+	switch unit {
+	case "s":
+		period = time.Second
+	case "m":
+		period = time.Minute
+	case "h":
+		period = time.Hour
+	default:
+		return Limit{}, fmt.Errorf("invalid rate limit unit %q", value)
+	}
+
+	burst := count
+	if hasBurst {
+		burst, err = strconv.Atoi(burstStr)
+		if err != nil || burst < 0 {
+			return Limit{}, fmt.Errorf("invalid rate limit burst %q", value)
+		}
+	}
+
+	return Limit{
+		Rate:  float64(count) / period.Seconds(),
+		Burst: burst,
+	}, nil
+}
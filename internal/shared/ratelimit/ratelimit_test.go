@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Limit
+		wantErr bool
+	}{
+		{name: "秒単位とバースト", value: "20/s:40", want: Limit{Rate: 20, Burst: 40}},
+		{name: "バースト省略", value: "60/m", want: Limit{Rate: 1, Burst: 60}},
+		{name: "時間単位", value: "36/h:3", want: Limit{Rate: 0.01, Burst: 3}},
+		{name: "無制限", value: "0", want: Limit{}},
+		{name: "空文字", value: "", want: Limit{}},
+		{name: "単位なし", value: "20", wantErr: true},
+		{name: "不正な単位", value: "20/d", wantErr: true},
+		{name: "不正なバースト", value: "20/s:x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLimit(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseLimit(%q) expected error", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseLimit(%q) failed: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLimit(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy("20/s:40", "30/m:10", "/contractpro.auth.v1.AuthService/SignupClient=5/h:3, /auth.AuthService/GetMe=0")
+	if err != nil {
+		t.Fatalf("ParsePolicy() failed: %v", err)
+	}
+
+	// メソッドごとの上書きはメソッド単位のバケット
+	limit, scope := policy.LimitFor("/contractpro.auth.v1.AuthService/SignupClient", true)
+	if limit.Burst != 3 || scope != "/contractpro.auth.v1.AuthService/SignupClient" {
+		t.Errorf("LimitFor(SignupClient) = %+v, %s", limit, scope)
+	}
+	// 旧サービス名で指定した上書きは新サービス名に読み替える
+	if limit, _ := policy.LimitFor("/contractpro.auth.v1.AuthService/GetMe", false); !limit.Unlimited() {
+		t.Errorf("LimitFor(GetMe) should be unlimited, got %+v", limit)
+	}
+	// 上書きがない場合は既定値のバケットを共有
+	if limit, scope := policy.LimitFor("/contractpro.auth.v1.AuthService/ListClientUsers", false); limit.Burst != 40 || scope != "default" {
+		t.Errorf("LimitFor(ListClientUsers) = %+v, %s", limit, scope)
+	}
+
+	if _, err := ParsePolicy("20/s", "30/m", "SignupClient=5/h"); err == nil {
+		t.Errorf("ParsePolicy() expected error for method without leading slash")
+	}
+}
+
+func TestMemoryStore_Allow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := Limit{Rate: 1, Burst: 2}
+	ctx := context.Background()
+
+	// バーストまでは連続して許可
+	for i := 0; i < 2; i++ {
+		result, err := store.Allow(ctx, "key", limit)
+		if err != nil || !result.Allowed {
+			t.Fatalf("Allow() #%d = %+v, %v; want allowed", i, result, err)
+		}
+	}
+
+	// バケットが空になると拒否され、補充までの時間を返す
+	result, _ := store.Allow(ctx, "key", limit)
+	if result.Allowed {
+		t.Fatalf("Allow() should be denied after burst")
+	}
+	if result.RetryAfter != time.Second {
+		t.Errorf("RetryAfter = %v, want 1s", result.RetryAfter)
+	}
+
+	// キーごとに独立したバケット
+	if result, _ := store.Allow(ctx, "other", limit); !result.Allowed {
+		t.Errorf("Allow(other) should be allowed")
+	}
+
+	// 時間経過で補充される
+	now = now.Add(time.Second)
+	if result, _ := store.Allow(ctx, "key", limit); !result.Allowed {
+		t.Errorf("Allow() should be allowed after refill")
+	}
+}
+
+func TestMemoryStore_Sweep(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := Limit{Rate: 1, Burst: 1}
+
+	_, _ = store.Allow(context.Background(), "idle", limit)
+	now = now.Add(2 * sweepInterval)
+	_, _ = store.Allow(context.Background(), "active", limit)
+
+	// 満杯まで補充されたバケットは削除される
+	if _, ok := store.buckets["idle"]; ok {
+		t.Errorf("idle bucket should be swept")
+	}
+	if _, ok := store.buckets["active"]; !ok {
+		t.Errorf("active bucket should remain")
+	}
+}
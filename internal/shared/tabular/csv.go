@@ -0,0 +1,50 @@
+package tabular
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// utf8BOM Excelで開いた場合に文字化けしないよう先頭に付与するBOM
+const utf8BOM = "\uFEFF"
+
+type csvWriter struct {
+	w        io.Writer
+	csv      *csv.Writer
+	wroteBOM bool
+}
+
+// NewCSVWriter CSV（UTF-8、BOM付き、CRLF改行）のWriterを作成
+func NewCSVWriter(w io.Writer) Writer {
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+	return &csvWriter{w: w, csv: writer}
+}
+
+func (c *csvWriter) WriteRow(cells []string) error {
+	if !c.wroteBOM {
+		if _, err := io.WriteString(c.w, utf8BOM); err != nil {
+			return err
+		}
+		c.wroteBOM = true
+	}
+	sanitized := make([]string, len(cells))
+	for i, cell := range cells {
+		sanitized[i] = sanitizeCSVCell(cell)
+	}
+	return c.csv.Write(sanitized)
+}
+
+func (c *csvWriter) Close() error {
+	c.csv.Flush()
+	return c.csv.Error()
+}
+
+// sanitizeCSVCell 表計算ソフトで数式として解釈される値を無害化（CSVインジェクション対策）
+func sanitizeCSVCell(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
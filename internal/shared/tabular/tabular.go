@@ -0,0 +1,58 @@
+package tabular
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+// Format 出力形式
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ErrUnsupportedFormat 未対応の出力形式
+var ErrUnsupportedFormat = errors.New("unsupported format")
+
+// Writer 表形式データを1行ずつ出力する（全体をメモリに保持しない）
+type Writer interface {
+	WriteRow(cells []string) error
+	Close() error // 残りのデータを書き出す（基になるio.Writerは閉じない）
+}
+
+// ParseFormat 出力形式を解析（大文字小文字は区別しない）
+func ParseFormat(value string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(value))); format {
+	case FormatCSV, FormatXLSX:
+		return format, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// NewWriter 出力形式に応じたWriterを作成
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVWriter(w), nil
+	case FormatXLSX:
+		return NewXLSXWriter(w), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ContentType 出力形式のMIMEタイプ
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "application/octet-stream"
+	}
+}
@@ -0,0 +1,107 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestParseFormat(t *testing.T) {
+	for value, want := range map[string]Format{"csv": FormatCSV, " XLSX ": FormatXLSX} {
+		got, err := ParseFormat(value)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v", value, got, err)
+		}
+	}
+	if _, err := ParseFormat("pdf"); err != ErrUnsupportedFormat {
+		t.Errorf("ParseFormat(pdf) error = %v", err)
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf)
+	if err := w.WriteRow([]string{"email", "name"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow([]string{"=HYPERLINK(\"x\")", "山田, 太郎"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// BOM付き、数式として解釈される値は無害化される
+	want := utf8BOM + "email,name\r\n\"'=HYPERLINK(\"\"x\"\")\",\"山田, 太郎\"\r\n"
+	if got := buf.String(); got != want {
+		t.Errorf("csv = %q, want %q", got, want)
+	}
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewXLSXWriter(&buf)
+	if err := w.WriteRow([]string{"email", "name"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow([]string{"a<b>@example.com", "山田 & 太郎"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("invalid zip: %v", err)
+	}
+	parts := map[string]string{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[file.Name] = string(content)
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("missing part %s", name)
+		}
+	}
+
+	// シートは整形式のXMLで、セルの値がエスケープされている
+	var sheet struct {
+		Rows []struct {
+			Cells []struct {
+				Ref   string `xml:"r,attr"`
+				Value string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xml.Unmarshal([]byte(parts["xl/worksheets/sheet1.xml"]), &sheet); err != nil {
+		t.Fatalf("invalid sheet xml: %v", err)
+	}
+	if len(sheet.Rows) != 2 {
+		t.Fatalf("rows = %d", len(sheet.Rows))
+	}
+	cell := sheet.Rows[1].Cells[1]
+	if cell.Ref != "B2" || cell.Value != "山田 & 太郎" {
+		t.Errorf("cell = %+v", cell)
+	}
+	if !strings.Contains(parts["xl/worksheets/sheet1.xml"], "a&lt;b&gt;@example.com") {
+		t.Error("cell value is not escaped")
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for index, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(index); got != want {
+			t.Errorf("columnName(%d) = %s, want %s", index, got, want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"io"
+	"strconv"
+)
+
+// xlsxStaticParts シート以外の固定のパーツ（1シートのみのブック）
+var xlsxStaticParts = []struct {
+	name    string
+	content string
+}{
+	{
+		name: "[Content_Types].xml",
+		content: xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`,
+	},
+	{
+		name: "_rels/.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		name: "xl/workbook.xml",
+		content: xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+			`</workbook>`,
+	},
+	{
+		name: "xl/_rels/workbook.xml.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`</Relationships>`,
+	},
+}
+
+const (
+	xlsxSheetHeader = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetFooter = `</sheetData></worksheet>`
+)
+
+type xlsxWriter struct {
+	zip     *zip.Writer
+	sheet   *bufio.Writer
+	started bool
+	rows    int
+	err     error
+}
+
+// NewXLSXWriter XLSX（1シート、すべてのセルを文字列として出力）のWriterを作成
+// シートのXMLはZIPエントリに逐次書き込むため、行数に関わらずメモリ使用量は一定
+func NewXLSXWriter(w io.Writer) Writer {
+	return &xlsxWriter{zip: zip.NewWriter(w)}
+}
+
+func (x *xlsxWriter) WriteRow(cells []string) error {
+	if err := x.start(); err != nil {
+		return err
+	}
+	x.rows++
+	row := strconv.Itoa(x.rows)
+	x.writeString(`<row r="` + row + `">`)
+	for i, cell := range cells {
+		// インライン文字列（共有文字列テーブルを使用しないため逐次出力できる）
+		x.writeString(`<c r="` + columnName(i) + row + `" t="inlineStr"><is><t xml:space="preserve">`)
+		if x.err == nil {
+			x.err = xml.EscapeText(x.sheet, []byte(cell))
+		}
+		x.writeString(`</t></is></c>`)
+	}
+	x.writeString(`</row>`)
+	return x.err
+}
+
+func (x *xlsxWriter) Close() error {
+	if err := x.start(); err != nil {
+		return err
+	}
+	x.writeString(xlsxSheetFooter)
+	if x.err != nil {
+		return x.err
+	}
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.zip.Close()
+}
+
+// start 固定のパーツとシートの開始タグを出力（初回のみ）
+func (x *xlsxWriter) start() error {
+	if x.started {
+		return x.err
+	}
+	x.started = true
+	for _, part := range xlsxStaticParts {
+		entry, err := x.zip.Create(part.name)
+		if err != nil {
+			x.err = err
+			return err
+		}
+		if _, err := io.WriteString(entry, part.content); err != nil {
+			x.err = err
+			return err
+		}
+	}
+	entry, err := x.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		x.err = err
+		return err
+	}
+	x.sheet = bufio.NewWriter(entry)
+	x.writeString(xlsxSheetHeader)
+	return x.err
+}
+
+func (x *xlsxWriter) writeString(s string) {
+	if x.err != nil {
+		return
+	}
+	_, x.err = x.sheet.WriteString(s)
+}
+
+// columnName 列番号（0始まり）を列名（A, B, ..., Z, AA, ...）に変換
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
@@ -0,0 +1,197 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	pbvalidate "contract-pro-suite/proto/validate"
+)
+
+// 違反の種類（BadRequest.FieldViolation.reasonとしてクライアントに返すため、値は変更しない）
+const (
+	ReasonRequired        = "REQUIRED"
+	ReasonTooShort        = "TOO_SHORT"
+	ReasonTooLong         = "TOO_LONG"
+	ReasonInvalidEmail    = "INVALID_EMAIL"
+	ReasonInvalidUUID     = "INVALID_UUID"
+	ReasonInvalidJSON     = "INVALID_JSON"
+	ReasonNotInEnum       = "NOT_IN_ENUM"
+	ReasonPatternMismatch = "PATTERN_MISMATCH"
+	ReasonInvalidDateTime = "INVALID_DATE_TIME"
+)
+
+// Violation フィールド単位のルール違反
+type Violation struct {
+	Field       string // フィールドのパス（例: "admin_email", "items[0].name"）
+	Reason      string
+	Description string
+}
+
+// Validate メッセージのフィールドに宣言されたルール（validate.field）を評価し、すべての違反を返す
+// ネストしたメッセージ（repeatedを含む）のフィールドも評価する
+func Validate(msg proto.Message) []Violation {
+	if msg == nil {
+		return nil
+	}
+	var violations []Violation
+	validateMessage(msg.ProtoReflect(), "", &violations)
+	return violations
+}
+
+func validateMessage(m protoreflect.Message, prefix string, violations *[]Violation) {
+	if !m.IsValid() {
+		return
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		rules := fieldRules(fd)
+
+		switch {
+		case fd.IsMap():
+			continue
+		case fd.IsList():
+			list := m.Get(fd).List()
+			if list.Len() == 0 {
+				if rules.GetRequired() {
+					*violations = append(*violations, Violation{Field: path, Reason: ReasonRequired, Description: "is required"})
+				}
+				continue
+			}
+			for j := 0; j < list.Len(); j++ {
+				validateValue(fd, rules, list.Get(j), fmt.Sprintf("%s[%d]", path, j), violations)
+			}
+		case !m.Has(fd):
+			// proto3の暗黙的なフィールドは空文字・ゼロ値の場合に未設定となる
+			if rules.GetRequired() {
+				*violations = append(*violations, Violation{Field: path, Reason: ReasonRequired, Description: "is required"})
+			}
+		default:
+			validateValue(fd, rules, m.Get(fd), path, violations)
+		}
+	}
+}
+
+// validateValue 設定されている値（repeatedの場合は要素）を評価
+func validateValue(fd protoreflect.FieldDescriptor, rules *pbvalidate.FieldRules, value protoreflect.Value, path string, violations *[]Violation) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		validateMessage(value.Message(), path+".", violations)
+	case protoreflect.StringKind:
+		s := value.String()
+		if strings.TrimSpace(s) == "" {
+			// 必須でない場合は空文字を未指定として扱い、他のルールを評価しない
+			if rules.GetRequired() {
+				*violations = append(*violations, Violation{Field: path, Reason: ReasonRequired, Description: "is required"})
+			}
+			return
+		}
+		if rules.GetString_() != nil {
+			*violations = append(*violations, validateString(path, s, rules.GetString_())...)
+		}
+	case protoreflect.EnumKind:
+		if rules.GetEnum().GetDefinedOnly() && fd.Enum().Values().ByNumber(value.Enum()) == nil {
+			*violations = append(*violations, Violation{Field: path, Reason: ReasonNotInEnum, Description: "must be a defined enum value"})
+		}
+	}
+}
+
+// validateString 文字列のルールを評価
+func validateString(path, s string, rules *pbvalidate.StringRules) []Violation {
+	var violations []Violation
+	violate := func(reason, description string) {
+		violations = append(violations, Violation{Field: path, Reason: reason, Description: description})
+	}
+
+	length := uint64(utf8.RuneCountInString(s))
+	if rules.MinLen != nil && length < rules.GetMinLen() {
+		violate(ReasonTooShort, fmt.Sprintf("must be at least %d characters", rules.GetMinLen()))
+	}
+	if rules.MaxLen != nil && length > rules.GetMaxLen() {
+		violate(ReasonTooLong, fmt.Sprintf("must be at most %d characters", rules.GetMaxLen()))
+	}
+	if rules.GetEmail() && !isEmail(s) {
+		violate(ReasonInvalidEmail, "must be a valid email address")
+	}
+	if rules.GetUuid() && !isUUID(s) {
+		violate(ReasonInvalidUUID, "must be a valid UUID")
+	}
+	if rules.GetJson() && !json.Valid([]byte(s)) {
+		violate(ReasonInvalidJSON, "must be valid JSON")
+	}
+	if rules.GetJsonObject() && !isJSONObject(s) {
+		violate(ReasonInvalidJSON, "must be a JSON object")
+	}
+	if len(rules.GetIn()) > 0 && !slices.Contains(rules.GetIn(), s) {
+		violate(ReasonNotInEnum, "must be one of "+strings.Join(rules.GetIn(), ", "))
+	}
+	if rules.GetPattern() != "" && !matchPattern(rules.GetPattern(), s) {
+		violate(ReasonPatternMismatch, "must match the pattern "+rules.GetPattern())
+	}
+	if rules.GetDateTime() {
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			violate(ReasonInvalidDateTime, "must be an RFC 3339 date-time")
+		}
+	}
+	return violations
+}
+
+// fieldRules フィールドに宣言されたルールを取得（宣言されていない場合はnil）
+func fieldRules(fd protoreflect.FieldDescriptor) *pbvalidate.FieldRules {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil || !proto.HasExtension(opts, pbvalidate.E_Field) {
+		return nil
+	}
+	rules, _ := proto.GetExtension(opts, pbvalidate.E_Field).(*pbvalidate.FieldRules)
+	return rules
+}
+
+// isEmail メールアドレスのaddr-spec部分のみで構成されているか（"Name <a@example.com>"は不可）
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s && addr.Name == ""
+}
+
+// isUUID ハイフン区切りの36文字のUUIDか（uuid.Parseが受け付ける{}・urn:uuid:形式は不可）
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	_, err := uuid.Parse(s)
+	return err == nil
+}
+
+// isJSONObject JSONオブジェクトか（nullは不可）
+func isJSONObject(s string) bool {
+	var object map[string]json.RawMessage
+	return json.Unmarshal([]byte(s), &object) == nil && object != nil
+}
+
+// patterns コンパイル済みの正規表現（ルールの文字列ごと）
+var patterns sync.Map
+
+// matchPattern 値全体が正規表現に一致するか（不正な正規表現は一致しないものとして扱う）
+func matchPattern(pattern, s string) bool {
+	cached, ok := patterns.Load(pattern)
+	if !ok {
+		re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+		if err != nil {
+			return false
+		}
+		cached, _ = patterns.LoadOrStore(pattern, re)
+	}
+	return cached.(*regexp.Regexp).MatchString(s)
+}
@@ -0,0 +1,130 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// reasons 違反をフィールドごとの理由に変換
+func reasons(violations []Violation) map[string][]string {
+	result := map[string][]string{}
+	for _, violation := range violations {
+		result[violation.Field] = append(result[violation.Field], violation.Reason)
+	}
+	return result
+}
+
+func TestValidate(t *testing.T) {
+	valid := &pbauth.SignupClientRequest{
+		Name:           "Test Client",
+		Slug:           "test-client",
+		ESignMode:      stringPtr("OTP_ONLY"),
+		Settings:       stringPtr(`{"theme":"dark"}`),
+		AdminEmail:     "admin@example.com",
+		AdminPassword:  "Password123!",
+		AdminFirstName: "Taro",
+		AdminLastName:  "Yamada",
+	}
+	assert.Empty(t, Validate(valid))
+
+	tests := []struct {
+		name string
+		req  *pbauth.SignupClientRequest
+		want map[string][]string
+	}{
+		{
+			name: "必須項目が未指定・空白のみ",
+			req:  &pbauth.SignupClientRequest{Name: "  ", AdminEmail: "admin@example.com", AdminPassword: "x", AdminFirstName: "Taro", AdminLastName: "Yamada"},
+			want: map[string][]string{"name": {ReasonRequired}, "slug": {ReasonRequired}},
+		},
+		{
+			name: "形式・長さ・列挙値の違反をすべて返す",
+			req: &pbauth.SignupClientRequest{
+				Name:           strings.Repeat("あ", 201),
+				Slug:           "Test_Client",
+				ESignMode:      stringPtr("witness_otp"),
+				Settings:       stringPtr(`["theme"]`),
+				AdminEmail:     "Admin <admin@example.com>",
+				AdminPassword:  "x",
+				AdminFirstName: "Taro",
+				AdminLastName:  "Yamada",
+			},
+			want: map[string][]string{
+				"name":        {ReasonTooLong},
+				"slug":        {ReasonPatternMismatch},
+				"e_sign_mode": {ReasonNotInEnum},
+				"settings":    {ReasonInvalidJSON},
+				"admin_email": {ReasonInvalidEmail},
+			},
+		},
+		{
+			name: "任意項目の空文字は評価しない",
+			req: &pbauth.SignupClientRequest{
+				Name: "Test Client", Slug: "a", ESignMode: stringPtr(""), Settings: stringPtr(""),
+				AdminEmail: "admin@example.com", AdminPassword: "x", AdminFirstName: "Taro", AdminLastName: "Yamada",
+			},
+			want: map[string][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reasons(Validate(tt.req)))
+		})
+	}
+}
+
+func TestValidate_StringRules(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174000"
+	tests := []struct {
+		name string
+		req  *pbauth.UpdateClientUserRequest
+		want map[string][]string
+	}{
+		{"有効", &pbauth.UpdateClientUserRequest{ClientUserId: userID, Etag: "1", Status: stringPtr("ACTIVE"), Settings: stringPtr(`null`)}, map[string][]string{}},
+		{"UUIDはハイフン区切りのみ", &pbauth.UpdateClientUserRequest{ClientUserId: "urn:uuid:" + userID, Etag: "1"}, map[string][]string{"client_user_id": {ReasonInvalidUUID}}},
+		{"不正なJSON", &pbauth.UpdateClientUserRequest{ClientUserId: userID, Etag: "1", Settings: stringPtr(`{"theme":`)}, map[string][]string{"settings": {ReasonInvalidJSON}}},
+		{"ステータスは大文字・小文字を区別", &pbauth.UpdateClientUserRequest{ClientUserId: userID, Etag: "1", Status: stringPtr("active")}, map[string][]string{"status": {ReasonNotInEnum}}},
+		{"ETagは必須", &pbauth.UpdateClientUserRequest{ClientUserId: userID, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}}}, map[string][]string{"etag": {ReasonRequired}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reasons(Validate(tt.req)))
+		})
+	}
+
+	violations := Validate(&pbauth.CreateApiKeyRequest{ServiceAccountId: userID, ExpiresAt: stringPtr("2026-01-01")})
+	assert.Equal(t, map[string][]string{"expires_at": {ReasonInvalidDateTime}}, reasons(violations))
+	assert.Empty(t, Validate(&pbauth.CreateApiKeyRequest{ServiceAccountId: userID, ExpiresAt: stringPtr("2026-01-01T00:00:00+09:00")}))
+	assert.Empty(t, Validate(nil))
+}
+
+// TestAuthProtoRules auth.protoで宣言した正規表現がすべてコンパイルできることを確認（不正な場合はどの値にも一致しないため）
+func TestAuthProtoRules(t *testing.T) {
+	messages := pbauth.File_proto_contractpro_auth_v1_auth_proto.Messages()
+	for i := 0; i < messages.Len(); i++ {
+		fields := messages.Get(i).Fields()
+		for j := 0; j < fields.Len(); j++ {
+			if pattern := fieldRules(fields.Get(j)).GetString_().GetPattern(); pattern != "" {
+				_, err := regexp.Compile(pattern)
+				assert.NoError(t, err, "field=%s", fields.Get(j).FullName())
+			}
+		}
+	}
+}
+
+func TestValidate_EnumRules(t *testing.T) {
+	userID := "123e4567-e89b-12d3-a456-426614174000"
+	assert.Empty(t, Validate(&pbauth.ListClientUsersRequest{State: pbauth.UserStatus_USER_STATUS_SUSPENDED}))
+	violations := Validate(&pbauth.UpdateClientUserRequest{ClientUserId: userID, Etag: "1", State: pbauth.UserStatus(42).Enum()})
+	assert.Equal(t, map[string][]string{"state": {ReasonNotInEnum}}, reasons(violations))
+}
@@ -0,0 +1,60 @@
+-- エンタープライズSSO（OIDC）対応
+-- クライアントごとに外部IdP（Entra ID、Okta等）の発行者設定を保持し、
+-- (issuer, subject) と client_users を紐付けるテーブルを作成
+
+-- client_identity_providers（クライアントIdP設定）テーブル
+CREATE TABLE client_identity_providers (
+    provider_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    name text NOT NULL,
+    issuer text NOT NULL,
+    audience text NOT NULL,
+    jwks_uri text,  -- NULLの場合はissuerのOpenID Connect Discoveryから取得
+    jit_provisioning boolean NOT NULL DEFAULT false,
+    default_role_code text NOT NULL DEFAULT 'member',
+    status text NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    deleted_at timestamptz,
+    deleted_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- JWTのissからIdP設定を一意に特定するため、issuerは全クライアントで一意
+CREATE UNIQUE INDEX idx_client_identity_providers_issuer ON client_identity_providers(issuer) WHERE deleted_at IS NULL;
+CREATE INDEX idx_client_identity_providers_client_id ON client_identity_providers(client_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_client_identity_providers_updated_at BEFORE UPDATE ON client_identity_providers
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- client_user_identities（外部IDとクライアントユーザーの紐付け）テーブル
+CREATE TABLE client_user_identities (
+    issuer text NOT NULL,
+    subject text NOT NULL,
+    provider_id uuid NOT NULL REFERENCES client_identity_providers(provider_id) ON DELETE RESTRICT,
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    client_user_id uuid NOT NULL REFERENCES client_users(client_user_id) ON DELETE RESTRICT,
+    email citext,
+    last_login_at timestamptz,
+    deleted_at timestamptz,
+    deleted_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (issuer, subject)
+);
+
+CREATE INDEX idx_client_user_identities_client_user ON client_user_identities(client_id, client_user_id) WHERE deleted_at IS NULL;
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE client_identity_providers ENABLE ROW LEVEL SECURITY;
+ALTER TABLE client_user_identities ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all client_identity_providers"
+    ON client_identity_providers
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
+
+CREATE POLICY "Service role can access all client_user_identities"
+    ON client_user_identities
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
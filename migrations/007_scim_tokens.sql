@@ -0,0 +1,29 @@
+-- SCIM 2.0 プロビジョニング対応
+-- IdP（Entra ID、Okta等）からのユーザー・グループ同期に使用する
+-- クライアントごとのBearerトークン（ハッシュのみ保存）テーブルを作成
+
+-- client_scim_tokens（SCIMトークン）テーブル
+CREATE TABLE client_scim_tokens (
+    token_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    token_hash text NOT NULL,  -- トークンのSHA-256（16進数）。平文は発行時のみ返却
+    token_prefix text NOT NULL,  -- 識別用のトークン先頭部分（管理画面表示用）
+    description text,
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    revoked_at timestamptz,
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_client_scim_tokens_token_hash ON client_scim_tokens(token_hash);
+CREATE INDEX idx_client_scim_tokens_client_id ON client_scim_tokens(client_id) WHERE revoked_at IS NULL;
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE client_scim_tokens ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all client_scim_tokens"
+    ON client_scim_tokens
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
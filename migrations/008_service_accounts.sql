@@ -0,0 +1,54 @@
+-- サービスアカウント・APIキー対応
+-- 外部連携（ERP同期、電子署名コールバック等）が人のJWTを使わずにAPIを呼び出すため、
+-- クライアントごとのサービスアカウントと、そのAPIキー（ハッシュのみ保存）テーブルを作成
+
+-- client_service_accounts（サービスアカウント）テーブル
+CREATE TABLE client_service_accounts (
+    service_account_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    name text NOT NULL,
+    description text,
+    role_id uuid NOT NULL REFERENCES client_roles(role_id) ON DELETE RESTRICT,  -- 権限はclient_rolesのロールで付与
+    status text NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    deleted_at timestamptz,
+    deleted_by uuid
+);
+
+CREATE UNIQUE INDEX idx_client_service_accounts_client_name ON client_service_accounts(client_id, name) WHERE deleted_at IS NULL;
+
+-- client_api_keys（APIキー）テーブル
+CREATE TABLE client_api_keys (
+    api_key_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    service_account_id uuid NOT NULL REFERENCES client_service_accounts(service_account_id) ON DELETE RESTRICT,
+    key_hash text NOT NULL,  -- キーのSHA-256（16進数）。平文は発行時のみ返却
+    key_prefix text NOT NULL,  -- 識別用のキー先頭部分（管理画面表示用）
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    revoked_at timestamptz,
+    rotated_from uuid REFERENCES client_api_keys(api_key_id),  -- ローテーション元のキー
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_client_api_keys_key_hash ON client_api_keys(key_hash);
+CREATE INDEX idx_client_api_keys_service_account_id ON client_api_keys(client_id, service_account_id);
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE client_service_accounts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE client_api_keys ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all client_service_accounts"
+    ON client_service_accounts
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
+
+CREATE POLICY "Service role can access all client_api_keys"
+    ON client_api_keys
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
@@ -0,0 +1,42 @@
+-- トークン失効（強制ログアウト）対応
+-- AuthInterceptorで検証済みのJWTは有効期限まで有効なため、ユーザー削除・停止後もアクセスできてしまう。
+-- 「この日時より前に発行されたトークンは無効」とする失効基準日時（ユーザー単位・クライアント単位）と、
+-- トークン識別子（jti）単位の失効リスト（トークンの有効期限まで保持）を作成
+
+-- token_revocation_watermarks（失効基準日時）テーブル
+CREATE TABLE token_revocation_watermarks (
+    subject_type text NOT NULL CHECK (subject_type IN ('USER', 'CLIENT')),  -- USER: ユーザー単位、CLIENT: クライアント内の全ユーザー
+    subject_id uuid NOT NULL,  -- USERの場合はユーザーID、CLIENTの場合はクライアントID
+    revoked_before timestamptz NOT NULL,  -- この日時より前に発行（iat）されたトークンは無効
+    reason text,  -- FORCE_LOGOUT, USER_DELETED, USER_SUSPENDED, PASSWORD_CHANGED, ROLE_REVOKED等
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (subject_type, subject_id)
+);
+
+-- revoked_tokens（トークン失効リスト）テーブル
+CREATE TABLE revoked_tokens (
+    jti text PRIMARY KEY,  -- トークン識別子（jti、未設定の場合はSupabaseのsession_id）
+    client_id uuid REFERENCES clients(client_id) ON DELETE CASCADE,
+    user_id uuid,
+    expires_at timestamptz NOT NULL,  -- トークンの有効期限（経過後は削除可能）
+    revoked_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE token_revocation_watermarks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE revoked_tokens ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all token_revocation_watermarks"
+    ON token_revocation_watermarks
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
+
+CREATE POLICY "Service role can access all revoked_tokens"
+    ON revoked_tokens
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
@@ -0,0 +1,23 @@
+-- クライアントごとのIPアドレス許可リスト
+-- 金融機関等のクライアントは社内ネットワークからのアクセスのみを許可する必要があるため、
+-- 許可するCIDRをクライアント単位で登録する（エントリがないクライアントは制限なし）
+
+-- client_ip_allowlist_entries（IPアドレス許可リスト）テーブル
+CREATE TABLE client_ip_allowlist_entries (
+    entry_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    cidr cidr NOT NULL,  -- 許可するアドレス範囲（IPv4/IPv6、単一アドレスは/32・/128）
+    description text,
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE(client_id, cidr)
+);
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE client_ip_allowlist_entries ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all client_ip_allowlist_entries"
+    ON client_ip_allowlist_entries
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
@@ -0,0 +1,29 @@
+-- サインアップの登録確認対応
+-- SignupClientは公開メソッドのため、登録直後にクライアントを有効化するとボットによる大量登録を防げない。
+-- 登録時はクライアントを登録確認待ち（PENDING_VERIFICATION）で作成し、
+-- 管理者メールアドレス宛ての確認トークンが検証された時点で有効化（ACTIVE）する
+
+-- clients.statusに登録確認待ちを追加
+ALTER TABLE clients DROP CONSTRAINT clients_status_check;
+ALTER TABLE clients ADD CONSTRAINT clients_status_check
+    CHECK (status IN ('PENDING_VERIFICATION', 'ACTIVE', 'SUSPENDED', 'TERMINATED'));
+
+-- client_signup_verifications（登録確認トークン）テーブル
+CREATE TABLE client_signup_verifications (
+    client_id uuid PRIMARY KEY REFERENCES clients(client_id) ON DELETE CASCADE,
+    admin_user_id uuid NOT NULL,  -- 登録時に作成した管理者ユーザー（Supabase AuthのユーザーID）
+    email citext NOT NULL,  -- 確認トークンの送信先
+    token_hash text NOT NULL UNIQUE,  -- 確認トークンのSHA-256ハッシュ（平文は保存しない）
+    expires_at timestamptz NOT NULL,
+    verified_at timestamptz,  -- 検証済みの場合は検証日時
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE client_signup_verifications ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all client_signup_verifications"
+    ON client_signup_verifications
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
@@ -0,0 +1,24 @@
+-- セルフサービス（自分のプロフィール・パスワード・メールアドレスの変更）対応
+-- パスワード変更日時を記録し、メールアドレス変更は新しいメールアドレス宛ての確認トークンが検証された時点で確定する
+
+-- client_users.password_changed_at（最終パスワード変更日時、未変更の場合はNULL）
+ALTER TABLE client_users ADD COLUMN password_changed_at timestamptz;
+
+-- client_user_email_changes（メールアドレス変更の確認待ち）テーブル
+CREATE TABLE client_user_email_changes (
+    client_user_id uuid PRIMARY KEY REFERENCES client_users(client_user_id) ON DELETE CASCADE,  -- 確認待ちはユーザーごとに1件（再申請で上書き）
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE CASCADE,
+    new_email citext NOT NULL,  -- 変更後のメールアドレス（確認トークンの送信先）
+    token_hash text NOT NULL UNIQUE,  -- 確認トークンのSHA-256ハッシュ（平文は保存しない）
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE client_user_email_changes ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all client_user_email_changes"
+    ON client_user_email_changes
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
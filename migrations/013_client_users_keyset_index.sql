@@ -0,0 +1,4 @@
+-- ListClientUsersのキーセットページネーション対応
+-- (created_at, client_user_id) の降順でクライアント内のユーザーを走査するためのインデックス
+
+CREATE INDEX idx_client_users_client_created ON client_users(client_id, created_at DESC, client_user_id DESC) WHERE deleted_at IS NULL;
@@ -0,0 +1,34 @@
+-- クライアントユーザーの検索・絞り込み・並び替え対応
+-- 氏名・メールアドレスの部分一致検索はpg_trgmのGINインデックスで、
+-- 部署・役職の絞り込みと最終アクティビティ順の並び替えはB-treeインデックスで行う
+-- 最終アクティビティは認証済みリクエストごとに更新されるため、client_users（updated_atのトリガー対象）とは別テーブルに記録する
+
+-- pg_trgm拡張機能を有効化（部分一致検索用）
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+-- client_user_activities（クライアントユーザーの最終アクティビティ）テーブル
+CREATE TABLE client_user_activities (
+    client_user_id uuid PRIMARY KEY REFERENCES client_users(client_user_id) ON DELETE CASCADE,
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE CASCADE,
+    last_active_at timestamptz NOT NULL  -- 最終アクティビティ日時（書き込みを抑えるため5分単位で更新）
+);
+
+CREATE INDEX idx_client_user_activities_client_last_active ON client_user_activities(client_id, last_active_at DESC);
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE client_user_activities ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all client_user_activities"
+    ON client_user_activities
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
+
+-- 部分一致検索用（ILIKE '%...%'）
+CREATE INDEX idx_client_users_email_trgm ON client_users USING gin ((email::text) gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX idx_client_users_last_first_name_trgm ON client_users USING gin ((last_name || ' ' || first_name) gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX idx_client_users_first_last_name_trgm ON client_users USING gin ((first_name || ' ' || last_name) gin_trgm_ops) WHERE deleted_at IS NULL;
+
+-- 絞り込み・並び替え用
+CREATE INDEX idx_client_users_client_department ON client_users(client_id, department) WHERE deleted_at IS NULL;
+CREATE INDEX idx_client_users_client_position ON client_users(client_id, position) WHERE deleted_at IS NULL;
@@ -0,0 +1,23 @@
+-- 適用済みのマイグレーションのバージョンの記録
+-- SQL Editorで適用した場合はsupabase_migrations.schema_migrationsに記録されないため、アプリケーションが参照するテーブルに記録する
+-- 以降のマイグレーションは最後に自身のバージョンをINSERTする（/readyzのマイグレーションの確認で使用）
+
+-- schema_versions（適用済みのマイグレーションのバージョン）テーブル
+CREATE TABLE schema_versions (
+    version integer PRIMARY KEY,  -- マイグレーションのファイル名の先頭の番号
+    applied_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- RLSを有効化（005_enable_rls_permission_tables.sqlと同じ方針）
+ALTER TABLE schema_versions ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Service role can access all schema_versions"
+    ON schema_versions
+    FOR ALL
+    USING (true)
+    WITH CHECK (true);
+
+-- 適用済みのマイグレーション（001〜014）とこのマイグレーションを記録
+INSERT INTO schema_versions (version)
+SELECT generate_series(1, 15)
+ON CONFLICT (version) DO NOTHING;
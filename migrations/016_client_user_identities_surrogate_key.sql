@@ -0,0 +1,12 @@
+-- 外部IDの紐付けをIdP設定の削除後に再登録できるようにする
+-- IdP設定の削除では紐付けも論理削除するため、(issuer, subject) の主キーでは同じissuerを再登録した後の
+-- JITプロビジョニングが主キー違反になる。サロゲートキーに置き換え、(issuer, subject) は
+-- idx_client_identity_providers_issuer と同じく削除されていない行でのみ一意とする
+
+ALTER TABLE client_user_identities DROP CONSTRAINT client_user_identities_pkey;
+ALTER TABLE client_user_identities ADD COLUMN identity_id uuid NOT NULL DEFAULT uuid_generate_v4();
+ALTER TABLE client_user_identities ADD PRIMARY KEY (identity_id);
+
+CREATE UNIQUE INDEX idx_client_user_identities_issuer_subject ON client_user_identities(issuer, subject) WHERE deleted_at IS NULL;
+
+INSERT INTO schema_versions (version) VALUES (16);
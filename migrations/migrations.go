@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// FS マイグレーションのSQLファイル（<バージョン>_<名前>.sql）
+// アプリケーションが前提とするスキーマのバージョンを判定するためにバイナリに埋め込む
+//
+//go:embed *.sql
+var FS embed.FS
+
+// LatestVersion 最新のマイグレーションのバージョン（ファイル名の先頭の番号）
+func LatestVersion() (int, error) {
+	files, err := fs.Glob(FS, "*.sql")
+	if err != nil {
+		return 0, fmt.Errorf("failed to list migrations: %w", err)
+	}
+	latest := 0
+	for _, file := range files {
+		version, err := ParseVersion(file)
+		if err != nil {
+			return 0, err
+		}
+		latest = max(latest, version)
+	}
+	return latest, nil
+}
+
+// ParseVersion マイグレーションのファイル名・バージョン（"014_client_user_search.sql"、"014"）から番号を取得
+func ParseVersion(name string) (int, error) {
+	prefix, _, _ := strings.Cut(name, "_")
+	version, err := strconv.Atoi(strings.TrimSuffix(prefix, ".sql"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid migration version %q: %w", name, err)
+	}
+	return version, nil
+}
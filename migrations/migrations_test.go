@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{"ファイル名", "014_client_user_search.sql", 14, false},
+		{"バージョンのみ", "014", 14, false},
+		{"タイムスタンプ形式", "20250101000000_init.sql", 20250101000000, false},
+		{"番号なし", "init.sql", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVersion(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLatestVersion(t *testing.T) {
+	latest, err := LatestVersion()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, latest, 14)
+}
+
+func TestMigrationsRecordVersion(t *testing.T) {
+	// 015_schema_versions.sql以降のマイグレーションはschema_versionsに自身のバージョンを記録する
+	files, err := fs.Glob(FS, "*.sql")
+	require.NoError(t, err)
+	for _, file := range files {
+		version, err := ParseVersion(file)
+		require.NoError(t, err)
+		if version <= 15 {
+			continue
+		}
+		t.Run(file, func(t *testing.T) {
+			content, err := fs.ReadFile(FS, file)
+			require.NoError(t, err)
+			assert.Contains(t, string(content), fmt.Sprintf("INSERT INTO schema_versions (version) VALUES (%d)", version))
+		})
+	}
+
+	// 015_schema_versions.sqlは適用済みのマイグレーションと自身を記録する
+	content, err := fs.ReadFile(FS, "015_schema_versions.sql")
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "generate_series(1, 15)")
+}
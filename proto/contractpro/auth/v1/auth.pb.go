@@ -0,0 +1,5590 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: proto/contractpro/auth/v1/auth.proto
+
+package authv1
+
+import (
+	_ "contract-pro-suite/proto/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserType ユーザータイプ
+type UserType int32
+
+const (
+	UserType_USER_TYPE_UNSPECIFIED     UserType = 0
+	UserType_USER_TYPE_OPERATOR        UserType = 1 // オペレーター（運営側）
+	UserType_USER_TYPE_CLIENT_USER     UserType = 2 // クライアントユーザー
+	UserType_USER_TYPE_SERVICE_ACCOUNT UserType = 3 // サービスアカウント（APIキーによるシステム間連携）
+)
+
+// Enum value maps for UserType.
+var (
+	UserType_name = map[int32]string{
+		0: "USER_TYPE_UNSPECIFIED",
+		1: "USER_TYPE_OPERATOR",
+		2: "USER_TYPE_CLIENT_USER",
+		3: "USER_TYPE_SERVICE_ACCOUNT",
+	}
+	UserType_value = map[string]int32{
+		"USER_TYPE_UNSPECIFIED":     0,
+		"USER_TYPE_OPERATOR":        1,
+		"USER_TYPE_CLIENT_USER":     2,
+		"USER_TYPE_SERVICE_ACCOUNT": 3,
+	}
+)
+
+func (x UserType) Enum() *UserType {
+	p := new(UserType)
+	*p = x
+	return p
+}
+
+func (x UserType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_contractpro_auth_v1_auth_proto_enumTypes[0].Descriptor()
+}
+
+func (UserType) Type() protoreflect.EnumType {
+	return &file_proto_contractpro_auth_v1_auth_proto_enumTypes[0]
+}
+
+func (x UserType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserType.Descriptor instead.
+func (UserType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{0}
+}
+
+// UserStatus ユーザー（クライアントユーザー・オペレーター）のステータス
+type UserStatus int32
+
+const (
+	UserStatus_USER_STATUS_UNSPECIFIED UserStatus = 0
+	UserStatus_USER_STATUS_ACTIVE      UserStatus = 1
+	UserStatus_USER_STATUS_INACTIVE    UserStatus = 2
+	UserStatus_USER_STATUS_SUSPENDED   UserStatus = 3
+)
+
+// Enum value maps for UserStatus.
+var (
+	UserStatus_name = map[int32]string{
+		0: "USER_STATUS_UNSPECIFIED",
+		1: "USER_STATUS_ACTIVE",
+		2: "USER_STATUS_INACTIVE",
+		3: "USER_STATUS_SUSPENDED",
+	}
+	UserStatus_value = map[string]int32{
+		"USER_STATUS_UNSPECIFIED": 0,
+		"USER_STATUS_ACTIVE":      1,
+		"USER_STATUS_INACTIVE":    2,
+		"USER_STATUS_SUSPENDED":   3,
+	}
+)
+
+func (x UserStatus) Enum() *UserStatus {
+	p := new(UserStatus)
+	*p = x
+	return p
+}
+
+func (x UserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_contractpro_auth_v1_auth_proto_enumTypes[1].Descriptor()
+}
+
+func (UserStatus) Type() protoreflect.EnumType {
+	return &file_proto_contractpro_auth_v1_auth_proto_enumTypes[1]
+}
+
+func (x UserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserStatus.Descriptor instead.
+func (UserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{1}
+}
+
+// ClientStatus クライアント（テナント）のステータス
+type ClientStatus int32
+
+const (
+	ClientStatus_CLIENT_STATUS_UNSPECIFIED          ClientStatus = 0
+	ClientStatus_CLIENT_STATUS_PENDING_VERIFICATION ClientStatus = 1 // 登録確認待ち
+	ClientStatus_CLIENT_STATUS_ACTIVE               ClientStatus = 2
+	ClientStatus_CLIENT_STATUS_SUSPENDED            ClientStatus = 3
+	ClientStatus_CLIENT_STATUS_TERMINATED           ClientStatus = 4
+)
+
+// Enum value maps for ClientStatus.
+var (
+	ClientStatus_name = map[int32]string{
+		0: "CLIENT_STATUS_UNSPECIFIED",
+		1: "CLIENT_STATUS_PENDING_VERIFICATION",
+		2: "CLIENT_STATUS_ACTIVE",
+		3: "CLIENT_STATUS_SUSPENDED",
+		4: "CLIENT_STATUS_TERMINATED",
+	}
+	ClientStatus_value = map[string]int32{
+		"CLIENT_STATUS_UNSPECIFIED":          0,
+		"CLIENT_STATUS_PENDING_VERIFICATION": 1,
+		"CLIENT_STATUS_ACTIVE":               2,
+		"CLIENT_STATUS_SUSPENDED":            3,
+		"CLIENT_STATUS_TERMINATED":           4,
+	}
+)
+
+func (x ClientStatus) Enum() *ClientStatus {
+	p := new(ClientStatus)
+	*p = x
+	return p
+}
+
+func (x ClientStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClientStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_contractpro_auth_v1_auth_proto_enumTypes[2].Descriptor()
+}
+
+func (ClientStatus) Type() protoreflect.EnumType {
+	return &file_proto_contractpro_auth_v1_auth_proto_enumTypes[2]
+}
+
+func (x ClientStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClientStatus.Descriptor instead.
+func (ClientStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+// ESignMode 電子署名方式
+type ESignMode int32
+
+const (
+	ESignMode_E_SIGN_MODE_UNSPECIFIED  ESignMode = 0
+	ESignMode_E_SIGN_MODE_WITNESS_OTP  ESignMode = 1
+	ESignMode_E_SIGN_MODE_OTP_ONLY     ESignMode = 2
+	ESignMode_E_SIGN_MODE_CERTIFICATE  ESignMode = 3
+	ESignMode_E_SIGN_MODE_BIOMETRIC    ESignMode = 4
+	ESignMode_E_SIGN_MODE_SIMPLE_CLICK ESignMode = 5
+)
+
+// Enum value maps for ESignMode.
+var (
+	ESignMode_name = map[int32]string{
+		0: "E_SIGN_MODE_UNSPECIFIED",
+		1: "E_SIGN_MODE_WITNESS_OTP",
+		2: "E_SIGN_MODE_OTP_ONLY",
+		3: "E_SIGN_MODE_CERTIFICATE",
+		4: "E_SIGN_MODE_BIOMETRIC",
+		5: "E_SIGN_MODE_SIMPLE_CLICK",
+	}
+	ESignMode_value = map[string]int32{
+		"E_SIGN_MODE_UNSPECIFIED":  0,
+		"E_SIGN_MODE_WITNESS_OTP":  1,
+		"E_SIGN_MODE_OTP_ONLY":     2,
+		"E_SIGN_MODE_CERTIFICATE":  3,
+		"E_SIGN_MODE_BIOMETRIC":    4,
+		"E_SIGN_MODE_SIMPLE_CLICK": 5,
+	}
+)
+
+func (x ESignMode) Enum() *ESignMode {
+	p := new(ESignMode)
+	*p = x
+	return p
+}
+
+func (x ESignMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ESignMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_contractpro_auth_v1_auth_proto_enumTypes[3].Descriptor()
+}
+
+func (ESignMode) Type() protoreflect.EnumType {
+	return &file_proto_contractpro_auth_v1_auth_proto_enumTypes[3]
+}
+
+func (x ESignMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ESignMode.Descriptor instead.
+func (ESignMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+}
+
+// OperatorRole オペレーターのクライアントへの割り当てロール
+type OperatorRole int32
+
+const (
+	OperatorRole_OPERATOR_ROLE_UNSPECIFIED OperatorRole = 0
+	OperatorRole_OPERATOR_ROLE_ADMIN       OperatorRole = 1 // 全操作
+	OperatorRole_OPERATOR_ROLE_OPERATOR    OperatorRole = 2 // 読み取り・書き込み
+	OperatorRole_OPERATOR_ROLE_VIEWER      OperatorRole = 3 // 読み取りのみ
+)
+
+// Enum value maps for OperatorRole.
+var (
+	OperatorRole_name = map[int32]string{
+		0: "OPERATOR_ROLE_UNSPECIFIED",
+		1: "OPERATOR_ROLE_ADMIN",
+		2: "OPERATOR_ROLE_OPERATOR",
+		3: "OPERATOR_ROLE_VIEWER",
+	}
+	OperatorRole_value = map[string]int32{
+		"OPERATOR_ROLE_UNSPECIFIED": 0,
+		"OPERATOR_ROLE_ADMIN":       1,
+		"OPERATOR_ROLE_OPERATOR":    2,
+		"OPERATOR_ROLE_VIEWER":      3,
+	}
+)
+
+func (x OperatorRole) Enum() *OperatorRole {
+	p := new(OperatorRole)
+	*p = x
+	return p
+}
+
+func (x OperatorRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperatorRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_contractpro_auth_v1_auth_proto_enumTypes[4].Descriptor()
+}
+
+func (OperatorRole) Type() protoreflect.EnumType {
+	return &file_proto_contractpro_auth_v1_auth_proto_enumTypes[4]
+}
+
+func (x OperatorRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperatorRole.Descriptor instead.
+func (OperatorRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+// GetMeRequest 現在のユーザー情報取得リクエスト
+type GetMeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeRequest) Reset() {
+	*x = GetMeRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeRequest) ProtoMessage() {}
+
+func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
+func (*GetMeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{0}
+}
+
+// GetMeResponse 現在のユーザー情報取得レスポンス
+type GetMeResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ユーザーID（UUID）
+	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                 // メールアドレス
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	UserType string  `protobuf:"bytes,3,opt,name=user_type,json=userType,proto3" json:"user_type,omitempty"`       // ユーザータイプ（非推奨: principal_typeを使用）
+	ClientId *string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"` // クライアントID（UUID、オプション）
+	// プロフィール（ユーザータイプに応じていずれか1つを設定）
+	ClientUser      *ClientUser       `protobuf:"bytes,5,opt,name=client_user,json=clientUser,proto3" json:"client_user,omitempty"`                                              // クライアントユーザーの場合
+	Operator        *Operator         `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`                                                                    // オペレーターの場合
+	ServiceAccount  *ServiceAccount   `protobuf:"bytes,7,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`                                  // サービスアカウントの場合
+	Tenant          *Tenant           `protobuf:"bytes,8,opt,name=tenant,proto3" json:"tenant,omitempty"`                                                                        // 現在のクライアント（オペレーターで割り当てがない場合は未設定）
+	Roles           []*Role           `protobuf:"bytes,9,rep,name=roles,proto3" json:"roles,omitempty"`                                                                          // 割り当て済みロール（クライアントユーザー・サービスアカウント）
+	Permissions     []*Permission     `protobuf:"bytes,10,rep,name=permissions,proto3" json:"permissions,omitempty"`                                                             // 実効権限（付与されたもののみ、オペレーターはfeatureが"*"）
+	AssignedClients []*AssignedClient `protobuf:"bytes,11,rep,name=assigned_clients,json=assignedClients,proto3" json:"assigned_clients,omitempty"`                              // 割り当て済みクライアント（オペレーターのみ、新しい順）
+	PrincipalType   UserType          `protobuf:"varint,12,opt,name=principal_type,json=principalType,proto3,enum=contractpro.auth.v1.UserType" json:"principal_type,omitempty"` // ユーザータイプ
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMeResponse) Reset() {
+	*x = GetMeResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeResponse) ProtoMessage() {}
+
+func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
+func (*GetMeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMeResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMeResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *GetMeResponse) GetUserType() string {
+	if x != nil {
+		return x.UserType
+	}
+	return ""
+}
+
+func (x *GetMeResponse) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *GetMeResponse) GetClientUser() *ClientUser {
+	if x != nil {
+		return x.ClientUser
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetOperator() *Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetAssignedClients() []*AssignedClient {
+	if x != nil {
+		return x.AssignedClients
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetPrincipalType() UserType {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return UserType_USER_TYPE_UNSPECIFIED
+}
+
+// SignupClientRequest サービス利用開始時のアカウント登録リクエスト
+type SignupClientRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// クライアント情報
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                        // クライアント名（必須）
+	CompanyCode *string `protobuf:"bytes,2,opt,name=company_code,json=companyCode,proto3,oneof" json:"company_code,omitempty"` // 企業コード（オプション、JIPDEC標準企業コード、一意）
+	Slug        string  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`                                        // スラッグ（必須、一意、サブドメイン用）
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	ESignMode              *string   `protobuf:"bytes,4,opt,name=e_sign_mode,json=eSignMode,proto3,oneof" json:"e_sign_mode,omitempty"`                                         // 電子署名方式（非推奨: signature_modeを使用）
+	RetentionDefaultMonths *int32    `protobuf:"varint,5,opt,name=retention_default_months,json=retentionDefaultMonths,proto3,oneof" json:"retention_default_months,omitempty"` // データ保存期間（月、オプション、デフォルト: 84）
+	Settings               *string   `protobuf:"bytes,6,opt,name=settings,proto3,oneof" json:"settings,omitempty"`                                                              // 設定（JSON文字列、オプション、デフォルト: {}）
+	SignatureMode          ESignMode `protobuf:"varint,7,opt,name=signature_mode,json=signatureMode,proto3,enum=contractpro.auth.v1.ESignMode" json:"signature_mode,omitempty"` // 電子署名方式（オプション、デフォルト: WITNESS_OTP。e_sign_modeと両方指定する場合は同じ値）
+	// 管理者ユーザー情報
+	AdminEmail      string  `protobuf:"bytes,10,opt,name=admin_email,json=adminEmail,proto3" json:"admin_email,omitempty"`                      // 管理者メールアドレス（必須）
+	AdminPassword   string  `protobuf:"bytes,11,opt,name=admin_password,json=adminPassword,proto3" json:"admin_password,omitempty"`             // 管理者パスワード（必須、パスワードポリシーを満たすこと。違反時はBadRequestの詳細を返す）
+	AdminFirstName  string  `protobuf:"bytes,12,opt,name=admin_first_name,json=adminFirstName,proto3" json:"admin_first_name,omitempty"`        // 管理者名（必須）
+	AdminLastName   string  `protobuf:"bytes,13,opt,name=admin_last_name,json=adminLastName,proto3" json:"admin_last_name,omitempty"`           // 管理者姓（必須）
+	AdminDepartment *string `protobuf:"bytes,14,opt,name=admin_department,json=adminDepartment,proto3,oneof" json:"admin_department,omitempty"` // 管理者部署（オプション）
+	AdminPosition   *string `protobuf:"bytes,15,opt,name=admin_position,json=adminPosition,proto3,oneof" json:"admin_position,omitempty"`       // 管理者役職（オプション）
+	// ボット対策
+	ChallengeToken *string `protobuf:"bytes,20,opt,name=challenge_token,json=challengeToken,proto3,oneof" json:"challenge_token,omitempty"` // チャレンジトークン（CAPTCHA等、チャレンジ検証が有効な場合は必須）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SignupClientRequest) Reset() {
+	*x = SignupClientRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignupClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignupClientRequest) ProtoMessage() {}
+
+func (x *SignupClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignupClientRequest.ProtoReflect.Descriptor instead.
+func (*SignupClientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignupClientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetCompanyCode() string {
+	if x != nil && x.CompanyCode != nil {
+		return *x.CompanyCode
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *SignupClientRequest) GetESignMode() string {
+	if x != nil && x.ESignMode != nil {
+		return *x.ESignMode
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetRetentionDefaultMonths() int32 {
+	if x != nil && x.RetentionDefaultMonths != nil {
+		return *x.RetentionDefaultMonths
+	}
+	return 0
+}
+
+func (x *SignupClientRequest) GetSettings() string {
+	if x != nil && x.Settings != nil {
+		return *x.Settings
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetSignatureMode() ESignMode {
+	if x != nil {
+		return x.SignatureMode
+	}
+	return ESignMode_E_SIGN_MODE_UNSPECIFIED
+}
+
+func (x *SignupClientRequest) GetAdminEmail() string {
+	if x != nil {
+		return x.AdminEmail
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetAdminPassword() string {
+	if x != nil {
+		return x.AdminPassword
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetAdminFirstName() string {
+	if x != nil {
+		return x.AdminFirstName
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetAdminLastName() string {
+	if x != nil {
+		return x.AdminLastName
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetAdminDepartment() string {
+	if x != nil && x.AdminDepartment != nil {
+		return *x.AdminDepartment
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetAdminPosition() string {
+	if x != nil && x.AdminPosition != nil {
+		return *x.AdminPosition
+	}
+	return ""
+}
+
+func (x *SignupClientRequest) GetChallengeToken() string {
+	if x != nil && x.ChallengeToken != nil {
+		return *x.ChallengeToken
+	}
+	return ""
+}
+
+// SignupClientResponse サービス利用開始時のアカウント登録レスポンス
+type SignupClientResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClientId    string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`            // クライアントID（UUID）
+	ClientName  string                 `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`      // クライアント名
+	AdminUserId string                 `protobuf:"bytes,3,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"` // 管理者ユーザーID（UUID）
+	AdminEmail  string                 `protobuf:"bytes,4,opt,name=admin_email,json=adminEmail,proto3" json:"admin_email,omitempty"`      // 管理者メールアドレス
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Status        string       `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                      // クライアントステータス（非推奨: stateを使用）
+	State         ClientStatus `protobuf:"varint,6,opt,name=state,proto3,enum=contractpro.auth.v1.ClientStatus" json:"state,omitempty"` // クライアントステータス（登録確認が完了するまでPENDING_VERIFICATION）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignupClientResponse) Reset() {
+	*x = SignupClientResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignupClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignupClientResponse) ProtoMessage() {}
+
+func (x *SignupClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignupClientResponse.ProtoReflect.Descriptor instead.
+func (*SignupClientResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignupClientResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SignupClientResponse) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *SignupClientResponse) GetAdminUserId() string {
+	if x != nil {
+		return x.AdminUserId
+	}
+	return ""
+}
+
+func (x *SignupClientResponse) GetAdminEmail() string {
+	if x != nil {
+		return x.AdminEmail
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *SignupClientResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SignupClientResponse) GetState() ClientStatus {
+	if x != nil {
+		return x.State
+	}
+	return ClientStatus_CLIENT_STATUS_UNSPECIFIED
+}
+
+// VerifySignupRequest 登録確認リクエスト
+type VerifySignupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // 登録確認トークン（必須、確認メールのリンクに含まれる値）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySignupRequest) Reset() {
+	*x = VerifySignupRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySignupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignupRequest) ProtoMessage() {}
+
+func (x *VerifySignupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignupRequest.ProtoReflect.Descriptor instead.
+func (*VerifySignupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VerifySignupRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// VerifySignupResponse 登録確認レスポンス
+type VerifySignupResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClientId    string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`            // クライアントID（UUID）
+	AdminUserId string                 `protobuf:"bytes,2,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"` // 管理者ユーザーID（UUID）
+	AdminEmail  string                 `protobuf:"bytes,3,opt,name=admin_email,json=adminEmail,proto3" json:"admin_email,omitempty"`      // 管理者メールアドレス
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Status        string       `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                      // クライアントステータス（非推奨: stateを使用）
+	State         ClientStatus `protobuf:"varint,5,opt,name=state,proto3,enum=contractpro.auth.v1.ClientStatus" json:"state,omitempty"` // クライアントステータス（ACTIVE）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySignupResponse) Reset() {
+	*x = VerifySignupResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySignupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignupResponse) ProtoMessage() {}
+
+func (x *VerifySignupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignupResponse.ProtoReflect.Descriptor instead.
+func (*VerifySignupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifySignupResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *VerifySignupResponse) GetAdminUserId() string {
+	if x != nil {
+		return x.AdminUserId
+	}
+	return ""
+}
+
+func (x *VerifySignupResponse) GetAdminEmail() string {
+	if x != nil {
+		return x.AdminEmail
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *VerifySignupResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VerifySignupResponse) GetState() ClientStatus {
+	if x != nil {
+		return x.State
+	}
+	return ClientStatus_CLIENT_STATUS_UNSPECIFIED
+}
+
+// UpdateMeRequest 自分のプロフィール更新リクエスト
+type UpdateMeRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FirstName  *string                `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"` // 名
+	LastName   *string                `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`    // 姓
+	Department *string                `protobuf:"bytes,3,opt,name=department,proto3,oneof" json:"department,omitempty"`                // 部署
+	Position   *string                `protobuf:"bytes,4,opt,name=position,proto3,oneof" json:"position,omitempty"`                    // 役職
+	Settings   *string                `protobuf:"bytes,5,opt,name=settings,proto3,oneof" json:"settings,omitempty"`                    // 設定（JSON文字列）
+	// 更新するフィールド（推奨、UpdateClientUserRequest.update_maskと同じ、email・statusは指定不可）
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Etag          string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"` // 取得時のClientUser.etag（省略可、一致しない場合はFAILED_PRECONDITION）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMeRequest) Reset() {
+	*x = UpdateMeRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeRequest) ProtoMessage() {}
+
+func (x *UpdateMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateMeRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateMeRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateMeRequest) GetDepartment() string {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return ""
+}
+
+func (x *UpdateMeRequest) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+func (x *UpdateMeRequest) GetSettings() string {
+	if x != nil && x.Settings != nil {
+		return *x.Settings
+	}
+	return ""
+}
+
+func (x *UpdateMeRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateMeRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// UpdateMeResponse 自分のプロフィール更新レスポンス
+type UpdateMeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ClientUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMeResponse) Reset() {
+	*x = UpdateMeResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMeResponse) ProtoMessage() {}
+
+func (x *UpdateMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateMeResponse) GetUser() *ClientUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ChangeMyPasswordRequest 自分のパスワード変更リクエスト
+type ChangeMyPasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"` // 現在のパスワード（必須）
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`             // 新しいパスワード（必須、パスワードポリシーを満たし、現在のパスワードと異なること）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeMyPasswordRequest) Reset() {
+	*x = ChangeMyPasswordRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeMyPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMyPasswordRequest) ProtoMessage() {}
+
+func (x *ChangeMyPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMyPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangeMyPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChangeMyPasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangeMyPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// ChangeMyPasswordResponse 自分のパスワード変更レスポンス
+type ChangeMyPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeMyPasswordResponse) Reset() {
+	*x = ChangeMyPasswordResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeMyPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMyPasswordResponse) ProtoMessage() {}
+
+func (x *ChangeMyPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMyPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangeMyPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+// ChangeMyEmailRequest 自分のメールアドレス変更申請リクエスト
+type ChangeMyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"` // 変更後のメールアドレス（必須）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeMyEmailRequest) Reset() {
+	*x = ChangeMyEmailRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeMyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMyEmailRequest) ProtoMessage() {}
+
+func (x *ChangeMyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMyEmailRequest.ProtoReflect.Descriptor instead.
+func (*ChangeMyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChangeMyEmailRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+// ChangeMyEmailResponse 自分のメールアドレス変更申請レスポンス
+type ChangeMyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PendingEmail  string                 `protobuf:"bytes,1,opt,name=pending_email,json=pendingEmail,proto3" json:"pending_email,omitempty"` // 確認待ちのメールアドレス（確認されるまで変更は反映されない）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeMyEmailResponse) Reset() {
+	*x = ChangeMyEmailResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeMyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMyEmailResponse) ProtoMessage() {}
+
+func (x *ChangeMyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMyEmailResponse.ProtoReflect.Descriptor instead.
+func (*ChangeMyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChangeMyEmailResponse) GetPendingEmail() string {
+	if x != nil {
+		return x.PendingEmail
+	}
+	return ""
+}
+
+// ConfirmMyEmailChangeRequest メールアドレス変更確認リクエスト
+type ConfirmMyEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // 確認トークン（必須、確認メールのリンクに含まれる値）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMyEmailChangeRequest) Reset() {
+	*x = ConfirmMyEmailChangeRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMyEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMyEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmMyEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMyEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmMyEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmMyEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// ConfirmMyEmailChangeResponse メールアドレス変更確認レスポンス
+type ConfirmMyEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ClientUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMyEmailChangeResponse) Reset() {
+	*x = ConfirmMyEmailChangeResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMyEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMyEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmMyEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMyEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmMyEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmMyEmailChangeResponse) GetUser() *ClientUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ListClientUsersRequest クライアントユーザー一覧取得リクエスト
+type ListClientUsersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Limit     int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                         // 取得件数（デフォルト: 50、最大: 100）
+	Offset    int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                       // オフセット（非推奨: page_tokenを使用、page_token指定時は無視）
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // 前のレスポンスのnext_page_token（未指定の場合は先頭から、検索条件・並び順は前のリクエストと同じにすること）
+	Query     string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`                          // 氏名・メールアドレスの部分一致検索
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Status        string     `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                     // ステータスで絞り込み（非推奨: stateを使用）
+	Department    string     `protobuf:"bytes,6,opt,name=department,proto3" json:"department,omitempty"`                             // 部署で絞り込み（完全一致）
+	Position      string     `protobuf:"bytes,7,opt,name=position,proto3" json:"position,omitempty"`                                 // 役職で絞り込み（完全一致）
+	RoleCode      string     `protobuf:"bytes,8,opt,name=role_code,json=roleCode,proto3" json:"role_code,omitempty"`                 // 割り当て済みロールのコードで絞り込み
+	OrderBy       string     `protobuf:"bytes,9,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                    // 並び順: name, created_at, last_active_at（" desc"で降順、デフォルト: "created_at desc"）
+	State         UserStatus `protobuf:"varint,10,opt,name=state,proto3,enum=contractpro.auth.v1.UserStatus" json:"state,omitempty"` // ステータスで絞り込み（statusと両方指定する場合は同じ値）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientUsersRequest) Reset() {
+	*x = ListClientUsersRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientUsersRequest) ProtoMessage() {}
+
+func (x *ListClientUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListClientUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListClientUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListClientUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListClientUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *ListClientUsersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetRoleCode() string {
+	if x != nil {
+		return x.RoleCode
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetState() UserStatus {
+	if x != nil {
+		return x.State
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+// ListClientUsersResponse クライアントユーザー一覧取得レスポンス
+type ListClientUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*ClientUser          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`                                        // ユーザー一覧（order_byの順）
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                       // 総件数
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次のページのトークン（最後のページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientUsersResponse) Reset() {
+	*x = ListClientUsersResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientUsersResponse) ProtoMessage() {}
+
+func (x *ListClientUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListClientUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListClientUsersResponse) GetUsers() []*ClientUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListClientUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListClientUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetClientUserRequest クライアントユーザー詳細取得リクエスト
+type GetClientUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientUserId  string                 `protobuf:"bytes,1,opt,name=client_user_id,json=clientUserId,proto3" json:"client_user_id,omitempty"` // クライアントユーザーID（UUID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientUserRequest) Reset() {
+	*x = GetClientUserRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientUserRequest) ProtoMessage() {}
+
+func (x *GetClientUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientUserRequest.ProtoReflect.Descriptor instead.
+func (*GetClientUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetClientUserRequest) GetClientUserId() string {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return ""
+}
+
+// GetClientUserResponse クライアントユーザー詳細取得レスポンス
+type GetClientUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ClientUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientUserResponse) Reset() {
+	*x = GetClientUserResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientUserResponse) ProtoMessage() {}
+
+func (x *GetClientUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientUserResponse.ProtoReflect.Descriptor instead.
+func (*GetClientUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetClientUserResponse) GetUser() *ClientUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// CreateClientUserRequest クライアントユーザー作成リクエスト
+type CreateClientUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`                          // メールアドレス（必須）
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                    // パスワード（必須、パスワードポリシーを満たすこと。違反時はBadRequestの詳細を返す）
+	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"` // 名（必須）
+	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`    // 姓（必須）
+	Department    *string                `protobuf:"bytes,5,opt,name=department,proto3,oneof" json:"department,omitempty"`          // 部署（オプション）
+	Position      *string                `protobuf:"bytes,6,opt,name=position,proto3,oneof" json:"position,omitempty"`              // 役職（オプション）
+	Settings      *string                `protobuf:"bytes,7,opt,name=settings,proto3,oneof" json:"settings,omitempty"`              // 設定（JSON文字列、オプション、デフォルト: {}）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClientUserRequest) Reset() {
+	*x = CreateClientUserRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientUserRequest) ProtoMessage() {}
+
+func (x *CreateClientUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateClientUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateClientUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateClientUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateClientUserRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateClientUserRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateClientUserRequest) GetDepartment() string {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return ""
+}
+
+func (x *CreateClientUserRequest) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+func (x *CreateClientUserRequest) GetSettings() string {
+	if x != nil && x.Settings != nil {
+		return *x.Settings
+	}
+	return ""
+}
+
+// CreateClientUserResponse クライアントユーザー作成レスポンス
+type CreateClientUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ClientUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClientUserResponse) Reset() {
+	*x = CreateClientUserResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientUserResponse) ProtoMessage() {}
+
+func (x *CreateClientUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateClientUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateClientUserResponse) GetUser() *ClientUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// UpdateClientUserRequest クライアントユーザー更新リクエスト
+type UpdateClientUserRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClientUserId string                 `protobuf:"bytes,1,opt,name=client_user_id,json=clientUserId,proto3" json:"client_user_id,omitempty"` // クライアントユーザーID（UUID、必須）
+	Email        *string                `protobuf:"bytes,2,opt,name=email,proto3,oneof" json:"email,omitempty"`                               // メールアドレス（オプション）
+	FirstName    *string                `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`      // 名（オプション）
+	LastName     *string                `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`         // 姓（オプション）
+	Department   *string                `protobuf:"bytes,5,opt,name=department,proto3,oneof" json:"department,omitempty"`                     // 部署（オプション）
+	Position     *string                `protobuf:"bytes,6,opt,name=position,proto3,oneof" json:"position,omitempty"`                         // 役職（オプション）
+	Settings     *string                `protobuf:"bytes,7,opt,name=settings,proto3,oneof" json:"settings,omitempty"`                         // 設定（JSON文字列、オプション）
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Status *string `protobuf:"bytes,8,opt,name=status,proto3,oneof" json:"status,omitempty"` // ステータス（非推奨: stateを使用）
+	// 更新するフィールド（推奨）。指定した場合はマスクに含まれるフィールドのみを更新し、
+	// 値が未指定・空文字のdepartment/positionは削除、settingsはJSON Merge Patch（RFC 7386）として適用する
+	// client_id・created_at等の更新できないフィールドや不明なフィールドはINVALID_ARGUMENT
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,9,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// 取得時のClientUser.etag（必須）。他の更新により一致しない場合はFAILED_PRECONDITION、
+	// 更新中に競合した場合はABORTEDとなるため、再取得してからやり直す
+	Etag          string      `protobuf:"bytes,10,opt,name=etag,proto3" json:"etag,omitempty"`
+	State         *UserStatus `protobuf:"varint,11,opt,name=state,proto3,enum=contractpro.auth.v1.UserStatus,oneof" json:"state,omitempty"` // ステータス（オプション、update_maskのパスは"status"、statusと両方指定する場合は同じ値）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClientUserRequest) Reset() {
+	*x = UpdateClientUserRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClientUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClientUserRequest) ProtoMessage() {}
+
+func (x *UpdateClientUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClientUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClientUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateClientUserRequest) GetClientUserId() string {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return ""
+}
+
+func (x *UpdateClientUserRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateClientUserRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateClientUserRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateClientUserRequest) GetDepartment() string {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return ""
+}
+
+func (x *UpdateClientUserRequest) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+func (x *UpdateClientUserRequest) GetSettings() string {
+	if x != nil && x.Settings != nil {
+		return *x.Settings
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *UpdateClientUserRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateClientUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateClientUserRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateClientUserRequest) GetState() UserStatus {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+// UpdateClientUserResponse クライアントユーザー更新レスポンス
+type UpdateClientUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ClientUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClientUserResponse) Reset() {
+	*x = UpdateClientUserResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClientUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClientUserResponse) ProtoMessage() {}
+
+func (x *UpdateClientUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClientUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClientUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateClientUserResponse) GetUser() *ClientUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// DeleteClientUserRequest クライアントユーザー削除リクエスト
+type DeleteClientUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientUserId  string                 `protobuf:"bytes,1,opt,name=client_user_id,json=clientUserId,proto3" json:"client_user_id,omitempty"` // クライアントユーザーID（UUID）
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`                                       // 取得時のClientUser.etag（必須、UpdateClientUserRequest.etagと同じ）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClientUserRequest) Reset() {
+	*x = DeleteClientUserRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClientUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClientUserRequest) ProtoMessage() {}
+
+func (x *DeleteClientUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClientUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClientUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteClientUserRequest) GetClientUserId() string {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return ""
+}
+
+func (x *DeleteClientUserRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// DeleteClientUserResponse クライアントユーザー削除レスポンス
+type DeleteClientUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClientUserResponse) Reset() {
+	*x = DeleteClientUserResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClientUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClientUserResponse) ProtoMessage() {}
+
+func (x *DeleteClientUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClientUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteClientUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{23}
+}
+
+// ExportClientUsersRequest クライアントユーザーエクスポートリクエスト（検索条件はListClientUsersRequestと同じ）
+type ExportClientUsersRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // 出力形式: csv（デフォルト）, xlsx
+	Query  string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`   // 氏名・メールアドレスの部分一致検索
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Status        string     `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                    // ステータスで絞り込み（非推奨: stateを使用）
+	Department    string     `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`                            // 部署で絞り込み（完全一致）
+	Position      string     `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`                                // 役職で絞り込み（完全一致）
+	RoleCode      string     `protobuf:"bytes,6,opt,name=role_code,json=roleCode,proto3" json:"role_code,omitempty"`                // 割り当て済みロールのコードで絞り込み
+	OrderBy       string     `protobuf:"bytes,7,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                   // 並び順: name, created_at, last_active_at（" desc"で降順、デフォルト: "created_at desc"）
+	State         UserStatus `protobuf:"varint,8,opt,name=state,proto3,enum=contractpro.auth.v1.UserStatus" json:"state,omitempty"` // ステータスで絞り込み（statusと両方指定する場合は同じ値）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportClientUsersRequest) Reset() {
+	*x = ExportClientUsersRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportClientUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportClientUsersRequest) ProtoMessage() {}
+
+func (x *ExportClientUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportClientUsersRequest.ProtoReflect.Descriptor instead.
+func (*ExportClientUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportClientUsersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportClientUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *ExportClientUsersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportClientUsersRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *ExportClientUsersRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ExportClientUsersRequest) GetRoleCode() string {
+	if x != nil {
+		return x.RoleCode
+	}
+	return ""
+}
+
+func (x *ExportClientUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ExportClientUsersRequest) GetState() UserStatus {
+	if x != nil {
+		return x.State
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+// ExportClientUsersResponse クライアントユーザーエクスポートレスポンス（chunkを受信順に連結するとファイルになる）
+type ExportClientUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`                                // ファイルの一部
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIMEタイプ（最初のメッセージのみ）
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`                          // ファイル名（最初のメッセージのみ）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportClientUsersResponse) Reset() {
+	*x = ExportClientUsersResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportClientUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportClientUsersResponse) ProtoMessage() {}
+
+func (x *ExportClientUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportClientUsersResponse.ProtoReflect.Descriptor instead.
+func (*ExportClientUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportClientUsersResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportClientUsersResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportClientUsersResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// LogoutRequest ログアウトリクエスト
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{26}
+}
+
+// LogoutResponse ログアウトレスポンス
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{27}
+}
+
+// ForceLogoutRequest 強制ログアウトリクエスト
+type ForceLogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientUserId  string                 `protobuf:"bytes,1,opt,name=client_user_id,json=clientUserId,proto3" json:"client_user_id,omitempty"` // クライアントユーザーID（UUID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutRequest) Reset() {
+	*x = ForceLogoutRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutRequest) ProtoMessage() {}
+
+func (x *ForceLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutRequest.ProtoReflect.Descriptor instead.
+func (*ForceLogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ForceLogoutRequest) GetClientUserId() string {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return ""
+}
+
+// ForceLogoutResponse 強制ログアウトレスポンス
+type ForceLogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutResponse) Reset() {
+	*x = ForceLogoutResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutResponse) ProtoMessage() {}
+
+func (x *ForceLogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutResponse.ProtoReflect.Descriptor instead.
+func (*ForceLogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{29}
+}
+
+// ForceLogoutTenantRequest クライアント全体の強制ログアウトリクエスト
+type ForceLogoutTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutTenantRequest) Reset() {
+	*x = ForceLogoutTenantRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutTenantRequest) ProtoMessage() {}
+
+func (x *ForceLogoutTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutTenantRequest.ProtoReflect.Descriptor instead.
+func (*ForceLogoutTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{30}
+}
+
+// ForceLogoutTenantResponse クライアント全体の強制ログアウトレスポンス
+type ForceLogoutTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutTenantResponse) Reset() {
+	*x = ForceLogoutTenantResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutTenantResponse) ProtoMessage() {}
+
+func (x *ForceLogoutTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutTenantResponse.ProtoReflect.Descriptor instead.
+func (*ForceLogoutTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{31}
+}
+
+// CreateScimTokenRequest SCIMトークン発行リクエスト
+type CreateScimTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   *string                `protobuf:"bytes,1,opt,name=description,proto3,oneof" json:"description,omitempty"`              // 説明（IdP名など）
+	ExpiresAt     *string                `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // 有効期限（ISO 8601、省略時は無期限）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScimTokenRequest) Reset() {
+	*x = CreateScimTokenRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScimTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScimTokenRequest) ProtoMessage() {}
+
+func (x *CreateScimTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScimTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateScimTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateScimTokenRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateScimTokenRequest) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+// CreateScimTokenResponse SCIMトークン発行レスポンス
+type CreateScimTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenId       string                 `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                  // トークンID（UUID）
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                                     // トークン（発行時のみ返却、再取得不可）
+	TokenPrefix   string                 `protobuf:"bytes,3,opt,name=token_prefix,json=tokenPrefix,proto3" json:"token_prefix,omitempty"`      // トークンの先頭部分（識別用）
+	ExpiresAt     *string                `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`      // 有効期限（ISO 8601）
+	ScimBasePath  string                 `protobuf:"bytes,5,opt,name=scim_base_path,json=scimBasePath,proto3" json:"scim_base_path,omitempty"` // SCIMエンドポイントのパス
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScimTokenResponse) Reset() {
+	*x = CreateScimTokenResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScimTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScimTokenResponse) ProtoMessage() {}
+
+func (x *CreateScimTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScimTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateScimTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateScimTokenResponse) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *CreateScimTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateScimTokenResponse) GetTokenPrefix() string {
+	if x != nil {
+		return x.TokenPrefix
+	}
+	return ""
+}
+
+func (x *CreateScimTokenResponse) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *CreateScimTokenResponse) GetScimBasePath() string {
+	if x != nil {
+		return x.ScimBasePath
+	}
+	return ""
+}
+
+// RevokeScimTokenRequest SCIMトークン取り消しリクエスト
+type RevokeScimTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenId       string                 `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"` // トークンID（UUID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeScimTokenRequest) Reset() {
+	*x = RevokeScimTokenRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeScimTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeScimTokenRequest) ProtoMessage() {}
+
+func (x *RevokeScimTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeScimTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeScimTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevokeScimTokenRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// RevokeScimTokenResponse SCIMトークン取り消しレスポンス
+type RevokeScimTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeScimTokenResponse) Reset() {
+	*x = RevokeScimTokenResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeScimTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeScimTokenResponse) ProtoMessage() {}
+
+func (x *RevokeScimTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeScimTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeScimTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{35}
+}
+
+// ListServiceAccountsRequest サービスアカウント一覧取得リクエスト
+type ListServiceAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsRequest) Reset() {
+	*x = ListServiceAccountsRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{36}
+}
+
+// ListServiceAccountsResponse サービスアカウント一覧取得レスポンス
+type ListServiceAccountsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccounts []*ServiceAccount      `protobuf:"bytes,1,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"` // サービスアカウント一覧
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsResponse) Reset() {
+	*x = ListServiceAccountsResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListServiceAccountsResponse) GetServiceAccounts() []*ServiceAccount {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+// CreateServiceAccountRequest サービスアカウント作成リクエスト
+type CreateServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                     // 名前（必須、クライアント内で一意）
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"` // 説明（連携先システム名など）
+	RoleId        string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`   // ロールID（必須、UUID、client_rolesのロール）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+// CreateServiceAccountResponse サービスアカウント作成レスポンス
+type CreateServiceAccountResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"` // 作成されたサービスアカウント
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountResponse) Reset() {
+	*x = CreateServiceAccountResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+// DeleteServiceAccountRequest サービスアカウント削除リクエスト
+type DeleteServiceAccountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccountId string                 `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"` // サービスアカウントID（UUID）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountRequest) Reset() {
+	*x = DeleteServiceAccountRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountRequest) ProtoMessage() {}
+
+func (x *DeleteServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteServiceAccountRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+// DeleteServiceAccountResponse サービスアカウント削除レスポンス
+type DeleteServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountResponse) Reset() {
+	*x = DeleteServiceAccountResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountResponse) ProtoMessage() {}
+
+func (x *DeleteServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{41}
+}
+
+// ListApiKeysRequest APIキー一覧取得リクエスト
+type ListApiKeysRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccountId string                 `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"` // サービスアカウントID（UUID）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListApiKeysRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+// ListApiKeysResponse APIキー一覧取得レスポンス
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"` // APIキー一覧（取り消し済みを含む）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+// CreateApiKeyRequest APIキー発行リクエスト
+type CreateApiKeyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccountId string                 `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"` // サービスアカウントID（UUID）
+	ExpiresAt        *string                `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`                  // 有効期限（ISO 8601、省略時は無期限）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateApiKeyRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+// CreateApiKeyResponse APIキー発行レスポンス
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // 発行されたAPIキーの情報
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`                     // APIキー（発行時のみ返却、再取得不可）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// RotateApiKeyRequest APIキーのローテーションリクエスト
+type RotateApiKeyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId           string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`                                      // ローテーション対象のAPIキーID（UUID）
+	GracePeriodSeconds *int32                 `protobuf:"varint,2,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3,oneof" json:"grace_period_seconds,omitempty"` // 旧キーの猶予期間（秒、省略時は0: 即時取り消し、最大7日）
+	ExpiresAt          *string                `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`                               // 新キーの有効期限（ISO 8601、省略時は無期限）
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateApiKeyRequest) Reset() {
+	*x = RotateApiKeyRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyRequest) ProtoMessage() {}
+
+func (x *RotateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RotateApiKeyRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *RotateApiKeyRequest) GetGracePeriodSeconds() int32 {
+	if x != nil && x.GracePeriodSeconds != nil {
+		return *x.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *RotateApiKeyRequest) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+// RotateApiKeyResponse APIキーのローテーションレスポンス
+type RotateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // 新しいAPIキーの情報
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`                     // 新しいAPIキー（発行時のみ返却、再取得不可）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateApiKeyResponse) Reset() {
+	*x = RotateApiKeyResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiKeyResponse) ProtoMessage() {}
+
+func (x *RotateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RotateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *RotateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// RevokeApiKeyRequest APIキー取り消しリクエスト
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"` // APIキーID（UUID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RevokeApiKeyRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+// RevokeApiKeyResponse APIキー取り消しレスポンス
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{49}
+}
+
+// ListIpAllowlistEntriesRequest 許可リスト取得リクエスト
+type ListIpAllowlistEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIpAllowlistEntriesRequest) Reset() {
+	*x = ListIpAllowlistEntriesRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIpAllowlistEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIpAllowlistEntriesRequest) ProtoMessage() {}
+
+func (x *ListIpAllowlistEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIpAllowlistEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListIpAllowlistEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{50}
+}
+
+// ListIpAllowlistEntriesResponse 許可リスト取得レスポンス
+type ListIpAllowlistEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*IpAllowlistEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // 許可リストのエントリ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIpAllowlistEntriesResponse) Reset() {
+	*x = ListIpAllowlistEntriesResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIpAllowlistEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIpAllowlistEntriesResponse) ProtoMessage() {}
+
+func (x *ListIpAllowlistEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIpAllowlistEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListIpAllowlistEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListIpAllowlistEntriesResponse) GetEntries() []*IpAllowlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// AddIpAllowlistEntryRequest 許可リスト追加リクエスト
+type AddIpAllowlistEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidr          string                 `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`                     // アドレス範囲（CIDR表記、単一アドレスも可。例: 203.0.113.0/24, 2001:db8::1）
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"` // 説明（例: 本社VPN）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddIpAllowlistEntryRequest) Reset() {
+	*x = AddIpAllowlistEntryRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddIpAllowlistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddIpAllowlistEntryRequest) ProtoMessage() {}
+
+func (x *AddIpAllowlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddIpAllowlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddIpAllowlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AddIpAllowlistEntryRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *AddIpAllowlistEntryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// AddIpAllowlistEntryResponse 許可リスト追加レスポンス
+type AddIpAllowlistEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *IpAllowlistEntry      `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"` // 追加したエントリ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddIpAllowlistEntryResponse) Reset() {
+	*x = AddIpAllowlistEntryResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddIpAllowlistEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddIpAllowlistEntryResponse) ProtoMessage() {}
+
+func (x *AddIpAllowlistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddIpAllowlistEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddIpAllowlistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddIpAllowlistEntryResponse) GetEntry() *IpAllowlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// RemoveIpAllowlistEntryRequest 許可リスト削除リクエスト
+type RemoveIpAllowlistEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"` // エントリID（UUID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveIpAllowlistEntryRequest) Reset() {
+	*x = RemoveIpAllowlistEntryRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveIpAllowlistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveIpAllowlistEntryRequest) ProtoMessage() {}
+
+func (x *RemoveIpAllowlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveIpAllowlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveIpAllowlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RemoveIpAllowlistEntryRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+// RemoveIpAllowlistEntryResponse 許可リスト削除レスポンス
+type RemoveIpAllowlistEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveIpAllowlistEntryResponse) Reset() {
+	*x = RemoveIpAllowlistEntryResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveIpAllowlistEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveIpAllowlistEntryResponse) ProtoMessage() {}
+
+func (x *RemoveIpAllowlistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveIpAllowlistEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveIpAllowlistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{55}
+}
+
+// ListIdentityProvidersRequest IdP設定一覧取得リクエスト
+type ListIdentityProvidersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentityProvidersRequest) Reset() {
+	*x = ListIdentityProvidersRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentityProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentityProvidersRequest) ProtoMessage() {}
+
+func (x *ListIdentityProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentityProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentityProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{56}
+}
+
+// ListIdentityProvidersResponse IdP設定一覧取得レスポンス
+type ListIdentityProvidersResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IdentityProviders []*IdentityProvider    `protobuf:"bytes,1,rep,name=identity_providers,json=identityProviders,proto3" json:"identity_providers,omitempty"` // IdP設定（登録順）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListIdentityProvidersResponse) Reset() {
+	*x = ListIdentityProvidersResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentityProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentityProvidersResponse) ProtoMessage() {}
+
+func (x *ListIdentityProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentityProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentityProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListIdentityProvidersResponse) GetIdentityProviders() []*IdentityProvider {
+	if x != nil {
+		return x.IdentityProviders
+	}
+	return nil
+}
+
+// CreateIdentityProviderRequest IdP設定登録リクエスト
+type CreateIdentityProviderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                      // 表示名（必須）
+	Issuer          string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`                                                  // JWTのiss（必須、https、全クライアントで一意）
+	Audience        string                 `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`                                              // JWTのaud（必須）
+	JwksUri         *string                `protobuf:"bytes,4,opt,name=jwks_uri,json=jwksUri,proto3,oneof" json:"jwks_uri,omitempty"`                           // JWKSのURL（オプション、https、未指定の場合はissuerのOpenID Connect Discoveryから取得）
+	JitProvisioning bool                   `protobuf:"varint,5,opt,name=jit_provisioning,json=jitProvisioning,proto3" json:"jit_provisioning,omitempty"`        // 未紐付けのユーザーを初回ログイン時に作成するか
+	DefaultRoleCode *string                `protobuf:"bytes,6,opt,name=default_role_code,json=defaultRoleCode,proto3,oneof" json:"default_role_code,omitempty"` // JITプロビジョニングで割り当てるロールのコード（オプション、デフォルト: member）
+	Status          *string                `protobuf:"bytes,7,opt,name=status,proto3,oneof" json:"status,omitempty"`                                            // ステータス（オプション、デフォルト: ACTIVE）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateIdentityProviderRequest) Reset() {
+	*x = CreateIdentityProviderRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIdentityProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIdentityProviderRequest) ProtoMessage() {}
+
+func (x *CreateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIdentityProviderRequest.ProtoReflect.Descriptor instead.
+func (*CreateIdentityProviderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateIdentityProviderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateIdentityProviderRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *CreateIdentityProviderRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *CreateIdentityProviderRequest) GetJwksUri() string {
+	if x != nil && x.JwksUri != nil {
+		return *x.JwksUri
+	}
+	return ""
+}
+
+func (x *CreateIdentityProviderRequest) GetJitProvisioning() bool {
+	if x != nil {
+		return x.JitProvisioning
+	}
+	return false
+}
+
+func (x *CreateIdentityProviderRequest) GetDefaultRoleCode() string {
+	if x != nil && x.DefaultRoleCode != nil {
+		return *x.DefaultRoleCode
+	}
+	return ""
+}
+
+func (x *CreateIdentityProviderRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// CreateIdentityProviderResponse IdP設定登録レスポンス
+type CreateIdentityProviderResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IdentityProvider *IdentityProvider      `protobuf:"bytes,1,opt,name=identity_provider,json=identityProvider,proto3" json:"identity_provider,omitempty"` // 登録したIdP設定
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateIdentityProviderResponse) Reset() {
+	*x = CreateIdentityProviderResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIdentityProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIdentityProviderResponse) ProtoMessage() {}
+
+func (x *CreateIdentityProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIdentityProviderResponse.ProtoReflect.Descriptor instead.
+func (*CreateIdentityProviderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateIdentityProviderResponse) GetIdentityProvider() *IdentityProvider {
+	if x != nil {
+		return x.IdentityProvider
+	}
+	return nil
+}
+
+// UpdateIdentityProviderRequest IdP設定更新リクエスト（指定したフィールドのみ更新）
+type UpdateIdentityProviderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProviderId      string                 `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`                        // IdP設定ID（UUID）
+	Name            *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                                                // 表示名
+	Audience        *string                `protobuf:"bytes,3,opt,name=audience,proto3,oneof" json:"audience,omitempty"`                                        // JWTのaud（空文字は不可）
+	JwksUri         *string                `protobuf:"bytes,4,opt,name=jwks_uri,json=jwksUri,proto3,oneof" json:"jwks_uri,omitempty"`                           // JWKSのURL（空文字の場合はOpenID Connect Discoveryから取得）
+	JitProvisioning *bool                  `protobuf:"varint,5,opt,name=jit_provisioning,json=jitProvisioning,proto3,oneof" json:"jit_provisioning,omitempty"`  // 未紐付けのユーザーを初回ログイン時に作成するか
+	DefaultRoleCode *string                `protobuf:"bytes,6,opt,name=default_role_code,json=defaultRoleCode,proto3,oneof" json:"default_role_code,omitempty"` // JITプロビジョニングで割り当てるロールのコード
+	Status          *string                `protobuf:"bytes,7,opt,name=status,proto3,oneof" json:"status,omitempty"`                                            // ステータス（INACTIVEの場合はこのIdPのJWTで認証しない）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateIdentityProviderRequest) Reset() {
+	*x = UpdateIdentityProviderRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIdentityProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIdentityProviderRequest) ProtoMessage() {}
+
+func (x *UpdateIdentityProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIdentityProviderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIdentityProviderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateIdentityProviderRequest) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *UpdateIdentityProviderRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateIdentityProviderRequest) GetAudience() string {
+	if x != nil && x.Audience != nil {
+		return *x.Audience
+	}
+	return ""
+}
+
+func (x *UpdateIdentityProviderRequest) GetJwksUri() string {
+	if x != nil && x.JwksUri != nil {
+		return *x.JwksUri
+	}
+	return ""
+}
+
+func (x *UpdateIdentityProviderRequest) GetJitProvisioning() bool {
+	if x != nil && x.JitProvisioning != nil {
+		return *x.JitProvisioning
+	}
+	return false
+}
+
+func (x *UpdateIdentityProviderRequest) GetDefaultRoleCode() string {
+	if x != nil && x.DefaultRoleCode != nil {
+		return *x.DefaultRoleCode
+	}
+	return ""
+}
+
+func (x *UpdateIdentityProviderRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+// UpdateIdentityProviderResponse IdP設定更新レスポンス
+type UpdateIdentityProviderResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IdentityProvider *IdentityProvider      `protobuf:"bytes,1,opt,name=identity_provider,json=identityProvider,proto3" json:"identity_provider,omitempty"` // 更新したIdP設定
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateIdentityProviderResponse) Reset() {
+	*x = UpdateIdentityProviderResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIdentityProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIdentityProviderResponse) ProtoMessage() {}
+
+func (x *UpdateIdentityProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIdentityProviderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIdentityProviderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateIdentityProviderResponse) GetIdentityProvider() *IdentityProvider {
+	if x != nil {
+		return x.IdentityProvider
+	}
+	return nil
+}
+
+// DeleteIdentityProviderRequest IdP設定削除リクエスト
+type DeleteIdentityProviderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderId    string                 `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"` // IdP設定ID（UUID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIdentityProviderRequest) Reset() {
+	*x = DeleteIdentityProviderRequest{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIdentityProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIdentityProviderRequest) ProtoMessage() {}
+
+func (x *DeleteIdentityProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIdentityProviderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIdentityProviderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteIdentityProviderRequest) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+// DeleteIdentityProviderResponse IdP設定削除レスポンス
+type DeleteIdentityProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIdentityProviderResponse) Reset() {
+	*x = DeleteIdentityProviderResponse{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIdentityProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIdentityProviderResponse) ProtoMessage() {}
+
+func (x *DeleteIdentityProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIdentityProviderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIdentityProviderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{63}
+}
+
+// ServiceAccount サービスアカウント情報
+type ServiceAccount struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccountId string                 `protobuf:"bytes,1,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"` // サービスアカウントID（UUID）
+	ClientId         string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                           // クライアントID（UUID）
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                   // 名前
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`                               // 説明
+	RoleId           string                 `protobuf:"bytes,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`                                 // ロールID（UUID）
+	Status           string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                               // ステータス（ACTIVE, INACTIVE）
+	CreatedAt        string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                        // 作成日時（ISO 8601）
+	UpdatedAt        string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                        // 更新日時（ISO 8601）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ServiceAccount) Reset() {
+	*x = ServiceAccount{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccount) ProtoMessage() {}
+
+func (x *ServiceAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccount.ProtoReflect.Descriptor instead.
+func (*ServiceAccount) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ServiceAccount) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// ApiKey APIキー情報（平文のキーは含まない）
+type ApiKey struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId         string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`                         // APIキーID（UUID）
+	ServiceAccountId string                 `protobuf:"bytes,2,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"` // サービスアカウントID（UUID）
+	KeyPrefix        string                 `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`                        // キーの先頭部分（識別用）
+	ExpiresAt        *string                `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`                  // 有効期限（ISO 8601）
+	LastUsedAt       *string                `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"`             // 最終利用日時（ISO 8601）
+	RevokedAt        *string                `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`                  // 取り消し日時（ISO 8601）
+	RotatedFrom      *string                `protobuf:"bytes,7,opt,name=rotated_from,json=rotatedFrom,proto3,oneof" json:"rotated_from,omitempty"`            // ローテーション元のAPIキーID（UUID）
+	CreatedAt        string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                        // 作成日時（ISO 8601）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ApiKey) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetLastUsedAt() string {
+	if x != nil && x.LastUsedAt != nil {
+		return *x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRevokedAt() string {
+	if x != nil && x.RevokedAt != nil {
+		return *x.RevokedAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRotatedFrom() string {
+	if x != nil && x.RotatedFrom != nil {
+		return *x.RotatedFrom
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ClientUser クライアントユーザー情報
+type ClientUser struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClientUserId string                 `protobuf:"bytes,1,opt,name=client_user_id,json=clientUserId,proto3" json:"client_user_id,omitempty"` // クライアントユーザーID（UUID）
+	ClientId     string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`               // クライアントID（UUID）
+	Email        string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                     // メールアドレス
+	FirstName    string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`            // 名
+	LastName     string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`               // 姓
+	Department   *string                `protobuf:"bytes,6,opt,name=department,proto3,oneof" json:"department,omitempty"`                     // 部署
+	Position     *string                `protobuf:"bytes,7,opt,name=position,proto3,oneof" json:"position,omitempty"`                         // 役職
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Status            string     `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                                         // ステータス（非推奨: stateを使用）
+	Settings          string     `protobuf:"bytes,9,opt,name=settings,proto3" json:"settings,omitempty"`                                                     // 設定（JSON文字列）
+	CreatedAt         string     `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                 // 作成日時（ISO 8601）
+	UpdatedAt         string     `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                 // 更新日時（ISO 8601）
+	PasswordChangedAt *string    `protobuf:"bytes,12,opt,name=password_changed_at,json=passwordChangedAt,proto3,oneof" json:"password_changed_at,omitempty"` // パスワード最終変更日時（ISO 8601）
+	Etag              string     `protobuf:"bytes,13,opt,name=etag,proto3" json:"etag,omitempty"`                                                            // バージョン（更新・削除時に指定する不透明な文字列、更新のたびに変わる）
+	State             UserStatus `protobuf:"varint,14,opt,name=state,proto3,enum=contractpro.auth.v1.UserStatus" json:"state,omitempty"`                     // ステータス
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ClientUser) Reset() {
+	*x = ClientUser{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientUser) ProtoMessage() {}
+
+func (x *ClientUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientUser.ProtoReflect.Descriptor instead.
+func (*ClientUser) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ClientUser) GetClientUserId() string {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return ""
+}
+
+func (x *ClientUser) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ClientUser) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *ClientUser) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *ClientUser) GetDepartment() string {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return ""
+}
+
+func (x *ClientUser) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *ClientUser) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ClientUser) GetSettings() string {
+	if x != nil {
+		return x.Settings
+	}
+	return ""
+}
+
+func (x *ClientUser) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ClientUser) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ClientUser) GetPasswordChangedAt() string {
+	if x != nil && x.PasswordChangedAt != nil {
+		return *x.PasswordChangedAt
+	}
+	return ""
+}
+
+func (x *ClientUser) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *ClientUser) GetState() UserStatus {
+	if x != nil {
+		return x.State
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+// Operator オペレーター情報
+type Operator struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // オペレーターID（UUID）
+	Email      string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                             // メールアドレス
+	FirstName  string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`    // 名
+	LastName   string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`       // 姓
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Status            string     `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                                        // ステータス（非推奨: stateを使用）
+	MfaEnabled        bool       `protobuf:"varint,6,opt,name=mfa_enabled,json=mfaEnabled,proto3" json:"mfa_enabled,omitempty"`                             // MFA有効
+	LastLoginAt       *string    `protobuf:"bytes,7,opt,name=last_login_at,json=lastLoginAt,proto3,oneof" json:"last_login_at,omitempty"`                   // 最終ログイン日時（ISO 8601）
+	PasswordChangedAt *string    `protobuf:"bytes,8,opt,name=password_changed_at,json=passwordChangedAt,proto3,oneof" json:"password_changed_at,omitempty"` // パスワード最終変更日時（ISO 8601）
+	CreatedAt         string     `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                 // 作成日時（ISO 8601）
+	UpdatedAt         string     `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                // 更新日時（ISO 8601）
+	State             UserStatus `protobuf:"varint,11,opt,name=state,proto3,enum=contractpro.auth.v1.UserStatus" json:"state,omitempty"`                    // ステータス
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Operator) Reset() {
+	*x = Operator{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operator) ProtoMessage() {}
+
+func (x *Operator) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operator.ProtoReflect.Descriptor instead.
+func (*Operator) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Operator) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *Operator) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Operator) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Operator) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *Operator) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Operator) GetMfaEnabled() bool {
+	if x != nil {
+		return x.MfaEnabled
+	}
+	return false
+}
+
+func (x *Operator) GetLastLoginAt() string {
+	if x != nil && x.LastLoginAt != nil {
+		return *x.LastLoginAt
+	}
+	return ""
+}
+
+func (x *Operator) GetPasswordChangedAt() string {
+	if x != nil && x.PasswordChangedAt != nil {
+		return *x.PasswordChangedAt
+	}
+	return ""
+}
+
+func (x *Operator) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Operator) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Operator) GetState() UserStatus {
+	if x != nil {
+		return x.State
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+// Tenant クライアント（テナント）の概要
+type Tenant struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ClientId string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // クライアントID（UUID）
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                         // クライアント名
+	Slug     string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`                         // スラッグ
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	ESignMode string `protobuf:"bytes,4,opt,name=e_sign_mode,json=eSignMode,proto3" json:"e_sign_mode,omitempty"` // 電子署名モード（非推奨: signature_modeを使用）
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Status        string       `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                                                        // ステータス（非推奨: stateを使用）
+	Etag          string       `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`                                                                            // バージョン（更新・削除時に指定する不透明な文字列、更新のたびに変わる）
+	State         ClientStatus `protobuf:"varint,7,opt,name=state,proto3,enum=contractpro.auth.v1.ClientStatus" json:"state,omitempty"`                                   // ステータス
+	SignatureMode ESignMode    `protobuf:"varint,8,opt,name=signature_mode,json=signatureMode,proto3,enum=contractpro.auth.v1.ESignMode" json:"signature_mode,omitempty"` // 電子署名モード
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Tenant) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Tenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tenant) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *Tenant) GetESignMode() string {
+	if x != nil {
+		return x.ESignMode
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *Tenant) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Tenant) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Tenant) GetState() ClientStatus {
+	if x != nil {
+		return x.State
+	}
+	return ClientStatus_CLIENT_STATUS_UNSPECIFIED
+}
+
+func (x *Tenant) GetSignatureMode() ESignMode {
+	if x != nil {
+		return x.SignatureMode
+	}
+	return ESignMode_E_SIGN_MODE_UNSPECIFIED
+}
+
+// Role ロールの概要
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"` // ロールID（UUID）
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                   // ロールコード
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                   // ロール名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Role) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *Role) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Permission 権限（機能と操作の組み合わせ）
+type Permission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feature       string                 `protobuf:"bytes,1,opt,name=feature,proto3" json:"feature,omitempty"` // 機能（"*"は全機能）
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`   // 操作（READ, WRITE, DELETE等、"*"は全操作）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Permission) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *Permission) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// AssignedClient オペレーターに割り当てられたクライアント
+type AssignedClient struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tenant *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // クライアント
+	// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+	Role          string       `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                                                                            // 割り当てロール（非推奨: operator_roleを使用）
+	OperatorRole  OperatorRole `protobuf:"varint,3,opt,name=operator_role,json=operatorRole,proto3,enum=contractpro.auth.v1.OperatorRole" json:"operator_role,omitempty"` // 割り当てロール
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignedClient) Reset() {
+	*x = AssignedClient{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignedClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignedClient) ProtoMessage() {}
+
+func (x *AssignedClient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignedClient.ProtoReflect.Descriptor instead.
+func (*AssignedClient) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AssignedClient) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in proto/contractpro/auth/v1/auth.proto.
+func (x *AssignedClient) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AssignedClient) GetOperatorRole() OperatorRole {
+	if x != nil {
+		return x.OperatorRole
+	}
+	return OperatorRole_OPERATOR_ROLE_UNSPECIFIED
+}
+
+// IpAllowlistEntry IPアドレス許可リストのエントリ
+type IpAllowlistEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`       // エントリID（UUID）
+	Cidr          string                 `protobuf:"bytes,2,opt,name=cidr,proto3" json:"cidr,omitempty"`                            // アドレス範囲（CIDR表記）
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`        // 説明
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 作成日時（ISO 8601）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IpAllowlistEntry) Reset() {
+	*x = IpAllowlistEntry{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IpAllowlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IpAllowlistEntry) ProtoMessage() {}
+
+func (x *IpAllowlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IpAllowlistEntry.ProtoReflect.Descriptor instead.
+func (*IpAllowlistEntry) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *IpAllowlistEntry) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *IpAllowlistEntry) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *IpAllowlistEntry) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *IpAllowlistEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// IdentityProvider 外部IdP（OIDC）設定
+type IdentityProvider struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProviderId      string                 `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`                  // IdP設定ID（UUID）
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                // 表示名
+	Issuer          string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`                                            // JWTのiss
+	Audience        string                 `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`                                        // JWTのaud
+	JwksUri         *string                `protobuf:"bytes,5,opt,name=jwks_uri,json=jwksUri,proto3,oneof" json:"jwks_uri,omitempty"`                     // JWKSのURL（未設定の場合はOpenID Connect Discoveryから取得）
+	JitProvisioning bool                   `protobuf:"varint,6,opt,name=jit_provisioning,json=jitProvisioning,proto3" json:"jit_provisioning,omitempty"`  // 未紐付けのユーザーを初回ログイン時に作成するか
+	DefaultRoleCode string                 `protobuf:"bytes,7,opt,name=default_role_code,json=defaultRoleCode,proto3" json:"default_role_code,omitempty"` // JITプロビジョニングで割り当てるロールのコード
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                            // ステータス（ACTIVE, INACTIVE）
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                     // 作成日時（ISO 8601）
+	UpdatedAt       string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                    // 更新日時（ISO 8601）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IdentityProvider) Reset() {
+	*x = IdentityProvider{}
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProvider) ProtoMessage() {}
+
+func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v1_auth_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProvider.ProtoReflect.Descriptor instead.
+func (*IdentityProvider) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *IdentityProvider) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetJwksUri() string {
+	if x != nil && x.JwksUri != nil {
+		return *x.JwksUri
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetJitProvisioning() bool {
+	if x != nil {
+		return x.JitProvisioning
+	}
+	return false
+}
+
+func (x *IdentityProvider) GetDefaultRoleCode() string {
+	if x != nil {
+		return x.DefaultRoleCode
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *IdentityProvider) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+var File_proto_contractpro_auth_v1_auth_proto protoreflect.FileDescriptor
+
+const file_proto_contractpro_auth_v1_auth_proto_rawDesc = "" +
+	"\n" +
+	"$proto/contractpro/auth/v1/auth.proto\x12\x13contractpro.auth.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1dproto/validate/validate.proto\"\x0e\n" +
+	"\fGetMeRequest\"\x99\x05\n" +
+	"\rGetMeResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1f\n" +
+	"\tuser_type\x18\x03 \x01(\tB\x02\x18\x01R\buserType\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x00R\bclientId\x88\x01\x01\x12@\n" +
+	"\vclient_user\x18\x05 \x01(\v2\x1f.contractpro.auth.v1.ClientUserR\n" +
+	"clientUser\x129\n" +
+	"\boperator\x18\x06 \x01(\v2\x1d.contractpro.auth.v1.OperatorR\boperator\x12L\n" +
+	"\x0fservice_account\x18\a \x01(\v2#.contractpro.auth.v1.ServiceAccountR\x0eserviceAccount\x123\n" +
+	"\x06tenant\x18\b \x01(\v2\x1b.contractpro.auth.v1.TenantR\x06tenant\x12/\n" +
+	"\x05roles\x18\t \x03(\v2\x19.contractpro.auth.v1.RoleR\x05roles\x12A\n" +
+	"\vpermissions\x18\n" +
+	" \x03(\v2\x1f.contractpro.auth.v1.PermissionR\vpermissions\x12N\n" +
+	"\x10assigned_clients\x18\v \x03(\v2#.contractpro.auth.v1.AssignedClientR\x0fassignedClients\x12D\n" +
+	"\x0eprincipal_type\x18\f \x01(\x0e2\x1d.contractpro.auth.v1.UserTypeR\rprincipalTypeB\f\n" +
+	"\n" +
+	"_client_id\"\xbf\a\n" +
+	"\x13SignupClientRequest\x12\x1f\n" +
+	"\x04name\x18\x01 \x01(\tB\v\xc2\xf3\x18\a\b\x01\x12\x03\x10\xc8\x01R\x04name\x120\n" +
+	"\fcompany_code\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10@H\x00R\vcompanyCode\x88\x01\x01\x12@\n" +
+	"\x04slug\x18\x03 \x01(\tB,\xc2\xf3\x18(\b\x01\x12$B\"[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?R\x04slug\x12j\n" +
+	"\ve_sign_mode\x18\x04 \x01(\tBE\xc2\xf3\x18?\x12=:\vWITNESS_OTP:\bOTP_ONLY:\vCERTIFICATE:\tBIOMETRIC:\fSIMPLE_CLICK\x18\x01H\x01R\teSignMode\x88\x01\x01\x12=\n" +
+	"\x18retention_default_months\x18\x05 \x01(\x05H\x02R\x16retentionDefaultMonths\x88\x01\x01\x12)\n" +
+	"\bsettings\x18\x06 \x01(\tB\b\xc2\xf3\x18\x04\x12\x020\x01H\x03R\bsettings\x88\x01\x01\x12O\n" +
+	"\x0esignature_mode\x18\a \x01(\x0e2\x1e.contractpro.auth.v1.ESignModeB\b\xc2\xf3\x18\x04\x1a\x02\b\x01R\rsignatureMode\x12.\n" +
+	"\vadmin_email\x18\n" +
+	" \x01(\tB\r\xc2\xf3\x18\t\b\x01\x12\x05\x10\xfe\x01\x18\x01R\n" +
+	"adminEmail\x12-\n" +
+	"\x0eadmin_password\x18\v \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\radminPassword\x124\n" +
+	"\x10admin_first_name\x18\f \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02\x10dR\x0eadminFirstName\x122\n" +
+	"\x0fadmin_last_name\x18\r \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02\x10dR\radminLastName\x128\n" +
+	"\x10admin_department\x18\x0e \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x04R\x0fadminDepartment\x88\x01\x01\x124\n" +
+	"\x0eadmin_position\x18\x0f \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x05R\radminPosition\x88\x01\x01\x12,\n" +
+	"\x0fchallenge_token\x18\x14 \x01(\tH\x06R\x0echallengeToken\x88\x01\x01B\x0f\n" +
+	"\r_company_codeB\x0e\n" +
+	"\f_e_sign_modeB\x1b\n" +
+	"\x19_retention_default_monthsB\v\n" +
+	"\t_settingsB\x13\n" +
+	"\x11_admin_departmentB\x11\n" +
+	"\x0f_admin_positionB\x12\n" +
+	"\x10_challenge_token\"\xee\x01\n" +
+	"\x14SignupClientResponse\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1f\n" +
+	"\vclient_name\x18\x02 \x01(\tR\n" +
+	"clientName\x12\"\n" +
+	"\radmin_user_id\x18\x03 \x01(\tR\vadminUserId\x12\x1f\n" +
+	"\vadmin_email\x18\x04 \x01(\tR\n" +
+	"adminEmail\x12\x1a\n" +
+	"\x06status\x18\x05 \x01(\tB\x02\x18\x01R\x06status\x127\n" +
+	"\x05state\x18\x06 \x01(\x0e2!.contractpro.auth.v1.ClientStatusR\x05state\"3\n" +
+	"\x13VerifySignupRequest\x12\x1c\n" +
+	"\x05token\x18\x01 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\x05token\"\xcd\x01\n" +
+	"\x14VerifySignupResponse\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\"\n" +
+	"\radmin_user_id\x18\x02 \x01(\tR\vadminUserId\x12\x1f\n" +
+	"\vadmin_email\x18\x03 \x01(\tR\n" +
+	"adminEmail\x12\x1a\n" +
+	"\x06status\x18\x04 \x01(\tB\x02\x18\x01R\x06status\x127\n" +
+	"\x05state\x18\x05 \x01(\x0e2!.contractpro.auth.v1.ClientStatusR\x05state\"\x87\x03\n" +
+	"\x0fUpdateMeRequest\x12,\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x00R\tfirstName\x88\x01\x01\x12*\n" +
+	"\tlast_name\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x01R\blastName\x88\x01\x01\x12-\n" +
+	"\n" +
+	"department\x18\x03 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x02R\n" +
+	"department\x88\x01\x01\x12)\n" +
+	"\bposition\x18\x04 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x03R\bposition\x88\x01\x01\x12)\n" +
+	"\bsettings\x18\x05 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02(\x01H\x04R\bsettings\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etagB\r\n" +
+	"\v_first_nameB\f\n" +
+	"\n" +
+	"_last_nameB\r\n" +
+	"\v_departmentB\v\n" +
+	"\t_positionB\v\n" +
+	"\t_settings\"G\n" +
+	"\x10UpdateMeResponse\x123\n" +
+	"\x04user\x18\x01 \x01(\v2\x1f.contractpro.auth.v1.ClientUserR\x04user\"w\n" +
+	"\x17ChangeMyPasswordRequest\x121\n" +
+	"\x10current_password\x18\x01 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\x0fcurrentPassword\x12)\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\vnewPassword\"\x1a\n" +
+	"\x18ChangeMyPasswordResponse\"B\n" +
+	"\x14ChangeMyEmailRequest\x12*\n" +
+	"\tnew_email\x18\x01 \x01(\tB\r\xc2\xf3\x18\t\b\x01\x12\x05\x10\xfe\x01\x18\x01R\bnewEmail\"<\n" +
+	"\x15ChangeMyEmailResponse\x12#\n" +
+	"\rpending_email\x18\x01 \x01(\tR\fpendingEmail\";\n" +
+	"\x1bConfirmMyEmailChangeRequest\x12\x1c\n" +
+	"\x05token\x18\x01 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\x05token\"S\n" +
+	"\x1cConfirmMyEmailChangeResponse\x123\n" +
+	"\x04user\x18\x01 \x01(\v2\x1f.contractpro.auth.v1.ClientUserR\x04user\"\xfa\x02\n" +
+	"\x16ListClientUsersRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\x05query\x18\x04 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\xc8\x01R\x05query\x12=\n" +
+	"\x06status\x18\x05 \x01(\tB%\xc2\xf3\x18\x1f\x12\x1d:\x06ACTIVE:\bINACTIVE:\tSUSPENDED\x18\x01R\x06status\x12\x1e\n" +
+	"\n" +
+	"department\x18\x06 \x01(\tR\n" +
+	"department\x12\x1a\n" +
+	"\bposition\x18\a \x01(\tR\bposition\x12\x1b\n" +
+	"\trole_code\x18\b \x01(\tR\broleCode\x12\x19\n" +
+	"\border_by\x18\t \x01(\tR\aorderBy\x12?\n" +
+	"\x05state\x18\n" +
+	" \x01(\x0e2\x1f.contractpro.auth.v1.UserStatusB\b\xc2\xf3\x18\x04\x1a\x02\b\x01R\x05state\"\x8e\x01\n" +
+	"\x17ListClientUsersResponse\x125\n" +
+	"\x05users\x18\x01 \x03(\v2\x1f.contractpro.auth.v1.ClientUserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"H\n" +
+	"\x14GetClientUserRequest\x120\n" +
+	"\x0eclient_user_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\fclientUserId\"L\n" +
+	"\x15GetClientUserResponse\x123\n" +
+	"\x04user\x18\x01 \x01(\v2\x1f.contractpro.auth.v1.ClientUserR\x04user\"\xe4\x02\n" +
+	"\x17CreateClientUserRequest\x12#\n" +
+	"\x05email\x18\x01 \x01(\tB\r\xc2\xf3\x18\t\b\x01\x12\x05\x10\xfe\x01\x18\x01R\x05email\x12\"\n" +
+	"\bpassword\x18\x02 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\bpassword\x12)\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02\x10dR\tfirstName\x12'\n" +
+	"\tlast_name\x18\x04 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02\x10dR\blastName\x12-\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x00R\n" +
+	"department\x88\x01\x01\x12)\n" +
+	"\bposition\x18\x06 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x01R\bposition\x88\x01\x01\x12)\n" +
+	"\bsettings\x18\a \x01(\tB\b\xc2\xf3\x18\x04\x12\x020\x01H\x02R\bsettings\x88\x01\x01B\r\n" +
+	"\v_departmentB\v\n" +
+	"\t_positionB\v\n" +
+	"\t_settings\"O\n" +
+	"\x18CreateClientUserResponse\x123\n" +
+	"\x04user\x18\x01 \x01(\v2\x1f.contractpro.auth.v1.ClientUserR\x04user\"\x9a\x05\n" +
+	"\x17UpdateClientUserRequest\x120\n" +
+	"\x0eclient_user_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\fclientUserId\x12&\n" +
+	"\x05email\x18\x02 \x01(\tB\v\xc2\xf3\x18\a\x12\x05\x10\xfe\x01\x18\x01H\x00R\x05email\x88\x01\x01\x12,\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x01R\tfirstName\x88\x01\x01\x12*\n" +
+	"\tlast_name\x18\x04 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x02R\blastName\x88\x01\x01\x12-\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x03R\n" +
+	"department\x88\x01\x01\x12)\n" +
+	"\bposition\x18\x06 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10dH\x04R\bposition\x88\x01\x01\x12)\n" +
+	"\bsettings\x18\a \x01(\tB\b\xc2\xf3\x18\x04\x12\x02(\x01H\x05R\bsettings\x88\x01\x01\x12B\n" +
+	"\x06status\x18\b \x01(\tB%\xc2\xf3\x18\x1f\x12\x1d:\x06ACTIVE:\bINACTIVE:\tSUSPENDED\x18\x01H\x06R\x06status\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\t \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1a\n" +
+	"\x04etag\x18\n" +
+	" \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\x04etag\x12D\n" +
+	"\x05state\x18\v \x01(\x0e2\x1f.contractpro.auth.v1.UserStatusB\b\xc2\xf3\x18\x04\x1a\x02\b\x01H\aR\x05state\x88\x01\x01B\b\n" +
+	"\x06_emailB\r\n" +
+	"\v_first_nameB\f\n" +
+	"\n" +
+	"_last_nameB\r\n" +
+	"\v_departmentB\v\n" +
+	"\t_positionB\v\n" +
+	"\t_settingsB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_state\"O\n" +
+	"\x18UpdateClientUserResponse\x123\n" +
+	"\x04user\x18\x01 \x01(\v2\x1f.contractpro.auth.v1.ClientUserR\x04user\"g\n" +
+	"\x17DeleteClientUserRequest\x120\n" +
+	"\x0eclient_user_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\fclientUserId\x12\x1a\n" +
+	"\x04etag\x18\x02 \x01(\tB\x06\xc2\xf3\x18\x02\b\x01R\x04etag\"\x1a\n" +
+	"\x18DeleteClientUserResponse\"\xc7\x02\n" +
+	"\x18ExportClientUsersRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x1f\n" +
+	"\x05query\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\xc8\x01R\x05query\x12=\n" +
+	"\x06status\x18\x03 \x01(\tB%\xc2\xf3\x18\x1f\x12\x1d:\x06ACTIVE:\bINACTIVE:\tSUSPENDED\x18\x01R\x06status\x12\x1e\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tR\n" +
+	"department\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\tR\bposition\x12\x1b\n" +
+	"\trole_code\x18\x06 \x01(\tR\broleCode\x12\x19\n" +
+	"\border_by\x18\a \x01(\tR\aorderBy\x12?\n" +
+	"\x05state\x18\b \x01(\x0e2\x1f.contractpro.auth.v1.UserStatusB\b\xc2\xf3\x18\x04\x1a\x02\b\x01R\x05state\"p\n" +
+	"\x19ExportClientUsersResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"\x0f\n" +
+	"\rLogoutRequest\"\x10\n" +
+	"\x0eLogoutResponse\"F\n" +
+	"\x12ForceLogoutRequest\x120\n" +
+	"\x0eclient_user_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\fclientUserId\"\x15\n" +
+	"\x13ForceLogoutResponse\"\x1a\n" +
+	"\x18ForceLogoutTenantRequest\"\x1b\n" +
+	"\x19ForceLogoutTenantResponse\"\x97\x01\n" +
+	"\x16CreateScimTokenRequest\x120\n" +
+	"\vdescription\x18\x01 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\xc8\x01H\x00R\vdescription\x88\x01\x01\x12,\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02H\x01H\x01R\texpiresAt\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_expires_at\"\xc6\x01\n" +
+	"\x17CreateScimTokenResponse\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\tR\atokenId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12!\n" +
+	"\ftoken_prefix\x18\x03 \x01(\tR\vtokenPrefix\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tH\x00R\texpiresAt\x88\x01\x01\x12$\n" +
+	"\x0escim_base_path\x18\x05 \x01(\tR\fscimBasePathB\r\n" +
+	"\v_expires_at\"?\n" +
+	"\x16RevokeScimTokenRequest\x12%\n" +
+	"\btoken_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\atokenId\"\x19\n" +
+	"\x17RevokeScimTokenResponse\"\x1c\n" +
+	"\x1aListServiceAccountsRequest\"m\n" +
+	"\x1bListServiceAccountsResponse\x12N\n" +
+	"\x10service_accounts\x18\x01 \x03(\v2#.contractpro.auth.v1.ServiceAccountR\x0fserviceAccounts\"\xa4\x01\n" +
+	"\x1bCreateServiceAccountRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02\x10dR\x04name\x120\n" +
+	"\vdescription\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\xf4\x03H\x00R\vdescription\x88\x01\x01\x12#\n" +
+	"\arole_id\x18\x03 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\x06roleIdB\x0e\n" +
+	"\f_description\"l\n" +
+	"\x1cCreateServiceAccountResponse\x12L\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2#.contractpro.auth.v1.ServiceAccountR\x0eserviceAccount\"W\n" +
+	"\x1bDeleteServiceAccountRequest\x128\n" +
+	"\x12service_account_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\x10serviceAccountId\"\x1e\n" +
+	"\x1cDeleteServiceAccountResponse\"N\n" +
+	"\x12ListApiKeysRequest\x128\n" +
+	"\x12service_account_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\x10serviceAccountId\"M\n" +
+	"\x13ListApiKeysResponse\x126\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x1b.contractpro.auth.v1.ApiKeyR\aapiKeys\"\x8c\x01\n" +
+	"\x13CreateApiKeyRequest\x128\n" +
+	"\x12service_account_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\x10serviceAccountId\x12,\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02H\x01H\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"^\n" +
+	"\x14CreateApiKeyResponse\x124\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x1b.contractpro.auth.v1.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\xcc\x01\n" +
+	"\x13RotateApiKeyRequest\x12(\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\bapiKeyId\x125\n" +
+	"\x14grace_period_seconds\x18\x02 \x01(\x05H\x00R\x12gracePeriodSeconds\x88\x01\x01\x12,\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02H\x01H\x01R\texpiresAt\x88\x01\x01B\x17\n" +
+	"\x15_grace_period_secondsB\r\n" +
+	"\v_expires_at\"^\n" +
+	"\x14RotateApiKeyResponse\x124\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x1b.contractpro.auth.v1.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"?\n" +
+	"\x13RevokeApiKeyRequest\x12(\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\bapiKeyId\"\x16\n" +
+	"\x14RevokeApiKeyResponse\"\x1f\n" +
+	"\x1dListIpAllowlistEntriesRequest\"a\n" +
+	"\x1eListIpAllowlistEntriesResponse\x12?\n" +
+	"\aentries\x18\x01 \x03(\v2%.contractpro.auth.v1.IpAllowlistEntryR\aentries\"~\n" +
+	"\x1aAddIpAllowlistEntryRequest\x12\x1e\n" +
+	"\x04cidr\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02\x10@R\x04cidr\x120\n" +
+	"\vdescription\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\xc8\x01H\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"Z\n" +
+	"\x1bAddIpAllowlistEntryResponse\x12;\n" +
+	"\x05entry\x18\x01 \x01(\v2%.contractpro.auth.v1.IpAllowlistEntryR\x05entry\"F\n" +
+	"\x1dRemoveIpAllowlistEntryRequest\x12%\n" +
+	"\bentry_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\aentryId\" \n" +
+	"\x1eRemoveIpAllowlistEntryResponse\"\x1e\n" +
+	"\x1cListIdentityProvidersRequest\"u\n" +
+	"\x1dListIdentityProvidersResponse\x12T\n" +
+	"\x12identity_providers\x18\x01 \x03(\v2%.contractpro.auth.v1.IdentityProviderR\x11identityProviders\"\x84\x03\n" +
+	"\x1dCreateIdentityProviderRequest\x12\x1f\n" +
+	"\x04name\x18\x01 \x01(\tB\v\xc2\xf3\x18\a\b\x01\x12\x03\x10\xc8\x01R\x04name\x12#\n" +
+	"\x06issuer\x18\x02 \x01(\tB\v\xc2\xf3\x18\a\b\x01\x12\x03\x10\x80\x10R\x06issuer\x12'\n" +
+	"\baudience\x18\x03 \x01(\tB\v\xc2\xf3\x18\a\b\x01\x12\x03\x10\x80\x10R\baudience\x12)\n" +
+	"\bjwks_uri\x18\x04 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x10H\x00R\ajwksUri\x88\x01\x01\x12)\n" +
+	"\x10jit_provisioning\x18\x05 \x01(\bR\x0fjitProvisioning\x129\n" +
+	"\x11default_role_code\x18\x06 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10@H\x01R\x0fdefaultRoleCode\x88\x01\x01\x125\n" +
+	"\x06status\x18\a \x01(\tB\x18\xc2\xf3\x18\x14\x12\x12:\x06ACTIVE:\bINACTIVEH\x02R\x06status\x88\x01\x01B\v\n" +
+	"\t_jwks_uriB\x14\n" +
+	"\x12_default_role_codeB\t\n" +
+	"\a_status\"t\n" +
+	"\x1eCreateIdentityProviderResponse\x12R\n" +
+	"\x11identity_provider\x18\x01 \x01(\v2%.contractpro.auth.v1.IdentityProviderR\x10identityProvider\"\xc2\x03\n" +
+	"\x1dUpdateIdentityProviderRequest\x12+\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\n" +
+	"providerId\x12\"\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\xc8\x01H\x00R\x04name\x88\x01\x01\x12*\n" +
+	"\baudience\x18\x03 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x10H\x01R\baudience\x88\x01\x01\x12)\n" +
+	"\bjwks_uri\x18\x04 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\x80\x10H\x02R\ajwksUri\x88\x01\x01\x12.\n" +
+	"\x10jit_provisioning\x18\x05 \x01(\bH\x03R\x0fjitProvisioning\x88\x01\x01\x129\n" +
+	"\x11default_role_code\x18\x06 \x01(\tB\b\xc2\xf3\x18\x04\x12\x02\x10@H\x04R\x0fdefaultRoleCode\x88\x01\x01\x125\n" +
+	"\x06status\x18\a \x01(\tB\x18\xc2\xf3\x18\x14\x12\x12:\x06ACTIVE:\bINACTIVEH\x05R\x06status\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_audienceB\v\n" +
+	"\t_jwks_uriB\x13\n" +
+	"\x11_jit_provisioningB\x14\n" +
+	"\x12_default_role_codeB\t\n" +
+	"\a_status\"t\n" +
+	"\x1eUpdateIdentityProviderResponse\x12R\n" +
+	"\x11identity_provider\x18\x01 \x01(\v2%.contractpro.auth.v1.IdentityProviderR\x10identityProvider\"L\n" +
+	"\x1dDeleteIdentityProviderRequest\x12+\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\n" +
+	"providerId\" \n" +
+	"\x1eDeleteIdentityProviderResponse\"\x95\x02\n" +
+	"\x0eServiceAccount\x12,\n" +
+	"\x12service_account_id\x18\x01 \x01(\tR\x10serviceAccountId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x17\n" +
+	"\arole_id\x18\x05 \x01(\tR\x06roleId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAtB\x0e\n" +
+	"\f_description\"\xe9\x02\n" +
+	"\x06ApiKey\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12,\n" +
+	"\x12service_account_id\x18\x02 \x01(\tR\x10serviceAccountId\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x03 \x01(\tR\tkeyPrefix\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tH\x00R\texpiresAt\x88\x01\x01\x12%\n" +
+	"\flast_used_at\x18\x05 \x01(\tH\x01R\n" +
+	"lastUsedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\tH\x02R\trevokedAt\x88\x01\x01\x12&\n" +
+	"\frotated_from\x18\a \x01(\tH\x03R\vrotatedFrom\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAtB\r\n" +
+	"\v_expires_atB\x0f\n" +
+	"\r_last_used_atB\r\n" +
+	"\v_revoked_atB\x0f\n" +
+	"\r_rotated_from\"\x91\x04\n" +
+	"\n" +
+	"ClientUser\x12$\n" +
+	"\x0eclient_user_id\x18\x01 \x01(\tR\fclientUserId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x05 \x01(\tR\blastName\x12#\n" +
+	"\n" +
+	"department\x18\x06 \x01(\tH\x00R\n" +
+	"department\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\a \x01(\tH\x01R\bposition\x88\x01\x01\x12\x1a\n" +
+	"\x06status\x18\b \x01(\tB\x02\x18\x01R\x06status\x12\x1a\n" +
+	"\bsettings\x18\t \x01(\tR\bsettings\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x123\n" +
+	"\x13password_changed_at\x18\f \x01(\tH\x02R\x11passwordChangedAt\x88\x01\x01\x12\x12\n" +
+	"\x04etag\x18\r \x01(\tR\x04etag\x125\n" +
+	"\x05state\x18\x0e \x01(\x0e2\x1f.contractpro.auth.v1.UserStatusR\x05stateB\r\n" +
+	"\v_departmentB\v\n" +
+	"\t_positionB\x16\n" +
+	"\x14_password_changed_at\"\xb7\x03\n" +
+	"\bOperator\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\x12\x1a\n" +
+	"\x06status\x18\x05 \x01(\tB\x02\x18\x01R\x06status\x12\x1f\n" +
+	"\vmfa_enabled\x18\x06 \x01(\bR\n" +
+	"mfaEnabled\x12'\n" +
+	"\rlast_login_at\x18\a \x01(\tH\x00R\vlastLoginAt\x88\x01\x01\x123\n" +
+	"\x13password_changed_at\x18\b \x01(\tH\x01R\x11passwordChangedAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x125\n" +
+	"\x05state\x18\v \x01(\x0e2\x1f.contractpro.auth.v1.UserStatusR\x05stateB\x10\n" +
+	"\x0e_last_login_atB\x16\n" +
+	"\x14_password_changed_at\"\xa1\x02\n" +
+	"\x06Tenant\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\"\n" +
+	"\ve_sign_mode\x18\x04 \x01(\tB\x02\x18\x01R\teSignMode\x12\x1a\n" +
+	"\x06status\x18\x05 \x01(\tB\x02\x18\x01R\x06status\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\x127\n" +
+	"\x05state\x18\a \x01(\x0e2!.contractpro.auth.v1.ClientStatusR\x05state\x12E\n" +
+	"\x0esignature_mode\x18\b \x01(\x0e2\x1e.contractpro.auth.v1.ESignModeR\rsignatureMode\"G\n" +
+	"\x04Role\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\">\n" +
+	"\n" +
+	"Permission\x12\x18\n" +
+	"\afeature\x18\x01 \x01(\tR\afeature\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\"\xa5\x01\n" +
+	"\x0eAssignedClient\x123\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x1b.contractpro.auth.v1.TenantR\x06tenant\x12\x16\n" +
+	"\x04role\x18\x02 \x01(\tB\x02\x18\x01R\x04role\x12F\n" +
+	"\roperator_role\x18\x03 \x01(\x0e2!.contractpro.auth.v1.OperatorRoleR\foperatorRole\"\x97\x01\n" +
+	"\x10IpAllowlistEntry\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x12\n" +
+	"\x04cidr\x18\x02 \x01(\tR\x04cidr\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAtB\x0e\n" +
+	"\f_description\"\xd5\x02\n" +
+	"\x10IdentityProvider\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\tR\n" +
+	"providerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x1a\n" +
+	"\baudience\x18\x04 \x01(\tR\baudience\x12\x1e\n" +
+	"\bjwks_uri\x18\x05 \x01(\tH\x00R\ajwksUri\x88\x01\x01\x12)\n" +
+	"\x10jit_provisioning\x18\x06 \x01(\bR\x0fjitProvisioning\x12*\n" +
+	"\x11default_role_code\x18\a \x01(\tR\x0fdefaultRoleCode\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAtB\v\n" +
+	"\t_jwks_uri*w\n" +
+	"\bUserType\x12\x19\n" +
+	"\x15USER_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12USER_TYPE_OPERATOR\x10\x01\x12\x19\n" +
+	"\x15USER_TYPE_CLIENT_USER\x10\x02\x12\x1d\n" +
+	"\x19USER_TYPE_SERVICE_ACCOUNT\x10\x03*v\n" +
+	"\n" +
+	"UserStatus\x12\x1b\n" +
+	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14USER_STATUS_INACTIVE\x10\x02\x12\x19\n" +
+	"\x15USER_STATUS_SUSPENDED\x10\x03*\xaa\x01\n" +
+	"\fClientStatus\x12\x1d\n" +
+	"\x19CLIENT_STATUS_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"CLIENT_STATUS_PENDING_VERIFICATION\x10\x01\x12\x18\n" +
+	"\x14CLIENT_STATUS_ACTIVE\x10\x02\x12\x1b\n" +
+	"\x17CLIENT_STATUS_SUSPENDED\x10\x03\x12\x1c\n" +
+	"\x18CLIENT_STATUS_TERMINATED\x10\x04*\xb5\x01\n" +
+	"\tESignMode\x12\x1b\n" +
+	"\x17E_SIGN_MODE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17E_SIGN_MODE_WITNESS_OTP\x10\x01\x12\x18\n" +
+	"\x14E_SIGN_MODE_OTP_ONLY\x10\x02\x12\x1b\n" +
+	"\x17E_SIGN_MODE_CERTIFICATE\x10\x03\x12\x19\n" +
+	"\x15E_SIGN_MODE_BIOMETRIC\x10\x04\x12\x1c\n" +
+	"\x18E_SIGN_MODE_SIMPLE_CLICK\x10\x05*|\n" +
+	"\fOperatorRole\x12\x1d\n" +
+	"\x19OPERATOR_ROLE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13OPERATOR_ROLE_ADMIN\x10\x01\x12\x1a\n" +
+	"\x16OPERATOR_ROLE_OPERATOR\x10\x02\x12\x18\n" +
+	"\x14OPERATOR_ROLE_VIEWER\x10\x032\xb1%\n" +
+	"\vAuthService\x12^\n" +
+	"\x05GetMe\x12!.contractpro.auth.v1.GetMeRequest\x1a\".contractpro.auth.v1.GetMeResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/v1/me\x12z\n" +
+	"\fSignupClient\x12(.contractpro.auth.v1.SignupClientRequest\x1a).contractpro.auth.v1.SignupClientResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/signup\x12\x81\x01\n" +
+	"\fVerifySignup\x12(.contractpro.auth.v1.VerifySignupRequest\x1a).contractpro.auth.v1.VerifySignupResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/signup/verify\x12j\n" +
+	"\bUpdateMe\x12$.contractpro.auth.v1.UpdateMeRequest\x1a%.contractpro.auth.v1.UpdateMeResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*2\x06/v1/me\x12\x8b\x01\n" +
+	"\x10ChangeMyPassword\x12,.contractpro.auth.v1.ChangeMyPasswordRequest\x1a-.contractpro.auth.v1.ChangeMyPasswordResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/me/password\x12\x7f\n" +
+	"\rChangeMyEmail\x12).contractpro.auth.v1.ChangeMyEmailRequest\x1a*.contractpro.auth.v1.ChangeMyEmailResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/me/email\x12\x9c\x01\n" +
+	"\x14ConfirmMyEmailChange\x120.contractpro.auth.v1.ConfirmMyEmailChangeRequest\x1a1.contractpro.auth.v1.ConfirmMyEmailChangeResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/me/email/confirm\x12\x86\x01\n" +
+	"\x0fListClientUsers\x12+.contractpro.auth.v1.ListClientUsersRequest\x1a,.contractpro.auth.v1.ListClientUsersResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/client-users\x12\x91\x01\n" +
+	"\rGetClientUser\x12).contractpro.auth.v1.GetClientUserRequest\x1a*.contractpro.auth.v1.GetClientUserResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/client-users/{client_user_id}\x12\x8c\x01\n" +
+	"\x10CreateClientUser\x12,.contractpro.auth.v1.CreateClientUserRequest\x1a-.contractpro.auth.v1.CreateClientUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/client-users\x12\x9d\x01\n" +
+	"\x10UpdateClientUser\x12,.contractpro.auth.v1.UpdateClientUserRequest\x1a-.contractpro.auth.v1.UpdateClientUserResponse\",\x82\xd3\xe4\x93\x02&:\x01*2!/v1/client-users/{client_user_id}\x12\x9a\x01\n" +
+	"\x10DeleteClientUser\x12,.contractpro.auth.v1.DeleteClientUserRequest\x1a-.contractpro.auth.v1.DeleteClientUserResponse\")\x82\xd3\xe4\x93\x02#*!/v1/client-users/{client_user_id}\x12\x9c\x01\n" +
+	"\x11ExportClientUsers\x12-.contractpro.auth.v1.ExportClientUsersRequest\x1a..contractpro.auth.v1.ExportClientUsersResponse\"&\x82\xd3\xe4\x93\x02 b\x05chunk\x12\x17/v1/client-users/export0\x01\x12h\n" +
+	"\x06Logout\x12\".contractpro.auth.v1.LogoutRequest\x1a#.contractpro.auth.v1.LogoutResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/logout\x12\x95\x01\n" +
+	"\vForceLogout\x12'.contractpro.auth.v1.ForceLogoutRequest\x1a(.contractpro.auth.v1.ForceLogoutResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/client-users/{client_user_id}/logout\x12\x90\x01\n" +
+	"\x11ForceLogoutTenant\x12-.contractpro.auth.v1.ForceLogoutTenantRequest\x1a..contractpro.auth.v1.ForceLogoutTenantResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/tenant/logout\x12\x88\x01\n" +
+	"\x0fCreateScimToken\x12+.contractpro.auth.v1.CreateScimTokenRequest\x1a,.contractpro.auth.v1.CreateScimTokenResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/scim-tokens\x12\x90\x01\n" +
+	"\x0fRevokeScimToken\x12+.contractpro.auth.v1.RevokeScimTokenRequest\x1a,.contractpro.auth.v1.RevokeScimTokenResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/scim-tokens/{token_id}\x12\x96\x01\n" +
+	"\x13ListServiceAccounts\x12/.contractpro.auth.v1.ListServiceAccountsRequest\x1a0.contractpro.auth.v1.ListServiceAccountsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/service-accounts\x12\x9c\x01\n" +
+	"\x14CreateServiceAccount\x120.contractpro.auth.v1.CreateServiceAccountRequest\x1a1.contractpro.auth.v1.CreateServiceAccountResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/service-accounts\x12\xae\x01\n" +
+	"\x14DeleteServiceAccount\x120.contractpro.auth.v1.DeleteServiceAccountRequest\x1a1.contractpro.auth.v1.DeleteServiceAccountResponse\"1\x82\xd3\xe4\x93\x02+*)/v1/service-accounts/{service_account_id}\x12\x9c\x01\n" +
+	"\vListApiKeys\x12'.contractpro.auth.v1.ListApiKeysRequest\x1a(.contractpro.auth.v1.ListApiKeysResponse\":\x82\xd3\xe4\x93\x024\x122/v1/service-accounts/{service_account_id}/api-keys\x12\xa2\x01\n" +
+	"\fCreateApiKey\x12(.contractpro.auth.v1.CreateApiKeyRequest\x1a).contractpro.auth.v1.CreateApiKeyResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/v1/service-accounts/{service_account_id}/api-keys\x12\x90\x01\n" +
+	"\fRotateApiKey\x12(.contractpro.auth.v1.RotateApiKeyRequest\x1a).contractpro.auth.v1.RotateApiKeyResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/api-keys/{api_key_id}/rotate\x12\x86\x01\n" +
+	"\fRevokeApiKey\x12(.contractpro.auth.v1.RevokeApiKeyRequest\x1a).contractpro.auth.v1.RevokeApiKeyResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/api-keys/{api_key_id}\x12\xa3\x01\n" +
+	"\x16ListIpAllowlistEntries\x122.contractpro.auth.v1.ListIpAllowlistEntriesRequest\x1a3.contractpro.auth.v1.ListIpAllowlistEntriesResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/ip-allowlist-entries\x12\x9d\x01\n" +
+	"\x13AddIpAllowlistEntry\x12/.contractpro.auth.v1.AddIpAllowlistEntryRequest\x1a0.contractpro.auth.v1.AddIpAllowlistEntryResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/ip-allowlist-entries\x12\xae\x01\n" +
+	"\x16RemoveIpAllowlistEntry\x122.contractpro.auth.v1.RemoveIpAllowlistEntryRequest\x1a3.contractpro.auth.v1.RemoveIpAllowlistEntryResponse\"+\x82\xd3\xe4\x93\x02%*#/v1/ip-allowlist-entries/{entry_id}\x12\x9e\x01\n" +
+	"\x15ListIdentityProviders\x121.contractpro.auth.v1.ListIdentityProvidersRequest\x1a2.contractpro.auth.v1.ListIdentityProvidersResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/identity-providers\x12\xa4\x01\n" +
+	"\x16CreateIdentityProvider\x122.contractpro.auth.v1.CreateIdentityProviderRequest\x1a3.contractpro.auth.v1.CreateIdentityProviderResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/identity-providers\x12\xb2\x01\n" +
+	"\x16UpdateIdentityProvider\x122.contractpro.auth.v1.UpdateIdentityProviderRequest\x1a3.contractpro.auth.v1.UpdateIdentityProviderResponse\"/\x82\xd3\xe4\x93\x02):\x01*2$/v1/identity-providers/{provider_id}\x12\xaf\x01\n" +
+	"\x16DeleteIdentityProvider\x122.contractpro.auth.v1.DeleteIdentityProviderRequest\x1a3.contractpro.auth.v1.DeleteIdentityProviderResponse\",\x82\xd3\xe4\x93\x02&*$/v1/identity-providers/{provider_id}B5Z3contract-pro-suite/proto/contractpro/auth/v1;authv1b\x06proto3"
+
+var (
+	file_proto_contractpro_auth_v1_auth_proto_rawDescOnce sync.Once
+	file_proto_contractpro_auth_v1_auth_proto_rawDescData []byte
+)
+
+func file_proto_contractpro_auth_v1_auth_proto_rawDescGZIP() []byte {
+	file_proto_contractpro_auth_v1_auth_proto_rawDescOnce.Do(func() {
+		file_proto_contractpro_auth_v1_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_contractpro_auth_v1_auth_proto_rawDesc), len(file_proto_contractpro_auth_v1_auth_proto_rawDesc)))
+	})
+	return file_proto_contractpro_auth_v1_auth_proto_rawDescData
+}
+
+var file_proto_contractpro_auth_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_contractpro_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_proto_contractpro_auth_v1_auth_proto_goTypes = []any{
+	(UserType)(0),                          // 0: contractpro.auth.v1.UserType
+	(UserStatus)(0),                        // 1: contractpro.auth.v1.UserStatus
+	(ClientStatus)(0),                      // 2: contractpro.auth.v1.ClientStatus
+	(ESignMode)(0),                         // 3: contractpro.auth.v1.ESignMode
+	(OperatorRole)(0),                      // 4: contractpro.auth.v1.OperatorRole
+	(*GetMeRequest)(nil),                   // 5: contractpro.auth.v1.GetMeRequest
+	(*GetMeResponse)(nil),                  // 6: contractpro.auth.v1.GetMeResponse
+	(*SignupClientRequest)(nil),            // 7: contractpro.auth.v1.SignupClientRequest
+	(*SignupClientResponse)(nil),           // 8: contractpro.auth.v1.SignupClientResponse
+	(*VerifySignupRequest)(nil),            // 9: contractpro.auth.v1.VerifySignupRequest
+	(*VerifySignupResponse)(nil),           // 10: contractpro.auth.v1.VerifySignupResponse
+	(*UpdateMeRequest)(nil),                // 11: contractpro.auth.v1.UpdateMeRequest
+	(*UpdateMeResponse)(nil),               // 12: contractpro.auth.v1.UpdateMeResponse
+	(*ChangeMyPasswordRequest)(nil),        // 13: contractpro.auth.v1.ChangeMyPasswordRequest
+	(*ChangeMyPasswordResponse)(nil),       // 14: contractpro.auth.v1.ChangeMyPasswordResponse
+	(*ChangeMyEmailRequest)(nil),           // 15: contractpro.auth.v1.ChangeMyEmailRequest
+	(*ChangeMyEmailResponse)(nil),          // 16: contractpro.auth.v1.ChangeMyEmailResponse
+	(*ConfirmMyEmailChangeRequest)(nil),    // 17: contractpro.auth.v1.ConfirmMyEmailChangeRequest
+	(*ConfirmMyEmailChangeResponse)(nil),   // 18: contractpro.auth.v1.ConfirmMyEmailChangeResponse
+	(*ListClientUsersRequest)(nil),         // 19: contractpro.auth.v1.ListClientUsersRequest
+	(*ListClientUsersResponse)(nil),        // 20: contractpro.auth.v1.ListClientUsersResponse
+	(*GetClientUserRequest)(nil),           // 21: contractpro.auth.v1.GetClientUserRequest
+	(*GetClientUserResponse)(nil),          // 22: contractpro.auth.v1.GetClientUserResponse
+	(*CreateClientUserRequest)(nil),        // 23: contractpro.auth.v1.CreateClientUserRequest
+	(*CreateClientUserResponse)(nil),       // 24: contractpro.auth.v1.CreateClientUserResponse
+	(*UpdateClientUserRequest)(nil),        // 25: contractpro.auth.v1.UpdateClientUserRequest
+	(*UpdateClientUserResponse)(nil),       // 26: contractpro.auth.v1.UpdateClientUserResponse
+	(*DeleteClientUserRequest)(nil),        // 27: contractpro.auth.v1.DeleteClientUserRequest
+	(*DeleteClientUserResponse)(nil),       // 28: contractpro.auth.v1.DeleteClientUserResponse
+	(*ExportClientUsersRequest)(nil),       // 29: contractpro.auth.v1.ExportClientUsersRequest
+	(*ExportClientUsersResponse)(nil),      // 30: contractpro.auth.v1.ExportClientUsersResponse
+	(*LogoutRequest)(nil),                  // 31: contractpro.auth.v1.LogoutRequest
+	(*LogoutResponse)(nil),                 // 32: contractpro.auth.v1.LogoutResponse
+	(*ForceLogoutRequest)(nil),             // 33: contractpro.auth.v1.ForceLogoutRequest
+	(*ForceLogoutResponse)(nil),            // 34: contractpro.auth.v1.ForceLogoutResponse
+	(*ForceLogoutTenantRequest)(nil),       // 35: contractpro.auth.v1.ForceLogoutTenantRequest
+	(*ForceLogoutTenantResponse)(nil),      // 36: contractpro.auth.v1.ForceLogoutTenantResponse
+	(*CreateScimTokenRequest)(nil),         // 37: contractpro.auth.v1.CreateScimTokenRequest
+	(*CreateScimTokenResponse)(nil),        // 38: contractpro.auth.v1.CreateScimTokenResponse
+	(*RevokeScimTokenRequest)(nil),         // 39: contractpro.auth.v1.RevokeScimTokenRequest
+	(*RevokeScimTokenResponse)(nil),        // 40: contractpro.auth.v1.RevokeScimTokenResponse
+	(*ListServiceAccountsRequest)(nil),     // 41: contractpro.auth.v1.ListServiceAccountsRequest
+	(*ListServiceAccountsResponse)(nil),    // 42: contractpro.auth.v1.ListServiceAccountsResponse
+	(*CreateServiceAccountRequest)(nil),    // 43: contractpro.auth.v1.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),   // 44: contractpro.auth.v1.CreateServiceAccountResponse
+	(*DeleteServiceAccountRequest)(nil),    // 45: contractpro.auth.v1.DeleteServiceAccountRequest
+	(*DeleteServiceAccountResponse)(nil),   // 46: contractpro.auth.v1.DeleteServiceAccountResponse
+	(*ListApiKeysRequest)(nil),             // 47: contractpro.auth.v1.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),            // 48: contractpro.auth.v1.ListApiKeysResponse
+	(*CreateApiKeyRequest)(nil),            // 49: contractpro.auth.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),           // 50: contractpro.auth.v1.CreateApiKeyResponse
+	(*RotateApiKeyRequest)(nil),            // 51: contractpro.auth.v1.RotateApiKeyRequest
+	(*RotateApiKeyResponse)(nil),           // 52: contractpro.auth.v1.RotateApiKeyResponse
+	(*RevokeApiKeyRequest)(nil),            // 53: contractpro.auth.v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),           // 54: contractpro.auth.v1.RevokeApiKeyResponse
+	(*ListIpAllowlistEntriesRequest)(nil),  // 55: contractpro.auth.v1.ListIpAllowlistEntriesRequest
+	(*ListIpAllowlistEntriesResponse)(nil), // 56: contractpro.auth.v1.ListIpAllowlistEntriesResponse
+	(*AddIpAllowlistEntryRequest)(nil),     // 57: contractpro.auth.v1.AddIpAllowlistEntryRequest
+	(*AddIpAllowlistEntryResponse)(nil),    // 58: contractpro.auth.v1.AddIpAllowlistEntryResponse
+	(*RemoveIpAllowlistEntryRequest)(nil),  // 59: contractpro.auth.v1.RemoveIpAllowlistEntryRequest
+	(*RemoveIpAllowlistEntryResponse)(nil), // 60: contractpro.auth.v1.RemoveIpAllowlistEntryResponse
+	(*ListIdentityProvidersRequest)(nil),   // 61: contractpro.auth.v1.ListIdentityProvidersRequest
+	(*ListIdentityProvidersResponse)(nil),  // 62: contractpro.auth.v1.ListIdentityProvidersResponse
+	(*CreateIdentityProviderRequest)(nil),  // 63: contractpro.auth.v1.CreateIdentityProviderRequest
+	(*CreateIdentityProviderResponse)(nil), // 64: contractpro.auth.v1.CreateIdentityProviderResponse
+	(*UpdateIdentityProviderRequest)(nil),  // 65: contractpro.auth.v1.UpdateIdentityProviderRequest
+	(*UpdateIdentityProviderResponse)(nil), // 66: contractpro.auth.v1.UpdateIdentityProviderResponse
+	(*DeleteIdentityProviderRequest)(nil),  // 67: contractpro.auth.v1.DeleteIdentityProviderRequest
+	(*DeleteIdentityProviderResponse)(nil), // 68: contractpro.auth.v1.DeleteIdentityProviderResponse
+	(*ServiceAccount)(nil),                 // 69: contractpro.auth.v1.ServiceAccount
+	(*ApiKey)(nil),                         // 70: contractpro.auth.v1.ApiKey
+	(*ClientUser)(nil),                     // 71: contractpro.auth.v1.ClientUser
+	(*Operator)(nil),                       // 72: contractpro.auth.v1.Operator
+	(*Tenant)(nil),                         // 73: contractpro.auth.v1.Tenant
+	(*Role)(nil),                           // 74: contractpro.auth.v1.Role
+	(*Permission)(nil),                     // 75: contractpro.auth.v1.Permission
+	(*AssignedClient)(nil),                 // 76: contractpro.auth.v1.AssignedClient
+	(*IpAllowlistEntry)(nil),               // 77: contractpro.auth.v1.IpAllowlistEntry
+	(*IdentityProvider)(nil),               // 78: contractpro.auth.v1.IdentityProvider
+	(*fieldmaskpb.FieldMask)(nil),          // 79: google.protobuf.FieldMask
+}
+var file_proto_contractpro_auth_v1_auth_proto_depIdxs = []int32{
+	71, // 0: contractpro.auth.v1.GetMeResponse.client_user:type_name -> contractpro.auth.v1.ClientUser
+	72, // 1: contractpro.auth.v1.GetMeResponse.operator:type_name -> contractpro.auth.v1.Operator
+	69, // 2: contractpro.auth.v1.GetMeResponse.service_account:type_name -> contractpro.auth.v1.ServiceAccount
+	73, // 3: contractpro.auth.v1.GetMeResponse.tenant:type_name -> contractpro.auth.v1.Tenant
+	74, // 4: contractpro.auth.v1.GetMeResponse.roles:type_name -> contractpro.auth.v1.Role
+	75, // 5: contractpro.auth.v1.GetMeResponse.permissions:type_name -> contractpro.auth.v1.Permission
+	76, // 6: contractpro.auth.v1.GetMeResponse.assigned_clients:type_name -> contractpro.auth.v1.AssignedClient
+	0,  // 7: contractpro.auth.v1.GetMeResponse.principal_type:type_name -> contractpro.auth.v1.UserType
+	3,  // 8: contractpro.auth.v1.SignupClientRequest.signature_mode:type_name -> contractpro.auth.v1.ESignMode
+	2,  // 9: contractpro.auth.v1.SignupClientResponse.state:type_name -> contractpro.auth.v1.ClientStatus
+	2,  // 10: contractpro.auth.v1.VerifySignupResponse.state:type_name -> contractpro.auth.v1.ClientStatus
+	79, // 11: contractpro.auth.v1.UpdateMeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	71, // 12: contractpro.auth.v1.UpdateMeResponse.user:type_name -> contractpro.auth.v1.ClientUser
+	71, // 13: contractpro.auth.v1.ConfirmMyEmailChangeResponse.user:type_name -> contractpro.auth.v1.ClientUser
+	1,  // 14: contractpro.auth.v1.ListClientUsersRequest.state:type_name -> contractpro.auth.v1.UserStatus
+	71, // 15: contractpro.auth.v1.ListClientUsersResponse.users:type_name -> contractpro.auth.v1.ClientUser
+	71, // 16: contractpro.auth.v1.GetClientUserResponse.user:type_name -> contractpro.auth.v1.ClientUser
+	71, // 17: contractpro.auth.v1.CreateClientUserResponse.user:type_name -> contractpro.auth.v1.ClientUser
+	79, // 18: contractpro.auth.v1.UpdateClientUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 19: contractpro.auth.v1.UpdateClientUserRequest.state:type_name -> contractpro.auth.v1.UserStatus
+	71, // 20: contractpro.auth.v1.UpdateClientUserResponse.user:type_name -> contractpro.auth.v1.ClientUser
+	1,  // 21: contractpro.auth.v1.ExportClientUsersRequest.state:type_name -> contractpro.auth.v1.UserStatus
+	69, // 22: contractpro.auth.v1.ListServiceAccountsResponse.service_accounts:type_name -> contractpro.auth.v1.ServiceAccount
+	69, // 23: contractpro.auth.v1.CreateServiceAccountResponse.service_account:type_name -> contractpro.auth.v1.ServiceAccount
+	70, // 24: contractpro.auth.v1.ListApiKeysResponse.api_keys:type_name -> contractpro.auth.v1.ApiKey
+	70, // 25: contractpro.auth.v1.CreateApiKeyResponse.api_key:type_name -> contractpro.auth.v1.ApiKey
+	70, // 26: contractpro.auth.v1.RotateApiKeyResponse.api_key:type_name -> contractpro.auth.v1.ApiKey
+	77, // 27: contractpro.auth.v1.ListIpAllowlistEntriesResponse.entries:type_name -> contractpro.auth.v1.IpAllowlistEntry
+	77, // 28: contractpro.auth.v1.AddIpAllowlistEntryResponse.entry:type_name -> contractpro.auth.v1.IpAllowlistEntry
+	78, // 29: contractpro.auth.v1.ListIdentityProvidersResponse.identity_providers:type_name -> contractpro.auth.v1.IdentityProvider
+	78, // 30: contractpro.auth.v1.CreateIdentityProviderResponse.identity_provider:type_name -> contractpro.auth.v1.IdentityProvider
+	78, // 31: contractpro.auth.v1.UpdateIdentityProviderResponse.identity_provider:type_name -> contractpro.auth.v1.IdentityProvider
+	1,  // 32: contractpro.auth.v1.ClientUser.state:type_name -> contractpro.auth.v1.UserStatus
+	1,  // 33: contractpro.auth.v1.Operator.state:type_name -> contractpro.auth.v1.UserStatus
+	2,  // 34: contractpro.auth.v1.Tenant.state:type_name -> contractpro.auth.v1.ClientStatus
+	3,  // 35: contractpro.auth.v1.Tenant.signature_mode:type_name -> contractpro.auth.v1.ESignMode
+	73, // 36: contractpro.auth.v1.AssignedClient.tenant:type_name -> contractpro.auth.v1.Tenant
+	4,  // 37: contractpro.auth.v1.AssignedClient.operator_role:type_name -> contractpro.auth.v1.OperatorRole
+	5,  // 38: contractpro.auth.v1.AuthService.GetMe:input_type -> contractpro.auth.v1.GetMeRequest
+	7,  // 39: contractpro.auth.v1.AuthService.SignupClient:input_type -> contractpro.auth.v1.SignupClientRequest
+	9,  // 40: contractpro.auth.v1.AuthService.VerifySignup:input_type -> contractpro.auth.v1.VerifySignupRequest
+	11, // 41: contractpro.auth.v1.AuthService.UpdateMe:input_type -> contractpro.auth.v1.UpdateMeRequest
+	13, // 42: contractpro.auth.v1.AuthService.ChangeMyPassword:input_type -> contractpro.auth.v1.ChangeMyPasswordRequest
+	15, // 43: contractpro.auth.v1.AuthService.ChangeMyEmail:input_type -> contractpro.auth.v1.ChangeMyEmailRequest
+	17, // 44: contractpro.auth.v1.AuthService.ConfirmMyEmailChange:input_type -> contractpro.auth.v1.ConfirmMyEmailChangeRequest
+	19, // 45: contractpro.auth.v1.AuthService.ListClientUsers:input_type -> contractpro.auth.v1.ListClientUsersRequest
+	21, // 46: contractpro.auth.v1.AuthService.GetClientUser:input_type -> contractpro.auth.v1.GetClientUserRequest
+	23, // 47: contractpro.auth.v1.AuthService.CreateClientUser:input_type -> contractpro.auth.v1.CreateClientUserRequest
+	25, // 48: contractpro.auth.v1.AuthService.UpdateClientUser:input_type -> contractpro.auth.v1.UpdateClientUserRequest
+	27, // 49: contractpro.auth.v1.AuthService.DeleteClientUser:input_type -> contractpro.auth.v1.DeleteClientUserRequest
+	29, // 50: contractpro.auth.v1.AuthService.ExportClientUsers:input_type -> contractpro.auth.v1.ExportClientUsersRequest
+	31, // 51: contractpro.auth.v1.AuthService.Logout:input_type -> contractpro.auth.v1.LogoutRequest
+	33, // 52: contractpro.auth.v1.AuthService.ForceLogout:input_type -> contractpro.auth.v1.ForceLogoutRequest
+	35, // 53: contractpro.auth.v1.AuthService.ForceLogoutTenant:input_type -> contractpro.auth.v1.ForceLogoutTenantRequest
+	37, // 54: contractpro.auth.v1.AuthService.CreateScimToken:input_type -> contractpro.auth.v1.CreateScimTokenRequest
+	39, // 55: contractpro.auth.v1.AuthService.RevokeScimToken:input_type -> contractpro.auth.v1.RevokeScimTokenRequest
+	41, // 56: contractpro.auth.v1.AuthService.ListServiceAccounts:input_type -> contractpro.auth.v1.ListServiceAccountsRequest
+	43, // 57: contractpro.auth.v1.AuthService.CreateServiceAccount:input_type -> contractpro.auth.v1.CreateServiceAccountRequest
+	45, // 58: contractpro.auth.v1.AuthService.DeleteServiceAccount:input_type -> contractpro.auth.v1.DeleteServiceAccountRequest
+	47, // 59: contractpro.auth.v1.AuthService.ListApiKeys:input_type -> contractpro.auth.v1.ListApiKeysRequest
+	49, // 60: contractpro.auth.v1.AuthService.CreateApiKey:input_type -> contractpro.auth.v1.CreateApiKeyRequest
+	51, // 61: contractpro.auth.v1.AuthService.RotateApiKey:input_type -> contractpro.auth.v1.RotateApiKeyRequest
+	53, // 62: contractpro.auth.v1.AuthService.RevokeApiKey:input_type -> contractpro.auth.v1.RevokeApiKeyRequest
+	55, // 63: contractpro.auth.v1.AuthService.ListIpAllowlistEntries:input_type -> contractpro.auth.v1.ListIpAllowlistEntriesRequest
+	57, // 64: contractpro.auth.v1.AuthService.AddIpAllowlistEntry:input_type -> contractpro.auth.v1.AddIpAllowlistEntryRequest
+	59, // 65: contractpro.auth.v1.AuthService.RemoveIpAllowlistEntry:input_type -> contractpro.auth.v1.RemoveIpAllowlistEntryRequest
+	61, // 66: contractpro.auth.v1.AuthService.ListIdentityProviders:input_type -> contractpro.auth.v1.ListIdentityProvidersRequest
+	63, // 67: contractpro.auth.v1.AuthService.CreateIdentityProvider:input_type -> contractpro.auth.v1.CreateIdentityProviderRequest
+	65, // 68: contractpro.auth.v1.AuthService.UpdateIdentityProvider:input_type -> contractpro.auth.v1.UpdateIdentityProviderRequest
+	67, // 69: contractpro.auth.v1.AuthService.DeleteIdentityProvider:input_type -> contractpro.auth.v1.DeleteIdentityProviderRequest
+	6,  // 70: contractpro.auth.v1.AuthService.GetMe:output_type -> contractpro.auth.v1.GetMeResponse
+	8,  // 71: contractpro.auth.v1.AuthService.SignupClient:output_type -> contractpro.auth.v1.SignupClientResponse
+	10, // 72: contractpro.auth.v1.AuthService.VerifySignup:output_type -> contractpro.auth.v1.VerifySignupResponse
+	12, // 73: contractpro.auth.v1.AuthService.UpdateMe:output_type -> contractpro.auth.v1.UpdateMeResponse
+	14, // 74: contractpro.auth.v1.AuthService.ChangeMyPassword:output_type -> contractpro.auth.v1.ChangeMyPasswordResponse
+	16, // 75: contractpro.auth.v1.AuthService.ChangeMyEmail:output_type -> contractpro.auth.v1.ChangeMyEmailResponse
+	18, // 76: contractpro.auth.v1.AuthService.ConfirmMyEmailChange:output_type -> contractpro.auth.v1.ConfirmMyEmailChangeResponse
+	20, // 77: contractpro.auth.v1.AuthService.ListClientUsers:output_type -> contractpro.auth.v1.ListClientUsersResponse
+	22, // 78: contractpro.auth.v1.AuthService.GetClientUser:output_type -> contractpro.auth.v1.GetClientUserResponse
+	24, // 79: contractpro.auth.v1.AuthService.CreateClientUser:output_type -> contractpro.auth.v1.CreateClientUserResponse
+	26, // 80: contractpro.auth.v1.AuthService.UpdateClientUser:output_type -> contractpro.auth.v1.UpdateClientUserResponse
+	28, // 81: contractpro.auth.v1.AuthService.DeleteClientUser:output_type -> contractpro.auth.v1.DeleteClientUserResponse
+	30, // 82: contractpro.auth.v1.AuthService.ExportClientUsers:output_type -> contractpro.auth.v1.ExportClientUsersResponse
+	32, // 83: contractpro.auth.v1.AuthService.Logout:output_type -> contractpro.auth.v1.LogoutResponse
+	34, // 84: contractpro.auth.v1.AuthService.ForceLogout:output_type -> contractpro.auth.v1.ForceLogoutResponse
+	36, // 85: contractpro.auth.v1.AuthService.ForceLogoutTenant:output_type -> contractpro.auth.v1.ForceLogoutTenantResponse
+	38, // 86: contractpro.auth.v1.AuthService.CreateScimToken:output_type -> contractpro.auth.v1.CreateScimTokenResponse
+	40, // 87: contractpro.auth.v1.AuthService.RevokeScimToken:output_type -> contractpro.auth.v1.RevokeScimTokenResponse
+	42, // 88: contractpro.auth.v1.AuthService.ListServiceAccounts:output_type -> contractpro.auth.v1.ListServiceAccountsResponse
+	44, // 89: contractpro.auth.v1.AuthService.CreateServiceAccount:output_type -> contractpro.auth.v1.CreateServiceAccountResponse
+	46, // 90: contractpro.auth.v1.AuthService.DeleteServiceAccount:output_type -> contractpro.auth.v1.DeleteServiceAccountResponse
+	48, // 91: contractpro.auth.v1.AuthService.ListApiKeys:output_type -> contractpro.auth.v1.ListApiKeysResponse
+	50, // 92: contractpro.auth.v1.AuthService.CreateApiKey:output_type -> contractpro.auth.v1.CreateApiKeyResponse
+	52, // 93: contractpro.auth.v1.AuthService.RotateApiKey:output_type -> contractpro.auth.v1.RotateApiKeyResponse
+	54, // 94: contractpro.auth.v1.AuthService.RevokeApiKey:output_type -> contractpro.auth.v1.RevokeApiKeyResponse
+	56, // 95: contractpro.auth.v1.AuthService.ListIpAllowlistEntries:output_type -> contractpro.auth.v1.ListIpAllowlistEntriesResponse
+	58, // 96: contractpro.auth.v1.AuthService.AddIpAllowlistEntry:output_type -> contractpro.auth.v1.AddIpAllowlistEntryResponse
+	60, // 97: contractpro.auth.v1.AuthService.RemoveIpAllowlistEntry:output_type -> contractpro.auth.v1.RemoveIpAllowlistEntryResponse
+	62, // 98: contractpro.auth.v1.AuthService.ListIdentityProviders:output_type -> contractpro.auth.v1.ListIdentityProvidersResponse
+	64, // 99: contractpro.auth.v1.AuthService.CreateIdentityProvider:output_type -> contractpro.auth.v1.CreateIdentityProviderResponse
+	66, // 100: contractpro.auth.v1.AuthService.UpdateIdentityProvider:output_type -> contractpro.auth.v1.UpdateIdentityProviderResponse
+	68, // 101: contractpro.auth.v1.AuthService.DeleteIdentityProvider:output_type -> contractpro.auth.v1.DeleteIdentityProviderResponse
+	70, // [70:102] is the sub-list for method output_type
+	38, // [38:70] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_proto_contractpro_auth_v1_auth_proto_init() }
+func file_proto_contractpro_auth_v1_auth_proto_init() {
+	if File_proto_contractpro_auth_v1_auth_proto != nil {
+		return
+	}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[6].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[18].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[20].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[32].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[33].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[38].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[44].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[46].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[52].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[58].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[60].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[64].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[65].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[66].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[67].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[72].OneofWrappers = []any{}
+	file_proto_contractpro_auth_v1_auth_proto_msgTypes[73].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_contractpro_auth_v1_auth_proto_rawDesc), len(file_proto_contractpro_auth_v1_auth_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   74,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_contractpro_auth_v1_auth_proto_goTypes,
+		DependencyIndexes: file_proto_contractpro_auth_v1_auth_proto_depIdxs,
+		EnumInfos:         file_proto_contractpro_auth_v1_auth_proto_enumTypes,
+		MessageInfos:      file_proto_contractpro_auth_v1_auth_proto_msgTypes,
+	}.Build()
+	File_proto_contractpro_auth_v1_auth_proto = out.File
+	file_proto_contractpro_auth_v1_auth_proto_goTypes = nil
+	file_proto_contractpro_auth_v1_auth_proto_depIdxs = nil
+}
@@ -0,0 +1,1393 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: proto/contractpro/auth/v1/auth.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuthService_GetMe_FullMethodName                  = "/contractpro.auth.v1.AuthService/GetMe"
+	AuthService_SignupClient_FullMethodName           = "/contractpro.auth.v1.AuthService/SignupClient"
+	AuthService_VerifySignup_FullMethodName           = "/contractpro.auth.v1.AuthService/VerifySignup"
+	AuthService_UpdateMe_FullMethodName               = "/contractpro.auth.v1.AuthService/UpdateMe"
+	AuthService_ChangeMyPassword_FullMethodName       = "/contractpro.auth.v1.AuthService/ChangeMyPassword"
+	AuthService_ChangeMyEmail_FullMethodName          = "/contractpro.auth.v1.AuthService/ChangeMyEmail"
+	AuthService_ConfirmMyEmailChange_FullMethodName   = "/contractpro.auth.v1.AuthService/ConfirmMyEmailChange"
+	AuthService_ListClientUsers_FullMethodName        = "/contractpro.auth.v1.AuthService/ListClientUsers"
+	AuthService_GetClientUser_FullMethodName          = "/contractpro.auth.v1.AuthService/GetClientUser"
+	AuthService_CreateClientUser_FullMethodName       = "/contractpro.auth.v1.AuthService/CreateClientUser"
+	AuthService_UpdateClientUser_FullMethodName       = "/contractpro.auth.v1.AuthService/UpdateClientUser"
+	AuthService_DeleteClientUser_FullMethodName       = "/contractpro.auth.v1.AuthService/DeleteClientUser"
+	AuthService_ExportClientUsers_FullMethodName      = "/contractpro.auth.v1.AuthService/ExportClientUsers"
+	AuthService_Logout_FullMethodName                 = "/contractpro.auth.v1.AuthService/Logout"
+	AuthService_ForceLogout_FullMethodName            = "/contractpro.auth.v1.AuthService/ForceLogout"
+	AuthService_ForceLogoutTenant_FullMethodName      = "/contractpro.auth.v1.AuthService/ForceLogoutTenant"
+	AuthService_CreateScimToken_FullMethodName        = "/contractpro.auth.v1.AuthService/CreateScimToken"
+	AuthService_RevokeScimToken_FullMethodName        = "/contractpro.auth.v1.AuthService/RevokeScimToken"
+	AuthService_ListServiceAccounts_FullMethodName    = "/contractpro.auth.v1.AuthService/ListServiceAccounts"
+	AuthService_CreateServiceAccount_FullMethodName   = "/contractpro.auth.v1.AuthService/CreateServiceAccount"
+	AuthService_DeleteServiceAccount_FullMethodName   = "/contractpro.auth.v1.AuthService/DeleteServiceAccount"
+	AuthService_ListApiKeys_FullMethodName            = "/contractpro.auth.v1.AuthService/ListApiKeys"
+	AuthService_CreateApiKey_FullMethodName           = "/contractpro.auth.v1.AuthService/CreateApiKey"
+	AuthService_RotateApiKey_FullMethodName           = "/contractpro.auth.v1.AuthService/RotateApiKey"
+	AuthService_RevokeApiKey_FullMethodName           = "/contractpro.auth.v1.AuthService/RevokeApiKey"
+	AuthService_ListIpAllowlistEntries_FullMethodName = "/contractpro.auth.v1.AuthService/ListIpAllowlistEntries"
+	AuthService_AddIpAllowlistEntry_FullMethodName    = "/contractpro.auth.v1.AuthService/AddIpAllowlistEntry"
+	AuthService_RemoveIpAllowlistEntry_FullMethodName = "/contractpro.auth.v1.AuthService/RemoveIpAllowlistEntry"
+	AuthService_ListIdentityProviders_FullMethodName  = "/contractpro.auth.v1.AuthService/ListIdentityProviders"
+	AuthService_CreateIdentityProvider_FullMethodName = "/contractpro.auth.v1.AuthService/CreateIdentityProvider"
+	AuthService_UpdateIdentityProvider_FullMethodName = "/contractpro.auth.v1.AuthService/UpdateIdentityProvider"
+	AuthService_DeleteIdentityProvider_FullMethodName = "/contractpro.auth.v1.AuthService/DeleteIdentityProvider"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuthService 認証サービス
+// 移行期間中は旧サービス名（auth.AuthService）でも同じ実装を提供する（メッセージのワイヤーフォーマットは同じ）
+type AuthServiceClient interface {
+	// GetMe 現在のユーザー情報を取得（認証必要、プロフィール・クライアント・ロール・実効権限を含む）
+	GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error)
+	// SignupClient サービス利用開始時のアカウント登録（認証不要）
+	// 新規クライアント（企業）を登録し、同時にそのクライアントの管理者権限を持つユーザーを作成
+	// クライアントは登録確認待ち（PENDING_VERIFICATION）で作成され、管理者メールアドレスに登録確認トークンを送信する
+	// 登録確認待ちのslugに同じ管理者メールアドレスで再度登録した場合は、確認トークンを再発行して再送する（確認メールの送信失敗時の再試行用）
+	SignupClient(ctx context.Context, in *SignupClientRequest, opts ...grpc.CallOption) (*SignupClientResponse, error)
+	// VerifySignup 登録確認トークンを検証し、クライアントと管理者ユーザーを有効化（認証不要）
+	VerifySignup(ctx context.Context, in *VerifySignupRequest, opts ...grpc.CallOption) (*VerifySignupResponse, error)
+	// セルフサービス（自分自身の情報の変更、users:WRITE権限は不要）
+	// UpdateMe 自分のプロフィール更新（認証必要、メールアドレス・ステータス・ロールは変更不可）
+	UpdateMe(ctx context.Context, in *UpdateMeRequest, opts ...grpc.CallOption) (*UpdateMeResponse, error)
+	// ChangeMyPassword 自分のパスワード変更（認証必要、変更後は発行済みトークンをすべて失効）
+	ChangeMyPassword(ctx context.Context, in *ChangeMyPasswordRequest, opts ...grpc.CallOption) (*ChangeMyPasswordResponse, error)
+	// ChangeMyEmail 自分のメールアドレス変更を申請（認証必要、変更後のメールアドレスに確認トークンを送信）
+	ChangeMyEmail(ctx context.Context, in *ChangeMyEmailRequest, opts ...grpc.CallOption) (*ChangeMyEmailResponse, error)
+	// ConfirmMyEmailChange メールアドレス変更の確認トークンを検証し、変更を確定（認証不要）
+	ConfirmMyEmailChange(ctx context.Context, in *ConfirmMyEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmMyEmailChangeResponse, error)
+	// クライアントユーザー管理
+	// ListClientUsers クライアントユーザー一覧取得（認証必要、権限: users:READ）
+	ListClientUsers(ctx context.Context, in *ListClientUsersRequest, opts ...grpc.CallOption) (*ListClientUsersResponse, error)
+	// GetClientUser クライアントユーザー詳細取得（認証必要、権限: users:READ）
+	GetClientUser(ctx context.Context, in *GetClientUserRequest, opts ...grpc.CallOption) (*GetClientUserResponse, error)
+	// CreateClientUser クライアントユーザー作成（認証必要、権限: users:WRITE）
+	CreateClientUser(ctx context.Context, in *CreateClientUserRequest, opts ...grpc.CallOption) (*CreateClientUserResponse, error)
+	// UpdateClientUser クライアントユーザー更新（認証必要、権限: users:WRITE）
+	UpdateClientUser(ctx context.Context, in *UpdateClientUserRequest, opts ...grpc.CallOption) (*UpdateClientUserResponse, error)
+	// DeleteClientUser クライアントユーザー削除（認証必要、権限: users:DELETE）
+	DeleteClientUser(ctx context.Context, in *DeleteClientUserRequest, opts ...grpc.CallOption) (*DeleteClientUserResponse, error)
+	// ExportClientUsers クライアントユーザーと有効なロールをCSV/XLSXでエクスポート（認証必要、権限: users:READ、サーバーストリーミング）
+	ExportClientUsers(ctx context.Context, in *ExportClientUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportClientUsersResponse], error)
+	// セッション管理（トークン失効）
+	// Logout 現在のトークンを失効（認証必要）
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// ForceLogout 指定したクライアントユーザーの発行済みトークンをすべて失効（認証必要、権限: users:WRITE）
+	ForceLogout(ctx context.Context, in *ForceLogoutRequest, opts ...grpc.CallOption) (*ForceLogoutResponse, error)
+	// ForceLogoutTenant クライアント内の全ユーザーの発行済みトークンを失効（認証必要、権限: system_settings:WRITE、実行したユーザー自身も含む）
+	ForceLogoutTenant(ctx context.Context, in *ForceLogoutTenantRequest, opts ...grpc.CallOption) (*ForceLogoutTenantResponse, error)
+	// SCIMプロビジョニング
+	// CreateScimToken SCIMトークン発行（認証必要、権限: system_settings:WRITE）
+	CreateScimToken(ctx context.Context, in *CreateScimTokenRequest, opts ...grpc.CallOption) (*CreateScimTokenResponse, error)
+	// RevokeScimToken SCIMトークン取り消し（認証必要、権限: system_settings:WRITE）
+	RevokeScimToken(ctx context.Context, in *RevokeScimTokenRequest, opts ...grpc.CallOption) (*RevokeScimTokenResponse, error)
+	// サービスアカウント・APIキー（システム間連携用）
+	// ListServiceAccounts サービスアカウント一覧取得（認証必要、権限: system_settings:READ）
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	// CreateServiceAccount サービスアカウント作成（認証必要、権限: system_settings:WRITE）
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	// DeleteServiceAccount サービスアカウント削除（認証必要、権限: system_settings:WRITE、発行済みAPIキーも取り消し）
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error)
+	// ListApiKeys APIキー一覧取得（認証必要、権限: system_settings:READ）
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	// CreateApiKey APIキー発行（認証必要、権限: system_settings:WRITE）
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	// RotateApiKey APIキーのローテーション（認証必要、権限: system_settings:WRITE）
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error)
+	// RevokeApiKey APIキー取り消し（認証必要、権限: system_settings:WRITE）
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	// IPアドレス許可リスト（エントリが1件以上あるクライアントは、許可されたアドレスからのみアクセス可能）
+	// ListIpAllowlistEntries 許可リスト取得（認証必要、権限: system_settings:READ）
+	ListIpAllowlistEntries(ctx context.Context, in *ListIpAllowlistEntriesRequest, opts ...grpc.CallOption) (*ListIpAllowlistEntriesResponse, error)
+	// AddIpAllowlistEntry 許可リストにアドレス範囲を追加（認証必要、権限: system_settings:WRITE）
+	AddIpAllowlistEntry(ctx context.Context, in *AddIpAllowlistEntryRequest, opts ...grpc.CallOption) (*AddIpAllowlistEntryResponse, error)
+	// RemoveIpAllowlistEntry 許可リストからエントリを削除（認証必要、権限: system_settings:WRITE）
+	RemoveIpAllowlistEntry(ctx context.Context, in *RemoveIpAllowlistEntryRequest, opts ...grpc.CallOption) (*RemoveIpAllowlistEntryResponse, error)
+	// 外部IdP（OIDC）設定（登録したIdPが発行したJWTで、クライアントユーザーとして認証できる）
+	// ListIdentityProviders IdP設定一覧取得（認証必要、権限: system_settings:READ）
+	ListIdentityProviders(ctx context.Context, in *ListIdentityProvidersRequest, opts ...grpc.CallOption) (*ListIdentityProvidersResponse, error)
+	// CreateIdentityProvider IdP設定登録（認証必要、権限: system_settings:WRITE）
+	CreateIdentityProvider(ctx context.Context, in *CreateIdentityProviderRequest, opts ...grpc.CallOption) (*CreateIdentityProviderResponse, error)
+	// UpdateIdentityProvider IdP設定更新（認証必要、権限: system_settings:WRITE、issuerは変更不可）
+	UpdateIdentityProvider(ctx context.Context, in *UpdateIdentityProviderRequest, opts ...grpc.CallOption) (*UpdateIdentityProviderResponse, error)
+	// DeleteIdentityProvider IdP設定削除（認証必要、権限: system_settings:WRITE、紐付け済みの外部IDも削除）
+	DeleteIdentityProvider(ctx context.Context, in *DeleteIdentityProviderRequest, opts ...grpc.CallOption) (*DeleteIdentityProviderResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMeResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SignupClient(ctx context.Context, in *SignupClientRequest, opts ...grpc.CallOption) (*SignupClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignupClientResponse)
+	err := c.cc.Invoke(ctx, AuthService_SignupClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifySignup(ctx context.Context, in *VerifySignupRequest, opts ...grpc.CallOption) (*VerifySignupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySignupResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifySignup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateMe(ctx context.Context, in *UpdateMeRequest, opts ...grpc.CallOption) (*UpdateMeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMeResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangeMyPassword(ctx context.Context, in *ChangeMyPasswordRequest, opts ...grpc.CallOption) (*ChangeMyPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeMyPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangeMyPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangeMyEmail(ctx context.Context, in *ChangeMyEmailRequest, opts ...grpc.CallOption) (*ChangeMyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeMyEmailResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangeMyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmMyEmailChange(ctx context.Context, in *ConfirmMyEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmMyEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmMyEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmMyEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListClientUsers(ctx context.Context, in *ListClientUsersRequest, opts ...grpc.CallOption) (*ListClientUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClientUsersResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListClientUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetClientUser(ctx context.Context, in *GetClientUserRequest, opts ...grpc.CallOption) (*GetClientUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClientUserResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetClientUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateClientUser(ctx context.Context, in *CreateClientUserRequest, opts ...grpc.CallOption) (*CreateClientUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClientUserResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateClientUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateClientUser(ctx context.Context, in *UpdateClientUserRequest, opts ...grpc.CallOption) (*UpdateClientUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateClientUserResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateClientUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteClientUser(ctx context.Context, in *DeleteClientUserRequest, opts ...grpc.CallOption) (*DeleteClientUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteClientUserResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteClientUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ExportClientUsers(ctx context.Context, in *ExportClientUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportClientUsersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AuthService_ServiceDesc.Streams[0], AuthService_ExportClientUsers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportClientUsersRequest, ExportClientUsersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_ExportClientUsersClient = grpc.ServerStreamingClient[ExportClientUsersResponse]
+
+func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ForceLogout(ctx context.Context, in *ForceLogoutRequest, opts ...grpc.CallOption) (*ForceLogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceLogoutResponse)
+	err := c.cc.Invoke(ctx, AuthService_ForceLogout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ForceLogoutTenant(ctx context.Context, in *ForceLogoutTenantRequest, opts ...grpc.CallOption) (*ForceLogoutTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceLogoutTenantResponse)
+	err := c.cc.Invoke(ctx, AuthService_ForceLogoutTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateScimToken(ctx context.Context, in *CreateScimTokenRequest, opts ...grpc.CallOption) (*CreateScimTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScimTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateScimToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeScimToken(ctx context.Context, in *RevokeScimTokenRequest, opts ...grpc.CallOption) (*RevokeScimTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeScimTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeScimToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteServiceAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*RotateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateApiKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_RotateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListIpAllowlistEntries(ctx context.Context, in *ListIpAllowlistEntriesRequest, opts ...grpc.CallOption) (*ListIpAllowlistEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIpAllowlistEntriesResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListIpAllowlistEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AddIpAllowlistEntry(ctx context.Context, in *AddIpAllowlistEntryRequest, opts ...grpc.CallOption) (*AddIpAllowlistEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddIpAllowlistEntryResponse)
+	err := c.cc.Invoke(ctx, AuthService_AddIpAllowlistEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RemoveIpAllowlistEntry(ctx context.Context, in *RemoveIpAllowlistEntryRequest, opts ...grpc.CallOption) (*RemoveIpAllowlistEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveIpAllowlistEntryResponse)
+	err := c.cc.Invoke(ctx, AuthService_RemoveIpAllowlistEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListIdentityProviders(ctx context.Context, in *ListIdentityProvidersRequest, opts ...grpc.CallOption) (*ListIdentityProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentityProvidersResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListIdentityProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateIdentityProvider(ctx context.Context, in *CreateIdentityProviderRequest, opts ...grpc.CallOption) (*CreateIdentityProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateIdentityProviderResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateIdentityProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateIdentityProvider(ctx context.Context, in *UpdateIdentityProviderRequest, opts ...grpc.CallOption) (*UpdateIdentityProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateIdentityProviderResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateIdentityProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteIdentityProvider(ctx context.Context, in *DeleteIdentityProviderRequest, opts ...grpc.CallOption) (*DeleteIdentityProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteIdentityProviderResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteIdentityProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility.
+//
+// AuthService 認証サービス
+// 移行期間中は旧サービス名（auth.AuthService）でも同じ実装を提供する（メッセージのワイヤーフォーマットは同じ）
+type AuthServiceServer interface {
+	// GetMe 現在のユーザー情報を取得（認証必要、プロフィール・クライアント・ロール・実効権限を含む）
+	GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error)
+	// SignupClient サービス利用開始時のアカウント登録（認証不要）
+	// 新規クライアント（企業）を登録し、同時にそのクライアントの管理者権限を持つユーザーを作成
+	// クライアントは登録確認待ち（PENDING_VERIFICATION）で作成され、管理者メールアドレスに登録確認トークンを送信する
+	// 登録確認待ちのslugに同じ管理者メールアドレスで再度登録した場合は、確認トークンを再発行して再送する（確認メールの送信失敗時の再試行用）
+	SignupClient(context.Context, *SignupClientRequest) (*SignupClientResponse, error)
+	// VerifySignup 登録確認トークンを検証し、クライアントと管理者ユーザーを有効化（認証不要）
+	VerifySignup(context.Context, *VerifySignupRequest) (*VerifySignupResponse, error)
+	// セルフサービス（自分自身の情報の変更、users:WRITE権限は不要）
+	// UpdateMe 自分のプロフィール更新（認証必要、メールアドレス・ステータス・ロールは変更不可）
+	UpdateMe(context.Context, *UpdateMeRequest) (*UpdateMeResponse, error)
+	// ChangeMyPassword 自分のパスワード変更（認証必要、変更後は発行済みトークンをすべて失効）
+	ChangeMyPassword(context.Context, *ChangeMyPasswordRequest) (*ChangeMyPasswordResponse, error)
+	// ChangeMyEmail 自分のメールアドレス変更を申請（認証必要、変更後のメールアドレスに確認トークンを送信）
+	ChangeMyEmail(context.Context, *ChangeMyEmailRequest) (*ChangeMyEmailResponse, error)
+	// ConfirmMyEmailChange メールアドレス変更の確認トークンを検証し、変更を確定（認証不要）
+	ConfirmMyEmailChange(context.Context, *ConfirmMyEmailChangeRequest) (*ConfirmMyEmailChangeResponse, error)
+	// クライアントユーザー管理
+	// ListClientUsers クライアントユーザー一覧取得（認証必要、権限: users:READ）
+	ListClientUsers(context.Context, *ListClientUsersRequest) (*ListClientUsersResponse, error)
+	// GetClientUser クライアントユーザー詳細取得（認証必要、権限: users:READ）
+	GetClientUser(context.Context, *GetClientUserRequest) (*GetClientUserResponse, error)
+	// CreateClientUser クライアントユーザー作成（認証必要、権限: users:WRITE）
+	CreateClientUser(context.Context, *CreateClientUserRequest) (*CreateClientUserResponse, error)
+	// UpdateClientUser クライアントユーザー更新（認証必要、権限: users:WRITE）
+	UpdateClientUser(context.Context, *UpdateClientUserRequest) (*UpdateClientUserResponse, error)
+	// DeleteClientUser クライアントユーザー削除（認証必要、権限: users:DELETE）
+	DeleteClientUser(context.Context, *DeleteClientUserRequest) (*DeleteClientUserResponse, error)
+	// ExportClientUsers クライアントユーザーと有効なロールをCSV/XLSXでエクスポート（認証必要、権限: users:READ、サーバーストリーミング）
+	ExportClientUsers(*ExportClientUsersRequest, grpc.ServerStreamingServer[ExportClientUsersResponse]) error
+	// セッション管理（トークン失効）
+	// Logout 現在のトークンを失効（認証必要）
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// ForceLogout 指定したクライアントユーザーの発行済みトークンをすべて失効（認証必要、権限: users:WRITE）
+	ForceLogout(context.Context, *ForceLogoutRequest) (*ForceLogoutResponse, error)
+	// ForceLogoutTenant クライアント内の全ユーザーの発行済みトークンを失効（認証必要、権限: system_settings:WRITE、実行したユーザー自身も含む）
+	ForceLogoutTenant(context.Context, *ForceLogoutTenantRequest) (*ForceLogoutTenantResponse, error)
+	// SCIMプロビジョニング
+	// CreateScimToken SCIMトークン発行（認証必要、権限: system_settings:WRITE）
+	CreateScimToken(context.Context, *CreateScimTokenRequest) (*CreateScimTokenResponse, error)
+	// RevokeScimToken SCIMトークン取り消し（認証必要、権限: system_settings:WRITE）
+	RevokeScimToken(context.Context, *RevokeScimTokenRequest) (*RevokeScimTokenResponse, error)
+	// サービスアカウント・APIキー（システム間連携用）
+	// ListServiceAccounts サービスアカウント一覧取得（認証必要、権限: system_settings:READ）
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	// CreateServiceAccount サービスアカウント作成（認証必要、権限: system_settings:WRITE）
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	// DeleteServiceAccount サービスアカウント削除（認証必要、権限: system_settings:WRITE、発行済みAPIキーも取り消し）
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error)
+	// ListApiKeys APIキー一覧取得（認証必要、権限: system_settings:READ）
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	// CreateApiKey APIキー発行（認証必要、権限: system_settings:WRITE）
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	// RotateApiKey APIキーのローテーション（認証必要、権限: system_settings:WRITE）
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error)
+	// RevokeApiKey APIキー取り消し（認証必要、権限: system_settings:WRITE）
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	// IPアドレス許可リスト（エントリが1件以上あるクライアントは、許可されたアドレスからのみアクセス可能）
+	// ListIpAllowlistEntries 許可リスト取得（認証必要、権限: system_settings:READ）
+	ListIpAllowlistEntries(context.Context, *ListIpAllowlistEntriesRequest) (*ListIpAllowlistEntriesResponse, error)
+	// AddIpAllowlistEntry 許可リストにアドレス範囲を追加（認証必要、権限: system_settings:WRITE）
+	AddIpAllowlistEntry(context.Context, *AddIpAllowlistEntryRequest) (*AddIpAllowlistEntryResponse, error)
+	// RemoveIpAllowlistEntry 許可リストからエントリを削除（認証必要、権限: system_settings:WRITE）
+	RemoveIpAllowlistEntry(context.Context, *RemoveIpAllowlistEntryRequest) (*RemoveIpAllowlistEntryResponse, error)
+	// 外部IdP（OIDC）設定（登録したIdPが発行したJWTで、クライアントユーザーとして認証できる）
+	// ListIdentityProviders IdP設定一覧取得（認証必要、権限: system_settings:READ）
+	ListIdentityProviders(context.Context, *ListIdentityProvidersRequest) (*ListIdentityProvidersResponse, error)
+	// CreateIdentityProvider IdP設定登録（認証必要、権限: system_settings:WRITE）
+	CreateIdentityProvider(context.Context, *CreateIdentityProviderRequest) (*CreateIdentityProviderResponse, error)
+	// UpdateIdentityProvider IdP設定更新（認証必要、権限: system_settings:WRITE、issuerは変更不可）
+	UpdateIdentityProvider(context.Context, *UpdateIdentityProviderRequest) (*UpdateIdentityProviderResponse, error)
+	// DeleteIdentityProvider IdP設定削除（認証必要、権限: system_settings:WRITE、紐付け済みの外部IDも削除）
+	DeleteIdentityProvider(context.Context, *DeleteIdentityProviderRequest) (*DeleteIdentityProviderResponse, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMe not implemented")
+}
+func (UnimplementedAuthServiceServer) SignupClient(context.Context, *SignupClientRequest) (*SignupClientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SignupClient not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifySignup(context.Context, *VerifySignupRequest) (*VerifySignupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifySignup not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateMe(context.Context, *UpdateMeRequest) (*UpdateMeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMe not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangeMyPassword(context.Context, *ChangeMyPasswordRequest) (*ChangeMyPasswordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeMyPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangeMyEmail(context.Context, *ChangeMyEmailRequest) (*ChangeMyEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeMyEmail not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmMyEmailChange(context.Context, *ConfirmMyEmailChangeRequest) (*ConfirmMyEmailChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmMyEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) ListClientUsers(context.Context, *ListClientUsersRequest) (*ListClientUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClientUsers not implemented")
+}
+func (UnimplementedAuthServiceServer) GetClientUser(context.Context, *GetClientUserRequest) (*GetClientUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClientUser not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateClientUser(context.Context, *CreateClientUserRequest) (*CreateClientUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateClientUser not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateClientUser(context.Context, *UpdateClientUserRequest) (*UpdateClientUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateClientUser not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteClientUser(context.Context, *DeleteClientUserRequest) (*DeleteClientUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteClientUser not implemented")
+}
+func (UnimplementedAuthServiceServer) ExportClientUsers(*ExportClientUsersRequest, grpc.ServerStreamingServer[ExportClientUsersResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportClientUsers not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) ForceLogout(context.Context, *ForceLogoutRequest) (*ForceLogoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceLogout not implemented")
+}
+func (UnimplementedAuthServiceServer) ForceLogoutTenant(context.Context, *ForceLogoutTenantRequest) (*ForceLogoutTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceLogoutTenant not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateScimToken(context.Context, *CreateScimTokenRequest) (*CreateScimTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateScimToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeScimToken(context.Context, *RevokeScimTokenRequest) (*RevokeScimTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeScimToken not implemented")
+}
+func (UnimplementedAuthServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedAuthServiceServer) RotateApiKey(context.Context, *RotateApiKeyRequest) (*RotateApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedAuthServiceServer) ListIpAllowlistEntries(context.Context, *ListIpAllowlistEntriesRequest) (*ListIpAllowlistEntriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIpAllowlistEntries not implemented")
+}
+func (UnimplementedAuthServiceServer) AddIpAllowlistEntry(context.Context, *AddIpAllowlistEntryRequest) (*AddIpAllowlistEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddIpAllowlistEntry not implemented")
+}
+func (UnimplementedAuthServiceServer) RemoveIpAllowlistEntry(context.Context, *RemoveIpAllowlistEntryRequest) (*RemoveIpAllowlistEntryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveIpAllowlistEntry not implemented")
+}
+func (UnimplementedAuthServiceServer) ListIdentityProviders(context.Context, *ListIdentityProvidersRequest) (*ListIdentityProvidersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIdentityProviders not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateIdentityProvider(context.Context, *CreateIdentityProviderRequest) (*CreateIdentityProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateIdentityProvider not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateIdentityProvider(context.Context, *UpdateIdentityProviderRequest) (*UpdateIdentityProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateIdentityProvider not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteIdentityProvider(context.Context, *DeleteIdentityProviderRequest) (*DeleteIdentityProviderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteIdentityProvider not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call panics, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_GetMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetMe(ctx, req.(*GetMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SignupClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignupClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignupClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignupClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignupClient(ctx, req.(*SignupClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifySignup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySignupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifySignup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifySignup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifySignup(ctx, req.(*VerifySignupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateMe(ctx, req.(*UpdateMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangeMyPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMyPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangeMyPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangeMyPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangeMyPassword(ctx, req.(*ChangeMyPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangeMyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangeMyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangeMyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangeMyEmail(ctx, req.(*ChangeMyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmMyEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMyEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmMyEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmMyEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmMyEmailChange(ctx, req.(*ConfirmMyEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListClientUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListClientUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListClientUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListClientUsers(ctx, req.(*ListClientUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetClientUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetClientUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetClientUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetClientUser(ctx, req.(*GetClientUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateClientUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateClientUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateClientUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateClientUser(ctx, req.(*CreateClientUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateClientUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateClientUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateClientUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateClientUser(ctx, req.(*UpdateClientUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteClientUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteClientUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteClientUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteClientUser(ctx, req.(*DeleteClientUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ExportClientUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportClientUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuthServiceServer).ExportClientUsers(m, &grpc.GenericServerStream[ExportClientUsersRequest, ExportClientUsersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_ExportClientUsersServer = grpc.ServerStreamingServer[ExportClientUsersResponse]
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ForceLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceLogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ForceLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ForceLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ForceLogout(ctx, req.(*ForceLogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ForceLogoutTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceLogoutTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ForceLogoutTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ForceLogoutTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ForceLogoutTenant(ctx, req.(*ForceLogoutTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScimTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateScimToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateScimToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateScimToken(ctx, req.(*CreateScimTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeScimToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeScimTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeScimToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeScimToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeScimToken(ctx, req.(*RevokeScimTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RotateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListIpAllowlistEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIpAllowlistEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListIpAllowlistEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListIpAllowlistEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListIpAllowlistEntries(ctx, req.(*ListIpAllowlistEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AddIpAllowlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIpAllowlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AddIpAllowlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_AddIpAllowlistEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AddIpAllowlistEntry(ctx, req.(*AddIpAllowlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RemoveIpAllowlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveIpAllowlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RemoveIpAllowlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RemoveIpAllowlistEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RemoveIpAllowlistEntry(ctx, req.(*RemoveIpAllowlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListIdentityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListIdentityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListIdentityProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListIdentityProviders(ctx, req.(*ListIdentityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateIdentityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIdentityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateIdentityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateIdentityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateIdentityProvider(ctx, req.(*CreateIdentityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateIdentityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIdentityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateIdentityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateIdentityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateIdentityProvider(ctx, req.(*UpdateIdentityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteIdentityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIdentityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteIdentityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteIdentityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteIdentityProvider(ctx, req.(*DeleteIdentityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "contractpro.auth.v1.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMe",
+			Handler:    _AuthService_GetMe_Handler,
+		},
+		{
+			MethodName: "SignupClient",
+			Handler:    _AuthService_SignupClient_Handler,
+		},
+		{
+			MethodName: "VerifySignup",
+			Handler:    _AuthService_VerifySignup_Handler,
+		},
+		{
+			MethodName: "UpdateMe",
+			Handler:    _AuthService_UpdateMe_Handler,
+		},
+		{
+			MethodName: "ChangeMyPassword",
+			Handler:    _AuthService_ChangeMyPassword_Handler,
+		},
+		{
+			MethodName: "ChangeMyEmail",
+			Handler:    _AuthService_ChangeMyEmail_Handler,
+		},
+		{
+			MethodName: "ConfirmMyEmailChange",
+			Handler:    _AuthService_ConfirmMyEmailChange_Handler,
+		},
+		{
+			MethodName: "ListClientUsers",
+			Handler:    _AuthService_ListClientUsers_Handler,
+		},
+		{
+			MethodName: "GetClientUser",
+			Handler:    _AuthService_GetClientUser_Handler,
+		},
+		{
+			MethodName: "CreateClientUser",
+			Handler:    _AuthService_CreateClientUser_Handler,
+		},
+		{
+			MethodName: "UpdateClientUser",
+			Handler:    _AuthService_UpdateClientUser_Handler,
+		},
+		{
+			MethodName: "DeleteClientUser",
+			Handler:    _AuthService_DeleteClientUser_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "ForceLogout",
+			Handler:    _AuthService_ForceLogout_Handler,
+		},
+		{
+			MethodName: "ForceLogoutTenant",
+			Handler:    _AuthService_ForceLogoutTenant_Handler,
+		},
+		{
+			MethodName: "CreateScimToken",
+			Handler:    _AuthService_CreateScimToken_Handler,
+		},
+		{
+			MethodName: "RevokeScimToken",
+			Handler:    _AuthService_RevokeScimToken_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _AuthService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _AuthService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _AuthService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _AuthService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _AuthService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _AuthService_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _AuthService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "ListIpAllowlistEntries",
+			Handler:    _AuthService_ListIpAllowlistEntries_Handler,
+		},
+		{
+			MethodName: "AddIpAllowlistEntry",
+			Handler:    _AuthService_AddIpAllowlistEntry_Handler,
+		},
+		{
+			MethodName: "RemoveIpAllowlistEntry",
+			Handler:    _AuthService_RemoveIpAllowlistEntry_Handler,
+		},
+		{
+			MethodName: "ListIdentityProviders",
+			Handler:    _AuthService_ListIdentityProviders_Handler,
+		},
+		{
+			MethodName: "CreateIdentityProvider",
+			Handler:    _AuthService_CreateIdentityProvider_Handler,
+		},
+		{
+			MethodName: "UpdateIdentityProvider",
+			Handler:    _AuthService_UpdateIdentityProvider_Handler,
+		},
+		{
+			MethodName: "DeleteIdentityProvider",
+			Handler:    _AuthService_DeleteIdentityProvider_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportClientUsers",
+			Handler:       _AuthService_ExportClientUsers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/contractpro/auth/v1/auth.proto",
+}
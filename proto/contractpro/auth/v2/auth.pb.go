@@ -0,0 +1,639 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: proto/contractpro/auth/v2/auth.proto
+
+package authv2
+
+import (
+	v1 "contract-pro-suite/proto/contractpro/auth/v1"
+	_ "contract-pro-suite/proto/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetClientUserRequest クライアントユーザー詳細取得リクエスト
+type GetClientUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientUserId  string                 `protobuf:"bytes,1,opt,name=client_user_id,json=clientUserId,proto3" json:"client_user_id,omitempty"` // クライアントユーザーID（UUID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientUserRequest) Reset() {
+	*x = GetClientUserRequest{}
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientUserRequest) ProtoMessage() {}
+
+func (x *GetClientUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientUserRequest.ProtoReflect.Descriptor instead.
+func (*GetClientUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v2_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetClientUserRequest) GetClientUserId() string {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return ""
+}
+
+// GetClientUserResponse クライアントユーザー詳細取得レスポンス
+type GetClientUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *ClientUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientUserResponse) Reset() {
+	*x = GetClientUserResponse{}
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientUserResponse) ProtoMessage() {}
+
+func (x *GetClientUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientUserResponse.ProtoReflect.Descriptor instead.
+func (*GetClientUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v2_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetClientUserResponse) GetUser() *ClientUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ListClientUsersRequest クライアントユーザー一覧取得リクエスト
+type ListClientUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                 // 取得件数（デフォルト: 50、最大: 100）
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`               // 前のレスポンスのnext_page_token（未指定の場合は先頭から、検索条件・並び順は前のリクエストと同じにすること）
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                                        // 氏名・メールアドレスの部分一致検索
+	Status        v1.UserStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=contractpro.auth.v1.UserStatus" json:"status,omitempty"` // ステータスで絞り込み
+	Department    string                 `protobuf:"bytes,5,opt,name=department,proto3" json:"department,omitempty"`                              // 部署で絞り込み（完全一致）
+	Position      string                 `protobuf:"bytes,6,opt,name=position,proto3" json:"position,omitempty"`                                  // 役職で絞り込み（完全一致）
+	RoleCode      string                 `protobuf:"bytes,7,opt,name=role_code,json=roleCode,proto3" json:"role_code,omitempty"`                  // 割り当て済みロールのコードで絞り込み
+	OrderBy       string                 `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                     // 並び順: name, created_at, last_active_at（" desc"で降順、デフォルト: "created_at desc"）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientUsersRequest) Reset() {
+	*x = ListClientUsersRequest{}
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientUsersRequest) ProtoMessage() {}
+
+func (x *ListClientUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListClientUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v2_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListClientUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClientUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetStatus() v1.UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v1.UserStatus(0)
+}
+
+func (x *ListClientUsersRequest) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetRoleCode() string {
+	if x != nil {
+		return x.RoleCode
+	}
+	return ""
+}
+
+func (x *ListClientUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+// ListClientUsersResponse クライアントユーザー一覧取得レスポンス
+type ListClientUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*ClientUser          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`                                        // ユーザー一覧（order_byの順）
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // 総件数
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 次のページのトークン（最後のページの場合は空）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientUsersResponse) Reset() {
+	*x = ListClientUsersResponse{}
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientUsersResponse) ProtoMessage() {}
+
+func (x *ListClientUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListClientUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v2_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListClientUsersResponse) GetUsers() []*ClientUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListClientUsersResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListClientUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ClientUser クライアントユーザー情報
+type ClientUser struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ClientUserId      string                 `protobuf:"bytes,1,opt,name=client_user_id,json=clientUserId,proto3" json:"client_user_id,omitempty"`    // クライアントユーザーID（UUID）
+	ClientId          string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                  // クライアントID（UUID）
+	Email             string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                        // メールアドレス
+	FirstName         string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`               // 名
+	LastName          string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`                  // 姓
+	Department        *string                `protobuf:"bytes,6,opt,name=department,proto3,oneof" json:"department,omitempty"`                        // 部署
+	Position          *string                `protobuf:"bytes,7,opt,name=position,proto3,oneof" json:"position,omitempty"`                            // 役職
+	Status            v1.UserStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=contractpro.auth.v1.UserStatus" json:"status,omitempty"` // ステータス
+	Settings          *structpb.Struct       `protobuf:"bytes,9,opt,name=settings,proto3" json:"settings,omitempty"`                                  // 設定
+	Roles             []*RoleSummary         `protobuf:"bytes,10,rep,name=roles,proto3" json:"roles,omitempty"`                                       // 有効なロール（コード順）
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                           // 削除日時（削除されていない場合は未設定）
+	PasswordChangedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"` // パスワード最終変更日時（未変更の場合は未設定）
+	LastLoginTime     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_login_time,json=lastLoginTime,proto3" json:"last_login_time,omitempty"`             // 最終ログイン日時（認証済みリクエストの最終日時、5分単位で記録、未ログインの場合は未設定）
+	Etag              string                 `protobuf:"bytes,16,opt,name=etag,proto3" json:"etag,omitempty"`                                                      // バージョン（v1の更新・削除時に指定する不透明な文字列、更新のたびに変わる）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ClientUser) Reset() {
+	*x = ClientUser{}
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientUser) ProtoMessage() {}
+
+func (x *ClientUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientUser.ProtoReflect.Descriptor instead.
+func (*ClientUser) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v2_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClientUser) GetClientUserId() string {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return ""
+}
+
+func (x *ClientUser) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ClientUser) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *ClientUser) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *ClientUser) GetDepartment() string {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return ""
+}
+
+func (x *ClientUser) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+func (x *ClientUser) GetStatus() v1.UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v1.UserStatus(0)
+}
+
+func (x *ClientUser) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ClientUser) GetRoles() []*RoleSummary {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ClientUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ClientUser) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ClientUser) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ClientUser) GetPasswordChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PasswordChangedAt
+	}
+	return nil
+}
+
+func (x *ClientUser) GetLastLoginTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginTime
+	}
+	return nil
+}
+
+func (x *ClientUser) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// RoleSummary 割り当て済みロールの概要
+type RoleSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"` // ロールID（UUID）
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                   // ロールコード
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                   // ロール名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleSummary) Reset() {
+	*x = RoleSummary{}
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleSummary) ProtoMessage() {}
+
+func (x *RoleSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_contractpro_auth_v2_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleSummary.ProtoReflect.Descriptor instead.
+func (*RoleSummary) Descriptor() ([]byte, []int) {
+	return file_proto_contractpro_auth_v2_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoleSummary) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *RoleSummary) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RoleSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_proto_contractpro_auth_v2_auth_proto protoreflect.FileDescriptor
+
+const file_proto_contractpro_auth_v2_auth_proto_rawDesc = "" +
+	"\n" +
+	"$proto/contractpro/auth/v2/auth.proto\x12\x13contractpro.auth.v2\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$proto/contractpro/auth/v1/auth.proto\x1a\x1dproto/validate/validate.proto\"H\n" +
+	"\x14GetClientUserRequest\x120\n" +
+	"\x0eclient_user_id\x18\x01 \x01(\tB\n" +
+	"\xc2\xf3\x18\x06\b\x01\x12\x02 \x01R\fclientUserId\"L\n" +
+	"\x15GetClientUserResponse\x123\n" +
+	"\x04user\x18\x01 \x01(\v2\x1f.contractpro.auth.v2.ClientUserR\x04user\"\xac\x02\n" +
+	"\x16ListClientUsersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\x05query\x18\x03 \x01(\tB\t\xc2\xf3\x18\x05\x12\x03\x10\xc8\x01R\x05query\x12A\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.contractpro.auth.v1.UserStatusB\b\xc2\xf3\x18\x04\x1a\x02\b\x01R\x06status\x12\x1e\n" +
+	"\n" +
+	"department\x18\x05 \x01(\tR\n" +
+	"department\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\tR\bposition\x12\x1b\n" +
+	"\trole_code\x18\a \x01(\tR\broleCode\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy\"\x99\x01\n" +
+	"\x17ListClientUsersResponse\x125\n" +
+	"\x05users\x18\x01 \x03(\v2\x1f.contractpro.auth.v2.ClientUserR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xfe\x05\n" +
+	"\n" +
+	"ClientUser\x12$\n" +
+	"\x0eclient_user_id\x18\x01 \x01(\tR\fclientUserId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x05 \x01(\tR\blastName\x12#\n" +
+	"\n" +
+	"department\x18\x06 \x01(\tH\x00R\n" +
+	"department\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\a \x01(\tH\x01R\bposition\x88\x01\x01\x127\n" +
+	"\x06status\x18\b \x01(\x0e2\x1f.contractpro.auth.v1.UserStatusR\x06status\x123\n" +
+	"\bsettings\x18\t \x01(\v2\x17.google.protobuf.StructR\bsettings\x126\n" +
+	"\x05roles\x18\n" +
+	" \x03(\v2 .contractpro.auth.v2.RoleSummaryR\x05roles\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12J\n" +
+	"\x13password_changed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x11passwordChangedAt\x12B\n" +
+	"\x0flast_login_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\rlastLoginTime\x12\x12\n" +
+	"\x04etag\x18\x10 \x01(\tR\x04etagB\r\n" +
+	"\v_departmentB\v\n" +
+	"\t_position\"N\n" +
+	"\vRoleSummary\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name2\xaa\x02\n" +
+	"\vAuthService\x12\x91\x01\n" +
+	"\rGetClientUser\x12).contractpro.auth.v2.GetClientUserRequest\x1a*.contractpro.auth.v2.GetClientUserResponse\")\x82\xd3\xe4\x93\x02#\x12!/v2/client-users/{client_user_id}\x12\x86\x01\n" +
+	"\x0fListClientUsers\x12+.contractpro.auth.v2.ListClientUsersRequest\x1a,.contractpro.auth.v2.ListClientUsersResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v2/client-usersB5Z3contract-pro-suite/proto/contractpro/auth/v2;authv2b\x06proto3"
+
+var (
+	file_proto_contractpro_auth_v2_auth_proto_rawDescOnce sync.Once
+	file_proto_contractpro_auth_v2_auth_proto_rawDescData []byte
+)
+
+func file_proto_contractpro_auth_v2_auth_proto_rawDescGZIP() []byte {
+	file_proto_contractpro_auth_v2_auth_proto_rawDescOnce.Do(func() {
+		file_proto_contractpro_auth_v2_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_contractpro_auth_v2_auth_proto_rawDesc), len(file_proto_contractpro_auth_v2_auth_proto_rawDesc)))
+	})
+	return file_proto_contractpro_auth_v2_auth_proto_rawDescData
+}
+
+var file_proto_contractpro_auth_v2_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_contractpro_auth_v2_auth_proto_goTypes = []any{
+	(*GetClientUserRequest)(nil),    // 0: contractpro.auth.v2.GetClientUserRequest
+	(*GetClientUserResponse)(nil),   // 1: contractpro.auth.v2.GetClientUserResponse
+	(*ListClientUsersRequest)(nil),  // 2: contractpro.auth.v2.ListClientUsersRequest
+	(*ListClientUsersResponse)(nil), // 3: contractpro.auth.v2.ListClientUsersResponse
+	(*ClientUser)(nil),              // 4: contractpro.auth.v2.ClientUser
+	(*RoleSummary)(nil),             // 5: contractpro.auth.v2.RoleSummary
+	(v1.UserStatus)(0),              // 6: contractpro.auth.v1.UserStatus
+	(*structpb.Struct)(nil),         // 7: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
+}
+var file_proto_contractpro_auth_v2_auth_proto_depIdxs = []int32{
+	4,  // 0: contractpro.auth.v2.GetClientUserResponse.user:type_name -> contractpro.auth.v2.ClientUser
+	6,  // 1: contractpro.auth.v2.ListClientUsersRequest.status:type_name -> contractpro.auth.v1.UserStatus
+	4,  // 2: contractpro.auth.v2.ListClientUsersResponse.users:type_name -> contractpro.auth.v2.ClientUser
+	6,  // 3: contractpro.auth.v2.ClientUser.status:type_name -> contractpro.auth.v1.UserStatus
+	7,  // 4: contractpro.auth.v2.ClientUser.settings:type_name -> google.protobuf.Struct
+	5,  // 5: contractpro.auth.v2.ClientUser.roles:type_name -> contractpro.auth.v2.RoleSummary
+	8,  // 6: contractpro.auth.v2.ClientUser.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 7: contractpro.auth.v2.ClientUser.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 8: contractpro.auth.v2.ClientUser.deleted_at:type_name -> google.protobuf.Timestamp
+	8,  // 9: contractpro.auth.v2.ClientUser.password_changed_at:type_name -> google.protobuf.Timestamp
+	8,  // 10: contractpro.auth.v2.ClientUser.last_login_time:type_name -> google.protobuf.Timestamp
+	0,  // 11: contractpro.auth.v2.AuthService.GetClientUser:input_type -> contractpro.auth.v2.GetClientUserRequest
+	2,  // 12: contractpro.auth.v2.AuthService.ListClientUsers:input_type -> contractpro.auth.v2.ListClientUsersRequest
+	1,  // 13: contractpro.auth.v2.AuthService.GetClientUser:output_type -> contractpro.auth.v2.GetClientUserResponse
+	3,  // 14: contractpro.auth.v2.AuthService.ListClientUsers:output_type -> contractpro.auth.v2.ListClientUsersResponse
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_proto_contractpro_auth_v2_auth_proto_init() }
+func file_proto_contractpro_auth_v2_auth_proto_init() {
+	if File_proto_contractpro_auth_v2_auth_proto != nil {
+		return
+	}
+	file_proto_contractpro_auth_v2_auth_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_contractpro_auth_v2_auth_proto_rawDesc), len(file_proto_contractpro_auth_v2_auth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_contractpro_auth_v2_auth_proto_goTypes,
+		DependencyIndexes: file_proto_contractpro_auth_v2_auth_proto_depIdxs,
+		MessageInfos:      file_proto_contractpro_auth_v2_auth_proto_msgTypes,
+	}.Build()
+	File_proto_contractpro_auth_v2_auth_proto = out.File
+	file_proto_contractpro_auth_v2_auth_proto_goTypes = nil
+	file_proto_contractpro_auth_v2_auth_proto_depIdxs = nil
+}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: proto/contractpro/auth/v2/auth.proto
+
+package authv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuthService_GetClientUser_FullMethodName   = "/contractpro.auth.v2.AuthService/GetClientUser"
+	AuthService_ListClientUsers_FullMethodName = "/contractpro.auth.v2.AuthService/ListClientUsers"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuthService 認証サービス（v2）
+// 日時をgoogle.protobuf.Timestamp、設定をgoogle.protobuf.Structで返す
+// 移行期間中はv1（contractpro.auth.v1.AuthService）と並行して提供し、作成・更新・削除はv1を使用する
+// 旧サービス名（auth.v2.AuthService）でも同じ実装を提供する
+type AuthServiceClient interface {
+	// GetClientUser クライアントユーザー詳細取得（認証必要、権限: users:READ、ロール・最終ログイン日時を含む）
+	GetClientUser(ctx context.Context, in *GetClientUserRequest, opts ...grpc.CallOption) (*GetClientUserResponse, error)
+	// ListClientUsers クライアントユーザー一覧取得（認証必要、権限: users:READ、検索・絞り込み・並び替えはv1と同じ）
+	ListClientUsers(ctx context.Context, in *ListClientUsersRequest, opts ...grpc.CallOption) (*ListClientUsersResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) GetClientUser(ctx context.Context, in *GetClientUserRequest, opts ...grpc.CallOption) (*GetClientUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClientUserResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetClientUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListClientUsers(ctx context.Context, in *ListClientUsersRequest, opts ...grpc.CallOption) (*ListClientUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClientUsersResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListClientUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility.
+//
+// AuthService 認証サービス（v2）
+// 日時をgoogle.protobuf.Timestamp、設定をgoogle.protobuf.Structで返す
+// 移行期間中はv1（contractpro.auth.v1.AuthService）と並行して提供し、作成・更新・削除はv1を使用する
+// 旧サービス名（auth.v2.AuthService）でも同じ実装を提供する
+type AuthServiceServer interface {
+	// GetClientUser クライアントユーザー詳細取得（認証必要、権限: users:READ、ロール・最終ログイン日時を含む）
+	GetClientUser(context.Context, *GetClientUserRequest) (*GetClientUserResponse, error)
+	// ListClientUsers クライアントユーザー一覧取得（認証必要、権限: users:READ、検索・絞り込み・並び替えはv1と同じ）
+	ListClientUsers(context.Context, *ListClientUsersRequest) (*ListClientUsersResponse, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) GetClientUser(context.Context, *GetClientUserRequest) (*GetClientUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClientUser not implemented")
+}
+func (UnimplementedAuthServiceServer) ListClientUsers(context.Context, *ListClientUsersRequest) (*ListClientUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClientUsers not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call panics, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_GetClientUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetClientUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetClientUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetClientUser(ctx, req.(*GetClientUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListClientUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListClientUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListClientUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListClientUsers(ctx, req.(*ListClientUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "contractpro.auth.v2.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClientUser",
+			Handler:    _AuthService_GetClientUser_Handler,
+		},
+		{
+			MethodName: "ListClientUsers",
+			Handler:    _AuthService_ListClientUsers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/contractpro/auth/v2/auth.proto",
+}
@@ -0,0 +1,345 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: proto/validate/validate.proto
+
+package validate
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FieldRules フィールドのルール
+type FieldRules struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 必須（文字列は空文字・空白のみ、メッセージ・optionalフィールドは未設定を違反とする）
+	// 必須でないフィールドは未設定・空文字の場合に他のルールを評価しない
+	Required      bool         `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	String_       *StringRules `protobuf:"bytes,2,opt,name=string,proto3" json:"string,omitempty"`
+	Enum          *EnumRules   `protobuf:"bytes,3,opt,name=enum,proto3" json:"enum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	mi := &file_proto_validate_validate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validate_validate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_proto_validate_validate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldRules) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FieldRules) GetString_() *StringRules {
+	if x != nil {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *FieldRules) GetEnum() *EnumRules {
+	if x != nil {
+		return x.Enum
+	}
+	return nil
+}
+
+// StringRules 文字列フィールドのルール（repeatedの場合は要素ごとに評価）
+type StringRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinLen        *uint64                `protobuf:"varint,1,opt,name=min_len,json=minLen,proto3,oneof" json:"min_len,omitempty"`       // 最小文字数（バイト数ではなく文字数）
+	MaxLen        *uint64                `protobuf:"varint,2,opt,name=max_len,json=maxLen,proto3,oneof" json:"max_len,omitempty"`       // 最大文字数
+	Email         bool                   `protobuf:"varint,3,opt,name=email,proto3" json:"email,omitempty"`                             // メールアドレス（RFC 5322のaddr-spec、表示名は不可）
+	Uuid          bool                   `protobuf:"varint,4,opt,name=uuid,proto3" json:"uuid,omitempty"`                               // UUID（ハイフン区切りの36文字）
+	Json          bool                   `protobuf:"varint,5,opt,name=json,proto3" json:"json,omitempty"`                               // JSON（値が1つであること、JSON Merge Patch等）
+	JsonObject    bool                   `protobuf:"varint,6,opt,name=json_object,json=jsonObject,proto3" json:"json_object,omitempty"` // JSONオブジェクト（settings等）
+	In            []string               `protobuf:"bytes,7,rep,name=in,proto3" json:"in,omitempty"`                                    // 許可する値（列挙型として扱う文字列、大文字・小文字を区別）
+	Pattern       string                 `protobuf:"bytes,8,opt,name=pattern,proto3" json:"pattern,omitempty"`                          // 正規表現（RE2、値全体に一致すること）
+	DateTime      bool                   `protobuf:"varint,9,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`       // 日時（RFC 3339）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringRules) Reset() {
+	*x = StringRules{}
+	mi := &file_proto_validate_validate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringRules) ProtoMessage() {}
+
+func (x *StringRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validate_validate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringRules.ProtoReflect.Descriptor instead.
+func (*StringRules) Descriptor() ([]byte, []int) {
+	return file_proto_validate_validate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StringRules) GetMinLen() uint64 {
+	if x != nil && x.MinLen != nil {
+		return *x.MinLen
+	}
+	return 0
+}
+
+func (x *StringRules) GetMaxLen() uint64 {
+	if x != nil && x.MaxLen != nil {
+		return *x.MaxLen
+	}
+	return 0
+}
+
+func (x *StringRules) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+func (x *StringRules) GetUuid() bool {
+	if x != nil {
+		return x.Uuid
+	}
+	return false
+}
+
+func (x *StringRules) GetJson() bool {
+	if x != nil {
+		return x.Json
+	}
+	return false
+}
+
+func (x *StringRules) GetJsonObject() bool {
+	if x != nil {
+		return x.JsonObject
+	}
+	return false
+}
+
+func (x *StringRules) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *StringRules) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *StringRules) GetDateTime() bool {
+	if x != nil {
+		return x.DateTime
+	}
+	return false
+}
+
+// EnumRules 列挙型フィールドのルール
+type EnumRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DefinedOnly   bool                   `protobuf:"varint,1,opt,name=defined_only,json=definedOnly,proto3" json:"defined_only,omitempty"` // 定義されている値のみ（未知の番号は不可）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnumRules) Reset() {
+	*x = EnumRules{}
+	mi := &file_proto_validate_validate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnumRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumRules) ProtoMessage() {}
+
+func (x *EnumRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_validate_validate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumRules.ProtoReflect.Descriptor instead.
+func (*EnumRules) Descriptor() ([]byte, []int) {
+	return file_proto_validate_validate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnumRules) GetDefinedOnly() bool {
+	if x != nil {
+		return x.DefinedOnly
+	}
+	return false
+}
+
+var file_proto_validate_validate_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         51000,
+		Name:          "validate.field",
+		Tag:           "bytes,51000,opt,name=field",
+		Filename:      "proto/validate/validate.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// 拡張番号は組織内で利用できる範囲（50000〜99999）から割り当て
+	//
+	// optional validate.FieldRules field = 51000;
+	E_Field = &file_proto_validate_validate_proto_extTypes[0]
+)
+
+var File_proto_validate_validate_proto protoreflect.FileDescriptor
+
+const file_proto_validate_validate_proto_rawDesc = "" +
+	"\n" +
+	"\x1dproto/validate/validate.proto\x12\bvalidate\x1a google/protobuf/descriptor.proto\"\x80\x01\n" +
+	"\n" +
+	"FieldRules\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\bR\brequired\x12-\n" +
+	"\x06string\x18\x02 \x01(\v2\x15.validate.StringRulesR\x06string\x12'\n" +
+	"\x04enum\x18\x03 \x01(\v2\x13.validate.EnumRulesR\x04enum\"\x87\x02\n" +
+	"\vStringRules\x12\x1c\n" +
+	"\amin_len\x18\x01 \x01(\x04H\x00R\x06minLen\x88\x01\x01\x12\x1c\n" +
+	"\amax_len\x18\x02 \x01(\x04H\x01R\x06maxLen\x88\x01\x01\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\bR\x05email\x12\x12\n" +
+	"\x04uuid\x18\x04 \x01(\bR\x04uuid\x12\x12\n" +
+	"\x04json\x18\x05 \x01(\bR\x04json\x12\x1f\n" +
+	"\vjson_object\x18\x06 \x01(\bR\n" +
+	"jsonObject\x12\x0e\n" +
+	"\x02in\x18\a \x03(\tR\x02in\x12\x18\n" +
+	"\apattern\x18\b \x01(\tR\apattern\x12\x1b\n" +
+	"\tdate_time\x18\t \x01(\bR\bdateTimeB\n" +
+	"\n" +
+	"\b_min_lenB\n" +
+	"\n" +
+	"\b_max_len\".\n" +
+	"\tEnumRules\x12!\n" +
+	"\fdefined_only\x18\x01 \x01(\bR\vdefinedOnly:K\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xb8\x8e\x03 \x01(\v2\x14.validate.FieldRulesR\x05fieldB#Z!contract-pro-suite/proto/validateb\x06proto3"
+
+var (
+	file_proto_validate_validate_proto_rawDescOnce sync.Once
+	file_proto_validate_validate_proto_rawDescData []byte
+)
+
+func file_proto_validate_validate_proto_rawDescGZIP() []byte {
+	file_proto_validate_validate_proto_rawDescOnce.Do(func() {
+		file_proto_validate_validate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_validate_validate_proto_rawDesc), len(file_proto_validate_validate_proto_rawDesc)))
+	})
+	return file_proto_validate_validate_proto_rawDescData
+}
+
+var file_proto_validate_validate_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_validate_validate_proto_goTypes = []any{
+	(*FieldRules)(nil),                // 0: validate.FieldRules
+	(*StringRules)(nil),               // 1: validate.StringRules
+	(*EnumRules)(nil),                 // 2: validate.EnumRules
+	(*descriptorpb.FieldOptions)(nil), // 3: google.protobuf.FieldOptions
+}
+var file_proto_validate_validate_proto_depIdxs = []int32{
+	1, // 0: validate.FieldRules.string:type_name -> validate.StringRules
+	2, // 1: validate.FieldRules.enum:type_name -> validate.EnumRules
+	3, // 2: validate.field:extendee -> google.protobuf.FieldOptions
+	0, // 3: validate.field:type_name -> validate.FieldRules
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	3, // [3:4] is the sub-list for extension type_name
+	2, // [2:3] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_validate_validate_proto_init() }
+func file_proto_validate_validate_proto_init() {
+	if File_proto_validate_validate_proto != nil {
+		return
+	}
+	file_proto_validate_validate_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_validate_validate_proto_rawDesc), len(file_proto_validate_validate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_validate_validate_proto_goTypes,
+		DependencyIndexes: file_proto_validate_validate_proto_depIdxs,
+		MessageInfos:      file_proto_validate_validate_proto_msgTypes,
+		ExtensionInfos:    file_proto_validate_validate_proto_extTypes,
+	}.Build()
+	File_proto_validate_validate_proto = out.File
+	file_proto_validate_validate_proto_goTypes = nil
+	file_proto_validate_validate_proto_depIdxs = nil
+}
@@ -21,6 +21,16 @@ if ! command -v protoc &> /dev/null; then
     exit 1
 fi
 
+# google/api/annotations.proto（HTTP/JSONゲートウェイのルート定義）のインクルードパス
+# https://github.com/googleapis/googleapis をクローンしたディレクトリを指定する
+GOOGLEAPIS_DIR="${GOOGLEAPIS_DIR:-$HOME/googleapis}"
+if [ ! -f "$GOOGLEAPIS_DIR/google/api/annotations.proto" ]; then
+    echo "Error: google/api/annotations.proto not found in $GOOGLEAPIS_DIR" >&2
+    echo "Please clone googleapis and set GOOGLEAPIS_DIR:" >&2
+    echo "  git clone --depth 1 https://github.com/googleapis/googleapis \$HOME/googleapis" >&2
+    exit 1
+fi
+
 # protoファイルのディレクトリ
 PROTO_DIR="proto"
 OUT_DIR="proto"
@@ -35,14 +45,21 @@ fi
 echo "Generating Protocol Buffers code..."
 
 protoc \
+  -I . \
+  -I "$GOOGLEAPIS_DIR" \
   --go_out="$OUT_DIR" \
   --go_opt=paths=source_relative \
   --go-grpc_out="$OUT_DIR" \
   --go-grpc_opt=paths=source_relative \
-  "$PROTO_DIR/auth/auth.proto"
+  "$PROTO_DIR/validate/validate.proto" \
+  "$PROTO_DIR/contractpro/auth/v1/auth.proto" \
+  "$PROTO_DIR/contractpro/auth/v2/auth.proto"
 
 echo "Protocol Buffers code generated successfully!"
 echo "Generated files:"
-echo "  - $OUT_DIR/auth/auth.pb.go"
-echo "  - $OUT_DIR/auth/auth_grpc.pb.go"
+echo "  - $OUT_DIR/contractpro/auth/v1/auth.pb.go"
+echo "  - $OUT_DIR/contractpro/auth/v1/auth_grpc.pb.go"
+echo "  - $OUT_DIR/contractpro/auth/v2/auth.pb.go"
+echo "  - $OUT_DIR/contractpro/auth/v2/auth_grpc.pb.go"
+echo "  - $OUT_DIR/validate/validate.pb.go"
 
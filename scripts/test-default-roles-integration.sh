@@ -147,7 +147,7 @@ GRPC_REQUEST=$(cat <<EOF
 EOF
 )
 
-GRPC_RESPONSE=$(grpcurl -plaintext -d "$GRPC_REQUEST" localhost:${GRPC_PORT} auth.AuthService/SignupClient 2>&1)
+GRPC_RESPONSE=$(grpcurl -plaintext -d "$GRPC_REQUEST" localhost:${GRPC_PORT} contractpro.auth.v1.AuthService/SignupClient 2>&1)
 grpc_exit_code=$?
 
 if [ $grpc_exit_code -eq 0 ] && (echo "$GRPC_RESPONSE" | grep -q "clientId" || echo "$GRPC_RESPONSE" | grep -q "client_id"); then
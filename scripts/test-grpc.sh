@@ -210,7 +210,7 @@ echo "=========================================="
 debug "Listing gRPC services: grpcurl -plaintext $GRPC_URL list"
 services=$(grpcurl -plaintext $GRPC_URL list 2>&1)
 
-if echo "$services" | grep -q "auth.AuthService"; then
+if echo "$services" | grep -q "contractpro.auth.v1.AuthService"; then
     test_passed "gRPC service list"
     echo "Available services:"
     echo "$services"
@@ -288,7 +288,7 @@ echo "=========================================="
 # クライアントIDを取得（デフォルトまたは環境変数から）
 CLIENT_ID="${DEFAULT_CLIENT_ID:-00000000-0000-0000-0000-000000000000}"
 
-debug "Calling gRPC GetMe: grpcurl -plaintext -H 'authorization: Bearer $JWT_TOKEN' -H 'x-client-id: $CLIENT_ID' $GRPC_URL auth.AuthService/GetMe"
+debug "Calling gRPC GetMe: grpcurl -plaintext -H 'authorization: Bearer $JWT_TOKEN' -H 'x-client-id: $CLIENT_ID' $GRPC_URL contractpro.auth.v1.AuthService/GetMe"
 
 # gRPCリクエストを実行
 response=$(grpcurl -plaintext \
@@ -296,7 +296,7 @@ response=$(grpcurl -plaintext \
     -H "x-client-id: $CLIENT_ID" \
     -d '{}' \
     $GRPC_URL \
-    auth.AuthService/GetMe 2>&1)
+    contractpro.auth.v1.AuthService/GetMe 2>&1)
 
 grpc_exit_code=$?
 
@@ -332,7 +332,7 @@ fi
     TEST_ADMIN_FIRST_NAME="Admin"
     TEST_ADMIN_LAST_NAME="User"
 
-    debug "Calling gRPC SignupClient: grpcurl -plaintext -d '{...}' $GRPC_URL auth.AuthService/SignupClient"
+    debug "Calling gRPC SignupClient: grpcurl -plaintext -d '{...}' $GRPC_URL contractpro.auth.v1.AuthService/SignupClient"
 
     # gRPCリクエストを実行
     signup_request=$(cat <<EOF
@@ -351,7 +351,7 @@ EOF
     response=$(grpcurl -plaintext \
         -d "$signup_request" \
         $GRPC_URL \
-        auth.AuthService/SignupClient 2>&1)
+        contractpro.auth.v1.AuthService/SignupClient 2>&1)
 
     grpc_exit_code=$?
 
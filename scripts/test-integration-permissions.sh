@@ -258,7 +258,7 @@ EOF
     
     # gRPCリクエストを実行（ポート8081はgRPCポート）
     GRPC_PORT="${GRPC_PORT:-8081}"
-    GRPC_RESPONSE=$(grpcurl -plaintext -d "$GRPC_REQUEST" localhost:${GRPC_PORT} auth.AuthService/SignupClient 2>&1)
+    GRPC_RESPONSE=$(grpcurl -plaintext -d "$GRPC_REQUEST" localhost:${GRPC_PORT} contractpro.auth.v1.AuthService/SignupClient 2>&1)
     
     if echo "$GRPC_RESPONSE" | grep -q "client_id"; then
         test_passed "クライアント作成（gRPC）"
@@ -0,0 +1,112 @@
+package domain
+
+// ErrorDomain エラー詳細（ErrorInfo.Domain）に設定するサービス名
+const ErrorDomain = "auth.contract-pro-suite"
+
+// ErrorKind ドメインエラーの種類（gRPCステータスコードに対応）
+type ErrorKind string
+
+const (
+	ErrorKindNotFound           ErrorKind = "NOT_FOUND"           // 対象が存在しない（NotFound）
+	ErrorKindConflict           ErrorKind = "CONFLICT"            // 既に存在する（AlreadyExists）
+	ErrorKindAborted            ErrorKind = "ABORTED"             // 同時更新の競合（Aborted、再試行可能）
+	ErrorKindPermissionDenied   ErrorKind = "PERMISSION_DENIED"   // 権限がない（PermissionDenied）
+	ErrorKindValidation         ErrorKind = "VALIDATION"          // 入力が不正（InvalidArgument、フィールド単位の違反を含む）
+	ErrorKindPreconditionFailed ErrorKind = "PRECONDITION_FAILED" // 現在の状態では実行できない（FailedPrecondition）
+)
+
+// ErrorReason エラーの理由コード（ErrorInfo.Reasonとしてクライアントに返すため、値は変更しない）
+type ErrorReason string
+
+const (
+	ReasonPermissionDenied              ErrorReason = "PERMISSION_DENIED"
+	ReasonClientAccessDenied            ErrorReason = "CLIENT_ACCESS_DENIED"
+	ReasonUserNotActive                 ErrorReason = "USER_NOT_ACTIVE"
+	ReasonSelfServiceNotSupported       ErrorReason = "SELF_SERVICE_NOT_SUPPORTED"
+	ReasonChallengeFailed               ErrorReason = "CHALLENGE_FAILED"
+	ReasonClientUserNotFound            ErrorReason = "CLIENT_USER_NOT_FOUND"
+	ReasonServiceAccountNotFound        ErrorReason = "SERVICE_ACCOUNT_NOT_FOUND"
+	ReasonAPIKeyNotFound                ErrorReason = "API_KEY_NOT_FOUND"
+	ReasonIPAllowlistEntryNotFound      ErrorReason = "IP_ALLOWLIST_ENTRY_NOT_FOUND"
+	ReasonIdentityProviderNotFound      ErrorReason = "IDENTITY_PROVIDER_NOT_FOUND"
+	ReasonSCIMResourceNotFound          ErrorReason = "SCIM_RESOURCE_NOT_FOUND"
+	ReasonSignupVerificationNotFound    ErrorReason = "SIGNUP_VERIFICATION_NOT_FOUND"
+	ReasonEmailChangeNotFound           ErrorReason = "EMAIL_CHANGE_NOT_FOUND"
+	ReasonEmailAlreadyExists            ErrorReason = "EMAIL_ALREADY_EXISTS"
+	ReasonServiceAccountAlreadyExists   ErrorReason = "SERVICE_ACCOUNT_ALREADY_EXISTS"
+	ReasonIPAllowlistEntryAlreadyExists ErrorReason = "IP_ALLOWLIST_ENTRY_ALREADY_EXISTS"
+	ReasonIdentityProviderAlreadyExists ErrorReason = "IDENTITY_PROVIDER_ALREADY_EXISTS"
+	ReasonGroupAlreadyExists            ErrorReason = "GROUP_ALREADY_EXISTS"
+	ReasonSlugAlreadyExists             ErrorReason = "SLUG_ALREADY_EXISTS"
+	ReasonCompanyCodeAlreadyExists      ErrorReason = "COMPANY_CODE_ALREADY_EXISTS"
+	ReasonConcurrentModification        ErrorReason = "CONCURRENT_MODIFICATION"
+	ReasonETagMismatch                  ErrorReason = "ETAG_MISMATCH"
+	ReasonSignupVerificationExpired     ErrorReason = "SIGNUP_VERIFICATION_EXPIRED"
+	ReasonEmailChangeExpired            ErrorReason = "EMAIL_CHANGE_EXPIRED"
+	ReasonSystemRoleImmutable           ErrorReason = "SYSTEM_ROLE_IMMUTABLE"
+	ReasonTokenNotRevocable             ErrorReason = "TOKEN_NOT_REVOCABLE"
+	ReasonInvalidRequest                ErrorReason = "INVALID_REQUEST" // auth.protoで宣言したルールの違反（違反フィールドはBadRequest）
+	ReasonRequiredField                 ErrorReason = "REQUIRED_FIELD"
+	ReasonETagRequired                  ErrorReason = "ETAG_REQUIRED"
+	ReasonInvalidUpdateMask             ErrorReason = "INVALID_UPDATE_MASK"
+	ReasonInvalidPageToken              ErrorReason = "INVALID_PAGE_TOKEN"
+	ReasonInvalidOrderBy                ErrorReason = "INVALID_ORDER_BY"
+	ReasonInvalidStatusFilter           ErrorReason = "INVALID_STATUS_FILTER"
+	ReasonUnsupportedExportFormat       ErrorReason = "UNSUPPORTED_EXPORT_FORMAT"
+	ReasonPasswordPolicyViolation       ErrorReason = "PASSWORD_POLICY_VIOLATION"
+	ReasonInvalidCurrentPassword        ErrorReason = "INVALID_CURRENT_PASSWORD"
+	ReasonEmailUnchanged                ErrorReason = "EMAIL_UNCHANGED"
+	ReasonDisposableEmailDomain         ErrorReason = "DISPOSABLE_EMAIL_DOMAIN"
+	ReasonInvalidRole                   ErrorReason = "INVALID_ROLE"
+	ReasonInvalidURL                    ErrorReason = "INVALID_URL"
+	ReasonInvalidGracePeriod            ErrorReason = "INVALID_GRACE_PERIOD"
+	ReasonInvalidExpiresAt              ErrorReason = "INVALID_EXPIRES_AT"
+	ReasonSCIMMemberNotFound            ErrorReason = "SCIM_MEMBER_NOT_FOUND"
+)
+
+// FieldViolation 入力フィールド単位の違反（BadRequest.FieldViolationに対応）
+type FieldViolation struct {
+	Field       string // リクエストのフィールド名（例: "password", "update_mask"）
+	Reason      string // 違反の種類（例: "TOO_SHORT"）
+	Description string
+}
+
+// FieldViolator フィールド単位の違反を持つエラー（Errorをラップする詳細なエラー型が実装する）
+type FieldViolator interface {
+	FieldViolations() []FieldViolation
+}
+
+// Error ドメインエラー
+// ユースケースはこの型（またはこれをラップしたエラー）を返し、インターセプターが種類に応じたステータスコードに変換する
+// メッセージはそのままクライアントに返すため、SQLエラー等の内部情報を含めない
+type Error struct {
+	Kind       ErrorKind
+	Reason     ErrorReason
+	Message    string
+	Violations []FieldViolation // ErrorKindValidationの場合の違反フィールド
+}
+
+// NewError ドメインエラーを作成
+func NewError(kind ErrorKind, reason ErrorReason, message string) *Error {
+	return &Error{Kind: kind, Reason: reason, Message: message}
+}
+
+// NewValidationError 単一フィールドの入力エラーを作成
+func NewValidationError(reason ErrorReason, field, message string) *Error {
+	return &Error{
+		Kind:       ErrorKindValidation,
+		Reason:     reason,
+		Message:    message,
+		Violations: []FieldViolation{{Field: field, Reason: string(reason), Description: message}},
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is 種類と理由コードが一致すれば同じエラーとみなす（errors.Is(err, ErrXxx)で判定できる）
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind && t.Reason == e.Reason
+}
@@ -0,0 +1,90 @@
+package domain
+
+import "slices"
+
+// UserStatus クライアントユーザー・オペレーターのステータス（DBの値と同じ）
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "ACTIVE"
+	UserStatusInactive  UserStatus = "INACTIVE"
+	UserStatusSuspended UserStatus = "SUSPENDED"
+)
+
+// UserStatuses 定義されているユーザーステータス
+var UserStatuses = []UserStatus{UserStatusActive, UserStatusInactive, UserStatusSuspended}
+
+// Valid 定義されている値かどうか
+func (s UserStatus) Valid() bool {
+	return slices.Contains(UserStatuses, s)
+}
+
+// ClientStatus クライアント（テナント）のステータス（DBの値と同じ）
+type ClientStatus string
+
+const (
+	ClientStatusPendingVerification ClientStatus = "PENDING_VERIFICATION" // 登録確認待ち（SignupClient直後）
+	ClientStatusActive              ClientStatus = "ACTIVE"
+	ClientStatusSuspended           ClientStatus = "SUSPENDED"
+	ClientStatusTerminated          ClientStatus = "TERMINATED"
+)
+
+// ClientStatuses 定義されているクライアントステータス
+var ClientStatuses = []ClientStatus{ClientStatusPendingVerification, ClientStatusActive, ClientStatusSuspended, ClientStatusTerminated}
+
+// Valid 定義されている値かどうか
+func (s ClientStatus) Valid() bool {
+	return slices.Contains(ClientStatuses, s)
+}
+
+// IdentityProviderStatus 外部IdP（OIDC）設定のステータス（DBの値と同じ）
+type IdentityProviderStatus string
+
+const (
+	IdentityProviderStatusActive   IdentityProviderStatus = "ACTIVE"
+	IdentityProviderStatusInactive IdentityProviderStatus = "INACTIVE" // このIdPのJWTで認証しない
+)
+
+// IdentityProviderStatuses 定義されているIdP設定ステータス
+var IdentityProviderStatuses = []IdentityProviderStatus{IdentityProviderStatusActive, IdentityProviderStatusInactive}
+
+// Valid 定義されている値かどうか
+func (s IdentityProviderStatus) Valid() bool {
+	return slices.Contains(IdentityProviderStatuses, s)
+}
+
+// ESignMode 電子署名方式（DBの値と同じ）
+type ESignMode string
+
+const (
+	ESignModeWitnessOTP  ESignMode = "WITNESS_OTP" // 既定値
+	ESignModeOTPOnly     ESignMode = "OTP_ONLY"
+	ESignModeCertificate ESignMode = "CERTIFICATE"
+	ESignModeBiometric   ESignMode = "BIOMETRIC"
+	ESignModeSimpleClick ESignMode = "SIMPLE_CLICK"
+)
+
+// ESignModes 定義されている電子署名方式
+var ESignModes = []ESignMode{ESignModeWitnessOTP, ESignModeOTPOnly, ESignModeCertificate, ESignModeBiometric, ESignModeSimpleClick}
+
+// Valid 定義されている値かどうか
+func (m ESignMode) Valid() bool {
+	return slices.Contains(ESignModes, m)
+}
+
+// OperatorRole オペレーターのクライアントへの割り当てロール（DBの値と同じ）
+type OperatorRole string
+
+const (
+	OperatorRoleAdmin    OperatorRole = "ADMIN"    // 全操作
+	OperatorRoleOperator OperatorRole = "OPERATOR" // 読み取り・書き込み
+	OperatorRoleViewer   OperatorRole = "VIEWER"   // 読み取りのみ
+)
+
+// OperatorRoles 定義されている割り当てロール
+var OperatorRoles = []OperatorRole{OperatorRoleAdmin, OperatorRoleOperator, OperatorRoleViewer}
+
+// Valid 定義されている値かどうか
+func (r OperatorRole) Valid() bool {
+	return slices.Contains(OperatorRoles, r)
+}
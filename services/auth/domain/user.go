@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -8,8 +10,9 @@ import (
 type UserType string
 
 const (
-	UserTypeOperator   UserType = "OPERATOR"
-	UserTypeClientUser UserType = "CLIENT_USER"
+	UserTypeOperator       UserType = "OPERATOR"
+	UserTypeClientUser     UserType = "CLIENT_USER"
+	UserTypeServiceAccount UserType = "SERVICE_ACCOUNT" // APIキーで認証されたサービスアカウント（システム間連携用）
 )
 
 // UserContext 認証済みユーザーのコンテキスト
@@ -17,7 +20,35 @@ type UserContext struct {
 	UserID   uuid.UUID
 	UserType UserType
 	Email    string
-	ClientID uuid.UUID // オペレーターの場合は割り当てられたクライアントID、クライアントユーザーの場合は所属クライアントID
+	ClientID uuid.UUID // オペレーターの場合は割り当てられたクライアントID、クライアントユーザー・サービスアカウントの場合は所属クライアントID
+}
+
+// ExternalIdentity 外部IdP（OIDC）で認証されたユーザーの識別情報
+type ExternalIdentity struct {
+	Issuer        string // JWTの発行者（iss）
+	Subject       string // IdP内のユーザー識別子（sub）
+	Email         string
+	EmailVerified bool
+	FirstName     string // given_name
+	LastName      string // family_name
+}
+
+// APIKeyIdentity APIキーで認証されたサービスアカウントの識別情報
+type APIKeyIdentity struct {
+	APIKeyID         uuid.UUID
+	ServiceAccountID uuid.UUID
+	ClientID         uuid.UUID
+}
+
+// TokenMetadata 失効判定に使用するトークンの属性
+type TokenMetadata struct {
+	ID        string    // トークン識別子（jti、未設定の場合はSupabaseのsession_id）
+	IssuedAt  time.Time // 発行日時（iat、未設定の場合はゼロ値）
+	ExpiresAt time.Time // 有効期限（exp、未設定の場合はゼロ値）
+
+	// MFA判定に使用する認証強度
+	AuthenticatorAssuranceLevel string   // 認証保証レベル（aal、Supabaseの場合はaal1/aal2）
+	AuthenticationMethods       []string // 認証方式（amr、RFC 8176の値またはSupabaseのmethod）
 }
 
 // Permission 権限
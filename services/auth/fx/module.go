@@ -5,7 +5,9 @@ import (
 
 	"contract-pro-suite/internal/shared/config"
 	"contract-pro-suite/internal/shared/db"
+	"contract-pro-suite/internal/shared/mail"
 	"contract-pro-suite/services/auth/repository"
+	"contract-pro-suite/services/auth/scim"
 	"contract-pro-suite/services/auth/server"
 	"contract-pro-suite/services/auth/usecase"
 	dbgen "contract-pro-suite/sqlc"
@@ -36,6 +38,36 @@ func NewAuthModule() fx.Option {
 		fx.Provide(func(queries *dbgen.Queries) repository.ClientUserRoleRepository {
 			return repository.NewClientUserRoleRepository(queries)
 		}),
+		fx.Provide(func(queries *dbgen.Queries) repository.IdentityProviderRepository {
+			return repository.NewIdentityProviderRepository(queries)
+		}),
+		fx.Provide(func(queries *dbgen.Queries) repository.ClientUserIdentityRepository {
+			return repository.NewClientUserIdentityRepository(queries)
+		}),
+		fx.Provide(func(queries *dbgen.Queries) repository.SCIMTokenRepository {
+			return repository.NewSCIMTokenRepository(queries)
+		}),
+		fx.Provide(func(queries *dbgen.Queries) repository.ServiceAccountRepository {
+			return repository.NewServiceAccountRepository(queries)
+		}),
+		fx.Provide(func(queries *dbgen.Queries) repository.APIKeyRepository {
+			return repository.NewAPIKeyRepository(queries)
+		}),
+		fx.Provide(func(queries *dbgen.Queries) repository.TokenRevocationRepository {
+			return repository.NewTokenRevocationRepository(queries)
+		}),
+		fx.Provide(func(queries *dbgen.Queries) repository.IPAllowlistRepository {
+			return repository.NewIPAllowlistRepository(queries)
+		}),
+		// メール送信（SMTP、未設定の場合はnil）の提供
+		fx.Provide(mail.NewSender),
+		// サインアップのボット対策（チャレンジ検証・登録確認の送信）の提供
+		fx.Provide(usecase.NewChallengeVerifier),
+		fx.Provide(usecase.NewSignupVerificationSender),
+		// パスワードポリシー（漏洩済みパスワードの判定）の提供
+		fx.Provide(usecase.NewBreachedPasswordChecker),
+		// メールアドレス変更の確認の送信の提供
+		fx.Provide(usecase.NewEmailChangeSender),
 		// ユースケースの提供
 		fx.Provide(func(
 			operatorRepo repository.OperatorRepository,
@@ -45,6 +77,14 @@ func NewAuthModule() fx.Option {
 			clientRoleRepo repository.ClientRoleRepository,
 			clientRolePermissionRepo repository.ClientRolePermissionRepository,
 			clientUserRoleRepo repository.ClientUserRoleRepository,
+			identityProviderRepo repository.IdentityProviderRepository,
+			clientUserIdentityRepo repository.ClientUserIdentityRepository,
+			serviceAccountRepo repository.ServiceAccountRepository,
+			tokenRevocationRepo repository.TokenRevocationRepository,
+			challengeVerifier usecase.ChallengeVerifier,
+			signupVerificationSender usecase.SignupVerificationSender,
+			breachedPasswordChecker usecase.BreachedPasswordChecker,
+			emailChangeSender usecase.EmailChangeSender,
 			cfg *config.Config,
 			database *db.DB,
 		) usecase.AuthUsecase {
@@ -56,12 +96,27 @@ func NewAuthModule() fx.Option {
 				clientRoleRepo,
 				clientRolePermissionRepo,
 				clientUserRoleRepo,
+				identityProviderRepo,
+				clientUserIdentityRepo,
+				serviceAccountRepo,
+				tokenRevocationRepo,
+				challengeVerifier,
+				signupVerificationSender,
+				breachedPasswordChecker,
+				emailChangeSender,
 				cfg,
 				database,
 			)
 		}),
+		fx.Provide(usecase.NewSCIMUsecase),
+		fx.Provide(usecase.NewServiceAccountUsecase),
+		fx.Provide(usecase.NewIPAllowlistUsecase),
+		fx.Provide(usecase.NewIdentityProviderUsecase),
 		// gRPCサーバーの提供
 		fx.Provide(server.NewAuthServer),
+		fx.Provide(server.NewAuthServerV2),
+		// SCIMハンドラーの提供
+		fx.Provide(scim.NewHandler),
 	)
 }
 
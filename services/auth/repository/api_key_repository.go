@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	db "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// APIKeyRepository サービスアカウントのAPIキーリポジトリ
+type APIKeyRepository interface {
+	GetByHash(ctx context.Context, keyHash string) (db.ClientApiKey, error)
+	GetByID(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID) (db.ClientApiKey, error)
+	List(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID) ([]db.ClientApiKey, error)
+	Create(ctx context.Context, params db.CreateApiKeyParams) (db.ClientApiKey, error)
+	Touch(ctx context.Context, apiKeyID uuid.UUID) error                                                       // 最終利用日時の更新
+	SetExpiry(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID, expiresAt time.Time) (int64, error) // 戻り値: 更新した件数
+	Revoke(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID) (int64, error)                         // 戻り値: 取り消した件数
+}
+
+type apiKeyRepository struct {
+	queries *db.Queries
+}
+
+// NewAPIKeyRepository APIキーリポジトリを作成
+func NewAPIKeyRepository(queries *db.Queries) APIKeyRepository {
+	return &apiKeyRepository{
+		queries: queries,
+	}
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (db.ClientApiKey, error) {
+	return r.queries.GetApiKeyByHash(ctx, keyHash)
+}
+
+func (r *apiKeyRepository) GetByID(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID) (db.ClientApiKey, error) {
+	return r.queries.GetApiKey(ctx, db.GetApiKeyParams{
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
+		ApiKeyID: pgtype.UUID{Bytes: apiKeyID, Valid: true},
+	})
+}
+
+func (r *apiKeyRepository) List(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID) ([]db.ClientApiKey, error) {
+	return r.queries.ListApiKeys(ctx, db.ListApiKeysParams{
+		ClientID:         pgtype.UUID{Bytes: clientID, Valid: true},
+		ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+	})
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, params db.CreateApiKeyParams) (db.ClientApiKey, error) {
+	return r.queries.CreateApiKey(ctx, params)
+}
+
+func (r *apiKeyRepository) Touch(ctx context.Context, apiKeyID uuid.UUID) error {
+	return r.queries.TouchApiKey(ctx, pgtype.UUID{Bytes: apiKeyID, Valid: true})
+}
+
+func (r *apiKeyRepository) SetExpiry(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID, expiresAt time.Time) (int64, error) {
+	return r.queries.SetApiKeyExpiry(ctx, db.SetApiKeyExpiryParams{
+		ClientID:  pgtype.UUID{Bytes: clientID, Valid: true},
+		ApiKeyID:  pgtype.UUID{Bytes: apiKeyID, Valid: true},
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, clientID uuid.UUID, apiKeyID uuid.UUID) (int64, error) {
+	return r.queries.RevokeApiKey(ctx, db.RevokeApiKeyParams{
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
+		ApiKeyID: pgtype.UUID{Bytes: apiKeyID, Valid: true},
+	})
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -15,8 +16,9 @@ type ClientRepository interface {
 	GetByCompanyCode(ctx context.Context, companyCode string) (db.Client, error)
 	List(ctx context.Context, limit, offset int32) ([]db.Client, error)
 	Create(ctx context.Context, params db.CreateClientParams) (db.Client, error)
-	Update(ctx context.Context, params db.UpdateClientParams) (db.Client, error)
-	Delete(ctx context.Context, clientID uuid.UUID, deletedBy uuid.UUID) error
+	Update(ctx context.Context, params db.UpdateClientParams) (db.Client, error) // params.UpdatedAtが現在の値と一致する場合のみ更新（一致しない場合はpgx.ErrNoRows）
+	Delete(ctx context.Context, clientID uuid.UUID, deletedBy uuid.UUID, updatedAt time.Time) (int64, error) // updated_atが一致する場合のみ論理削除、戻り値: 削除した件数
+	RenewSignupVerification(ctx context.Context, clientID uuid.UUID, email string, tokenHash string, expiresAt time.Time) (db.ClientSignupVerification, error) // 未検証かつ管理者メールアドレスが一致する場合のみ（一致しない場合はpgx.ErrNoRows）
 }
 
 type clientRepository struct {
@@ -57,9 +59,19 @@ func (r *clientRepository) Update(ctx context.Context, params db.UpdateClientPar
 	return r.queries.UpdateClient(ctx, params)
 }
 
-func (r *clientRepository) Delete(ctx context.Context, clientID uuid.UUID, deletedBy uuid.UUID) error {
+func (r *clientRepository) Delete(ctx context.Context, clientID uuid.UUID, deletedBy uuid.UUID, updatedAt time.Time) (int64, error) {
 	return r.queries.DeleteClient(ctx, db.DeleteClientParams{
 		ClientID:  pgtype.UUID{Bytes: clientID, Valid: true},
 		DeletedBy: pgtype.UUID{Bytes: deletedBy, Valid: true},
+		UpdatedAt: pgtype.Timestamptz{Time: updatedAt, Valid: true},
+	})
+}
+
+func (r *clientRepository) RenewSignupVerification(ctx context.Context, clientID uuid.UUID, email string, tokenHash string, expiresAt time.Time) (db.ClientSignupVerification, error) {
+	return r.queries.RenewSignupVerification(ctx, db.RenewSignupVerificationParams{
+		ClientID:  pgtype.UUID{Bytes: clientID, Valid: true},
+		Email:     email,
+		TokenHash: tokenHash,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
 	})
 }
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	db "contract-pro-suite/sqlc"
+)
+
+// ClientUserIdentityRepository 外部ID（issuer, subject）とクライアントユーザーの紐付けリポジトリ
+type ClientUserIdentityRepository interface {
+	Get(ctx context.Context, issuer, subject string) (db.ClientUserIdentity, error)
+	Create(ctx context.Context, params db.CreateClientUserIdentityParams) (db.ClientUserIdentity, error)
+	Touch(ctx context.Context, issuer, subject string) error
+}
+
+type clientUserIdentityRepository struct {
+	queries *db.Queries
+}
+
+// NewClientUserIdentityRepository 外部ID紐付けリポジトリを作成
+func NewClientUserIdentityRepository(queries *db.Queries) ClientUserIdentityRepository {
+	return &clientUserIdentityRepository{
+		queries: queries,
+	}
+}
+
+func (r *clientUserIdentityRepository) Get(ctx context.Context, issuer, subject string) (db.ClientUserIdentity, error) {
+	return r.queries.GetClientUserIdentity(ctx, db.GetClientUserIdentityParams{
+		Issuer:  issuer,
+		Subject: subject,
+	})
+}
+
+func (r *clientUserIdentityRepository) Create(ctx context.Context, params db.CreateClientUserIdentityParams) (db.ClientUserIdentity, error) {
+	return r.queries.CreateClientUserIdentity(ctx, params)
+}
+
+func (r *clientUserIdentityRepository) Touch(ctx context.Context, issuer, subject string) error {
+	return r.queries.TouchClientUserIdentity(ctx, db.TouchClientUserIdentityParams{
+		Issuer:  issuer,
+		Subject: subject,
+	})
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	db "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClientUserIdentityRepository_RelinkAfterProviderDeleted IdP設定の削除 → 同じissuerの再登録 → ログイン（JITの紐付け）のテスト
+func TestClientUserIdentityRepository_RelinkAfterProviderDeleted(t *testing.T) {
+	// 注意: このテストは実際のデータベース接続が必要です
+	// 統合テスト環境でのみ実行してください
+	t.Skip("統合テスト環境でのみ実行")
+
+	ctx := context.Background()
+	queries := db.New(nil) // 実際のデータベース接続が必要
+	providerRepo := NewIdentityProviderRepository(queries)
+	identityRepo := NewClientUserIdentityRepository(queries)
+
+	// テストデータの準備
+	const issuer = "https://idp.example.com"
+	const subject = "external-subject"
+	clientID := uuid.New()
+	clientUserID := uuid.New()
+	deletedBy := uuid.New()
+
+	_, err := queries.CreateClientUser(ctx, db.CreateClientUserParams{
+		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		Email:        "sso@example.com",
+		FirstName:    "SSO",
+		LastName:     "User",
+		Status:       "ACTIVE",
+		Settings:     []byte("{}"),
+	})
+	require.NoError(t, err)
+
+	createProvider := func() db.ClientIdentityProvider {
+		provider, err := providerRepo.Create(ctx, db.CreateIdentityProviderParams{
+			ClientID:        pgtype.UUID{Bytes: clientID, Valid: true},
+			Name:            "社内IdP",
+			Issuer:          issuer,
+			Audience:        "contract-pro-suite",
+			JitProvisioning: true,
+			DefaultRoleCode: "member",
+			Status:          "ACTIVE",
+		})
+		require.NoError(t, err)
+		return provider
+	}
+	link := func(provider db.ClientIdentityProvider) (db.ClientUserIdentity, error) {
+		return identityRepo.Create(ctx, db.CreateClientUserIdentityParams{
+			Issuer:       issuer,
+			Subject:      subject,
+			ProviderID:   provider.ProviderID,
+			ClientID:     provider.ClientID,
+			ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
+			Email:        pgtype.Text{String: "sso@example.com", Valid: true},
+		})
+	}
+
+	// 1. 初回ログインで紐付け
+	provider := createProvider()
+	first, err := link(provider)
+	require.NoError(t, err)
+
+	t.Run("削除されていない紐付けは重複できない", func(t *testing.T) {
+		_, err := link(provider)
+		assert.Error(t, err)
+	})
+
+	// 2. IdP設定を削除（紐付けも論理削除）
+	deleted, err := providerRepo.Delete(ctx, clientID, uuid.UUID(provider.ProviderID.Bytes), deletedBy)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, err = identityRepo.Get(ctx, issuer, subject)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+	// 3. 同じissuerを再登録して再ログイン（JITで新しい紐付けを作成できる）
+	reRegistered := createProvider()
+	relinked, err := link(reRegistered)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.IdentityID, relinked.IdentityID)
+
+	found, err := identityRepo.Get(ctx, issuer, subject)
+	require.NoError(t, err)
+	assert.Equal(t, reRegistered.ProviderID, found.ProviderID)
+	assert.Equal(t, clientUserID, uuid.UUID(found.ClientUserID.Bytes))
+}
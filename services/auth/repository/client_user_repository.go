@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	db "contract-pro-suite/sqlc"
 
@@ -13,11 +14,49 @@ import (
 type ClientUserRepository interface {
 	GetByID(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) (db.ClientUser, error)
 	GetByUserIDOnly(ctx context.Context, clientUserID uuid.UUID) (db.ClientUser, error) // client_user_idのみで検索（GetUserContext用）
+	GetProfile(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) (db.GetClientUserProfileRow, error) // 所属クライアント・ロール・実効権限を含めて取得（GetMe用）
 	GetByEmail(ctx context.Context, clientID uuid.UUID, email string) (db.ClientUser, error)
 	List(ctx context.Context, clientID uuid.UUID, limit, offset int32) ([]db.ClientUser, error)
+	ListPage(ctx context.Context, clientID uuid.UUID, cursor *KeysetCursor, limit int32) ([]db.ClientUser, error) // キーセットページネーション（cursorがnilの場合は先頭から）
+	ListAll(ctx context.Context, clientID uuid.UUID) ([]db.ClientUser, error) // ページネーションなしの全件取得（SCIMフィルタ評価用）
+	Search(ctx context.Context, clientID uuid.UUID, search ClientUserSearch, cursor *KeysetCursor, limit int32) ([]ClientUserSearchRow, error) // 検索・絞り込み・並び替え（キーセットページネーション）
+	SearchByOffset(ctx context.Context, clientID uuid.UUID, search ClientUserSearch, limit, offset int32) ([]db.ClientUser, error) // 検索・絞り込み（作成日時の降順、オフセットによるページング、SCIM用）
+	Count(ctx context.Context, clientID uuid.UUID) (int64, error)
+	CountSearch(ctx context.Context, clientID uuid.UUID, search ClientUserSearch) (int64, error)
 	Create(ctx context.Context, params db.CreateClientUserParams) (db.ClientUser, error)
-	Update(ctx context.Context, clientID uuid.UUID, params db.UpdateClientUserParams) (db.ClientUser, error)
-	Delete(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, deletedBy uuid.UUID) error
+	Update(ctx context.Context, clientID uuid.UUID, params db.UpdateClientUserParams) (db.ClientUser, error) // params.UpdatedAtが現在の値と一致する場合のみ更新（一致しない場合はpgx.ErrNoRows）
+	UpdatePasswordChangedAt(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) error // password_changed_atを現在日時に更新
+	Delete(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, deletedBy uuid.UUID, updatedAt time.Time) (int64, error) // updated_atが一致する場合のみ論理削除、戻り値: 削除した件数
+	TouchActivity(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) error // 最終アクティビティ日時を記録
+	ListActivitiesByUserIDs(ctx context.Context, clientID uuid.UUID, clientUserIDs []uuid.UUID) ([]db.ClientUserActivity, error) // 複数ユーザーの最終アクティビティ日時をまとめて取得（記録がないユーザーは含まない）
+}
+
+// ClientUserSortField クライアントユーザー一覧の並び替えキー
+type ClientUserSortField string
+
+const (
+	ClientUserSortByCreatedAt    ClientUserSortField = "created_at"
+	ClientUserSortByName         ClientUserSortField = "name"
+	ClientUserSortByLastActiveAt ClientUserSortField = "last_active_at"
+)
+
+// ClientUserSearch クライアントユーザーの検索条件（空文字の項目では絞り込まない）
+type ClientUserSearch struct {
+	QueryPattern string // 氏名・メールアドレスのILIKEパターン（エスケープ済み）
+	EmailPattern string // メールアドレスのILIKEパターン（エスケープ済み、SCIMのuserNameのeq・sw）
+	Active       *bool  // trueの場合はACTIVEのみ、falseの場合はACTIVE以外（SCIMのactive）
+	Status       string
+	Department   string
+	Position     string
+	RoleCode     string
+	SortField    ClientUserSortField
+	SortDesc     bool
+}
+
+// ClientUserSearchRow 検索結果（クライアントユーザーと最終アクティビティ日時）
+type ClientUserSearchRow struct {
+	ClientUser   db.ClientUser
+	LastActiveAt pgtype.Timestamptz
 }
 
 type clientUserRepository struct {
@@ -42,6 +81,13 @@ func (r *clientUserRepository) GetByUserIDOnly(ctx context.Context, clientUserID
 	return r.queries.GetClientUserByUserIDOnly(ctx, pgtype.UUID{Bytes: clientUserID, Valid: true})
 }
 
+func (r *clientUserRepository) GetProfile(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) (db.GetClientUserProfileRow, error) {
+	return r.queries.GetClientUserProfile(ctx, db.GetClientUserProfileParams{
+		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+	})
+}
+
 func (r *clientUserRepository) GetByEmail(ctx context.Context, clientID uuid.UUID, email string) (db.ClientUser, error) {
 	return r.queries.GetClientUserByEmail(ctx, db.GetClientUserByEmailParams{
 		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
@@ -57,6 +103,97 @@ func (r *clientUserRepository) List(ctx context.Context, clientID uuid.UUID, lim
 	})
 }
 
+func (r *clientUserRepository) ListPage(ctx context.Context, clientID uuid.UUID, cursor *KeysetCursor, limit int32) ([]db.ClientUser, error) {
+	params := db.ListClientUsersPageParams{
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
+		PageSize: limit,
+	}
+	if cursor != nil {
+		params.CursorCreatedAt = pgtype.Timestamptz{Time: cursor.Time, Valid: true}
+		params.CursorClientUserID = pgtype.UUID{Bytes: cursor.ID, Valid: true}
+	}
+	return r.queries.ListClientUsersPage(ctx, params)
+}
+
+func (r *clientUserRepository) ListAll(ctx context.Context, clientID uuid.UUID) ([]db.ClientUser, error) {
+	return r.queries.ListAllClientUsers(ctx, pgtype.UUID{Bytes: clientID, Valid: true})
+}
+
+func (r *clientUserRepository) Search(ctx context.Context, clientID uuid.UUID, search ClientUserSearch, cursor *KeysetCursor, limit int32) ([]ClientUserSearchRow, error) {
+	params := searchParams(clientID, search)
+	params.PageSize = limit
+	if cursor != nil {
+		// 並び替えキーが日時の場合はCursorTime、姓 名の場合はCursorNameで比較する
+		params.CursorTime = pgtype.Timestamptz{Time: cursor.Time, Valid: true}
+		params.CursorName = pgtype.Text{String: cursor.Text, Valid: true}
+		params.CursorClientUserID = pgtype.UUID{Bytes: cursor.ID, Valid: true}
+	}
+	rows, err := r.queries.SearchClientUsers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ClientUserSearchRow, len(rows))
+	for i, row := range rows {
+		result[i] = ClientUserSearchRow(row)
+	}
+	return result, nil
+}
+
+func (r *clientUserRepository) SearchByOffset(ctx context.Context, clientID uuid.UUID, search ClientUserSearch, limit, offset int32) ([]db.ClientUser, error) {
+	search.SortField = ClientUserSortByCreatedAt
+	search.SortDesc = true
+	params := searchParams(clientID, search)
+	params.PageSize = limit
+	params.PageOffset = offset
+	rows, err := r.queries.SearchClientUsers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]db.ClientUser, len(rows))
+	for i, row := range rows {
+		users[i] = row.ClientUser
+	}
+	return users, nil
+}
+
+// searchParams 検索条件をSearchClientUsersのパラメータに変換（並び替えキーが未指定・未定義の場合は作成日時）
+func searchParams(clientID uuid.UUID, search ClientUserSearch) db.SearchClientUsersParams {
+	sortField := ClientUserSortByCreatedAt
+	switch search.SortField {
+	case ClientUserSortByName, ClientUserSortByLastActiveAt:
+		sortField = search.SortField
+	}
+	return db.SearchClientUsersParams{
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		QueryPattern: optionalText(search.QueryPattern),
+		EmailPattern: optionalText(search.EmailPattern),
+		Active:       optionalBool(search.Active),
+		Status:       optionalText(search.Status),
+		Department:   optionalText(search.Department),
+		Position:     optionalText(search.Position),
+		RoleCode:     optionalText(search.RoleCode),
+		SortField:    string(sortField),
+		SortDesc:     search.SortDesc,
+	}
+}
+
+func (r *clientUserRepository) Count(ctx context.Context, clientID uuid.UUID) (int64, error) {
+	return r.queries.CountClientUsers(ctx, pgtype.UUID{Bytes: clientID, Valid: true})
+}
+
+func (r *clientUserRepository) CountSearch(ctx context.Context, clientID uuid.UUID, search ClientUserSearch) (int64, error) {
+	return r.queries.CountSearchClientUsers(ctx, db.CountSearchClientUsersParams{
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		QueryPattern: optionalText(search.QueryPattern),
+		EmailPattern: optionalText(search.EmailPattern),
+		Active:       optionalBool(search.Active),
+		Status:       optionalText(search.Status),
+		Department:   optionalText(search.Department),
+		Position:     optionalText(search.Position),
+		RoleCode:     optionalText(search.RoleCode),
+	})
+}
+
 func (r *clientUserRepository) Create(ctx context.Context, params db.CreateClientUserParams) (db.ClientUser, error) {
 	return r.queries.CreateClientUser(ctx, params)
 }
@@ -67,10 +204,49 @@ func (r *clientUserRepository) Update(ctx context.Context, clientID uuid.UUID, p
 	return r.queries.UpdateClientUser(ctx, params)
 }
 
-func (r *clientUserRepository) Delete(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, deletedBy uuid.UUID) error {
+func (r *clientUserRepository) UpdatePasswordChangedAt(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) error {
+	return r.queries.UpdateClientUserPasswordChangedAt(ctx, db.UpdateClientUserPasswordChangedAtParams{
+		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+	})
+}
+
+func (r *clientUserRepository) Delete(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, deletedBy uuid.UUID, updatedAt time.Time) (int64, error) {
 	return r.queries.DeleteClientUser(ctx, db.DeleteClientUserParams{
 		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
 		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
 		DeletedBy:    pgtype.UUID{Bytes: deletedBy, Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: updatedAt, Valid: true},
 	})
 }
+
+func (r *clientUserRepository) TouchActivity(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) error {
+	return r.queries.TouchClientUserActivity(ctx, db.TouchClientUserActivityParams{
+		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+	})
+}
+
+func (r *clientUserRepository) ListActivitiesByUserIDs(ctx context.Context, clientID uuid.UUID, clientUserIDs []uuid.UUID) ([]db.ClientUserActivity, error) {
+	ids := make([]pgtype.UUID, len(clientUserIDs))
+	for i, id := range clientUserIDs {
+		ids[i] = pgtype.UUID{Bytes: id, Valid: true}
+	}
+	return r.queries.ListClientUserActivitiesByUserIDs(ctx, db.ListClientUserActivitiesByUserIDsParams{
+		ClientID:      pgtype.UUID{Bytes: clientID, Valid: true},
+		ClientUserIds: ids,
+	})
+}
+
+// optionalText 空文字の場合はNULL
+func optionalText(value string) pgtype.Text {
+	return pgtype.Text{String: value, Valid: value != ""}
+}
+
+// optionalBool nilの場合はNULL
+func optionalBool(value *bool) pgtype.Bool {
+	if value == nil {
+		return pgtype.Bool{}
+	}
+	return pgtype.Bool{Bool: *value, Valid: true}
+}
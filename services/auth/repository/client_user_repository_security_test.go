@@ -3,10 +3,12 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	db "contract-pro-suite/sqlc"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -87,7 +89,7 @@ func TestClientUserRepository_Update_ClientIsolation(t *testing.T) {
 	clientUserID1 := uuid.New()
 
 	// クライアント1のユーザーを作成
-	_, err := queries.CreateClientUser(ctx, db.CreateClientUserParams{
+	created, err := queries.CreateClientUser(ctx, db.CreateClientUserParams{
 		ClientUserID: pgtype.UUID{Bytes: clientUserID1, Valid: true},
 		ClientID:     pgtype.UUID{Bytes: clientID1, Valid: true},
 		Email:        "user1@client1.com",
@@ -107,9 +109,26 @@ func TestClientUserRepository_Update_ClientIsolation(t *testing.T) {
 			Email:        "updated@client1.com",
 			Status:       "ACTIVE",
 			Settings:     []byte("{}"),
+			UpdatedAt:    created.UpdatedAt,
 		}
-		_, err := repo.Update(ctx, clientID1, params)
+		updated, err := repo.Update(ctx, clientID1, params)
 		assert.NoError(t, err)
+		created = updated
+	})
+
+	t.Run("古いupdated_atでは更新できない（楽観的排他制御）", func(t *testing.T) {
+		params := db.UpdateClientUserParams{
+			ClientUserID: pgtype.UUID{Bytes: clientUserID1, Valid: true},
+			ClientID:     pgtype.UUID{Bytes: clientID1, Valid: true},
+			FirstName:    "Stale",
+			LastName:     "Name",
+			Email:        "stale@client1.com",
+			Status:       "ACTIVE",
+			Settings:     []byte("{}"),
+			UpdatedAt:    pgtype.Timestamptz{Time: created.UpdatedAt.Time.Add(-time.Second), Valid: true},
+		}
+		_, err := repo.Update(ctx, clientID1, params)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
 	})
 
 	t.Run("異なるクライアントIDで更新できない（セキュリティチェック）", func(t *testing.T) {
@@ -121,6 +140,7 @@ func TestClientUserRepository_Update_ClientIsolation(t *testing.T) {
 			Email:        "hacked@client1.com",
 			Status:       "ACTIVE",
 			Settings:     []byte("{}"),
+			UpdatedAt:    created.UpdatedAt,
 		}
 		// クライアント2のIDを指定して更新を試みる
 		_, err := repo.Update(ctx, clientID2, params)
@@ -144,7 +164,7 @@ func TestClientUserRepository_Delete_ClientIsolation(t *testing.T) {
 	deletedBy := uuid.New()
 
 	// クライアント1のユーザーを作成
-	_, err := queries.CreateClientUser(ctx, db.CreateClientUserParams{
+	created, err := queries.CreateClientUser(ctx, db.CreateClientUserParams{
 		ClientUserID: pgtype.UUID{Bytes: clientUserID1, Valid: true},
 		ClientID:     pgtype.UUID{Bytes: clientID1, Valid: true},
 		Email:        "user1@client1.com",
@@ -155,16 +175,23 @@ func TestClientUserRepository_Delete_ClientIsolation(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	t.Run("古いupdated_atでは削除できない（楽観的排他制御）", func(t *testing.T) {
+		deleted, err := repo.Delete(ctx, clientID1, clientUserID1, deletedBy, created.UpdatedAt.Time.Add(-time.Second))
+		assert.NoError(t, err)
+		assert.Zero(t, deleted)
+	})
+
 	t.Run("正しいクライアントIDで削除できる", func(t *testing.T) {
-		err := repo.Delete(ctx, clientID1, clientUserID1, deletedBy)
+		deleted, err := repo.Delete(ctx, clientID1, clientUserID1, deletedBy, created.UpdatedAt.Time)
 		assert.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
 	})
 
 	t.Run("異なるクライアントIDで削除できない（セキュリティチェック）", func(t *testing.T) {
 		// クライアント1のユーザーIDで、クライアント2のIDを指定して削除を試みる
 		// 注意: 前のテストで削除されているため、新しいユーザーを作成する必要がある
 		clientUserID2 := uuid.New()
-		_, err := queries.CreateClientUser(ctx, db.CreateClientUserParams{
+		created, err := queries.CreateClientUser(ctx, db.CreateClientUserParams{
 			ClientUserID: pgtype.UUID{Bytes: clientUserID2, Valid: true},
 			ClientID:     pgtype.UUID{Bytes: clientID1, Valid: true},
 			Email:        "user2@client1.com",
@@ -176,8 +203,9 @@ func TestClientUserRepository_Delete_ClientIsolation(t *testing.T) {
 		require.NoError(t, err)
 
 		// クライアント2のIDを指定して削除を試みる
-		err = repo.Delete(ctx, clientID2, clientUserID2, deletedBy)
-		// エラーが発生するか、削除が失敗することを確認
-		assert.Error(t, err, "異なるクライアントのユーザーを削除できてはいけない")
+		deleted, err := repo.Delete(ctx, clientID2, clientUserID2, deletedBy, created.UpdatedAt.Time)
+		// 削除件数が0件であることを確認
+		assert.NoError(t, err)
+		assert.Zero(t, deleted, "異なるクライアントのユーザーを削除できてはいけない")
 	})
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -13,7 +14,10 @@ type ClientUserRoleRepository interface {
 	GetByUserAndRole(ctx context.Context, clientID, clientUserID, roleID uuid.UUID) (db.ClientUserRole, error)
 	GetByUserID(ctx context.Context, clientID, clientUserID uuid.UUID) ([]db.ClientUserRole, error)
 	GetByRoleID(ctx context.Context, clientID, roleID uuid.UUID) ([]db.ClientUserRole, error)
+	ListActiveByClient(ctx context.Context, clientID uuid.UUID) ([]db.ClientUserRole, error) // クライアントの有効なロール割り当てをまとめて取得
+	ListActiveByUserIDs(ctx context.Context, clientID uuid.UUID, clientUserIDs []uuid.UUID) ([]db.ListActiveClientUserRolesByUserIDsRow, error) // 複数ユーザーの有効なロール（コード・名前）をまとめて取得
 	Create(ctx context.Context, params db.CreateClientUserRoleParams) (db.ClientUserRole, error)
+	Assign(ctx context.Context, clientID, clientUserID, roleID uuid.UUID) (db.ClientUserRole, error) // 取り消し済みの割り当ては再有効化
 	Revoke(ctx context.Context, clientID, clientUserID, roleID uuid.UUID) error
 	Delete(ctx context.Context, clientID, clientUserID, roleID uuid.UUID, deletedBy uuid.UUID) error
 }
@@ -51,10 +55,34 @@ func (r *clientUserRoleRepository) GetByRoleID(ctx context.Context, clientID, ro
 	})
 }
 
+func (r *clientUserRoleRepository) ListActiveByClient(ctx context.Context, clientID uuid.UUID) ([]db.ClientUserRole, error) {
+	return r.queries.ListActiveClientUserRolesByClient(ctx, pgtype.UUID{Bytes: clientID, Valid: true})
+}
+
+func (r *clientUserRoleRepository) ListActiveByUserIDs(ctx context.Context, clientID uuid.UUID, clientUserIDs []uuid.UUID) ([]db.ListActiveClientUserRolesByUserIDsRow, error) {
+	ids := make([]pgtype.UUID, len(clientUserIDs))
+	for i, id := range clientUserIDs {
+		ids[i] = pgtype.UUID{Bytes: id, Valid: true}
+	}
+	return r.queries.ListActiveClientUserRolesByUserIDs(ctx, db.ListActiveClientUserRolesByUserIDsParams{
+		ClientID:      pgtype.UUID{Bytes: clientID, Valid: true},
+		ClientUserIds: ids,
+	})
+}
+
 func (r *clientUserRoleRepository) Create(ctx context.Context, params db.CreateClientUserRoleParams) (db.ClientUserRole, error) {
 	return r.queries.CreateClientUserRole(ctx, params)
 }
 
+func (r *clientUserRoleRepository) Assign(ctx context.Context, clientID, clientUserID, roleID uuid.UUID) (db.ClientUserRole, error) {
+	return r.queries.AssignClientUserRole(ctx, db.AssignClientUserRoleParams{
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
+		RoleID:       pgtype.UUID{Bytes: roleID, Valid: true},
+		AssignedAt:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+}
+
 func (r *clientUserRoleRepository) Revoke(ctx context.Context, clientID, clientUserID, roleID uuid.UUID) error {
 	return r.queries.RevokeClientUserRole(ctx, db.RevokeClientUserRoleParams{
 		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
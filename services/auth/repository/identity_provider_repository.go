@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "contract-pro-suite/sqlc"
+)
+
+// IdentityProviderRepository 外部IdP（OIDC）設定リポジトリ
+type IdentityProviderRepository interface {
+	GetByIssuer(ctx context.Context, issuer string) (db.ClientIdentityProvider, error)
+	Get(ctx context.Context, clientID uuid.UUID, providerID uuid.UUID) (db.ClientIdentityProvider, error)
+	ListByClientID(ctx context.Context, clientID uuid.UUID) ([]db.ClientIdentityProvider, error)
+	Create(ctx context.Context, params db.CreateIdentityProviderParams) (db.ClientIdentityProvider, error)
+	Update(ctx context.Context, params db.UpdateIdentityProviderParams) (db.ClientIdentityProvider, error)
+	Delete(ctx context.Context, clientID uuid.UUID, providerID uuid.UUID, deletedBy uuid.UUID) (int64, error) // 紐付け済みの外部IDも論理削除する
+}
+
+type identityProviderRepository struct {
+	queries *db.Queries
+}
+
+// NewIdentityProviderRepository 外部IdP設定リポジトリを作成
+func NewIdentityProviderRepository(queries *db.Queries) IdentityProviderRepository {
+	return &identityProviderRepository{
+		queries: queries,
+	}
+}
+
+func (r *identityProviderRepository) GetByIssuer(ctx context.Context, issuer string) (db.ClientIdentityProvider, error) {
+	return r.queries.GetIdentityProviderByIssuer(ctx, issuer)
+}
+
+func (r *identityProviderRepository) Get(ctx context.Context, clientID uuid.UUID, providerID uuid.UUID) (db.ClientIdentityProvider, error) {
+	return r.queries.GetIdentityProvider(ctx, db.GetIdentityProviderParams{
+		ClientID:   pgtype.UUID{Bytes: clientID, Valid: true},
+		ProviderID: pgtype.UUID{Bytes: providerID, Valid: true},
+	})
+}
+
+func (r *identityProviderRepository) ListByClientID(ctx context.Context, clientID uuid.UUID) ([]db.ClientIdentityProvider, error) {
+	return r.queries.ListIdentityProvidersByClientID(ctx, pgtype.UUID{Bytes: clientID, Valid: true})
+}
+
+func (r *identityProviderRepository) Create(ctx context.Context, params db.CreateIdentityProviderParams) (db.ClientIdentityProvider, error) {
+	return r.queries.CreateIdentityProvider(ctx, params)
+}
+
+func (r *identityProviderRepository) Update(ctx context.Context, params db.UpdateIdentityProviderParams) (db.ClientIdentityProvider, error) {
+	return r.queries.UpdateIdentityProvider(ctx, params)
+}
+
+func (r *identityProviderRepository) Delete(ctx context.Context, clientID uuid.UUID, providerID uuid.UUID, deletedBy uuid.UUID) (int64, error) {
+	return r.queries.DeleteIdentityProvider(ctx, db.DeleteIdentityProviderParams{
+		ClientID:   pgtype.UUID{Bytes: clientID, Valid: true},
+		ProviderID: pgtype.UUID{Bytes: providerID, Valid: true},
+		DeletedBy:  pgtype.UUID{Bytes: deletedBy, Valid: true},
+	})
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	db "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// IPAllowlistRepository IPアドレス許可リストリポジトリ
+type IPAllowlistRepository interface {
+	List(ctx context.Context, clientID uuid.UUID) ([]db.ClientIpAllowlistEntry, error)
+	Create(ctx context.Context, params db.CreateIpAllowlistEntryParams) (db.ClientIpAllowlistEntry, error)
+	Delete(ctx context.Context, clientID uuid.UUID, entryID uuid.UUID) (int64, error) // 戻り値: 削除した件数
+}
+
+type ipAllowlistRepository struct {
+	queries *db.Queries
+}
+
+// NewIPAllowlistRepository IPアドレス許可リストリポジトリを作成
+func NewIPAllowlistRepository(queries *db.Queries) IPAllowlistRepository {
+	return &ipAllowlistRepository{
+		queries: queries,
+	}
+}
+
+func (r *ipAllowlistRepository) List(ctx context.Context, clientID uuid.UUID) ([]db.ClientIpAllowlistEntry, error) {
+	return r.queries.ListIpAllowlistEntries(ctx, pgtype.UUID{Bytes: clientID, Valid: true})
+}
+
+func (r *ipAllowlistRepository) Create(ctx context.Context, params db.CreateIpAllowlistEntryParams) (db.ClientIpAllowlistEntry, error) {
+	return r.queries.CreateIpAllowlistEntry(ctx, params)
+}
+
+func (r *ipAllowlistRepository) Delete(ctx context.Context, clientID uuid.UUID, entryID uuid.UUID) (int64, error) {
+	return r.queries.DeleteIpAllowlistEntry(ctx, db.DeleteIpAllowlistEntryParams{
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
+		EntryID:  pgtype.UUID{Bytes: entryID, Valid: true},
+	})
+}
@@ -12,6 +12,7 @@ import (
 type OperatorRepository interface {
 	GetByID(ctx context.Context, operatorID uuid.UUID) (db.Operator, error)
 	GetByEmail(ctx context.Context, email string) (db.Operator, error)
+	GetProfile(ctx context.Context, operatorID uuid.UUID) (db.GetOperatorProfileRow, error) // 割り当て済みクライアントを含めて取得（GetMe用）
 	List(ctx context.Context, limit, offset int32) ([]db.Operator, error)
 	Create(ctx context.Context, params db.CreateOperatorParams) (db.Operator, error)
 	Update(ctx context.Context, params db.UpdateOperatorParams) (db.Operator, error)
@@ -33,6 +34,10 @@ func (r *operatorRepository) GetByID(ctx context.Context, operatorID uuid.UUID)
 	return r.queries.GetOperator(ctx, pgtype.UUID{Bytes: operatorID, Valid: true})
 }
 
+func (r *operatorRepository) GetProfile(ctx context.Context, operatorID uuid.UUID) (db.GetOperatorProfileRow, error) {
+	return r.queries.GetOperatorProfile(ctx, pgtype.UUID{Bytes: operatorID, Valid: true})
+}
+
 func (r *operatorRepository) GetByEmail(ctx context.Context, email string) (db.Operator, error) {
 	return r.queries.GetOperatorByEmail(ctx, email)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KeysetCursor キーセットページネーションのカーソル（直前のページの最後の行の (並び替えキー, ID)）
+// 並び替えキーが日時の場合はTime、文字列の場合はTextを使用する
+type KeysetCursor struct {
+	Time time.Time
+	Text string
+	ID   uuid.UUID
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	db "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SCIMTokenRepository SCIMプロビジョニング用トークンリポジトリ
+type SCIMTokenRepository interface {
+	GetByHash(ctx context.Context, tokenHash string) (db.ClientScimToken, error)
+	Create(ctx context.Context, params db.CreateScimTokenParams) (db.ClientScimToken, error)
+	Touch(ctx context.Context, tokenID uuid.UUID) error                               // 最終利用日時の更新
+	Revoke(ctx context.Context, clientID uuid.UUID, tokenID uuid.UUID) (int64, error) // 戻り値: 取り消した件数
+}
+
+type scimTokenRepository struct {
+	queries *db.Queries
+}
+
+// NewSCIMTokenRepository SCIMトークンリポジトリを作成
+func NewSCIMTokenRepository(queries *db.Queries) SCIMTokenRepository {
+	return &scimTokenRepository{
+		queries: queries,
+	}
+}
+
+func (r *scimTokenRepository) GetByHash(ctx context.Context, tokenHash string) (db.ClientScimToken, error) {
+	return r.queries.GetScimTokenByHash(ctx, tokenHash)
+}
+
+func (r *scimTokenRepository) Create(ctx context.Context, params db.CreateScimTokenParams) (db.ClientScimToken, error) {
+	return r.queries.CreateScimToken(ctx, params)
+}
+
+func (r *scimTokenRepository) Touch(ctx context.Context, tokenID uuid.UUID) error {
+	return r.queries.TouchScimToken(ctx, pgtype.UUID{Bytes: tokenID, Valid: true})
+}
+
+func (r *scimTokenRepository) Revoke(ctx context.Context, clientID uuid.UUID, tokenID uuid.UUID) (int64, error) {
+	return r.queries.RevokeScimToken(ctx, db.RevokeScimTokenParams{
+		TokenID:  pgtype.UUID{Bytes: tokenID, Valid: true},
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
+	})
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	db "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ServiceAccountRepository サービスアカウントリポジトリ
+type ServiceAccountRepository interface {
+	GetByID(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID) (db.ClientServiceAccount, error)
+	List(ctx context.Context, clientID uuid.UUID) ([]db.ClientServiceAccount, error)
+	Create(ctx context.Context, params db.CreateServiceAccountParams) (db.ClientServiceAccount, error)
+	Delete(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID, deletedBy uuid.UUID) (int64, error) // 戻り値: 削除した件数
+}
+
+type serviceAccountRepository struct {
+	queries *db.Queries
+}
+
+// NewServiceAccountRepository サービスアカウントリポジトリを作成
+func NewServiceAccountRepository(queries *db.Queries) ServiceAccountRepository {
+	return &serviceAccountRepository{
+		queries: queries,
+	}
+}
+
+func (r *serviceAccountRepository) GetByID(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID) (db.ClientServiceAccount, error) {
+	return r.queries.GetServiceAccount(ctx, db.GetServiceAccountParams{
+		ClientID:         pgtype.UUID{Bytes: clientID, Valid: true},
+		ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+	})
+}
+
+func (r *serviceAccountRepository) List(ctx context.Context, clientID uuid.UUID) ([]db.ClientServiceAccount, error) {
+	return r.queries.ListServiceAccounts(ctx, pgtype.UUID{Bytes: clientID, Valid: true})
+}
+
+func (r *serviceAccountRepository) Create(ctx context.Context, params db.CreateServiceAccountParams) (db.ClientServiceAccount, error) {
+	return r.queries.CreateServiceAccount(ctx, params)
+}
+
+func (r *serviceAccountRepository) Delete(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID, deletedBy uuid.UUID) (int64, error) {
+	return r.queries.DeleteServiceAccount(ctx, db.DeleteServiceAccountParams{
+		ClientID:         pgtype.UUID{Bytes: clientID, Valid: true},
+		ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+		DeletedBy:        pgtype.UUID{Bytes: deletedBy, Valid: true},
+	})
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	db "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TokenRevocationRepository トークン失効（失効基準日時・失効リスト）リポジトリ
+type TokenRevocationRepository interface {
+	ListWatermarks(ctx context.Context, userID uuid.UUID, clientID uuid.UUID) ([]db.TokenRevocationWatermark, error)
+	BumpWatermark(ctx context.Context, params db.BumpTokenRevocationWatermarkParams) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+	Revoke(ctx context.Context, params db.RevokeTokenParams) error
+	DeleteExpired(ctx context.Context) (int64, error) // 戻り値: 削除した件数
+}
+
+type tokenRevocationRepository struct {
+	queries *db.Queries
+}
+
+// NewTokenRevocationRepository トークン失効リポジトリを作成
+func NewTokenRevocationRepository(queries *db.Queries) TokenRevocationRepository {
+	return &tokenRevocationRepository{
+		queries: queries,
+	}
+}
+
+func (r *tokenRevocationRepository) ListWatermarks(ctx context.Context, userID uuid.UUID, clientID uuid.UUID) ([]db.TokenRevocationWatermark, error) {
+	return r.queries.ListTokenRevocationWatermarks(ctx, db.ListTokenRevocationWatermarksParams{
+		UserID:   pgtype.UUID{Bytes: userID, Valid: true},
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
+	})
+}
+
+func (r *tokenRevocationRepository) BumpWatermark(ctx context.Context, params db.BumpTokenRevocationWatermarkParams) error {
+	return r.queries.BumpTokenRevocationWatermark(ctx, params)
+}
+
+func (r *tokenRevocationRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return r.queries.IsTokenRevoked(ctx, jti)
+}
+
+func (r *tokenRevocationRepository) Revoke(ctx context.Context, params db.RevokeTokenParams) error {
+	return r.queries.RevokeToken(ctx, params)
+}
+
+func (r *tokenRevocationRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	return r.queries.DeleteExpiredRevokedTokens(ctx)
+}
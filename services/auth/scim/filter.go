@@ -0,0 +1,486 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expr SCIMフィルタ式（RFC 7644 3.4.2.2）
+// リソースはJSON表現（map[string]interface{}）に対して評価する
+type Expr interface {
+	Match(resource map[string]interface{}) bool
+}
+
+// logicalExpr and / or
+type logicalExpr struct {
+	op    string
+	left  Expr
+	right Expr
+}
+
+func (e *logicalExpr) Match(resource map[string]interface{}) bool {
+	if e.op == "and" {
+		return e.left.Match(resource) && e.right.Match(resource)
+	}
+	return e.left.Match(resource) || e.right.Match(resource)
+}
+
+// notExpr not ( ... )
+type notExpr struct {
+	inner Expr
+}
+
+func (e *notExpr) Match(resource map[string]interface{}) bool {
+	return !e.inner.Match(resource)
+}
+
+// compareExpr attrPath op value / attrPath pr
+type compareExpr struct {
+	attr  string
+	op    string
+	value interface{}
+}
+
+func (e *compareExpr) Match(resource map[string]interface{}) bool {
+	values := lookupValues(resource, e.attr)
+	if e.op == "pr" {
+		for _, v := range values {
+			if !isEmptyValue(v) {
+				return true
+			}
+		}
+		return false
+	}
+	if e.op == "ne" {
+		for _, v := range values {
+			if compareValue(v, "eq", e.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		if compareValue(v, e.op, e.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// valuePathExpr attrPath[valFilter]（複数値属性の要素に対するフィルタ）
+type valuePathExpr struct {
+	attr   string
+	filter Expr
+}
+
+func (e *valuePathExpr) Match(resource map[string]interface{}) bool {
+	for _, elem := range lookupElements(resource, e.attr) {
+		if e.filter.Match(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseFilter SCIMフィルタ文字列を解析
+func ParseFilter(filter string) (Expr, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %q", p.tokens[p.pos].text)
+	}
+	return expr, nil
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// tokenize フィルタ文字列をトークンに分割
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenLBracket, text: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenRBracket, text: "]"})
+			i++
+		case c == '"':
+			// JSON文字列としてエスケープを解釈
+			j := i + 1
+			for j < len(s) && s[j] != '"' {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			var str string
+			if err := json.Unmarshal([]byte(s[i:j+1]), &str); err != nil {
+				return nil, fmt.Errorf("invalid string literal: %w", err)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: str})
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t()[]\"", rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: s[i:j]})
+			i = j
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty filter")
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *filterParser) peek() *token {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *filterParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t != nil && t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (p *filterParser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{op: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (Expr, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{op: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseFactor() (Expr, error) {
+	if p.isKeyword("not") {
+		p.pos++
+		t := p.peek()
+		if t == nil || t.kind != tokenLParen {
+			return nil, fmt.Errorf("expected ( after not")
+		}
+		inner, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{inner: inner}, nil
+	}
+
+	t := p.peek()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+	if t.kind == tokenLParen {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.peek(); t == nil || t.kind != tokenRParen {
+			return nil, fmt.Errorf("expected )")
+		}
+		p.pos++
+		return inner, nil
+	}
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("expected attribute path, got %q", t.text)
+	}
+	attr := t.text
+	p.pos++
+
+	// attrPath[valFilter]
+	if next := p.peek(); next != nil && next.kind == tokenLBracket {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.peek(); t == nil || t.kind != tokenRBracket {
+			return nil, fmt.Errorf("expected ]")
+		}
+		p.pos++
+		return &valuePathExpr{attr: attr, filter: inner}, nil
+	}
+
+	opToken := p.peek()
+	if opToken == nil || opToken.kind != tokenWord {
+		return nil, fmt.Errorf("expected operator after %q", attr)
+	}
+	op := strings.ToLower(opToken.text)
+	p.pos++
+
+	switch op {
+	case "pr":
+		return &compareExpr{attr: attr, op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unsupported operator %q", opToken.text)
+	}
+
+	valueToken := p.peek()
+	if valueToken == nil {
+		return nil, fmt.Errorf("expected value after %q", opToken.text)
+	}
+	p.pos++
+	value, err := parseLiteral(*valueToken)
+	if err != nil {
+		return nil, err
+	}
+	return &compareExpr{attr: attr, op: op, value: value}, nil
+}
+
+// parseLiteral 比較値（文字列、真偽値、null、数値）を解析
+func parseLiteral(t token) (interface{}, error) {
+	if t.kind == tokenString {
+		return t.text, nil
+	}
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("invalid value %q", t.text)
+	}
+	switch strings.ToLower(t.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if n, err := strconv.ParseFloat(t.text, 64); err == nil {
+		return n, nil
+	}
+	return nil, fmt.Errorf("invalid value %q", t.text)
+}
+
+// splitAttrPath 属性パスをスキーマURN・属性名・サブ属性に分解
+// 例: "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department" → [URN, "department"]
+func splitAttrPath(path string) []string {
+	lower := strings.ToLower(path)
+	for _, urn := range []string{SchemaUser, SchemaGroup} {
+		if strings.HasPrefix(lower, strings.ToLower(urn)+":") {
+			return strings.Split(path[len(urn)+1:], ".")
+		}
+	}
+	if strings.HasPrefix(lower, strings.ToLower(SchemaEnterpriseUser)+":") {
+		return append([]string{SchemaEnterpriseUser}, strings.Split(path[len(SchemaEnterpriseUser)+1:], ".")...)
+	}
+	return strings.Split(path, ".")
+}
+
+// getKey 大文字小文字を区別せずにマップのキーを検索（SCIMの属性名は大文字小文字を区別しない）
+func getKey(m map[string]interface{}, name string) (string, bool) {
+	if _, ok := m[name]; ok {
+		return name, true
+	}
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// navigate 属性パスをたどって値を取得（複数値属性は要素ごとに展開）
+func navigate(resource map[string]interface{}, path string) []interface{} {
+	current := []interface{}{resource}
+	for _, name := range splitAttrPath(path) {
+		var next []interface{}
+		for _, v := range current {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key, ok := getKey(m, name)
+			if !ok {
+				continue
+			}
+			if arr, ok := m[key].([]interface{}); ok {
+				next = append(next, arr...)
+			} else {
+				next = append(next, m[key])
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+// lookupValues 比較対象の値を取得
+// 複合属性（emails等）を直接比較する場合はvalueサブ属性を使用
+func lookupValues(resource map[string]interface{}, path string) []interface{} {
+	current := navigate(resource, path)
+	values := make([]interface{}, 0, len(current))
+	for _, v := range current {
+		if m, ok := v.(map[string]interface{}); ok {
+			if key, ok := getKey(m, "value"); ok {
+				values = append(values, m[key])
+			}
+			continue
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+// lookupElements 複数値属性の要素を取得
+func lookupElements(resource map[string]interface{}, path string) []map[string]interface{} {
+	var elems []map[string]interface{}
+	for _, v := range navigate(resource, path) {
+		if m, ok := v.(map[string]interface{}); ok {
+			elems = append(elems, m)
+		}
+	}
+	return elems
+}
+
+// isEmptyValue pr演算子の判定（null、空文字列、空配列は値なし）
+func isEmptyValue(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case []interface{}:
+		return len(val) == 0
+	case map[string]interface{}:
+		return len(val) == 0
+	}
+	return false
+}
+
+// compareValue 属性値と比較値を比較（文字列は大文字小文字を区別しない）
+func compareValue(actual interface{}, op string, expected interface{}) bool {
+	switch exp := expected.(type) {
+	case nil:
+		return actual == nil && op == "eq"
+	case bool:
+		act, ok := toBool(actual)
+		return ok && op == "eq" && act == exp
+	case float64:
+		act, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return act == exp
+		case "gt":
+			return act > exp
+		case "ge":
+			return act >= exp
+		case "lt":
+			return act < exp
+		case "le":
+			return act <= exp
+		}
+		return false
+	case string:
+		act, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		a := strings.ToLower(act)
+		e := strings.ToLower(exp)
+		switch op {
+		case "eq":
+			return a == e
+		case "co":
+			return strings.Contains(a, e)
+		case "sw":
+			return strings.HasPrefix(a, e)
+		case "ew":
+			return strings.HasSuffix(a, e)
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+	}
+	return false
+}
+
+// toBool 真偽値に変換（Entra IDは"True"/"False"の文字列で送信するため文字列も許容）
+func toBool(v interface{}) (bool, bool) {
+	switch val := v.(type) {
+	case bool:
+		return val, true
+	case string:
+		b, err := strconv.ParseBool(strings.TrimFunc(val, unicode.IsSpace))
+		if err != nil {
+			return false, false
+		}
+		return b, true
+	}
+	return false, false
+}
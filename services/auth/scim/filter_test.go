@@ -0,0 +1,199 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testUserResource() map[string]interface{} {
+	return map[string]interface{}{
+		"schemas":  []interface{}{SchemaUser, SchemaEnterpriseUser},
+		"id":       "123e4567-e89b-12d3-a456-426614174000",
+		"userName": "Taro.Yamada@example.com",
+		"name": map[string]interface{}{
+			"familyName": "山田",
+			"givenName":  "太郎",
+		},
+		"emails": []interface{}{
+			map[string]interface{}{"value": "taro.yamada@example.com", "type": "work", "primary": true},
+			map[string]interface{}{"value": "taro@home.example.com", "type": "home"},
+		},
+		"active": true,
+		"title":  "",
+		SchemaEnterpriseUser: map[string]interface{}{
+			"department": "営業部",
+		},
+	}
+}
+
+func TestParseFilter_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected bool
+	}{
+		{name: "eq（大文字小文字を区別しない）", filter: `userName eq "taro.yamada@example.com"`, expected: true},
+		{name: "eq 不一致", filter: `userName eq "other@example.com"`, expected: false},
+		{name: "属性名の大文字小文字を区別しない", filter: `USERNAME eq "taro.yamada@example.com"`, expected: true},
+		{name: "ne", filter: `userName ne "other@example.com"`, expected: true},
+		{name: "co", filter: `userName co "yamada"`, expected: true},
+		{name: "sw", filter: `userName sw "taro"`, expected: true},
+		{name: "ew", filter: `userName ew "@example.com"`, expected: true},
+		{name: "サブ属性", filter: `name.familyName eq "山田"`, expected: true},
+		{name: "複数値属性のvalue", filter: `emails eq "taro@home.example.com"`, expected: true},
+		{name: "複数値属性のサブ属性", filter: `emails.type eq "home"`, expected: true},
+		{name: "valuePath", filter: `emails[type eq "work" and value co "yamada"]`, expected: true},
+		{name: "valuePath 不一致", filter: `emails[type eq "home" and value co "yamada"]`, expected: false},
+		{name: "真偽値", filter: `active eq true`, expected: true},
+		{name: "pr", filter: `name.givenName pr`, expected: true},
+		{name: "pr 空文字列", filter: `title pr`, expected: false},
+		{name: "and / or の優先順位", filter: `userName eq "x" or active eq true and name.givenName eq "太郎"`, expected: true},
+		{name: "not", filter: `not (active eq true)`, expected: false},
+		{name: "括弧", filter: `(userName eq "x" or userName sw "taro") and active eq true`, expected: true},
+		{name: "拡張スキーマのURN付き属性", filter: `urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department eq "営業部"`, expected: true},
+		{name: "コアスキーマのURN付き属性", filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName sw "taro"`, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := ParseFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, expr.Match(testUserResource()))
+		})
+	}
+}
+
+func TestParseFilter_ActiveString(t *testing.T) {
+	// Entra IDは"True"/"False"の文字列で送信する
+	resource := map[string]interface{}{"active": "False"}
+
+	expr, err := ParseFilter(`active eq false`)
+	require.NoError(t, err)
+	assert.True(t, expr.Match(resource))
+}
+
+func TestParseFilter_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+	}{
+		{name: "空文字列", filter: ``},
+		{name: "未対応の演算子", filter: `userName like "taro"`},
+		{name: "値なし", filter: `userName eq`},
+		{name: "閉じ括弧なし", filter: `(userName eq "taro"`},
+		{name: "閉じ角括弧なし", filter: `emails[type eq "work"`},
+		{name: "閉じ引用符なし", filter: `userName eq "taro`},
+		{name: "余分なトークン", filter: `userName eq "taro" "extra"`},
+		{name: "不正な値", filter: `userName eq taro`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFilter(tt.filter)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations []PatchOperation
+		verify     func(t *testing.T, resource map[string]interface{})
+	}{
+		{
+			name:       "replace 単一属性",
+			operations: []PatchOperation{{Op: "Replace", Path: "name.givenName", Value: "次郎"}},
+			verify: func(t *testing.T, resource map[string]interface{}) {
+				assert.Equal(t, "次郎", resource["name"].(map[string]interface{})["givenName"])
+			},
+		},
+		{
+			name:       "replace パス省略",
+			operations: []PatchOperation{{Op: "replace", Value: map[string]interface{}{"active": false, "title": "課長"}}},
+			verify: func(t *testing.T, resource map[string]interface{}) {
+				assert.Equal(t, false, resource["active"])
+				assert.Equal(t, "課長", resource["title"])
+			},
+		},
+		{
+			name:       "replace フィルタ付きパス",
+			operations: []PatchOperation{{Op: "replace", Path: `emails[type eq "work"].value`, Value: "new@example.com"}},
+			verify: func(t *testing.T, resource map[string]interface{}) {
+				emails := resource["emails"].([]interface{})
+				assert.Equal(t, "new@example.com", emails[0].(map[string]interface{})["value"])
+				assert.Equal(t, "taro@home.example.com", emails[1].(map[string]interface{})["value"])
+			},
+		},
+		{
+			name:       "replace 拡張スキーマの属性",
+			operations: []PatchOperation{{Op: "replace", Path: SchemaEnterpriseUser + ":department", Value: "開発部"}},
+			verify: func(t *testing.T, resource map[string]interface{}) {
+				assert.Equal(t, "開発部", resource[SchemaEnterpriseUser].(map[string]interface{})["department"])
+			},
+		},
+		{
+			name:       "add 複数値属性に追加",
+			operations: []PatchOperation{{Op: "add", Path: "emails", Value: []interface{}{map[string]interface{}{"value": "other@example.com"}}}},
+			verify: func(t *testing.T, resource map[string]interface{}) {
+				assert.Len(t, resource["emails"], 3)
+			},
+		},
+		{
+			name:       "remove フィルタ付きパス",
+			operations: []PatchOperation{{Op: "remove", Path: `emails[type eq "home"]`}},
+			verify: func(t *testing.T, resource map[string]interface{}) {
+				assert.Len(t, resource["emails"], 1)
+			},
+		},
+		{
+			name:       "remove 値指定",
+			operations: []PatchOperation{{Op: "remove", Path: "emails", Value: []interface{}{map[string]interface{}{"value": "taro@home.example.com"}}}},
+			verify: func(t *testing.T, resource map[string]interface{}) {
+				assert.Len(t, resource["emails"], 1)
+			},
+		},
+		{
+			name:       "remove 単一属性",
+			operations: []PatchOperation{{Op: "remove", Path: "title"}},
+			verify: func(t *testing.T, resource map[string]interface{}) {
+				_, ok := resource["title"]
+				assert.False(t, ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := testUserResource()
+			require.NoError(t, ApplyPatch(resource, tt.operations))
+			tt.verify(t, resource)
+		})
+	}
+}
+
+func TestApplyPatch_Error(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation PatchOperation
+		scimType  string
+	}{
+		{name: "未対応のop", operation: PatchOperation{Op: "move", Path: "title"}, scimType: "invalidSyntax"},
+		{name: "remove パス省略", operation: PatchOperation{Op: "remove"}, scimType: "noTarget"},
+		{name: "パス省略で値がオブジェクトでない", operation: PatchOperation{Op: "replace", Value: "x"}, scimType: "invalidValue"},
+		{name: "不正なフィルタ", operation: PatchOperation{Op: "replace", Path: `emails[type xx "work"].value`, Value: "x"}, scimType: "invalidPath"},
+		{name: "一致する要素なし", operation: PatchOperation{Op: "remove", Path: `emails[type eq "other"]`}, scimType: "noTarget"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ApplyPatch(testUserResource(), []PatchOperation{tt.operation})
+			require.Error(t, err)
+			reqErr, ok := err.(*requestError)
+			require.True(t, ok)
+			assert.Equal(t, tt.scimType, reqErr.scimType)
+		})
+	}
+}
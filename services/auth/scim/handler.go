@@ -0,0 +1,878 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"contract-pro-suite/internal/interceptor"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/usecase"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// BasePath SCIMエンドポイントのパス
+	BasePath = "/scim/v2"
+	// contentType SCIMのメディアタイプ（RFC 7644 3.1）
+	contentType = "application/scim+json"
+	// defaultCount countパラメータ省略時の件数
+	defaultCount = 100
+	// maxCount 1リクエストで返却する最大件数
+	maxCount = 200
+	// maxBodySize リクエストボディの最大サイズ
+	maxBodySize = 1 << 20
+)
+
+type principalKey struct{}
+
+// Handler SCIM 2.0 HTTPハンドラー
+type Handler struct {
+	scimUsecase usecase.SCIMUsecase
+	mux         *http.ServeMux
+}
+
+// NewHandler SCIMハンドラーを作成
+func NewHandler(scimUsecase usecase.SCIMUsecase) *Handler {
+	h := &Handler{
+		scimUsecase: scimUsecase,
+		mux:         http.NewServeMux(),
+	}
+
+	h.mux.HandleFunc("GET "+BasePath+"/Users", h.listUsers)
+	h.mux.HandleFunc("POST "+BasePath+"/Users", h.createUser)
+	h.mux.HandleFunc("GET "+BasePath+"/Users/{id}", h.getUser)
+	h.mux.HandleFunc("PUT "+BasePath+"/Users/{id}", h.replaceUser)
+	h.mux.HandleFunc("PATCH "+BasePath+"/Users/{id}", h.patchUser)
+	h.mux.HandleFunc("DELETE "+BasePath+"/Users/{id}", h.deleteUser)
+
+	h.mux.HandleFunc("GET "+BasePath+"/Groups", h.listGroups)
+	h.mux.HandleFunc("POST "+BasePath+"/Groups", h.createGroup)
+	h.mux.HandleFunc("GET "+BasePath+"/Groups/{id}", h.getGroup)
+	h.mux.HandleFunc("PUT "+BasePath+"/Groups/{id}", h.replaceGroup)
+	h.mux.HandleFunc("PATCH "+BasePath+"/Groups/{id}", h.patchGroup)
+	h.mux.HandleFunc("DELETE "+BasePath+"/Groups/{id}", h.deleteGroup)
+
+	h.mux.HandleFunc("GET "+BasePath+"/ServiceProviderConfig", h.getServiceProviderConfig)
+	h.mux.HandleFunc("GET "+BasePath+"/ResourceTypes", h.getResourceTypes)
+	h.mux.HandleFunc("GET "+BasePath+"/Schemas", h.getSchemas)
+
+	return h
+}
+
+// statusRecorder 監査ログ用にレスポンスのステータスコードを記録
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	err    string
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// ServeHTTP Bearerトークンを検証し、監査ログを記録してからルーティング
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	auditLog := interceptor.AuditLog{
+		Timestamp: time.Now(),
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		UserType:  "SCIM",
+	}
+	defer func() {
+		auditLog.StatusCode = rec.status
+		auditLog.Error = rec.err
+		interceptor.LogAudit(auditLog)
+	}()
+
+	token, ok := bearerToken(r)
+	if !ok {
+		rec.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(rec, http.StatusUnauthorized, "", "authorization header is required")
+		return
+	}
+	principal, err := h.scimUsecase.Authenticate(r.Context(), token)
+	if err != nil {
+		rec.Header().Set("WWW-Authenticate", `Bearer realm="scim", error="invalid_token"`)
+		writeError(rec, http.StatusUnauthorized, "", "invalid token")
+		return
+	}
+	auditLog.UserID = principal.TokenID.String()
+	auditLog.ClientID = principal.ClientID.String()
+
+	ctx := context.WithValue(r.Context(), principalKey{}, principal)
+	h.mux.ServeHTTP(rec, r.WithContext(ctx))
+}
+
+// bearerToken AuthorizationヘッダーからBearerトークンを取得
+func bearerToken(r *http.Request) (string, bool) {
+	authHeader := r.Header.Get("Authorization")
+	scheme, token, ok := strings.Cut(authHeader, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// principalFromContext 認証済みのSCIM呼び出し元を取得
+func principalFromContext(ctx context.Context) *usecase.SCIMPrincipal {
+	principal, _ := ctx.Value(principalKey{}).(*usecase.SCIMPrincipal)
+	return principal
+}
+
+// baseURL meta.locationに使用するエンドポイントのURL
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+	}
+	return scheme + "://" + r.Host + BasePath
+}
+
+// writeJSON SCIMレスポンスを書き込み
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError SCIMエラーレスポンスを書き込み
+func writeError(w http.ResponseWriter, status int, scimType, detail string) {
+	if rec, ok := w.(*statusRecorder); ok {
+		rec.err = detail
+	}
+	writeJSON(w, status, Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// handleError ユースケースのエラーをSCIMエラーレスポンスに変換
+func handleError(w http.ResponseWriter, err error) {
+	var reqErr *requestError
+	switch {
+	case errors.As(err, &reqErr):
+		writeError(w, http.StatusBadRequest, reqErr.scimType, reqErr.detail)
+	case errors.Is(err, usecase.ErrSCIMResourceNotFound):
+		writeError(w, http.StatusNotFound, "", err.Error())
+	case errors.Is(err, usecase.ErrEmailAlreadyExists), errors.Is(err, usecase.ErrGroupAlreadyExists):
+		writeError(w, http.StatusConflict, "uniqueness", err.Error())
+	case errors.Is(err, usecase.ErrConcurrentModification):
+		// 取得から更新までの間に他の更新があった（IdPの再送で解消する）
+		writeError(w, http.StatusConflict, "", err.Error())
+	case errors.Is(err, usecase.ErrSCIMMemberNotFound):
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+	case errors.Is(err, usecase.ErrSystemRoleImmutable):
+		writeError(w, http.StatusBadRequest, "mutability", err.Error())
+	case errors.Is(err, usecase.ErrPasswordPolicyViolation):
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+	default:
+		// 内部エラーの詳細はレスポンスに含めず、監査ログにのみ記録
+		writeError(w, http.StatusInternalServerError, "", "internal server error")
+		if rec, ok := w.(*statusRecorder); ok {
+			rec.err = err.Error()
+		}
+	}
+}
+
+// decodeBody リクエストボディをJSONオブジェクトとして読み込み
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return newRequestError("invalidSyntax", "invalid request body: %v", err)
+	}
+	return nil
+}
+
+// pathID パスパラメータのIDを解析（形式不正は存在しないリソースとして扱う）
+func pathID(r *http.Request) (uuid.UUID, error) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %s", usecase.ErrSCIMResourceNotFound, r.PathValue("id"))
+	}
+	return id, nil
+}
+
+// pagination startIndex（1始まり）とcountを解析
+func pagination(r *http.Request) (startIndex, count int, err error) {
+	startIndex, count = 1, defaultCount
+	query := r.URL.Query()
+	if v := query.Get("startIndex"); v != "" {
+		if startIndex, err = strconv.Atoi(v); err != nil {
+			return 0, 0, newRequestError("invalidValue", "invalid startIndex %q", v)
+		}
+		if startIndex < 1 {
+			startIndex = 1
+		}
+	}
+	if v := query.Get("count"); v != "" {
+		if count, err = strconv.Atoi(v); err != nil {
+			return 0, 0, newRequestError("invalidValue", "invalid count %q", v)
+		}
+		if count < 0 {
+			count = 0
+		}
+	}
+	if count > maxCount {
+		count = maxCount
+	}
+	return startIndex, count, nil
+}
+
+// parseFilterParam filterクエリパラメータを解析
+func parseFilterParam(r *http.Request) (Expr, error) {
+	filter := r.URL.Query().Get("filter")
+	if filter == "" {
+		return nil, nil
+	}
+	expr, err := ParseFilter(filter)
+	if err != nil {
+		return nil, newRequestError("invalidFilter", "invalid filter: %v", err)
+	}
+	return expr, nil
+}
+
+// pushdownUserFilter データベースで評価できるフィルタを絞り込み条件に変換
+// 対応するのはuserName・externalIdのeq・sw、activeのeq、およびそれらのandのみで、それ以外を含む場合はpushdownがfalse
+// externalIdは保存していないため、externalIdの比較は常に一致しない（matchNoneがtrue）
+func pushdownUserFilter(expr Expr) (filter usecase.SCIMUserFilter, matchNone bool, pushdown bool) {
+	switch e := expr.(type) {
+	case *logicalExpr:
+		if e.op != "and" {
+			return usecase.SCIMUserFilter{}, false, false
+		}
+		left, leftNone, leftOK := pushdownUserFilter(e.left)
+		right, rightNone, rightOK := pushdownUserFilter(e.right)
+		if (leftOK && leftNone) || (rightOK && rightNone) {
+			return usecase.SCIMUserFilter{}, true, true
+		}
+		// 同じ属性の条件が重複する場合はメモリ上で評価する
+		if !leftOK || !rightOK || (left.UserName != "" && right.UserName != "") || (left.Active != nil && right.Active != nil) {
+			return usecase.SCIMUserFilter{}, false, false
+		}
+		if right.UserName != "" {
+			left.UserName, left.UserNamePrefix = right.UserName, right.UserNamePrefix
+		}
+		if right.Active != nil {
+			left.Active = right.Active
+		}
+		return left, false, true
+	case *compareExpr:
+		path := splitAttrPath(e.attr)
+		if len(path) != 1 {
+			return usecase.SCIMUserFilter{}, false, false
+		}
+		switch attr := strings.ToLower(path[0]); {
+		case attr == "username" && (e.op == "eq" || e.op == "sw"):
+			value, ok := e.value.(string)
+			if !ok || value == "" {
+				return usecase.SCIMUserFilter{}, false, false
+			}
+			return usecase.SCIMUserFilter{UserName: value, UserNamePrefix: e.op == "sw"}, false, true
+		case attr == "externalid" && (e.op == "eq" || e.op == "sw"):
+			return usecase.SCIMUserFilter{}, true, true
+		case attr == "active" && e.op == "eq":
+			value, ok := e.value.(bool)
+			if !ok {
+				return usecase.SCIMUserFilter{}, false, false
+			}
+			return usecase.SCIMUserFilter{Active: &value}, false, true
+		}
+	}
+	return usecase.SCIMUserFilter{}, false, false
+}
+
+// excludeAttributes excludedAttributesで指定された属性をレスポンスから除外
+func excludeAttributes(r *http.Request, resource map[string]interface{}) map[string]interface{} {
+	excluded := r.URL.Query().Get("excludedAttributes")
+	if excluded == "" {
+		return resource
+	}
+	for _, attr := range strings.Split(excluded, ",") {
+		attr = strings.TrimSpace(attr)
+		// id・schemasは常に返却する（RFC 7643 2.4）
+		if strings.EqualFold(attr, "id") || strings.EqualFold(attr, "schemas") {
+			continue
+		}
+		if key, ok := getKey(resource, attr); ok {
+			delete(resource, key)
+		}
+	}
+	return resource
+}
+
+// writeList フィルタ済みのリソースをページングしてListResponseを返却
+func writeList(w http.ResponseWriter, r *http.Request, resources []map[string]interface{}, startIndex, count int) {
+	items := []interface{}{}
+	for i := startIndex - 1; i < len(resources) && len(items) < count; i++ {
+		items = append(items, excludeAttributes(r, resources[i]))
+	}
+	writeJSON(w, http.StatusOK, ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(items),
+		Resources:    items,
+	})
+}
+
+// listUsers GET /Users
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	principal := principalFromContext(r.Context())
+	startIndex, count, err := pagination(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	filter, err := parseFilterParam(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	base := baseURL(r)
+
+	// フィルタなし、またはデータベースで評価できるフィルタの場合はデータベースで絞り込み・ページング
+	var userFilter usecase.SCIMUserFilter
+	pushdown, matchNone := true, false
+	if filter != nil {
+		userFilter, matchNone, pushdown = pushdownUserFilter(filter)
+	}
+	if matchNone {
+		writeList(w, r, nil, startIndex, count)
+		return
+	}
+	if pushdown {
+		users, total, err := h.scimUsecase.ListUsers(r.Context(), principal, userFilter, int32(count), int32(startIndex-1))
+		if err != nil {
+			handleError(w, err)
+			return
+		}
+		items := make([]interface{}, 0, len(users))
+		for _, user := range users {
+			m, err := toMap(toSCIMUser(user, nil, base))
+			if err != nil {
+				handleError(w, err)
+				return
+			}
+			items = append(items, excludeAttributes(r, m))
+		}
+		writeJSON(w, http.StatusOK, ListResponse{
+			Schemas:      []string{SchemaListResponse},
+			TotalResults: int(total),
+			StartIndex:   startIndex,
+			ItemsPerPage: len(items),
+			Resources:    items,
+		})
+		return
+	}
+
+	// それ以外のフィルタは全件を取得してメモリ上で評価
+	users, err := h.scimUsecase.ListAllUsers(r.Context(), principal)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	matched := []map[string]interface{}{}
+	for _, user := range users {
+		m, err := toMap(toSCIMUser(user, nil, base))
+		if err != nil {
+			handleError(w, err)
+			return
+		}
+		if filter.Match(m) {
+			matched = append(matched, m)
+		}
+	}
+	writeList(w, r, matched, startIndex, count)
+}
+
+// writeUser ユーザーをグループ付きで返却
+func (h *Handler) writeUser(w http.ResponseWriter, r *http.Request, status int, user dbgen.ClientUser) {
+	principal := principalFromContext(r.Context())
+	groups, err := h.scimUsecase.GetUserGroups(r.Context(), principal, uuidFromPGType(user.ClientUserID))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	writeJSON(w, status, toSCIMUser(user, groups, baseURL(r)))
+}
+
+// getUser GET /Users/{id}
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	user, err := h.scimUsecase.GetUser(r.Context(), principalFromContext(r.Context()), id)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	h.writeUser(w, r, http.StatusOK, user)
+}
+
+// decodeUser リクエストボディをUserリソースとして読み込み
+func decodeUser(w http.ResponseWriter, r *http.Request) (User, error) {
+	var m map[string]interface{}
+	if err := decodeBody(w, r, &m); err != nil {
+		return User{}, err
+	}
+	return userFromMap(m)
+}
+
+// userFromMap JSON表現からUserに変換（activeの文字列表現を真偽値に正規化）
+func userFromMap(m map[string]interface{}) (User, error) {
+	if key, ok := getKey(m, "active"); ok {
+		if b, ok := toBool(m[key]); ok {
+			m[key] = b
+		} else if m[key] != nil {
+			return User{}, newRequestError("invalidValue", "active must be a boolean")
+		}
+	}
+	var user User
+	if err := fromMap(m, &user); err != nil {
+		return User{}, newRequestError("invalidValue", "invalid user resource: %v", err)
+	}
+	return user, nil
+}
+
+// validateUser ユーザー作成・置き換え時の必須属性チェック
+func validateUser(user User) error {
+	if user.UserName == "" && user.primaryEmail() == "" {
+		return newRequestError("invalidValue", "userName is required")
+	}
+	if user.Name == nil || user.Name.GivenName == "" || user.Name.FamilyName == "" {
+		return newRequestError("invalidValue", "name.givenName and name.familyName are required")
+	}
+	return nil
+}
+
+// userEmail メールアドレス（userNameを優先）
+func userEmail(user User) string {
+	if user.UserName != "" {
+		return user.UserName
+	}
+	return user.primaryEmail()
+}
+
+// userStatus active属性からステータスに変換
+func userStatus(active bool) string {
+	if active {
+		return string(domain.UserStatusActive)
+	}
+	return string(domain.UserStatusInactive)
+}
+
+// updateParams 現在のユーザーと変更後のリソースの差分から更新パラメータを作成
+func updateParams(current dbgen.ClientUser, user User) usecase.UpdateClientUserParams {
+	var params usecase.UpdateClientUserParams
+	// userNameとemailsのどちらか変更された方を採用（IdPによってPATCH対象が異なる）
+	email := user.UserName
+	if email == "" || email == current.Email {
+		email = user.primaryEmail()
+	}
+	if email != "" && email != current.Email {
+		params.Email = &email
+	}
+	if user.Name != nil {
+		if user.Name.GivenName != "" && user.Name.GivenName != current.FirstName {
+			params.FirstName = &user.Name.GivenName
+		}
+		if user.Name.FamilyName != "" && user.Name.FamilyName != current.LastName {
+			params.LastName = &user.Name.FamilyName
+		}
+	}
+	department := ""
+	if user.Enterprise != nil {
+		department = user.Enterprise.Department
+	}
+	if department != current.Department.String {
+		params.Department = &department
+	}
+	if user.Title != current.Position.String {
+		params.Position = &user.Title
+	}
+	if user.Active != nil && *user.Active != (current.Status == string(domain.UserStatusActive)) {
+		status := userStatus(*user.Active)
+		params.Status = &status
+	}
+	return params
+}
+
+// createUser POST /Users
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
+	principal := principalFromContext(r.Context())
+	user, err := decodeUser(w, r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	if err := validateUser(user); err != nil {
+		handleError(w, err)
+		return
+	}
+
+	params := usecase.CreateClientUserParams{
+		Email:     userEmail(user),
+		Password:  user.Password,
+		FirstName: user.Name.GivenName,
+		LastName:  user.Name.FamilyName,
+	}
+	if user.Enterprise != nil && user.Enterprise.Department != "" {
+		params.Department = &user.Enterprise.Department
+	}
+	if user.Title != "" {
+		params.Position = &user.Title
+	}
+	// 無効状態でのプロビジョニング（作成と同じトランザクションでステータスを設定する）
+	if user.Active != nil {
+		params.Status = domain.UserStatus(userStatus(*user.Active))
+	}
+
+	created, err := h.scimUsecase.CreateUser(r.Context(), principal, params)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	h.writeUser(w, r, http.StatusCreated, created)
+}
+
+// replaceUser PUT /Users/{id}
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	principal := principalFromContext(r.Context())
+	id, err := pathID(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	user, err := decodeUser(w, r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	if err := validateUser(user); err != nil {
+		handleError(w, err)
+		return
+	}
+	current, err := h.scimUsecase.GetUser(r.Context(), principal, id)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	// PUTは全体置き換えのため、active省略時は有効として扱う
+	if user.Active == nil {
+		active := true
+		user.Active = &active
+	}
+
+	updated, err := h.scimUsecase.UpdateUser(r.Context(), principal, id, updateParams(current, user))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	h.writeUser(w, r, http.StatusOK, updated)
+}
+
+// patchUser PATCH /Users/{id}
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	principal := principalFromContext(r.Context())
+	id, err := pathID(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	var req PatchRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		handleError(w, err)
+		return
+	}
+	current, err := h.scimUsecase.GetUser(r.Context(), principal, id)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	m, err := toMap(toSCIMUser(current, nil, baseURL(r)))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	if err := ApplyPatch(m, req.Operations); err != nil {
+		handleError(w, err)
+		return
+	}
+	user, err := userFromMap(m)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	updated, err := h.scimUsecase.UpdateUser(r.Context(), principal, id, updateParams(current, user))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	h.writeUser(w, r, http.StatusOK, updated)
+}
+
+// deleteUser DELETE /Users/{id}
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	if err := h.scimUsecase.DeleteUser(r.Context(), principalFromContext(r.Context()), id); err != nil {
+		handleError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listGroups GET /Groups
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	startIndex, count, err := pagination(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	filter, err := parseFilterParam(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	groups, err := h.scimUsecase.ListGroups(r.Context(), principalFromContext(r.Context()))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	base := baseURL(r)
+	matched := []map[string]interface{}{}
+	for _, group := range groups {
+		m, err := toMap(toSCIMGroup(group, base))
+		if err != nil {
+			handleError(w, err)
+			return
+		}
+		if filter == nil || filter.Match(m) {
+			matched = append(matched, m)
+		}
+	}
+	writeList(w, r, matched, startIndex, count)
+}
+
+// getGroup GET /Groups/{id}
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	group, err := h.scimUsecase.GetGroup(r.Context(), principalFromContext(r.Context()), id)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	m, err := toMap(toSCIMGroup(group, baseURL(r)))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, excludeAttributes(r, m))
+}
+
+// decodeGroup リクエストボディをGroupリソースとして読み込み
+func decodeGroup(w http.ResponseWriter, r *http.Request) (Group, []uuid.UUID, error) {
+	var group Group
+	if err := decodeBody(w, r, &group); err != nil {
+		return Group{}, nil, err
+	}
+	if group.DisplayName == "" {
+		return Group{}, nil, newRequestError("invalidValue", "displayName is required")
+	}
+	memberIDs, err := memberIDs(group.Members)
+	if err != nil {
+		return Group{}, nil, err
+	}
+	return group, memberIDs, nil
+}
+
+// memberIDs members属性からユーザーIDを取得
+func memberIDs(members []MultiValued) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.Parse(member.Value)
+		if err != nil {
+			return nil, newRequestError("invalidValue", "invalid member %q", member.Value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// createGroup POST /Groups
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	group, members, err := decodeGroup(w, r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	created, err := h.scimUsecase.CreateGroup(r.Context(), principalFromContext(r.Context()), group.DisplayName, members)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toSCIMGroup(created, baseURL(r)))
+}
+
+// replaceGroup PUT /Groups/{id}
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	group, members, err := decodeGroup(w, r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	updated, err := h.scimUsecase.UpdateGroup(r.Context(), principalFromContext(r.Context()), id, usecase.SCIMGroupUpdate{
+		DisplayName:    &group.DisplayName,
+		ReplaceMembers: &members,
+	})
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toSCIMGroup(updated, baseURL(r)))
+}
+
+// groupUpdate 現在のグループと変更後のリソースの差分から更新内容を作成
+func groupUpdate(current usecase.SCIMGroup, group Group) (usecase.SCIMGroupUpdate, error) {
+	var update usecase.SCIMGroupUpdate
+	if group.DisplayName != current.Role.Name {
+		if group.DisplayName == "" {
+			return update, newRequestError("mutability", "displayName cannot be removed")
+		}
+		update.DisplayName = &group.DisplayName
+	}
+
+	members, err := memberIDs(group.Members)
+	if err != nil {
+		return update, err
+	}
+	before := make(map[uuid.UUID]bool, len(current.MemberIDs))
+	for _, id := range current.MemberIDs {
+		before[id] = true
+	}
+	after := make(map[uuid.UUID]bool, len(members))
+	for _, id := range members {
+		after[id] = true
+		if !before[id] {
+			update.AddMembers = append(update.AddMembers, id)
+		}
+	}
+	for _, id := range current.MemberIDs {
+		if !after[id] {
+			update.RemoveMembers = append(update.RemoveMembers, id)
+		}
+	}
+	return update, nil
+}
+
+// patchGroup PATCH /Groups/{id}
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	principal := principalFromContext(r.Context())
+	id, err := pathID(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	var req PatchRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		handleError(w, err)
+		return
+	}
+	current, err := h.scimUsecase.GetGroup(r.Context(), principal, id)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	m, err := toMap(toSCIMGroup(current, baseURL(r)))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	if err := ApplyPatch(m, req.Operations); err != nil {
+		handleError(w, err)
+		return
+	}
+	var group Group
+	if err := fromMap(m, &group); err != nil {
+		handleError(w, newRequestError("invalidValue", "invalid group resource: %v", err))
+		return
+	}
+	update, err := groupUpdate(current, group)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	updated, err := h.scimUsecase.UpdateGroup(r.Context(), principal, id, update)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toSCIMGroup(updated, baseURL(r)))
+}
+
+// deleteGroup DELETE /Groups/{id}
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	if err := h.scimUsecase.DeleteGroup(r.Context(), principalFromContext(r.Context()), id); err != nil {
+		handleError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getServiceProviderConfig GET /ServiceProviderConfig
+func (h *Handler) getServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, serviceProviderConfig())
+}
+
+// getResourceTypes GET /ResourceTypes
+func (h *Handler) getResourceTypes(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, resourceTypes(baseURL(r)), 1, maxCount)
+}
+
+// getSchemas GET /Schemas
+func (h *Handler) getSchemas(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, schemas(), 1, maxCount)
+}
@@ -0,0 +1,516 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/usecase"
+	dbgen "contract-pro-suite/sqlc"
+)
+
+// MockSCIMUsecase モックSCIMUsecase
+type MockSCIMUsecase struct {
+	mock.Mock
+}
+
+func (m *MockSCIMUsecase) Authenticate(ctx context.Context, token string) (*usecase.SCIMPrincipal, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.SCIMPrincipal), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) CreateToken(ctx context.Context, userCtx *domain.UserContext, description string, expiresAt *time.Time) (*usecase.CreateSCIMTokenResult, error) {
+	args := m.Called(ctx, userCtx, description, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.CreateSCIMTokenResult), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) RevokeToken(ctx context.Context, userCtx *domain.UserContext, tokenID uuid.UUID) error {
+	args := m.Called(ctx, userCtx, tokenID)
+	return args.Error(0)
+}
+
+func (m *MockSCIMUsecase) ListUsers(ctx context.Context, principal *usecase.SCIMPrincipal, filter usecase.SCIMUserFilter, limit, offset int32) ([]dbgen.ClientUser, int64, error) {
+	args := m.Called(ctx, principal, filter, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]dbgen.ClientUser), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockSCIMUsecase) ListAllUsers(ctx context.Context, principal *usecase.SCIMPrincipal) ([]dbgen.ClientUser, error) {
+	args := m.Called(ctx, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) GetUser(ctx context.Context, principal *usecase.SCIMPrincipal, clientUserID uuid.UUID) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, principal, clientUserID)
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) GetUserGroups(ctx context.Context, principal *usecase.SCIMPrincipal, clientUserID uuid.UUID) ([]dbgen.ClientRole, error) {
+	args := m.Called(ctx, principal, clientUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientRole), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) CreateUser(ctx context.Context, principal *usecase.SCIMPrincipal, params usecase.CreateClientUserParams) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, principal, params)
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) UpdateUser(ctx context.Context, principal *usecase.SCIMPrincipal, clientUserID uuid.UUID, params usecase.UpdateClientUserParams) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, principal, clientUserID, params)
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) DeleteUser(ctx context.Context, principal *usecase.SCIMPrincipal, clientUserID uuid.UUID) error {
+	args := m.Called(ctx, principal, clientUserID)
+	return args.Error(0)
+}
+
+func (m *MockSCIMUsecase) ListGroups(ctx context.Context, principal *usecase.SCIMPrincipal) ([]usecase.SCIMGroup, error) {
+	args := m.Called(ctx, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]usecase.SCIMGroup), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) GetGroup(ctx context.Context, principal *usecase.SCIMPrincipal, roleID uuid.UUID) (usecase.SCIMGroup, error) {
+	args := m.Called(ctx, principal, roleID)
+	return args.Get(0).(usecase.SCIMGroup), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) CreateGroup(ctx context.Context, principal *usecase.SCIMPrincipal, displayName string, memberIDs []uuid.UUID) (usecase.SCIMGroup, error) {
+	args := m.Called(ctx, principal, displayName, memberIDs)
+	return args.Get(0).(usecase.SCIMGroup), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) UpdateGroup(ctx context.Context, principal *usecase.SCIMPrincipal, roleID uuid.UUID, update usecase.SCIMGroupUpdate) (usecase.SCIMGroup, error) {
+	args := m.Called(ctx, principal, roleID, update)
+	return args.Get(0).(usecase.SCIMGroup), args.Error(1)
+}
+
+func (m *MockSCIMUsecase) DeleteGroup(ctx context.Context, principal *usecase.SCIMPrincipal, roleID uuid.UUID) error {
+	args := m.Called(ctx, principal, roleID)
+	return args.Error(0)
+}
+
+var (
+	testPrincipal = &usecase.SCIMPrincipal{
+		TokenID:  uuid.MustParse("123e4567-e89b-12d3-a456-426614174100"),
+		ClientID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+	}
+	testUserID = uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	testRoleID = uuid.MustParse("123e4567-e89b-12d3-a456-426614174003")
+)
+
+func testClientUser() dbgen.ClientUser {
+	return dbgen.ClientUser{
+		ClientUserID: pgtype.UUID{Bytes: testUserID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: testPrincipal.ClientID, Valid: true},
+		Email:        "taro@example.com",
+		FirstName:    "太郎",
+		LastName:     "山田",
+		Department:   pgtype.Text{String: "営業部", Valid: true},
+		Status:       "ACTIVE",
+	}
+}
+
+// newTestRequest 認証済みのSCIMリクエストを作成
+func newTestRequest(method, path, body string) *http.Request {
+	req := httptest.NewRequest(method, BasePath+path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer scim_test")
+	req.Header.Set("Content-Type", contentType)
+	return req
+}
+
+func serve(t *testing.T, mockUsecase *MockSCIMUsecase, req *http.Request) (*httptest.ResponseRecorder, map[string]interface{}) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	NewHandler(mockUsecase).ServeHTTP(rec, req)
+
+	var body map[string]interface{}
+	if rec.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	}
+	return rec, body
+}
+
+func TestHandler_Authentication(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		authError     error
+		expected      int
+	}{
+		{name: "失敗: Authorizationヘッダーなし", authorization: "", expected: http.StatusUnauthorized},
+		{name: "失敗: Bearer以外", authorization: "Basic dXNlcjpwYXNz", expected: http.StatusUnauthorized},
+		{name: "失敗: 無効なトークン", authorization: "Bearer scim_invalid", authError: usecase.ErrInvalidSCIMToken, expected: http.StatusUnauthorized},
+		{name: "成功: 有効なトークン", authorization: "Bearer scim_test", expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockSCIMUsecase)
+			if tt.authError != nil {
+				mockUsecase.On("Authenticate", mock.Anything, "scim_invalid").Return(nil, tt.authError)
+			} else {
+				mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil).Maybe()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, BasePath+"/ServiceProviderConfig", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec, body := serve(t, mockUsecase, req)
+
+			assert.Equal(t, tt.expected, rec.Code)
+			assert.Equal(t, contentType, rec.Header().Get("Content-Type"))
+			if tt.expected == http.StatusUnauthorized {
+				assert.Equal(t, []interface{}{SchemaError}, body["schemas"])
+				assert.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+			}
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_ListUsers(t *testing.T) {
+	t.Run("成功: フィルタなし（データベースでページング）", func(t *testing.T) {
+		mockUsecase := new(MockSCIMUsecase)
+		mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+		mockUsecase.On("ListUsers", mock.Anything, testPrincipal, usecase.SCIMUserFilter{}, int32(10), int32(20)).
+			Return([]dbgen.ClientUser{testClientUser()}, int64(21), nil)
+
+		rec, body := serve(t, mockUsecase, newTestRequest(http.MethodGet, "/Users?startIndex=21&count=10", ""))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, float64(21), body["totalResults"])
+		assert.Equal(t, float64(21), body["startIndex"])
+		assert.Equal(t, float64(1), body["itemsPerPage"])
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("成功: userName・activeのフィルタはデータベースで絞り込み", func(t *testing.T) {
+		active := true
+		mockUsecase := new(MockSCIMUsecase)
+		mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+		mockUsecase.On("ListUsers", mock.Anything, testPrincipal, usecase.SCIMUserFilter{UserName: "Taro@Example.com", Active: &active}, int32(100), int32(0)).
+			Return([]dbgen.ClientUser{testClientUser()}, int64(1), nil)
+
+		filter := `userName eq "Taro@Example.com" and active eq true`
+		rec, body := serve(t, mockUsecase, newTestRequest(http.MethodGet, "/Users?filter="+url.QueryEscape(filter), ""))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, float64(1), body["totalResults"])
+		resources := body["Resources"].([]interface{})
+		require.Len(t, resources, 1)
+		assert.Equal(t, testUserID.String(), resources[0].(map[string]interface{})["id"])
+		mockUsecase.AssertExpectations(t)
+		mockUsecase.AssertNotCalled(t, "ListAllUsers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("成功: externalIdのフィルタは一致なし（externalIdは保存しない）", func(t *testing.T) {
+		mockUsecase := new(MockSCIMUsecase)
+		mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+
+		filter := `externalId eq "00u1abcd"`
+		rec, body := serve(t, mockUsecase, newTestRequest(http.MethodGet, "/Users?filter="+url.QueryEscape(filter), ""))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, float64(0), body["totalResults"])
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("成功: データベースで評価できないフィルタはメモリ上で評価", func(t *testing.T) {
+		other := testClientUser()
+		other.ClientUserID = pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		other.LastName = "佐藤"
+
+		mockUsecase := new(MockSCIMUsecase)
+		mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+		mockUsecase.On("ListAllUsers", mock.Anything, testPrincipal).
+			Return([]dbgen.ClientUser{testClientUser(), other}, nil)
+
+		filter := `name.familyName eq "山田"`
+		rec, body := serve(t, mockUsecase, newTestRequest(http.MethodGet, "/Users?filter="+url.QueryEscape(filter), ""))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, float64(1), body["totalResults"])
+		resources := body["Resources"].([]interface{})
+		require.Len(t, resources, 1)
+		assert.Equal(t, testUserID.String(), resources[0].(map[string]interface{})["id"])
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("失敗: 不正なフィルタ", func(t *testing.T) {
+		mockUsecase := new(MockSCIMUsecase)
+		mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+
+		rec, body := serve(t, mockUsecase, newTestRequest(http.MethodGet, "/Users?filter=userName%20like%20%22x%22", ""))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "invalidFilter", body["scimType"])
+	})
+}
+
+func TestHandler_CreateUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(m *MockSCIMUsecase)
+		expectedStatus int
+		expectedType   string
+	}{
+		{
+			name: "成功: ユーザー作成",
+			body: `{"schemas":["` + SchemaUser + `"],"userName":"taro@example.com","name":{"givenName":"太郎","familyName":"山田"},"title":"課長","active":true}`,
+			setupMock: func(m *MockSCIMUsecase) {
+				position := "課長"
+				m.On("CreateUser", mock.Anything, testPrincipal, usecase.CreateClientUserParams{
+					Email:     "taro@example.com",
+					FirstName: "太郎",
+					LastName:  "山田",
+					Position:  &position,
+					Status:    domain.UserStatusActive,
+				}).Return(testClientUser(), nil)
+				m.On("GetUserGroups", mock.Anything, testPrincipal, testUserID).Return([]dbgen.ClientRole{}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "成功: 無効状態で作成",
+			body: `{"userName":"taro@example.com","name":{"givenName":"太郎","familyName":"山田"},"active":"False"}`,
+			setupMock: func(m *MockSCIMUsecase) {
+				// 作成後の更新ではなく、作成時にステータスを指定する
+				m.On("CreateUser", mock.Anything, testPrincipal, usecase.CreateClientUserParams{
+					Email:     "taro@example.com",
+					FirstName: "太郎",
+					LastName:  "山田",
+					Status:    domain.UserStatusInactive,
+				}).Return(testClientUser(), nil)
+				m.On("GetUserGroups", mock.Anything, testPrincipal, testUserID).Return([]dbgen.ClientRole{}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "失敗: userNameなし",
+			body:           `{"name":{"givenName":"太郎","familyName":"山田"}}`,
+			setupMock:      func(m *MockSCIMUsecase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   "invalidValue",
+		},
+		{
+			name: "失敗: メールアドレス重複",
+			body: `{"userName":"taro@example.com","name":{"givenName":"太郎","familyName":"山田"}}`,
+			setupMock: func(m *MockSCIMUsecase) {
+				m.On("CreateUser", mock.Anything, testPrincipal, mock.Anything).
+					Return(dbgen.ClientUser{}, fmt.Errorf("%w: taro@example.com", usecase.ErrEmailAlreadyExists))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedType:   "uniqueness",
+		},
+		{
+			name: "失敗: 内部エラーの詳細は返却しない",
+			body: `{"userName":"taro@example.com","name":{"givenName":"太郎","familyName":"山田"}}`,
+			setupMock: func(m *MockSCIMUsecase) {
+				m.On("CreateUser", mock.Anything, testPrincipal, mock.Anything).
+					Return(dbgen.ClientUser{}, fmt.Errorf("failed to create supabase user: connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockSCIMUsecase)
+			mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+			tt.setupMock(mockUsecase)
+
+			rec, body := serve(t, mockUsecase, newTestRequest(http.MethodPost, "/Users", tt.body))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusCreated {
+				assert.Equal(t, testUserID.String(), body["id"])
+				assert.Equal(t, "taro@example.com", body["userName"])
+				assert.Equal(t, "営業部", body[SchemaEnterpriseUser].(map[string]interface{})["department"])
+			} else {
+				assert.Equal(t, tt.expectedType, stringValue(body["scimType"]))
+				assert.NotContains(t, rec.Body.String(), "connection refused")
+			}
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func TestHandler_PatchUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected usecase.UpdateClientUserParams
+	}{
+		{
+			name: "Entra ID形式: active文字列とフィルタ付きパス",
+			body: `{"schemas":["` + SchemaPatchOp + `"],"Operations":[
+				{"op":"Replace","path":"active","value":"False"},
+				{"op":"Replace","path":"emails[type eq \"work\"].value","value":"new@example.com"},
+				{"op":"Add","path":"` + SchemaEnterpriseUser + `:department","value":"開発部"}
+			]}`,
+			expected: usecase.UpdateClientUserParams{
+				Email:      stringPtr("new@example.com"),
+				Department: stringPtr("開発部"),
+				Status:     stringPtr("INACTIVE"),
+			},
+		},
+		{
+			name: "Okta形式: パス省略",
+			body: `{"schemas":["` + SchemaPatchOp + `"],"Operations":[
+				{"op":"replace","value":{"active":true,"name":{"givenName":"次郎"}}}
+			]}`,
+			expected: usecase.UpdateClientUserParams{
+				FirstName: stringPtr("次郎"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockSCIMUsecase)
+			mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+			mockUsecase.On("GetUser", mock.Anything, testPrincipal, testUserID).Return(testClientUser(), nil)
+			mockUsecase.On("UpdateUser", mock.Anything, testPrincipal, testUserID, tt.expected).Return(testClientUser(), nil)
+			mockUsecase.On("GetUserGroups", mock.Anything, testPrincipal, testUserID).Return([]dbgen.ClientRole{}, nil)
+
+			rec, _ := serve(t, mockUsecase, newTestRequest(http.MethodPatch, "/Users/"+testUserID.String(), tt.body))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestHandler_GetUser_NotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{name: "形式不正のID", id: "not-a-uuid"},
+		{name: "他クライアントまたは存在しないユーザー", id: testUserID.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockSCIMUsecase)
+			mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+			mockUsecase.On("GetUser", mock.Anything, testPrincipal, testUserID).
+				Return(dbgen.ClientUser{}, fmt.Errorf("%w: user %s", usecase.ErrSCIMResourceNotFound, testUserID)).Maybe()
+
+			rec, body := serve(t, mockUsecase, newTestRequest(http.MethodGet, "/Users/"+tt.id, ""))
+
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+			assert.Equal(t, "404", body["status"])
+		})
+	}
+}
+
+func TestHandler_PatchGroup(t *testing.T) {
+	existingMember := uuid.MustParse("123e4567-e89b-12d3-a456-426614174010")
+	newMember := uuid.MustParse("123e4567-e89b-12d3-a456-426614174011")
+	group := usecase.SCIMGroup{
+		Role: dbgen.ClientRole{
+			RoleID:   pgtype.UUID{Bytes: testRoleID, Valid: true},
+			ClientID: pgtype.UUID{Bytes: testPrincipal.ClientID, Valid: true},
+			Name:     "営業",
+		},
+		MemberIDs: []uuid.UUID{existingMember},
+	}
+
+	tests := []struct {
+		name     string
+		body     string
+		expected usecase.SCIMGroupUpdate
+	}{
+		{
+			name: "メンバー追加",
+			body: `{"Operations":[{"op":"add","path":"members","value":[{"value":"` + newMember.String() + `"}]}]}`,
+			expected: usecase.SCIMGroupUpdate{
+				AddMembers: []uuid.UUID{newMember},
+			},
+		},
+		{
+			name: "メンバー削除（フィルタ付きパス）",
+			body: `{"Operations":[{"op":"remove","path":"members[value eq \"` + existingMember.String() + `\"]"}]}`,
+			expected: usecase.SCIMGroupUpdate{
+				RemoveMembers: []uuid.UUID{existingMember},
+			},
+		},
+		{
+			name: "表示名変更",
+			body: `{"Operations":[{"op":"replace","value":{"displayName":"営業部"}}]}`,
+			expected: usecase.SCIMGroupUpdate{
+				DisplayName: stringPtr("営業部"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockSCIMUsecase)
+			mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+			mockUsecase.On("GetGroup", mock.Anything, testPrincipal, testRoleID).Return(group, nil)
+			mockUsecase.On("UpdateGroup", mock.Anything, testPrincipal, testRoleID, tt.expected).Return(group, nil)
+
+			rec, body := serve(t, mockUsecase, newTestRequest(http.MethodPatch, "/Groups/"+testRoleID.String(), tt.body))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, testRoleID.String(), body["id"])
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_DeleteGroup_SystemRole(t *testing.T) {
+	mockUsecase := new(MockSCIMUsecase)
+	mockUsecase.On("Authenticate", mock.Anything, "scim_test").Return(testPrincipal, nil)
+	mockUsecase.On("DeleteGroup", mock.Anything, testPrincipal, testRoleID).Return(usecase.ErrSystemRoleImmutable)
+
+	rec, body := serve(t, mockUsecase, newTestRequest(http.MethodDelete, "/Groups/"+testRoleID.String(), ""))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "mutability", body["scimType"])
+	mockUsecase.AssertExpectations(t)
+}
@@ -0,0 +1,279 @@
+package scim
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PatchRequest PATCHリクエスト（RFC 7644 3.5.2）
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation PATCH操作
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// requestError リクエスト不正のエラー（レスポンスのscimTypeを保持）
+type requestError struct {
+	scimType string
+	detail   string
+}
+
+func (e *requestError) Error() string {
+	return e.detail
+}
+
+func newRequestError(scimType, format string, args ...interface{}) error {
+	return &requestError{scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+// patchPath 解析済みのPATCHパス（attr[filter].sub）
+type patchPath struct {
+	attr   string
+	filter Expr
+	sub    string
+}
+
+// parsePatchPath PATCHパスを解析
+func parsePatchPath(path string) (*patchPath, error) {
+	open := strings.Index(path, "[")
+	if open < 0 {
+		return &patchPath{attr: path}, nil
+	}
+	closeIdx := strings.LastIndex(path, "]")
+	if closeIdx < open {
+		return nil, newRequestError("invalidPath", "invalid path %q", path)
+	}
+	filter, err := ParseFilter(path[open+1 : closeIdx])
+	if err != nil {
+		return nil, newRequestError("invalidPath", "invalid path %q: %v", path, err)
+	}
+	p := &patchPath{attr: path[:open], filter: filter}
+	rest := path[closeIdx+1:]
+	if rest != "" {
+		if !strings.HasPrefix(rest, ".") || len(rest) == 1 {
+			return nil, newRequestError("invalidPath", "invalid path %q", path)
+		}
+		p.sub = rest[1:]
+	}
+	return p, nil
+}
+
+// ApplyPatch リソースのJSON表現にPATCH操作を順に適用
+func ApplyPatch(resource map[string]interface{}, operations []PatchOperation) error {
+	for _, op := range operations {
+		if err := applyOperation(resource, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyOperation 1件のPATCH操作を適用
+func applyOperation(resource map[string]interface{}, op PatchOperation) error {
+	kind := strings.ToLower(op.Op)
+	switch kind {
+	case "add", "replace", "remove":
+	default:
+		return newRequestError("invalidSyntax", "unsupported op %q", op.Op)
+	}
+
+	if op.Path == "" {
+		if kind == "remove" {
+			return newRequestError("noTarget", "path is required for remove")
+		}
+		// パス省略時は値のオブジェクトを属性ごとに適用
+		values, ok := op.Value.(map[string]interface{})
+		if !ok {
+			return newRequestError("invalidValue", "value must be an object when path is omitted")
+		}
+		for attr, value := range values {
+			if err := setAttr(resource, attr, value, kind == "add"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	path, err := parsePatchPath(op.Path)
+	if err != nil {
+		return err
+	}
+	if path.filter != nil {
+		return applyFiltered(resource, path, kind, op.Value)
+	}
+	if kind == "remove" {
+		return removeAttr(resource, path.attr, op.Value)
+	}
+	return setAttr(resource, path.attr, op.Value, kind == "add")
+}
+
+// parentOf 属性パスの親オブジェクトと最終属性名を取得（createがtrueなら途中のオブジェクトを作成）
+func parentOf(resource map[string]interface{}, attr string, create bool) (map[string]interface{}, string, error) {
+	parts := splitAttrPath(attr)
+	current := resource
+	for _, name := range parts[:len(parts)-1] {
+		key, ok := getKey(current, name)
+		if !ok {
+			if !create {
+				return nil, "", nil
+			}
+			key = name
+			current[key] = map[string]interface{}{}
+		}
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			return nil, "", newRequestError("invalidPath", "attribute %q is not a complex attribute", name)
+		}
+		current = next
+	}
+	last := parts[len(parts)-1]
+	if key, ok := getKey(current, last); ok {
+		last = key
+	}
+	return current, last, nil
+}
+
+// setAttr 属性を設定（addかつ複数値属性の場合は要素を追加）
+func setAttr(resource map[string]interface{}, attr string, value interface{}, add bool) error {
+	parent, key, err := parentOf(resource, attr, true)
+	if err != nil {
+		return err
+	}
+	existing, hasExisting := parent[key]
+	if add && hasExisting {
+		if arr, ok := existing.([]interface{}); ok {
+			if values, ok := value.([]interface{}); ok {
+				parent[key] = append(arr, values...)
+			} else {
+				parent[key] = append(arr, value)
+			}
+			return nil
+		}
+		// 複合属性への追加はサブ属性単位でマージ
+		if m, ok := existing.(map[string]interface{}); ok {
+			if values, ok := value.(map[string]interface{}); ok {
+				for k, v := range values {
+					m[k] = v
+				}
+				return nil
+			}
+		}
+	}
+	parent[key] = value
+	return nil
+}
+
+// removeAttr 属性を削除
+// 複数値属性に値が指定された場合は、valueが一致する要素のみ削除（Entra IDのメンバー削除形式）
+func removeAttr(resource map[string]interface{}, attr string, value interface{}) error {
+	parent, key, err := parentOf(resource, attr, false)
+	if err != nil || parent == nil {
+		return err
+	}
+	arr, isArray := parent[key].([]interface{})
+	targets, hasTargets := value.([]interface{})
+	if !isArray || !hasTargets {
+		delete(parent, key)
+		return nil
+	}
+	remaining := make([]interface{}, 0, len(arr))
+	for _, elem := range arr {
+		if !containsValue(targets, elem) {
+			remaining = append(remaining, elem)
+		}
+	}
+	parent[key] = remaining
+	return nil
+}
+
+// containsValue 要素のvalueサブ属性が削除対象に含まれるか判定
+func containsValue(targets []interface{}, elem interface{}) bool {
+	m, ok := elem.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	key, ok := getKey(m, "value")
+	if !ok {
+		return false
+	}
+	for _, target := range targets {
+		t, ok := target.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if tk, ok := getKey(t, "value"); ok && fmt.Sprint(t[tk]) == fmt.Sprint(m[key]) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyFiltered フィルタ付きパス（emails[type eq "work"].value等）の操作を適用
+func applyFiltered(resource map[string]interface{}, path *patchPath, kind string, value interface{}) error {
+	parent, key, err := parentOf(resource, path.attr, kind != "remove")
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return newRequestError("noTarget", "no target for path %q", path.attr)
+	}
+	arr, _ := parent[key].([]interface{})
+
+	matched := false
+	result := make([]interface{}, 0, len(arr))
+	for _, elem := range arr {
+		m, ok := elem.(map[string]interface{})
+		if !ok || !path.filter.Match(m) {
+			result = append(result, elem)
+			continue
+		}
+		matched = true
+		switch {
+		case kind == "remove" && path.sub == "":
+			// 要素ごと削除
+			continue
+		case kind == "remove":
+			if k, ok := getKey(m, path.sub); ok {
+				delete(m, k)
+			}
+		case path.sub != "":
+			subKey := path.sub
+			if k, ok := getKey(m, path.sub); ok {
+				subKey = k
+			}
+			m[subKey] = value
+		default:
+			values, ok := value.(map[string]interface{})
+			if !ok {
+				return newRequestError("invalidValue", "value must be an object for path %q", path.attr)
+			}
+			for k, v := range values {
+				m[k] = v
+			}
+		}
+		result = append(result, m)
+	}
+
+	if !matched {
+		if kind == "remove" {
+			return newRequestError("noTarget", "no element matched path %q", path.attr)
+		}
+		if path.sub == "" {
+			return newRequestError("noTarget", "no element matched path %q", path.attr)
+		}
+		// 一致する要素がない場合は、フィルタの等価条件から要素を作成（emails[type eq "work"].value の初回設定等）
+		elem := map[string]interface{}{path.sub: value}
+		if cmp, ok := path.filter.(*compareExpr); ok && cmp.op == "eq" {
+			elem[cmp.attr] = cmp.value
+		}
+		result = append(result, elem)
+	}
+	parent[key] = result
+	return nil
+}
@@ -0,0 +1,323 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/usecase"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SCIMスキーマURN（RFC 7643）
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaEnterpriseUser        = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// User SCIMのUserリソース（client_usersに対応）
+type User struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	UserName    string          `json:"userName"`
+	Name        *Name           `json:"name,omitempty"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Title       string          `json:"title,omitempty"`
+	Emails      []MultiValued   `json:"emails,omitempty"`
+	Active      *bool           `json:"active,omitempty"`
+	Password    string          `json:"password,omitempty"`
+	Groups      []MultiValued   `json:"groups,omitempty"`
+	Enterprise  *EnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta        *Meta           `json:"meta,omitempty"`
+}
+
+// Name SCIMのname複合属性
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// EnterpriseUser エンタープライズユーザー拡張（departmentのみ対応）
+type EnterpriseUser struct {
+	Department string `json:"department,omitempty"`
+}
+
+// MultiValued 複数値属性の要素（emails、groups、members）
+type MultiValued struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// Group SCIMのGroupリソース（client_rolesに対応）
+type Group struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []MultiValued `json:"members,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+// Meta リソースのメタデータ
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+// ListResponse 一覧レスポンス
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// Error SCIMエラーレスポンス（RFC 7644 3.12）
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// formatTime SCIMの日時形式（RFC 3339）に変換
+func formatTime(t pgtype.Timestamptz) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.UTC().Format(time.RFC3339)
+}
+
+// uuidFromPGType pgtype.UUIDからuuid.UUIDに変換
+func uuidFromPGType(pgUUID pgtype.UUID) uuid.UUID {
+	if !pgUUID.Valid {
+		return uuid.Nil
+	}
+	return pgUUID.Bytes
+}
+
+// toSCIMUser client_usersのレコードをSCIMのUserに変換
+func toSCIMUser(user dbgen.ClientUser, groups []dbgen.ClientRole, baseURL string) User {
+	id := uuidFromPGType(user.ClientUserID).String()
+	active := user.Status == string(domain.UserStatusActive)
+
+	u := User{
+		Schemas:  []string{SchemaUser},
+		ID:       id,
+		UserName: user.Email,
+		Name: &Name{
+			// 日本語表記に合わせて「姓 名」の順
+			Formatted:  strings.TrimSpace(user.LastName + " " + user.FirstName),
+			FamilyName: user.LastName,
+			GivenName:  user.FirstName,
+		},
+		DisplayName: strings.TrimSpace(user.LastName + " " + user.FirstName),
+		Emails: []MultiValued{
+			{Value: user.Email, Type: "work", Primary: true},
+		},
+		Active: &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      formatTime(user.CreatedAt),
+			LastModified: formatTime(user.UpdatedAt),
+			Location:     baseURL + "/Users/" + id,
+		},
+	}
+	if user.Position.Valid {
+		u.Title = user.Position.String
+	}
+	if user.Department.Valid && user.Department.String != "" {
+		u.Schemas = append(u.Schemas, SchemaEnterpriseUser)
+		u.Enterprise = &EnterpriseUser{Department: user.Department.String}
+	}
+	for _, role := range groups {
+		roleID := uuidFromPGType(role.RoleID).String()
+		u.Groups = append(u.Groups, MultiValued{
+			Value:   roleID,
+			Display: role.Name,
+			Ref:     baseURL + "/Groups/" + roleID,
+		})
+	}
+	return u
+}
+
+// toSCIMGroup ロールとメンバーをSCIMのGroupに変換
+func toSCIMGroup(group usecase.SCIMGroup, baseURL string) Group {
+	id := uuidFromPGType(group.Role.RoleID).String()
+	g := Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          id,
+		DisplayName: group.Role.Name,
+		Members:     make([]MultiValued, 0, len(group.MemberIDs)),
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      formatTime(group.Role.CreatedAt),
+			Location:     baseURL + "/Groups/" + id,
+		},
+	}
+	for _, memberID := range group.MemberIDs {
+		g.Members = append(g.Members, MultiValued{
+			Value: memberID.String(),
+			Ref:   baseURL + "/Users/" + memberID.String(),
+		})
+	}
+	return g
+}
+
+// toMap リソースをフィルタ・PATCH評価用のJSON表現に変換
+func toMap(resource interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// fromMap JSON表現をリソースに戻す
+func fromMap(m map[string]interface{}, resource interface{}) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, resource)
+}
+
+// primaryEmail emails属性から代表メールアドレスを取得（primary優先、なければ先頭）
+func (u *User) primaryEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary && email.Value != "" {
+			return email.Value
+		}
+	}
+	for _, email := range u.Emails {
+		if email.Value != "" {
+			return email.Value
+		}
+	}
+	return ""
+}
+
+// serviceProviderConfig サポートする機能（RFC 7643 5）
+func serviceProviderConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxCount},
+		"changePassword": map[string]bool{"supported": false},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]interface{}{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "クライアントごとに発行したSCIMトークンによる認証",
+				"primary":     true,
+			},
+		},
+	}
+}
+
+// resourceTypes サポートするリソースタイプ（RFC 7643 6）
+func resourceTypes(baseURL string) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"schemas":  []string{"urn:ietf:params:scim:schemas:core:2.0:ResourceType"},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   SchemaUser,
+			"schemaExtensions": []map[string]interface{}{
+				{"schema": SchemaEnterpriseUser, "required": false},
+			},
+			"meta": Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/User"},
+		},
+		{
+			"schemas":  []string{"urn:ietf:params:scim:schemas:core:2.0:ResourceType"},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   SchemaGroup,
+			"meta":     Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/Group"},
+		},
+	}
+}
+
+// schemaAttribute スキーマ定義の属性
+func schemaAttribute(name, attrType string, multiValued, required bool) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        name,
+		"type":        attrType,
+		"multiValued": multiValued,
+		"required":    required,
+		"mutability":  "readWrite",
+		"returned":    "default",
+	}
+}
+
+// schemas サポートする属性のスキーマ定義（RFC 7643 7）
+func schemas() []map[string]interface{} {
+	name := schemaAttribute("name", "complex", false, true)
+	name["subAttributes"] = []map[string]interface{}{
+		schemaAttribute("formatted", "string", false, false),
+		schemaAttribute("familyName", "string", false, true),
+		schemaAttribute("givenName", "string", false, true),
+	}
+	return []map[string]interface{}{
+		{
+			"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:Schema"},
+			"id":          SchemaUser,
+			"name":        "User",
+			"description": "クライアントユーザー",
+			"attributes": []map[string]interface{}{
+				schemaAttribute("userName", "string", false, true),
+				name,
+				schemaAttribute("displayName", "string", false, false),
+				schemaAttribute("title", "string", false, false),
+				schemaAttribute("emails", "complex", true, false),
+				schemaAttribute("active", "boolean", false, false),
+				schemaAttribute("password", "string", false, false),
+				schemaAttribute("groups", "complex", true, false),
+			},
+		},
+		{
+			"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:Schema"},
+			"id":          SchemaEnterpriseUser,
+			"name":        "EnterpriseUser",
+			"description": "エンタープライズユーザー拡張",
+			"attributes": []map[string]interface{}{
+				schemaAttribute("department", "string", false, false),
+			},
+		},
+		{
+			"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:Schema"},
+			"id":          SchemaGroup,
+			"name":        "Group",
+			"description": "クライアントロール",
+			"attributes": []map[string]interface{}{
+				schemaAttribute("displayName", "string", false, true),
+				schemaAttribute("members", "complex", true, false),
+			},
+		},
+	}
+}
@@ -8,7 +8,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	"contract-pro-suite/internal/interceptor"
-	pbauth "contract-pro-suite/proto/auth"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/domain"
 	"contract-pro-suite/services/auth/usecase"
 	dbgen "contract-pro-suite/sqlc"
 
@@ -19,13 +20,27 @@ import (
 // AuthServer 認証gRPCサーバー
 type AuthServer struct {
 	pbauth.UnimplementedAuthServiceServer
-	authUsecase usecase.AuthUsecase
+	authUsecase             usecase.AuthUsecase
+	scimUsecase             usecase.SCIMUsecase
+	serviceAccountUsecase   usecase.ServiceAccountUsecase
+	ipAllowlistUsecase      usecase.IPAllowlistUsecase
+	identityProviderUsecase usecase.IdentityProviderUsecase
 }
 
 // NewAuthServer 認証gRPCサーバーを作成
-func NewAuthServer(authUsecase usecase.AuthUsecase) *AuthServer {
+func NewAuthServer(
+	authUsecase usecase.AuthUsecase,
+	scimUsecase usecase.SCIMUsecase,
+	serviceAccountUsecase usecase.ServiceAccountUsecase,
+	ipAllowlistUsecase usecase.IPAllowlistUsecase,
+	identityProviderUsecase usecase.IdentityProviderUsecase,
+) *AuthServer {
 	return &AuthServer{
-		authUsecase: authUsecase,
+		authUsecase:             authUsecase,
+		scimUsecase:             scimUsecase,
+		serviceAccountUsecase:   serviceAccountUsecase,
+		ipAllowlistUsecase:      ipAllowlistUsecase,
+		identityProviderUsecase: identityProviderUsecase,
 	}
 }
 
@@ -39,48 +54,72 @@ func (s *AuthServer) GetMe(ctx context.Context, req *pbauth.GetMeRequest) (*pbau
 		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
 	}
 
+	// ユースケースを呼び出し
+	me, err := s.authUsecase.GetMe(ctx, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
 	// レスポンスを作成
 	resp := &pbauth.GetMeResponse{
-		UserId:   userCtx.UserID.String(),
-		Email:    userCtx.Email,
-		UserType: string(userCtx.UserType),
+		UserId:        userCtx.UserID.String(),
+		Email:         userCtx.Email,
+		UserType:      string(userCtx.UserType),
+		PrincipalType: userTypeToPB(userCtx.UserType),
 	}
 
 	// client_idが設定されている場合は追加
-	if userCtx.ClientID.String() != "00000000-0000-0000-0000-000000000000" {
+	if userCtx.ClientID != uuid.Nil {
 		clientIDStr := userCtx.ClientID.String()
 		resp.ClientId = &clientIDStr
 	}
 
-	return resp, nil
-}
-
-// SignupClient サービス利用開始時のアカウント登録（クライアント + 管理者ユーザー作成）
-func (s *AuthServer) SignupClient(ctx context.Context, req *pbauth.SignupClientRequest) (*pbauth.SignupClientResponse, error) {
-	// リクエストのバリデーション
-	if req.Name == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	if me.ClientUser != nil {
+		resp.ClientUser = convertClientUserToPB(*me.ClientUser)
+	}
+	if me.Operator != nil {
+		resp.Operator = convertOperatorToPB(*me.Operator)
 	}
-	if req.Slug == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "slug is required")
+	if me.ServiceAccount != nil {
+		resp.ServiceAccount = convertServiceAccountToPB(*me.ServiceAccount)
 	}
-	if req.AdminEmail == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "admin_email is required")
+	if me.Tenant != nil {
+		resp.Tenant = convertTenantToPB(*me.Tenant)
 	}
-	if req.AdminPassword == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "admin_password is required")
+	for _, role := range me.Roles {
+		resp.Roles = append(resp.Roles, &pbauth.Role{
+			RoleId: role.RoleID.String(),
+			Code:   role.Code,
+			Name:   role.Name,
+		})
 	}
-	if req.AdminFirstName == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "admin_first_name is required")
+	for _, permission := range me.Permissions {
+		resp.Permissions = append(resp.Permissions, &pbauth.Permission{
+			Feature: permission.Feature,
+			Action:  permission.Action,
+		})
 	}
-	if req.AdminLastName == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "admin_last_name is required")
+	for _, assigned := range me.AssignedClients {
+		resp.AssignedClients = append(resp.AssignedClients, &pbauth.AssignedClient{
+			Tenant:       convertTenantToPB(assigned),
+			Role:         assigned.Role,
+			OperatorRole: operatorRoleToPB(domain.OperatorRole(assigned.Role)),
+		})
 	}
 
+	return resp, nil
+}
+
+// SignupClient サービス利用開始時のアカウント登録（クライアント + 管理者ユーザー作成）
+func (s *AuthServer) SignupClient(ctx context.Context, req *pbauth.SignupClientRequest) (*pbauth.SignupClientResponse, error) {
+	// 必須項目・形式はValidationInterceptorがauth.protoのルールで検証済み
 	// デフォルト値の設定
-	eSignMode := req.GetESignMode()
+	eSignMode, err := resolveEnumField("e_sign_mode", "signature_mode", req.GetESignMode(), eSignModeFromPB(req.GetSignatureMode()))
+	if err != nil {
+		return nil, err
+	}
 	if eSignMode == "" {
-		eSignMode = "WITNESS_OTP"
+		eSignMode = domain.ESignModeWitnessOTP
 	}
 	retentionMonths := req.GetRetentionDefaultMonths()
 	if retentionMonths == 0 {
@@ -118,17 +157,12 @@ func (s *AuthServer) SignupClient(ctx context.Context, req *pbauth.SignupClientR
 		AdminLastName:          req.AdminLastName,
 		AdminDepartment:        adminDepartment,
 		AdminPosition:          adminPosition,
+		ChallengeToken:         req.GetChallengeToken(),
 	}
 
 	result, err := s.authUsecase.SignupClient(ctx, params)
 	if err != nil {
-		// エラーの種類に応じて適切なgRPCステータスコードを返す
-		errMsg := err.Error()
-		companyCode := req.GetCompanyCode()
-		if errMsg == "slug already exists: "+req.Slug || (companyCode != "" && errMsg == "company_code already exists: "+companyCode) {
-			return nil, status.Errorf(codes.AlreadyExists, "%s", errMsg)
-		}
-		return nil, status.Errorf(codes.Internal, "failed to signup client: %v", err)
+		return nil, err
 	}
 
 	// レスポンスを作成
@@ -137,6 +171,31 @@ func (s *AuthServer) SignupClient(ctx context.Context, req *pbauth.SignupClientR
 		ClientName:  result.ClientName,
 		AdminUserId: result.AdminUserID.String(),
 		AdminEmail:  result.AdminEmail,
+		Status:      string(result.Status),
+		State:       clientStatusToPB(result.Status),
+	}, nil
+}
+
+// VerifySignup 登録確認（認証不要）
+func (s *AuthServer) VerifySignup(ctx context.Context, req *pbauth.VerifySignupRequest) (*pbauth.VerifySignupResponse, error) {
+	// リクエストのバリデーション
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	// ユースケースを呼び出し
+	result, err := s.authUsecase.VerifySignup(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.VerifySignupResponse{
+		ClientId:    result.ClientID.String(),
+		AdminUserId: result.AdminUserID.String(),
+		AdminEmail:  result.AdminEmail,
+		Status:      string(result.Status),
+		State:       clientStatusToPB(result.Status),
 	}, nil
 }
 
@@ -149,24 +208,41 @@ func (s *AuthServer) ListClientUsers(ctx context.Context, req *pbauth.ListClient
 	}
 
 	// パラメータの取得
-	limit := req.GetLimit()
-	offset := req.GetOffset()
+	page := usecase.PageRequest{
+		PageSize:  req.GetLimit(),
+		PageToken: req.GetPageToken(),
+		Offset:    req.GetOffset(),
+	}
+
+	statusFilter, err := resolveEnumField("status", "state", req.GetStatus(), userStatusFromPB(req.GetState()))
+	if err != nil {
+		return nil, err
+	}
+	filter := usecase.ClientUserFilter{
+		Query:      req.GetQuery(),
+		Status:     string(statusFilter),
+		Department: req.GetDepartment(),
+		Position:   req.GetPosition(),
+		RoleCode:   req.GetRoleCode(),
+		OrderBy:    req.GetOrderBy(),
+	}
 
 	// ユースケースを呼び出し
-	users, total, err := s.authUsecase.ListClientUsers(ctx, userCtx, limit, offset)
+	result, err := s.authUsecase.ListClientUsers(ctx, userCtx, filter, page)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list client users: %v", err)
+		return nil, err
 	}
 
 	// レスポンスを作成
-	pbUsers := make([]*pbauth.ClientUser, len(users))
-	for i, user := range users {
+	pbUsers := make([]*pbauth.ClientUser, len(result.Items))
+	for i, user := range result.Items {
 		pbUsers[i] = convertClientUserToPB(user)
 	}
 
 	return &pbauth.ListClientUsersResponse{
-		Users: pbUsers,
-		Total: total,
+		Users:         pbUsers,
+		Total:         int32(result.TotalCount),
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -187,7 +263,7 @@ func (s *AuthServer) GetClientUser(ctx context.Context, req *pbauth.GetClientUse
 	// ユースケースを呼び出し
 	user, err := s.authUsecase.GetClientUser(ctx, userCtx, clientUserID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get client user: %v", err)
+		return nil, err
 	}
 
 	// レスポンスを作成
@@ -204,20 +280,7 @@ func (s *AuthServer) CreateClientUser(ctx context.Context, req *pbauth.CreateCli
 		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
 	}
 
-	// リクエストのバリデーション
-	if req.GetEmail() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "email is required")
-	}
-	if req.GetPassword() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "password is required")
-	}
-	if req.GetFirstName() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "first_name is required")
-	}
-	if req.GetLastName() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "last_name is required")
-	}
-
+	// 必須項目・形式はValidationInterceptorがauth.protoのルールで検証済み
 	// パラメータの構築
 	params := usecase.CreateClientUserParams{
 		Email:     req.GetEmail(),
@@ -239,11 +302,7 @@ func (s *AuthServer) CreateClientUser(ctx context.Context, req *pbauth.CreateCli
 	// ユースケースを呼び出し
 	user, err := s.authUsecase.CreateClientUser(ctx, userCtx, params)
 	if err != nil {
-		errMsg := err.Error()
-		if errMsg == "email already exists: "+req.GetEmail() {
-			return nil, status.Errorf(codes.AlreadyExists, "%s", errMsg)
-		}
-		return nil, status.Errorf(codes.Internal, "failed to create client user: %v", err)
+		return nil, err
 	}
 
 	// レスポンスを作成
@@ -287,18 +346,23 @@ func (s *AuthServer) UpdateClientUser(ctx context.Context, req *pbauth.UpdateCli
 	if req.Settings != nil {
 		params.Settings = req.Settings
 	}
-	if req.Status != nil {
-		params.Status = req.Status
+	if req.Status != nil || req.State != nil {
+		userStatus, err := resolveEnumField("status", "state", req.GetStatus(), userStatusFromPB(req.GetState()))
+		if err != nil {
+			return nil, err
+		}
+		statusValue := string(userStatus)
+		params.Status = &statusValue
+	}
+	if req.UpdateMask != nil {
+		params.UpdateMask = req.GetUpdateMask().GetPaths()
 	}
+	params.ETag = req.GetEtag()
 
 	// ユースケースを呼び出し
 	user, err := s.authUsecase.UpdateClientUser(ctx, userCtx, clientUserID, params)
 	if err != nil {
-		errMsg := err.Error()
-		if req.Email != nil && errMsg == "email already exists: "+*req.Email {
-			return nil, status.Errorf(codes.AlreadyExists, "%s", errMsg)
-		}
-		return nil, status.Errorf(codes.Internal, "failed to update client user: %v", err)
+		return nil, err
 	}
 
 	// レスポンスを作成
@@ -322,8 +386,8 @@ func (s *AuthServer) DeleteClientUser(ctx context.Context, req *pbauth.DeleteCli
 	}
 
 	// ユースケースを呼び出し
-	if err := s.authUsecase.DeleteClientUser(ctx, userCtx, clientUserID); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete client user: %v", err)
+	if err := s.authUsecase.DeleteClientUser(ctx, userCtx, clientUserID, req.GetEtag()); err != nil {
+		return nil, err
 	}
 
 	// レスポンスを作成
@@ -339,7 +403,9 @@ func convertClientUserToPB(user dbgen.ClientUser) *pbauth.ClientUser {
 		FirstName:    user.FirstName,
 		LastName:     user.LastName,
 		Status:       user.Status,
+		State:        userStatusToPB(domain.UserStatus(user.Status)),
 		Settings:     string(user.Settings),
+		Etag:         usecase.ETag(user.UpdatedAt),
 	}
 
 	// CreatedAtの変換
@@ -362,10 +428,52 @@ func convertClientUserToPB(user dbgen.ClientUser) *pbauth.ClientUser {
 	if user.Position.Valid {
 		pbUser.Position = &user.Position.String
 	}
+	if user.PasswordChangedAt.Valid {
+		passwordChangedAt := user.PasswordChangedAt.Time.Format(time.RFC3339)
+		pbUser.PasswordChangedAt = &passwordChangedAt
+	}
 
 	return pbUser
 }
 
+// convertOperatorToPB dbgen.Operatorをpbauth.Operatorに変換（パスワードハッシュ等は含めない）
+func convertOperatorToPB(operator dbgen.Operator) *pbauth.Operator {
+	pbOperator := &pbauth.Operator{
+		OperatorId: uuidFromPGType(operator.OperatorID).String(),
+		Email:      operator.Email,
+		FirstName:  operator.FirstName,
+		LastName:   operator.LastName,
+		Status:     operator.Status,
+		State:      userStatusToPB(domain.UserStatus(operator.Status)),
+		MfaEnabled: operator.MfaEnabled,
+		CreatedAt:  operator.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:  operator.UpdatedAt.Time.Format(time.RFC3339),
+	}
+	if operator.LastLoginAt.Valid {
+		lastLoginAt := operator.LastLoginAt.Time.Format(time.RFC3339)
+		pbOperator.LastLoginAt = &lastLoginAt
+	}
+	if operator.PasswordChangedAt.Valid {
+		passwordChangedAt := operator.PasswordChangedAt.Time.Format(time.RFC3339)
+		pbOperator.PasswordChangedAt = &passwordChangedAt
+	}
+	return pbOperator
+}
+
+// convertTenantToPB usecase.MeTenantをpbauth.Tenantに変換
+func convertTenantToPB(tenant usecase.MeTenant) *pbauth.Tenant {
+	return &pbauth.Tenant{
+		ClientId:      tenant.ClientID.String(),
+		Name:          tenant.Name,
+		Slug:          tenant.Slug,
+		ESignMode:     tenant.ESignMode,
+		Status:        tenant.Status,
+		Etag:          tenant.ETag,
+		State:         clientStatusToPB(domain.ClientStatus(tenant.Status)),
+		SignatureMode: eSignModeToPB(domain.ESignMode(tenant.ESignMode)),
+	}
+}
+
 // uuidFromPGType pgtype.UUIDからuuid.UUIDに変換
 func uuidFromPGType(pgUUID pgtype.UUID) uuid.UUID {
 	if !pgUUID.Valid {
@@ -3,21 +3,50 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"contract-pro-suite/internal/interceptor"
-	pbauth "contract-pro-suite/proto/auth"
+	"contract-pro-suite/internal/shared/tabular"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
 	"contract-pro-suite/services/auth/domain"
 	"contract-pro-suite/services/auth/usecase"
 	dbgen "contract-pro-suite/sqlc"
 )
 
+// toStatus ErrorInterceptorと同様にハンドラーが返したエラーをgRPCステータスに変換するヘルパー関数
+func toStatus(err error) (*status.Status, bool) {
+	return status.FromError(interceptor.ToStatus(err).Err())
+}
+
+// toStatusCode ErrorInterceptorで変換した後のステータスコードを返すヘルパー関数
+func toStatusCode(err error) codes.Code {
+	return interceptor.ToStatus(err).Code()
+}
+
+// withValidation ValidationInterceptorを通してハンドラーを呼び出すヘルパー関数（auth.protoのルールによる検証を含む）
+func withValidation[Req, Resp any](ctx context.Context, req Req, handler func(context.Context, Req) (Resp, error)) (Resp, error) {
+	var zero Resp
+	resp, err := interceptor.ValidationInterceptor()(ctx, req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return handler(ctx, req.(Req))
+	})
+	if err != nil {
+		return zero, err
+	}
+	return resp.(Resp), nil
+}
+
 // stringPtr 文字列ポインタを生成するヘルパー関数
 func stringPtr(s string) *string {
 	return &s
@@ -36,6 +65,22 @@ func (m *MockAuthUsecase) GetUserContext(ctx context.Context, jwtUserID string)
 	return args.Get(0).(*domain.UserContext), args.Error(1)
 }
 
+func (m *MockAuthUsecase) GetUserContextByExternalIdentity(ctx context.Context, identity domain.ExternalIdentity) (*domain.UserContext, error) {
+	args := m.Called(ctx, identity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserContext), args.Error(1)
+}
+
+func (m *MockAuthUsecase) GetUserContextByAPIKey(ctx context.Context, identity domain.APIKeyIdentity) (*domain.UserContext, error) {
+	args := m.Called(ctx, identity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserContext), args.Error(1)
+}
+
 func (m *MockAuthUsecase) ValidateClientAccess(ctx context.Context, userCtx *domain.UserContext, clientID uuid.UUID) error {
 	args := m.Called(ctx, userCtx, clientID)
 	return args.Error(0)
@@ -46,6 +91,44 @@ func (m *MockAuthUsecase) CheckPermission(ctx context.Context, userCtx *domain.U
 	return args.Error(0)
 }
 
+func (m *MockAuthUsecase) CheckTokenRevocation(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	args := m.Called(ctx, userCtx, token)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) CheckMFA(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	args := m.Called(ctx, userCtx, token)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) RecordActivity(ctx context.Context, userCtx *domain.UserContext) error {
+	args := m.Called(ctx, userCtx)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) Logout(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	args := m.Called(ctx, userCtx, token)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) ForceLogout(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) error {
+	args := m.Called(ctx, userCtx, clientUserID)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) ForceLogoutTenant(ctx context.Context, userCtx *domain.UserContext) error {
+	args := m.Called(ctx, userCtx)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) GetMe(ctx context.Context, userCtx *domain.UserContext) (*usecase.MeResult, error) {
+	args := m.Called(ctx, userCtx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.MeResult), args.Error(1)
+}
+
 func (m *MockAuthUsecase) SignupClient(ctx context.Context, params usecase.SignupClientParams) (*usecase.SignupClientResult, error) {
 	args := m.Called(ctx, params)
 	if args.Get(0) == nil {
@@ -54,12 +137,54 @@ func (m *MockAuthUsecase) SignupClient(ctx context.Context, params usecase.Signu
 	return args.Get(0).(*usecase.SignupClientResult), args.Error(1)
 }
 
-func (m *MockAuthUsecase) ListClientUsers(ctx context.Context, userCtx *domain.UserContext, limit, offset int32) ([]dbgen.ClientUser, int32, error) {
-	args := m.Called(ctx, userCtx, limit, offset)
+func (m *MockAuthUsecase) VerifySignup(ctx context.Context, token string) (*usecase.VerifySignupResult, error) {
+	args := m.Called(ctx, token)
 	if args.Get(0) == nil {
-		return nil, int32(0), args.Error(2)
+		return nil, args.Error(1)
 	}
-	return args.Get(0).([]dbgen.ClientUser), args.Get(1).(int32), args.Error(2)
+	return args.Get(0).(*usecase.VerifySignupResult), args.Error(1)
+}
+
+func (m *MockAuthUsecase) UpdateMe(ctx context.Context, userCtx *domain.UserContext, params usecase.UpdateMeParams) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, userCtx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientUser{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ChangeMyPassword(ctx context.Context, userCtx *domain.UserContext, currentPassword, newPassword string) error {
+	args := m.Called(ctx, userCtx, currentPassword, newPassword)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) ChangeMyEmail(ctx context.Context, userCtx *domain.UserContext, newEmail string) error {
+	args := m.Called(ctx, userCtx, newEmail)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) ConfirmMyEmailChange(ctx context.Context, token string) (dbgen.ClientUser, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return dbgen.ClientUser{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ListClientUsers(ctx context.Context, userCtx *domain.UserContext, filter usecase.ClientUserFilter, page usecase.PageRequest) (usecase.Page[dbgen.ClientUser], error) {
+	args := m.Called(ctx, userCtx, filter, page)
+	if args.Get(0) == nil {
+		return usecase.Page[dbgen.ClientUser]{}, args.Error(1)
+	}
+	return args.Get(0).(usecase.Page[dbgen.ClientUser]), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ExportClientUsers(ctx context.Context, userCtx *domain.UserContext, filter usecase.ClientUserFilter, format tabular.Format, w io.Writer) (*usecase.ExportClientUsersResult, error) {
+	args := m.Called(ctx, userCtx, filter, format, w)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.ExportClientUsersResult), args.Error(1)
 }
 
 func (m *MockAuthUsecase) GetClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) (dbgen.ClientUser, error) {
@@ -70,6 +195,22 @@ func (m *MockAuthUsecase) GetClientUser(ctx context.Context, userCtx *domain.Use
 	return args.Get(0).(dbgen.ClientUser), args.Error(1)
 }
 
+func (m *MockAuthUsecase) GetClientUserDetail(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) (usecase.ClientUserDetail, error) {
+	args := m.Called(ctx, userCtx, clientUserID)
+	if args.Get(0) == nil {
+		return usecase.ClientUserDetail{}, args.Error(1)
+	}
+	return args.Get(0).(usecase.ClientUserDetail), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ListClientUserDetails(ctx context.Context, userCtx *domain.UserContext, filter usecase.ClientUserFilter, page usecase.PageRequest) (usecase.Page[usecase.ClientUserDetail], error) {
+	args := m.Called(ctx, userCtx, filter, page)
+	if args.Get(0) == nil {
+		return usecase.Page[usecase.ClientUserDetail]{}, args.Error(1)
+	}
+	return args.Get(0).(usecase.Page[usecase.ClientUserDetail]), args.Error(1)
+}
+
 func (m *MockAuthUsecase) CreateClientUser(ctx context.Context, userCtx *domain.UserContext, params usecase.CreateClientUserParams) (dbgen.ClientUser, error) {
 	args := m.Called(ctx, userCtx, params)
 	if args.Get(0) == nil {
@@ -86,8 +227,8 @@ func (m *MockAuthUsecase) UpdateClientUser(ctx context.Context, userCtx *domain.
 	return args.Get(0).(dbgen.ClientUser), args.Error(1)
 }
 
-func (m *MockAuthUsecase) DeleteClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) error {
-	args := m.Called(ctx, userCtx, clientUserID)
+func (m *MockAuthUsecase) DeleteClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID, etag string) error {
+	args := m.Called(ctx, userCtx, clientUserID, etag)
 	return args.Error(0)
 }
 
@@ -96,6 +237,8 @@ func TestAuthServer_GetMe(t *testing.T) {
 		name           string
 		userCtx        *domain.UserContext
 		userCtxExists  bool
+		mockResult     *usecase.MeResult
+		mockError      error
 		expectedStatus codes.Code
 		expectedError  bool
 	}{
@@ -105,9 +248,19 @@ func TestAuthServer_GetMe(t *testing.T) {
 				UserID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
 				UserType: domain.UserTypeOperator,
 				Email:    "operator@example.com",
-				ClientID: uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+				ClientID: uuid.Nil,
+			},
+			userCtxExists: true,
+			mockResult: &usecase.MeResult{
+				Operator: &dbgen.Operator{
+					OperatorID: pgtype.UUID{Bytes: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), Valid: true},
+					Email:      "operator@example.com",
+					FirstName:  "Op",
+					LastName:   "Erator",
+					Status:     "ACTIVE",
+				},
+				Permissions: []usecase.MePermission{},
 			},
-			userCtxExists:  true,
 			expectedStatus: codes.OK,
 			expectedError:  false,
 		},
@@ -119,10 +272,42 @@ func TestAuthServer_GetMe(t *testing.T) {
 				Email:    "client@example.com",
 				ClientID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
 			},
-			userCtxExists:  true,
+			userCtxExists: true,
+			mockResult: &usecase.MeResult{
+				ClientUser: &dbgen.ClientUser{
+					ClientUserID: pgtype.UUID{Bytes: uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"), Valid: true},
+					ClientID:     pgtype.UUID{Bytes: uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"), Valid: true},
+					Email:        "client@example.com",
+					FirstName:    "Client",
+					LastName:     "User",
+					Status:       "ACTIVE",
+				},
+				Tenant: &usecase.MeTenant{
+					ClientID:  uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+					Name:      "Test Company",
+					Slug:      "test-company",
+					ESignMode: "WITNESS_OTP",
+					Status:    "ACTIVE",
+				},
+				Roles:       []usecase.MeRole{{RoleID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174003"), Code: "ADMIN", Name: "管理者"}},
+				Permissions: []usecase.MePermission{{Feature: "users", Action: "READ"}, {Feature: "users", Action: "WRITE"}},
+			},
 			expectedStatus: codes.OK,
 			expectedError:  false,
 		},
+		{
+			name: "失敗: プロフィール取得エラー",
+			userCtx: &domain.UserContext{
+				UserID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+				UserType: domain.UserTypeClientUser,
+				Email:    "client@example.com",
+				ClientID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+			},
+			userCtxExists:  true,
+			mockError:      fmt.Errorf("failed to get client user profile: no rows"),
+			expectedStatus: codes.Internal,
+			expectedError:  true,
+		},
 		{
 			name:           "失敗: ユーザーコンテキストなし",
 			userCtx:        nil,
@@ -136,12 +321,17 @@ func TestAuthServer_GetMe(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// モックの準備
 			mockUsecase := new(MockAuthUsecase)
-			authServer := NewAuthServer(mockUsecase)
+			authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
 
 			// コンテキストの準備
 			ctx := context.Background()
 			if tt.userCtxExists {
 				ctx = interceptor.SetEnhancedUserContextForTest(ctx, tt.userCtx)
+				if tt.mockError != nil {
+					mockUsecase.On("GetMe", mock.Anything, tt.userCtx).Return(nil, tt.mockError)
+				} else {
+					mockUsecase.On("GetMe", mock.Anything, tt.userCtx).Return(tt.mockResult, nil)
+				}
 			}
 
 			// GetMeを呼び出し
@@ -151,7 +341,7 @@ func TestAuthServer_GetMe(t *testing.T) {
 			// エラーチェック
 			if tt.expectedError {
 				assert.Error(t, err)
-				st, ok := status.FromError(err)
+				st, ok := toStatus(err)
 				assert.True(t, ok)
 				assert.Equal(t, tt.expectedStatus, st.Code())
 				assert.Nil(t, resp)
@@ -163,7 +353,7 @@ func TestAuthServer_GetMe(t *testing.T) {
 				assert.Equal(t, string(tt.userCtx.UserType), resp.UserType)
 
 				// client_idのチェック
-				if tt.userCtx.ClientID.String() != "00000000-0000-0000-0000-000000000000" {
+				if tt.userCtx.ClientID != uuid.Nil {
 					assert.NotNil(t, resp.ClientId)
 					assert.Equal(t, tt.userCtx.ClientID.String(), *resp.ClientId)
 				} else {
@@ -172,7 +362,25 @@ func TestAuthServer_GetMe(t *testing.T) {
 						assert.Equal(t, "", *resp.ClientId)
 					}
 				}
+
+				// プロフィール・クライアント・ロール・権限のチェック
+				switch tt.userCtx.UserType {
+				case domain.UserTypeClientUser:
+					assert.NotNil(t, resp.ClientUser)
+					assert.Nil(t, resp.Operator)
+					assert.Equal(t, "test-company", resp.Tenant.GetSlug())
+					assert.Equal(t, "WITNESS_OTP", resp.Tenant.GetESignMode())
+					assert.Len(t, resp.Roles, 1)
+					assert.Equal(t, "ADMIN", resp.Roles[0].Code)
+					assert.Len(t, resp.Permissions, 2)
+				case domain.UserTypeOperator:
+					assert.NotNil(t, resp.Operator)
+					assert.Nil(t, resp.ClientUser)
+					assert.Nil(t, resp.Tenant)
+					assert.Empty(t, resp.AssignedClients)
+				}
 			}
+			mockUsecase.AssertExpectations(t)
 		})
 	}
 }
@@ -234,7 +442,7 @@ func TestAuthServer_SignupClient(t *testing.T) {
 				AdminLastName:  "User",
 			},
 			mockResult:     nil,
-			mockError:      fmt.Errorf("slug already exists: existing-slug"),
+			mockError:      fmt.Errorf("%w: existing-slug", usecase.ErrSlugAlreadyExists),
 			expectedStatus: codes.AlreadyExists,
 			expectedError:  true,
 		},
@@ -250,17 +458,48 @@ func TestAuthServer_SignupClient(t *testing.T) {
 				AdminLastName:  "User",
 			},
 			mockResult:     nil,
-			mockError:      fmt.Errorf("company_code already exists: EXISTING"),
+			mockError:      fmt.Errorf("%w: EXISTING", usecase.ErrCompanyCodeAlreadyExists),
 			expectedStatus: codes.AlreadyExists,
 			expectedError:  true,
 		},
+		{
+			name: "失敗: 使い捨てメールアドレス",
+			req: &pbauth.SignupClientRequest{
+				Name:           "Test Client",
+				Slug:           "test-client",
+				AdminEmail:     "admin@mailinator.com",
+				AdminPassword:  "Password123!",
+				AdminFirstName: "Admin",
+				AdminLastName:  "User",
+			},
+			mockResult:     nil,
+			mockError:      usecase.ErrDisposableEmailDomain,
+			expectedStatus: codes.InvalidArgument,
+			expectedError:  true,
+		},
+		{
+			name: "失敗: チャレンジ検証失敗",
+			req: &pbauth.SignupClientRequest{
+				Name:           "Test Client",
+				Slug:           "test-client",
+				AdminEmail:     "admin@test.com",
+				AdminPassword:  "Password123!",
+				AdminFirstName: "Admin",
+				AdminLastName:  "User",
+				ChallengeToken: stringPtr("invalid"),
+			},
+			mockResult:     nil,
+			mockError:      usecase.ErrChallengeFailed,
+			expectedStatus: codes.PermissionDenied,
+			expectedError:  true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// モックの準備
 			mockUsecase := new(MockAuthUsecase)
-			authServer := NewAuthServer(mockUsecase)
+			authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
 
 			// 成功ケースの場合のみモックを設定
 			if !tt.expectedError && tt.mockResult != nil {
@@ -269,14 +508,14 @@ func TestAuthServer_SignupClient(t *testing.T) {
 				mockUsecase.On("SignupClient", mock.Anything, mock.Anything).Return(nil, tt.mockError)
 			}
 
-			// SignupClientを呼び出し
+			// SignupClientを呼び出し（必須項目はValidationInterceptorで検証）
 			ctx := context.Background()
-			resp, err := authServer.SignupClient(ctx, tt.req)
+			resp, err := withValidation(ctx, tt.req, authServer.SignupClient)
 
 			// エラーチェック
 			if tt.expectedError {
 				assert.Error(t, err)
-				st, ok := status.FromError(err)
+				st, ok := toStatus(err)
 				assert.True(t, ok)
 				assert.Equal(t, tt.expectedStatus, st.Code())
 				assert.Nil(t, resp)
@@ -293,3 +532,317 @@ func TestAuthServer_SignupClient(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthServer_VerifySignup(t *testing.T) {
+	clientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	adminUserID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+
+	tests := []struct {
+		name           string
+		token          string
+		mockResult     *usecase.VerifySignupResult
+		mockError      error
+		expectedStatus codes.Code
+	}{
+		{
+			name:  "成功: 登録確認",
+			token: "valid-token",
+			mockResult: &usecase.VerifySignupResult{
+				ClientID:    clientID,
+				AdminUserID: adminUserID,
+				AdminEmail:  "admin@test.com",
+				Status:      "ACTIVE",
+			},
+			expectedStatus: codes.OK,
+		},
+		{
+			name:           "失敗: トークン未指定",
+			token:          "",
+			expectedStatus: codes.InvalidArgument,
+		},
+		{
+			name:           "失敗: 不正なトークン",
+			token:          "unknown-token",
+			mockError:      usecase.ErrInvalidSignupVerification,
+			expectedStatus: codes.NotFound,
+		},
+		{
+			name:           "失敗: 有効期限切れ",
+			token:          "expired-token",
+			mockError:      fmt.Errorf("wrapped: %w", usecase.ErrSignupVerificationExpired),
+			expectedStatus: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockAuthUsecase)
+			authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+			if tt.token != "" {
+				if tt.mockError != nil {
+					mockUsecase.On("VerifySignup", mock.Anything, tt.token).Return(nil, tt.mockError)
+				} else {
+					mockUsecase.On("VerifySignup", mock.Anything, tt.token).Return(tt.mockResult, nil)
+				}
+			}
+
+			resp, err := authServer.VerifySignup(context.Background(), &pbauth.VerifySignupRequest{Token: tt.token})
+			if tt.expectedStatus != codes.OK {
+				st, ok := toStatus(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, st.Code())
+				assert.Nil(t, resp)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, clientID.String(), resp.ClientId)
+			assert.Equal(t, adminUserID.String(), resp.AdminUserId)
+			assert.Equal(t, "ACTIVE", resp.Status)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthServer_CreateClientUser_PasswordPolicy(t *testing.T) {
+	mockUsecase := new(MockAuthUsecase)
+	authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+	mockUsecase.On("CreateClientUser", mock.Anything, mock.Anything, mock.Anything).Return(dbgen.ClientUser{}, &usecase.PasswordPolicyError{
+		Violations: []usecase.PasswordViolation{
+			{Code: usecase.PasswordViolationTooShort, Description: "must be at least 12 characters"},
+			{Code: usecase.PasswordViolationBreached, Description: "has appeared in a data breach"},
+		},
+	})
+
+	ctx := interceptor.SetEnhancedUserContextForTest(context.Background(), &domain.UserContext{
+		UserID:   uuid.New(),
+		UserType: domain.UserTypeClientUser,
+		ClientID: uuid.New(),
+	})
+	resp, err := authServer.CreateClientUser(ctx, &pbauth.CreateClientUserRequest{
+		Email:     "user@test.com",
+		Password:  "short",
+		FirstName: "Test",
+		LastName:  "User",
+	})
+	assert.Nil(t, resp)
+
+	// フィールド単位の違反がBadRequestの詳細として返される
+	st, ok := toStatus(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if assert.NotNil(t, badRequest) && assert.Len(t, badRequest.FieldViolations, 2) {
+		assert.Equal(t, "password", badRequest.FieldViolations[0].Field)
+		assert.Equal(t, usecase.PasswordViolationTooShort, badRequest.FieldViolations[0].Reason)
+		assert.Equal(t, usecase.PasswordViolationBreached, badRequest.FieldViolations[1].Reason)
+	}
+}
+
+func TestAuthServer_UpdateClientUser_UpdateMask(t *testing.T) {
+	mockUsecase := new(MockAuthUsecase)
+	authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+	mockUsecase.On("UpdateClientUser", mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(params usecase.UpdateClientUserParams) bool {
+		return assert.ObjectsAreEqual([]string{"first_name", "client_id"}, params.UpdateMask)
+	})).Return(dbgen.ClientUser{}, &usecase.UpdateMaskError{Path: "client_id", Reason: "field is immutable"})
+
+	ctx := interceptor.SetEnhancedUserContextForTest(context.Background(), &domain.UserContext{
+		UserID:   uuid.New(),
+		UserType: domain.UserTypeClientUser,
+		ClientID: uuid.New(),
+	})
+	resp, err := authServer.UpdateClientUser(ctx, &pbauth.UpdateClientUserRequest{
+		ClientUserId: uuid.New().String(),
+		FirstName:    stringPtr("Jiro"),
+		UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"first_name", "client_id"}},
+	})
+	assert.Nil(t, resp)
+
+	// 不正なパスはupdate_maskのフィールド違反としてInvalidArgumentで返される
+	st, ok := toStatus(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if assert.NotNil(t, badRequest) && assert.Len(t, badRequest.FieldViolations, 1) {
+		assert.Equal(t, "update_mask", badRequest.FieldViolations[0].Field)
+		assert.Equal(t, string(domain.ReasonInvalidUpdateMask), badRequest.FieldViolations[0].Reason)
+		assert.Contains(t, badRequest.FieldViolations[0].Description, "client_id")
+	}
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestAuthServer_DeleteClientUser_ETag(t *testing.T) {
+	ctx := interceptor.SetEnhancedUserContextForTest(context.Background(), &domain.UserContext{
+		UserID:   uuid.New(),
+		UserType: domain.UserTypeClientUser,
+		ClientID: uuid.New(),
+	})
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{usecase.ErrETagRequired, codes.InvalidArgument},
+		{usecase.ErrETagMismatch, codes.FailedPrecondition},
+		{usecase.ErrConcurrentModification, codes.Aborted},
+		{nil, codes.OK},
+	}
+	for _, tt := range tests {
+		mockUsecase := new(MockAuthUsecase)
+		authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+		clientUserID := uuid.New()
+		mockUsecase.On("DeleteClientUser", mock.Anything, mock.Anything, clientUserID, "18d2c1e0a5b").Return(tt.err)
+
+		_, err := authServer.DeleteClientUser(ctx, &pbauth.DeleteClientUserRequest{ClientUserId: clientUserID.String(), Etag: "18d2c1e0a5b"})
+		assert.Equal(t, tt.code, toStatusCode(err), "err=%v", tt.err)
+		mockUsecase.AssertExpectations(t)
+	}
+}
+
+func TestConvertClientUserToPB_ETag(t *testing.T) {
+	updatedAt := pgtype.Timestamptz{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	pbUser := convertClientUserToPB(dbgen.ClientUser{UpdatedAt: updatedAt})
+	assert.Equal(t, usecase.ETag(updatedAt), pbUser.Etag)
+	assert.NotEmpty(t, pbUser.Etag)
+}
+
+func TestAuthServer_ChangeMyPassword(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *pbauth.ChangeMyPasswordRequest
+		mockError      error
+		callsUsecase   bool
+		expectedStatus codes.Code
+	}{
+		{
+			name:           "成功: パスワード変更",
+			req:            &pbauth.ChangeMyPasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "Kx7mQ2vLp9wZ"},
+			callsUsecase:   true,
+			expectedStatus: codes.OK,
+		},
+		{
+			name:           "失敗: 現在のパスワード未指定",
+			req:            &pbauth.ChangeMyPasswordRequest{NewPassword: "Kx7mQ2vLp9wZ"},
+			expectedStatus: codes.InvalidArgument,
+		},
+		{
+			name:           "失敗: 現在のパスワードが一致しない",
+			req:            &pbauth.ChangeMyPasswordRequest{CurrentPassword: "Wrong-Pass1", NewPassword: "Kx7mQ2vLp9wZ"},
+			mockError:      usecase.ErrInvalidCurrentPassword,
+			callsUsecase:   true,
+			expectedStatus: codes.InvalidArgument,
+		},
+		{
+			name:           "失敗: オペレーターは利用不可",
+			req:            &pbauth.ChangeMyPasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "Kx7mQ2vLp9wZ"},
+			mockError:      usecase.ErrSelfServiceNotSupported,
+			callsUsecase:   true,
+			expectedStatus: codes.PermissionDenied,
+		},
+		{
+			name: "失敗: パスワードポリシー違反",
+			req:  &pbauth.ChangeMyPasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "Current-Pass1"},
+			mockError: &usecase.PasswordPolicyError{Violations: []usecase.PasswordViolation{
+				{Code: usecase.PasswordViolationSameAsCurrent, Description: "must differ from the current password"},
+			}},
+			callsUsecase:   true,
+			expectedStatus: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockAuthUsecase)
+			authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+			userCtx := &domain.UserContext{
+				UserID:   uuid.New(),
+				UserType: domain.UserTypeClientUser,
+				ClientID: uuid.New(),
+			}
+			if tt.callsUsecase {
+				mockUsecase.On("ChangeMyPassword", mock.Anything, userCtx, tt.req.CurrentPassword, tt.req.NewPassword).Return(tt.mockError)
+			}
+
+			ctx := interceptor.SetEnhancedUserContextForTest(context.Background(), userCtx)
+			resp, err := authServer.ChangeMyPassword(ctx, tt.req)
+			if tt.expectedStatus != codes.OK {
+				st, ok := toStatus(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, st.Code())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthServer_ChangeMyEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		newEmail       string
+		mockError      error
+		expectedStatus codes.Code
+	}{
+		{
+			name:           "成功: 変更申請",
+			newEmail:       "new@test.com",
+			expectedStatus: codes.OK,
+		},
+		{
+			name:           "失敗: メールアドレス未指定",
+			newEmail:       "",
+			expectedStatus: codes.InvalidArgument,
+		},
+		{
+			name:           "失敗: 現在と同じメールアドレス",
+			newEmail:       "current@test.com",
+			mockError:      usecase.ErrEmailUnchanged,
+			expectedStatus: codes.InvalidArgument,
+		},
+		{
+			name:           "失敗: 重複するメールアドレス",
+			newEmail:       "taken@test.com",
+			mockError:      fmt.Errorf("%w: taken@test.com", usecase.ErrEmailAlreadyExists),
+			expectedStatus: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := new(MockAuthUsecase)
+			authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+			userCtx := &domain.UserContext{
+				UserID:   uuid.New(),
+				UserType: domain.UserTypeClientUser,
+				ClientID: uuid.New(),
+			}
+			if tt.newEmail != "" {
+				mockUsecase.On("ChangeMyEmail", mock.Anything, userCtx, tt.newEmail).Return(tt.mockError)
+			}
+
+			ctx := interceptor.SetEnhancedUserContextForTest(context.Background(), userCtx)
+			resp, err := authServer.ChangeMyEmail(ctx, &pbauth.ChangeMyEmailRequest{NewEmail: tt.newEmail})
+			if tt.expectedStatus != codes.OK {
+				st, ok := toStatus(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedStatus, st.Code())
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.newEmail, resp.PendingEmail)
+			}
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauthv2 "contract-pro-suite/proto/contractpro/auth/v2"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/usecase"
+)
+
+// AuthServerV2 認証gRPCサーバー（v2）
+// 移行期間中はv1（AuthServer）と同じgRPCサーバーに登録し、同じユースケースを使用する
+type AuthServerV2 struct {
+	pbauthv2.UnimplementedAuthServiceServer
+	authUsecase usecase.AuthUsecase
+}
+
+// NewAuthServerV2 認証gRPCサーバー（v2）を作成
+func NewAuthServerV2(authUsecase usecase.AuthUsecase) *AuthServerV2 {
+	return &AuthServerV2{
+		authUsecase: authUsecase,
+	}
+}
+
+// GetClientUser クライアントユーザー詳細取得（ロール・最終ログイン日時を含む）
+func (s *AuthServerV2) GetClientUser(ctx context.Context, req *pbauthv2.GetClientUserRequest) (*pbauthv2.GetClientUserResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	clientUserID, err := uuid.Parse(req.GetClientUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid client_user_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	detail, err := s.authUsecase.GetClientUserDetail(ctx, userCtx, clientUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	user, err := convertClientUserToPBV2(detail)
+	if err != nil {
+		return nil, err
+	}
+	return &pbauthv2.GetClientUserResponse{User: user}, nil
+}
+
+// ListClientUsers クライアントユーザー一覧取得（ロール・最終ログイン日時を含む）
+func (s *AuthServerV2) ListClientUsers(ctx context.Context, req *pbauthv2.ListClientUsersRequest) (*pbauthv2.ListClientUsersResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// パラメータの取得
+	page := usecase.PageRequest{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	}
+	filter := usecase.ClientUserFilter{
+		Query:      req.GetQuery(),
+		Status:     string(userStatusFromPB(req.GetStatus())),
+		Department: req.GetDepartment(),
+		Position:   req.GetPosition(),
+		RoleCode:   req.GetRoleCode(),
+		OrderBy:    req.GetOrderBy(),
+	}
+
+	// ユースケースを呼び出し
+	result, err := s.authUsecase.ListClientUserDetails(ctx, userCtx, filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	pbUsers := make([]*pbauthv2.ClientUser, len(result.Items))
+	for i, detail := range result.Items {
+		pbUsers[i], err = convertClientUserToPBV2(detail)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pbauthv2.ListClientUsersResponse{
+		Users:         pbUsers,
+		TotalCount:    result.TotalCount,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// convertClientUserToPBV2 usecase.ClientUserDetailをpbauthv2.ClientUserに変換
+func convertClientUserToPBV2(detail usecase.ClientUserDetail) (*pbauthv2.ClientUser, error) {
+	user := detail.User
+	pbUser := &pbauthv2.ClientUser{
+		ClientUserId:      uuidFromPGType(user.ClientUserID).String(),
+		ClientId:          uuidFromPGType(user.ClientID).String(),
+		Email:             user.Email,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		Status:            userStatusToPB(domain.UserStatus(user.Status)),
+		CreatedAt:         timestampFromPGType(user.CreatedAt),
+		UpdatedAt:         timestampFromPGType(user.UpdatedAt),
+		DeletedAt:         timestampFromPGType(user.DeletedAt),
+		PasswordChangedAt: timestampFromPGType(user.PasswordChangedAt),
+		LastLoginTime:     timestampFromPGType(detail.LastActiveAt),
+		Etag:              usecase.ETag(user.UpdatedAt),
+	}
+	if user.Department.Valid {
+		pbUser.Department = &user.Department.String
+	}
+	if user.Position.Valid {
+		pbUser.Position = &user.Position.String
+	}
+
+	// 設定（JSONオブジェクト）をStructに変換（未設定・nullの場合は空のStruct）
+	pbUser.Settings = &structpb.Struct{}
+	if len(user.Settings) > 0 && string(user.Settings) != "null" {
+		if err := protojson.Unmarshal(user.Settings, pbUser.Settings); err != nil {
+			return nil, fmt.Errorf("failed to convert settings of client user %s: %w", pbUser.ClientUserId, err)
+		}
+	}
+
+	for _, role := range detail.Roles {
+		pbUser.Roles = append(pbUser.Roles, &pbauthv2.RoleSummary{
+			RoleId: uuidFromPGType(role.RoleID).String(),
+			Code:   role.Code,
+			Name:   role.Name,
+		})
+	}
+	return pbUser, nil
+}
+
+// timestampFromPGType pgtype.Timestamptzからtimestamppb.Timestampに変換（NULLの場合はnil）
+func timestampFromPGType(t pgtype.Timestamptz) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
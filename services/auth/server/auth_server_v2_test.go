@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	pbauthv2 "contract-pro-suite/proto/contractpro/auth/v2"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/usecase"
+	dbgen "contract-pro-suite/sqlc"
+)
+
+func TestConvertClientUserToPBV2(t *testing.T) {
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastActiveAt := time.Date(2026, 2, 1, 9, 30, 0, 0, time.UTC)
+	roleID := uuid.New()
+	detail := usecase.ClientUserDetail{
+		User: dbgen.ClientUser{
+			ClientUserID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			Email:        "user@example.com",
+			Department:   pgtype.Text{String: "法務部", Valid: true},
+			Status:       "SUSPENDED",
+			Settings:     []byte(`{"theme":"dark","notifications":{"email":true}}`),
+			CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+			UpdatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+		},
+		Roles:        []dbgen.ListActiveClientUserRolesByUserIDsRow{{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}, Code: "admin", Name: "管理者"}},
+		LastActiveAt: pgtype.Timestamptz{Time: lastActiveAt, Valid: true},
+	}
+
+	pbUser, err := convertClientUserToPBV2(detail)
+	assert.NoError(t, err)
+	assert.Equal(t, pbauth.UserStatus_USER_STATUS_SUSPENDED, pbUser.Status)
+	assert.Equal(t, "法務部", pbUser.GetDepartment())
+	assert.Nil(t, pbUser.Position)
+	assert.Equal(t, "dark", pbUser.GetSettings().GetFields()["theme"].GetStringValue())
+	assert.True(t, pbUser.GetSettings().GetFields()["notifications"].GetStructValue().GetFields()["email"].GetBoolValue())
+	assert.True(t, createdAt.Equal(pbUser.GetCreatedAt().AsTime()))
+	assert.True(t, lastActiveAt.Equal(pbUser.GetLastLoginTime().AsTime()))
+	assert.Nil(t, pbUser.DeletedAt)
+	assert.Nil(t, pbUser.PasswordChangedAt)
+	assert.Equal(t, usecase.ETag(detail.User.UpdatedAt), pbUser.Etag)
+	if assert.Len(t, pbUser.Roles, 1) {
+		assert.Equal(t, roleID.String(), pbUser.Roles[0].RoleId)
+		assert.Equal(t, "admin", pbUser.Roles[0].Code)
+	}
+
+	// 未設定の設定は空のStruct、JSONオブジェクトでない設定は内部エラー
+	detail.User.Settings = nil
+	pbUser, err = convertClientUserToPBV2(detail)
+	assert.NoError(t, err)
+	assert.Empty(t, pbUser.GetSettings().GetFields())
+	detail.User.Settings = []byte(`["theme"]`)
+	_, err = convertClientUserToPBV2(detail)
+	assert.Equal(t, codes.Internal, toStatusCode(err))
+}
+
+func TestAuthServerV2_ListClientUsers(t *testing.T) {
+	ctx := interceptor.SetEnhancedUserContextForTest(context.Background(), &domain.UserContext{
+		UserID:   uuid.New(),
+		UserType: domain.UserTypeClientUser,
+		ClientID: uuid.New(),
+	})
+	mockUsecase := new(MockAuthUsecase)
+	authServer := NewAuthServerV2(mockUsecase)
+	filter := usecase.ClientUserFilter{Status: "ACTIVE", OrderBy: "name"}
+	mockUsecase.On("ListClientUserDetails", mock.Anything, mock.Anything, filter, usecase.PageRequest{PageSize: 10, PageToken: "token"}).Return(usecase.Page[usecase.ClientUserDetail]{
+		Items:         []usecase.ClientUserDetail{{User: dbgen.ClientUser{Email: "user@example.com", Status: "ACTIVE"}}},
+		TotalCount:    11,
+		NextPageToken: "next",
+	}, nil)
+
+	resp, err := authServer.ListClientUsers(ctx, &pbauthv2.ListClientUsersRequest{
+		PageSize:  10,
+		PageToken: "token",
+		Status:    pbauth.UserStatus_USER_STATUS_ACTIVE,
+		OrderBy:   "name",
+	})
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) && assert.Len(t, resp.Users, 1) {
+		assert.Equal(t, "user@example.com", resp.Users[0].Email)
+		assert.Equal(t, int64(11), resp.TotalCount)
+		assert.Equal(t, "next", resp.NextPageToken)
+	}
+	mockUsecase.AssertExpectations(t)
+
+	// 存在しないユーザーはNotFound
+	clientUserID := uuid.New()
+	mockUsecase.On("GetClientUserDetail", mock.Anything, mock.Anything, clientUserID).Return(nil, usecase.ErrClientUserNotFound)
+	_, err = authServer.GetClientUser(ctx, &pbauthv2.GetClientUserRequest{ClientUserId: clientUserID.String()})
+	assert.Equal(t, codes.NotFound, toStatusCode(err))
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/domain"
+)
+
+// proto enumの値名の接頭辞（値名から接頭辞を除いた部分がドメインの値（DBの値）と一致する）
+const (
+	userTypePrefix     = "USER_TYPE_"
+	userStatusPrefix   = "USER_STATUS_"
+	clientStatusPrefix = "CLIENT_STATUS_"
+	eSignModePrefix    = "E_SIGN_MODE_"
+	operatorRolePrefix = "OPERATOR_ROLE_"
+)
+
+// enumToPB ドメインの値を接頭辞付きのproto enumに変換（未定義の値はUNSPECIFIED）
+func enumToPB[E ~int32, S ~string](values map[string]int32, prefix string, value S) E {
+	if value == "" {
+		return 0
+	}
+	return E(values[prefix+string(value)])
+}
+
+// enumFromPB proto enumをドメインの値に変換（UNSPECIFIED・未定義の値は空文字）
+func enumFromPB[S ~string, E ~int32](names map[int32]string, prefix string, value E) S {
+	if value == 0 {
+		return ""
+	}
+	name, ok := names[int32(value)]
+	if !ok {
+		return ""
+	}
+	return S(strings.TrimPrefix(name, prefix))
+}
+
+func userTypeToPB(t domain.UserType) pbauth.UserType {
+	return enumToPB[pbauth.UserType](pbauth.UserType_value, userTypePrefix, t)
+}
+
+func userStatusToPB(s domain.UserStatus) pbauth.UserStatus {
+	return enumToPB[pbauth.UserStatus](pbauth.UserStatus_value, userStatusPrefix, s)
+}
+
+func userStatusFromPB(s pbauth.UserStatus) domain.UserStatus {
+	return enumFromPB[domain.UserStatus](pbauth.UserStatus_name, userStatusPrefix, s)
+}
+
+func clientStatusToPB(s domain.ClientStatus) pbauth.ClientStatus {
+	return enumToPB[pbauth.ClientStatus](pbauth.ClientStatus_value, clientStatusPrefix, s)
+}
+
+func eSignModeToPB(m domain.ESignMode) pbauth.ESignMode {
+	return enumToPB[pbauth.ESignMode](pbauth.ESignMode_value, eSignModePrefix, m)
+}
+
+func eSignModeFromPB(m pbauth.ESignMode) domain.ESignMode {
+	return enumFromPB[domain.ESignMode](pbauth.ESignMode_name, eSignModePrefix, m)
+}
+
+func operatorRoleToPB(r domain.OperatorRole) pbauth.OperatorRole {
+	return enumToPB[pbauth.OperatorRole](pbauth.OperatorRole_value, operatorRolePrefix, r)
+}
+
+// resolveEnumField 非推奨の文字列フィールドと列挙型フィールドから値を決定
+// 列挙型フィールドを優先し、両方指定されて値が異なる場合はバリデーションエラー
+func resolveEnumField[S ~string](legacyField, field string, legacy string, value S) (S, error) {
+	switch {
+	case value == "":
+		return S(legacy), nil
+	case legacy == "" || S(legacy) == value:
+		return value, nil
+	default:
+		return "", domain.NewValidationError(domain.ReasonInvalidRequest, field,
+			fmt.Sprintf("%s and %s must be the same value when both are set", legacyField, field))
+	}
+}
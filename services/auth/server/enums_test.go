@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/usecase"
+	dbgen "contract-pro-suite/sqlc"
+)
+
+// TestEnumConversions ドメインで定義されている値がすべてproto enumに対応していることを確認
+func TestEnumConversions(t *testing.T) {
+	for _, s := range domain.UserStatuses {
+		assert.NotZero(t, userStatusToPB(s), "status=%s", s)
+		assert.Equal(t, s, userStatusFromPB(userStatusToPB(s)))
+	}
+	for _, s := range domain.ClientStatuses {
+		assert.NotZero(t, clientStatusToPB(s), "status=%s", s)
+	}
+	for _, m := range domain.ESignModes {
+		assert.NotZero(t, eSignModeToPB(m), "mode=%s", m)
+		assert.Equal(t, m, eSignModeFromPB(eSignModeToPB(m)))
+	}
+	for _, r := range domain.OperatorRoles {
+		assert.NotZero(t, operatorRoleToPB(r), "role=%s", r)
+	}
+	for _, userType := range []domain.UserType{domain.UserTypeOperator, domain.UserTypeClientUser, domain.UserTypeServiceAccount} {
+		assert.NotZero(t, userTypeToPB(userType), "user_type=%s", userType)
+	}
+
+	// 未定義の値はUNSPECIFIED・空文字に変換する
+	assert.Equal(t, pbauth.UserStatus_USER_STATUS_UNSPECIFIED, userStatusToPB("DELETED"))
+	assert.Equal(t, pbauth.ESignMode_E_SIGN_MODE_UNSPECIFIED, eSignModeToPB(""))
+	assert.Equal(t, domain.UserStatus(""), userStatusFromPB(pbauth.UserStatus_USER_STATUS_UNSPECIFIED))
+	assert.Equal(t, domain.UserStatus(""), userStatusFromPB(pbauth.UserStatus(42)))
+}
+
+func TestResolveEnumField(t *testing.T) {
+	tests := []struct {
+		name    string
+		legacy  string
+		value   domain.UserStatus
+		want    domain.UserStatus
+		wantErr bool
+	}{
+		{"どちらも未指定", "", "", "", false},
+		{"文字列のみ", "ACTIVE", "", domain.UserStatusActive, false},
+		{"列挙型のみ", "", domain.UserStatusSuspended, domain.UserStatusSuspended, false},
+		{"両方指定で同じ値", "SUSPENDED", domain.UserStatusSuspended, domain.UserStatusSuspended, false},
+		{"両方指定で異なる値", "ACTIVE", domain.UserStatusSuspended, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveEnumField("status", "state", tt.legacy, tt.value)
+			if tt.wantErr {
+				assert.Equal(t, codes.InvalidArgument, toStatusCode(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthServer_UpdateClientUser_State(t *testing.T) {
+	ctx := interceptor.SetEnhancedUserContextForTest(context.Background(), &domain.UserContext{
+		UserID:   uuid.New(),
+		UserType: domain.UserTypeClientUser,
+		ClientID: uuid.New(),
+	})
+
+	mockUsecase := new(MockAuthUsecase)
+	authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+	mockUsecase.On("UpdateClientUser", mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(params usecase.UpdateClientUserParams) bool {
+		return params.Status != nil && *params.Status == "SUSPENDED"
+	})).Return(dbgen.ClientUser{Status: "SUSPENDED"}, nil)
+
+	// 列挙型のみ指定した場合もステータスを更新し、レスポンスは両方のフィールドを返す
+	resp, err := authServer.UpdateClientUser(ctx, &pbauth.UpdateClientUserRequest{
+		ClientUserId: uuid.New().String(),
+		State:        pbauth.UserStatus_USER_STATUS_SUSPENDED.Enum(),
+		Etag:         "1",
+	})
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, "SUSPENDED", resp.User.Status)
+		assert.Equal(t, pbauth.UserStatus_USER_STATUS_SUSPENDED, resp.User.State)
+	}
+	mockUsecase.AssertExpectations(t)
+
+	// 両方指定して値が異なる場合はユースケースを呼び出さない
+	_, err = authServer.UpdateClientUser(ctx, &pbauth.UpdateClientUserRequest{
+		ClientUserId: uuid.New().String(),
+		Status:       stringPtr("ACTIVE"),
+		State:        pbauth.UserStatus_USER_STATUS_SUSPENDED.Enum(),
+		Etag:         "1",
+	})
+	assert.Equal(t, codes.InvalidArgument, toStatusCode(err))
+	mockUsecase.AssertNumberOfCalls(t, "UpdateClientUser", 1)
+}
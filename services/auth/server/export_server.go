@@ -0,0 +1,136 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/interceptor"
+	"contract-pro-suite/internal/shared/tabular"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/usecase"
+)
+
+// exportChunkSize エクスポートで1メッセージに含める最大バイト数（gRPCのメッセージサイズ上限より十分小さくする）
+const exportChunkSize = 64 * 1024
+
+// ExportClientUsers クライアントユーザーと有効なロールをCSV/XLSXでエクスポート
+func (s *AuthServer) ExportClientUsers(req *pbauth.ExportClientUsersRequest, stream grpc.ServerStreamingServer[pbauth.ExportClientUsersResponse]) error {
+	ctx := stream.Context()
+
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	format := tabular.FormatCSV
+	if req.GetFormat() != "" {
+		parsed, err := tabular.ParseFormat(req.GetFormat())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "unsupported format: %s", req.GetFormat())
+		}
+		format = parsed
+	}
+	statusFilter, err := resolveEnumField("status", "state", req.GetStatus(), userStatusFromPB(req.GetState()))
+	if err != nil {
+		return err
+	}
+	filter := usecase.ClientUserFilter{
+		Query:      req.GetQuery(),
+		Status:     string(statusFilter),
+		Department: req.GetDepartment(),
+		Position:   req.GetPosition(),
+		RoleCode:   req.GetRoleCode(),
+		OrderBy:    req.GetOrderBy(),
+	}
+
+	// ユースケースを呼び出し（出力は一定サイズごとにメッセージとして送信）
+	sender := &exportChunkSender{
+		stream:      stream,
+		contentType: format.ContentType(),
+		filename:    fmt.Sprintf("client_users_%s.%s", time.Now().UTC().Format("20060102T150405Z"), format),
+	}
+	buffered := bufio.NewWriterSize(sender, exportChunkSize)
+	result, err := s.authUsecase.ExportClientUsers(ctx, userCtx, filter, format, buffered)
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if err == nil {
+		err = sender.finish()
+	}
+
+	// エクスポートは監査対象のため、成否に関わらず記録する
+	auditLog := interceptor.AuditLog{
+		Timestamp: time.Now(),
+		UserID:    userCtx.UserID.String(),
+		UserType:  string(userCtx.UserType),
+		ClientID:  userCtx.ClientID.String(),
+		Method:    "gRPC",
+		Path:      pbauth.AuthService_ExportClientUsers_FullMethodName,
+		Event:     interceptor.AuditEventClientUsersExported,
+		Details: map[string]any{
+			"format": string(format),
+			"filter": filter,
+		},
+	}
+	if result != nil {
+		auditLog.Details["rows"] = result.Rows
+	}
+
+	if err != nil {
+		// 監査ログにはクライアントに返すステータスと同じ内容を記録する
+		st := interceptor.ToStatus(err)
+		err = st.Err()
+		auditLog.Error = st.Message()
+	}
+	auditLog.StatusCode = interceptor.StatusCode(err)
+	interceptor.LogAudit(auditLog)
+	return err
+}
+
+// exportChunkSender 書き込まれたデータをExportClientUsersResponseとして送信する（最初のメッセージにのみメタデータを付与）
+type exportChunkSender struct {
+	stream      grpc.ServerStreamingServer[pbauth.ExportClientUsersResponse]
+	contentType string
+	filename    string
+	sent        bool
+}
+
+func (e *exportChunkSender) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		end := min(written+exportChunkSize, len(p))
+		if err := e.send(p[written:end]); err != nil {
+			return written, err
+		}
+		written = end
+	}
+	return written, nil
+}
+
+// finish 1件も送信していない場合でもメタデータを送信
+func (e *exportChunkSender) finish() error {
+	if e.sent {
+		return nil
+	}
+	return e.send(nil)
+}
+
+func (e *exportChunkSender) send(chunk []byte) error {
+	resp := &pbauth.ExportClientUsersResponse{Chunk: chunk}
+	if !e.sent {
+		resp.ContentType = e.contentType
+		resp.Filename = e.filename
+	}
+	if err := e.stream.Send(resp); err != nil {
+		return err
+	}
+	e.sent = true
+	return nil
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/interceptor"
+	"contract-pro-suite/internal/shared/tabular"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/usecase"
+)
+
+// fakeExportStream 送信したメッセージを記録するストリーム
+type fakeExportStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pbauth.ExportClientUsersResponse
+}
+
+func (f *fakeExportStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeExportStream) Send(resp *pbauth.ExportClientUsersResponse) error {
+	// 送信後にバッファが再利用されても影響しないようコピーして記録
+	f.sent = append(f.sent, &pbauth.ExportClientUsersResponse{
+		Chunk:       bytes.Clone(resp.Chunk),
+		ContentType: resp.ContentType,
+		Filename:    resp.Filename,
+	})
+	return nil
+}
+
+func TestAuthServer_ExportClientUsers(t *testing.T) {
+	userCtx := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: uuid.New()}
+	ctx := interceptor.SetEnhancedUserContextForTest(context.Background(), userCtx)
+
+	t.Run("出力をチャンクに分割して送信し、最初のメッセージにメタデータを付与する", func(t *testing.T) {
+		mockUsecase := new(MockAuthUsecase)
+		authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+		content := bytes.Repeat([]byte("a"), exportChunkSize+10)
+		filter := usecase.ClientUserFilter{Status: "ACTIVE", OrderBy: "name"}
+		mockUsecase.On("ExportClientUsers", mock.Anything, userCtx, filter, tabular.FormatXLSX, mock.Anything).
+			Run(func(args mock.Arguments) {
+				_, _ = args.Get(4).(io.Writer).Write(content)
+			}).
+			Return(&usecase.ExportClientUsersResult{Format: tabular.FormatXLSX, Rows: 3}, nil)
+
+		stream := &fakeExportStream{ctx: ctx}
+		err := authServer.ExportClientUsers(&pbauth.ExportClientUsersRequest{Format: "XLSX", Status: "ACTIVE", OrderBy: "name"}, stream)
+		assert.NoError(t, err)
+		if assert.Len(t, stream.sent, 2) {
+			assert.Equal(t, tabular.FormatXLSX.ContentType(), stream.sent[0].ContentType)
+			assert.Regexp(t, `^client_users_\d{8}T\d{6}Z\.xlsx$`, stream.sent[0].Filename)
+			assert.Empty(t, stream.sent[1].ContentType)
+			assert.Equal(t, content, append(stream.sent[0].Chunk, stream.sent[1].Chunk...))
+		}
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("未対応の出力形式", func(t *testing.T) {
+		authServer := NewAuthServer(new(MockAuthUsecase), nil, nil, nil, nil)
+		err := authServer.ExportClientUsers(&pbauth.ExportClientUsersRequest{Format: "pdf"}, &fakeExportStream{ctx: ctx})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("権限なし", func(t *testing.T) {
+		mockUsecase := new(MockAuthUsecase)
+		authServer := NewAuthServer(mockUsecase, nil, nil, nil, nil)
+		mockUsecase.On("ExportClientUsers", mock.Anything, userCtx, usecase.ClientUserFilter{}, tabular.FormatCSV, mock.Anything).
+			Return(nil, fmt.Errorf("%w: viewer can only read", usecase.ErrPermissionDenied))
+
+		stream := &fakeExportStream{ctx: ctx}
+		err := authServer.ExportClientUsers(&pbauth.ExportClientUsersRequest{}, stream)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, stream.sent)
+	})
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/usecase"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+)
+
+// ListIdentityProviders 外部IdP設定一覧取得
+func (s *AuthServer) ListIdentityProviders(ctx context.Context, req *pbauth.ListIdentityProvidersRequest) (*pbauth.ListIdentityProvidersResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// ユースケースを呼び出し
+	providers, err := s.identityProviderUsecase.ListIdentityProviders(ctx, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	pbProviders := make([]*pbauth.IdentityProvider, len(providers))
+	for i, provider := range providers {
+		pbProviders[i] = convertIdentityProviderToPB(provider)
+	}
+
+	return &pbauth.ListIdentityProvidersResponse{
+		IdentityProviders: pbProviders,
+	}, nil
+}
+
+// CreateIdentityProvider 外部IdP設定登録
+func (s *AuthServer) CreateIdentityProvider(ctx context.Context, req *pbauth.CreateIdentityProviderRequest) (*pbauth.CreateIdentityProviderResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// ユースケースを呼び出し
+	provider, err := s.identityProviderUsecase.CreateIdentityProvider(ctx, userCtx, usecase.CreateIdentityProviderParams{
+		Name:            req.GetName(),
+		Issuer:          req.GetIssuer(),
+		Audience:        req.GetAudience(),
+		JwksURI:         req.JwksUri,
+		JITProvisioning: req.GetJitProvisioning(),
+		DefaultRoleCode: req.GetDefaultRoleCode(),
+		Status:          req.GetStatus(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.CreateIdentityProviderResponse{
+		IdentityProvider: convertIdentityProviderToPB(provider),
+	}, nil
+}
+
+// UpdateIdentityProvider 外部IdP設定更新
+func (s *AuthServer) UpdateIdentityProvider(ctx context.Context, req *pbauth.UpdateIdentityProviderRequest) (*pbauth.UpdateIdentityProviderResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	providerID, err := uuid.Parse(req.GetProviderId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	provider, err := s.identityProviderUsecase.UpdateIdentityProvider(ctx, userCtx, providerID, usecase.UpdateIdentityProviderParams{
+		Name:            req.Name,
+		Audience:        req.Audience,
+		JwksURI:         req.JwksUri,
+		JITProvisioning: req.JitProvisioning,
+		DefaultRoleCode: req.DefaultRoleCode,
+		Status:          req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.UpdateIdentityProviderResponse{
+		IdentityProvider: convertIdentityProviderToPB(provider),
+	}, nil
+}
+
+// DeleteIdentityProvider 外部IdP設定削除
+func (s *AuthServer) DeleteIdentityProvider(ctx context.Context, req *pbauth.DeleteIdentityProviderRequest) (*pbauth.DeleteIdentityProviderResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	providerID, err := uuid.Parse(req.GetProviderId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	if err := s.identityProviderUsecase.DeleteIdentityProvider(ctx, userCtx, providerID); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.DeleteIdentityProviderResponse{}, nil
+}
+
+// convertIdentityProviderToPB dbgen.ClientIdentityProviderをpbauth.IdentityProviderに変換
+func convertIdentityProviderToPB(provider dbgen.ClientIdentityProvider) *pbauth.IdentityProvider {
+	pbProvider := &pbauth.IdentityProvider{
+		ProviderId:      uuidFromPGType(provider.ProviderID).String(),
+		Name:            provider.Name,
+		Issuer:          provider.Issuer,
+		Audience:        provider.Audience,
+		JitProvisioning: provider.JitProvisioning,
+		DefaultRoleCode: provider.DefaultRoleCode,
+		Status:          provider.Status,
+		CreatedAt:       provider.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:       provider.UpdatedAt.Time.Format(time.RFC3339),
+	}
+	if provider.JwksUri.Valid {
+		pbProvider.JwksUri = &provider.JwksUri.String
+	}
+	return pbProvider
+}
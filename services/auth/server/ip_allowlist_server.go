@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+)
+
+// ListIpAllowlistEntries IPアドレス許可リスト取得
+func (s *AuthServer) ListIpAllowlistEntries(ctx context.Context, req *pbauth.ListIpAllowlistEntriesRequest) (*pbauth.ListIpAllowlistEntriesResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// ユースケースを呼び出し
+	entries, err := s.ipAllowlistUsecase.ListIPAllowlistEntries(ctx, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	pbEntries := make([]*pbauth.IpAllowlistEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = convertIPAllowlistEntryToPB(entry)
+	}
+
+	return &pbauth.ListIpAllowlistEntriesResponse{
+		Entries: pbEntries,
+	}, nil
+}
+
+// AddIpAllowlistEntry IPアドレス許可リストにアドレス範囲を追加
+func (s *AuthServer) AddIpAllowlistEntry(ctx context.Context, req *pbauth.AddIpAllowlistEntryRequest) (*pbauth.AddIpAllowlistEntryResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	cidr, err := parseCIDR(req.GetCidr())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cidr: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	entry, err := s.ipAllowlistUsecase.AddIPAllowlistEntry(ctx, userCtx, cidr, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.AddIpAllowlistEntryResponse{
+		Entry: convertIPAllowlistEntryToPB(entry),
+	}, nil
+}
+
+// RemoveIpAllowlistEntry IPアドレス許可リストからエントリを削除
+func (s *AuthServer) RemoveIpAllowlistEntry(ctx context.Context, req *pbauth.RemoveIpAllowlistEntryRequest) (*pbauth.RemoveIpAllowlistEntryResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	entryID, err := uuid.Parse(req.GetEntryId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	if err := s.ipAllowlistUsecase.RemoveIPAllowlistEntry(ctx, userCtx, entryID); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.RemoveIpAllowlistEntryResponse{}, nil
+}
+
+// parseCIDR CIDR表記または単一アドレスを解析（単一アドレスは/32・/128として扱う）
+func parseCIDR(value string) (netip.Prefix, error) {
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	return netip.ParsePrefix(value)
+}
+
+// convertIPAllowlistEntryToPB dbgen.ClientIpAllowlistEntryをpbauth.IpAllowlistEntryに変換
+func convertIPAllowlistEntryToPB(entry dbgen.ClientIpAllowlistEntry) *pbauth.IpAllowlistEntry {
+	pbEntry := &pbauth.IpAllowlistEntry{
+		EntryId:   uuidFromPGType(entry.EntryID).String(),
+		Cidr:      entry.Cidr.String(),
+		CreatedAt: entry.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if entry.Description.Valid {
+		pbEntry.Description = &entry.Description.String
+	}
+	return pbEntry
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/usecase"
+)
+
+// UpdateMe 自分のプロフィール更新
+func (s *AuthServer) UpdateMe(ctx context.Context, req *pbauth.UpdateMeRequest) (*pbauth.UpdateMeResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	if req.FirstName != nil && strings.TrimSpace(req.GetFirstName()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "first_name must not be empty")
+	}
+	if req.LastName != nil && strings.TrimSpace(req.GetLastName()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "last_name must not be empty")
+	}
+
+	// ユースケースを呼び出し
+	user, err := s.authUsecase.UpdateMe(ctx, userCtx, usecase.UpdateMeParams{
+		FirstName:  req.FirstName,
+		LastName:   req.LastName,
+		Department: req.Department,
+		Position:   req.Position,
+		Settings:   req.Settings,
+		UpdateMask: req.GetUpdateMask().GetPaths(),
+		ETag:       req.GetEtag(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.UpdateMeResponse{
+		User: convertClientUserToPB(user),
+	}, nil
+}
+
+// ChangeMyPassword 自分のパスワード変更
+func (s *AuthServer) ChangeMyPassword(ctx context.Context, req *pbauth.ChangeMyPasswordRequest) (*pbauth.ChangeMyPasswordResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	if req.CurrentPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "current_password is required")
+	}
+	if req.NewPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "new_password is required")
+	}
+
+	// ユースケースを呼び出し
+	if err := s.authUsecase.ChangeMyPassword(ctx, userCtx, req.CurrentPassword, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.ChangeMyPasswordResponse{}, nil
+}
+
+// ChangeMyEmail 自分のメールアドレス変更を申請
+func (s *AuthServer) ChangeMyEmail(ctx context.Context, req *pbauth.ChangeMyEmailRequest) (*pbauth.ChangeMyEmailResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	newEmail := strings.TrimSpace(req.GetNewEmail())
+	if newEmail == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "new_email is required")
+	}
+
+	// ユースケースを呼び出し
+	if err := s.authUsecase.ChangeMyEmail(ctx, userCtx, newEmail); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.ChangeMyEmailResponse{
+		PendingEmail: newEmail,
+	}, nil
+}
+
+// ConfirmMyEmailChange メールアドレス変更の確認トークンを検証し、変更を確定
+func (s *AuthServer) ConfirmMyEmailChange(ctx context.Context, req *pbauth.ConfirmMyEmailChangeRequest) (*pbauth.ConfirmMyEmailChangeResponse, error) {
+	// リクエストのバリデーション
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	// ユースケースを呼び出し
+	user, err := s.authUsecase.ConfirmMyEmailChange(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.ConfirmMyEmailChangeResponse{
+		User: convertClientUserToPB(user),
+	}, nil
+}
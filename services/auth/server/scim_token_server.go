@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/scim"
+
+	"github.com/google/uuid"
+)
+
+// CreateScimToken SCIMトークン発行
+func (s *AuthServer) CreateScimToken(ctx context.Context, req *pbauth.CreateScimTokenRequest) (*pbauth.CreateScimTokenResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t, err := time.Parse(time.RFC3339, req.GetExpiresAt())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expires_at: %v", err)
+		}
+		if !t.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		expiresAt = &t
+	}
+
+	// ユースケースを呼び出し
+	result, err := s.scimUsecase.CreateToken(ctx, userCtx, req.GetDescription(), expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	resp := &pbauth.CreateScimTokenResponse{
+		TokenId:      uuidFromPGType(result.TokenInfo.TokenID).String(),
+		Token:        result.Token,
+		TokenPrefix:  result.TokenInfo.TokenPrefix,
+		ScimBasePath: scim.BasePath,
+	}
+	if result.TokenInfo.ExpiresAt.Valid {
+		expires := result.TokenInfo.ExpiresAt.Time.Format(time.RFC3339)
+		resp.ExpiresAt = &expires
+	}
+
+	return resp, nil
+}
+
+// RevokeScimToken SCIMトークン取り消し
+func (s *AuthServer) RevokeScimToken(ctx context.Context, req *pbauth.RevokeScimTokenRequest) (*pbauth.RevokeScimTokenResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	tokenID, err := uuid.Parse(req.GetTokenId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	if err := s.scimUsecase.RevokeToken(ctx, userCtx, tokenID); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.RevokeScimTokenResponse{}, nil
+}
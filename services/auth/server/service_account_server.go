@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+	"contract-pro-suite/services/auth/usecase"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+)
+
+// ListServiceAccounts サービスアカウント一覧取得
+func (s *AuthServer) ListServiceAccounts(ctx context.Context, req *pbauth.ListServiceAccountsRequest) (*pbauth.ListServiceAccountsResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// ユースケースを呼び出し
+	accounts, err := s.serviceAccountUsecase.ListServiceAccounts(ctx, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	pbAccounts := make([]*pbauth.ServiceAccount, len(accounts))
+	for i, account := range accounts {
+		pbAccounts[i] = convertServiceAccountToPB(account)
+	}
+
+	return &pbauth.ListServiceAccountsResponse{
+		ServiceAccounts: pbAccounts,
+	}, nil
+}
+
+// CreateServiceAccount サービスアカウント作成
+func (s *AuthServer) CreateServiceAccount(ctx context.Context, req *pbauth.CreateServiceAccountRequest) (*pbauth.CreateServiceAccountResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	if req.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	roleID, err := uuid.Parse(req.GetRoleId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	account, err := s.serviceAccountUsecase.CreateServiceAccount(ctx, userCtx, usecase.CreateServiceAccountParams{
+		Name:        req.GetName(),
+		Description: req.Description,
+		RoleID:      roleID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.CreateServiceAccountResponse{
+		ServiceAccount: convertServiceAccountToPB(account),
+	}, nil
+}
+
+// DeleteServiceAccount サービスアカウント削除
+func (s *AuthServer) DeleteServiceAccount(ctx context.Context, req *pbauth.DeleteServiceAccountRequest) (*pbauth.DeleteServiceAccountResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	serviceAccountID, err := uuid.Parse(req.GetServiceAccountId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid service_account_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	if err := s.serviceAccountUsecase.DeleteServiceAccount(ctx, userCtx, serviceAccountID); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.DeleteServiceAccountResponse{}, nil
+}
+
+// ListApiKeys APIキー一覧取得
+func (s *AuthServer) ListApiKeys(ctx context.Context, req *pbauth.ListApiKeysRequest) (*pbauth.ListApiKeysResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	serviceAccountID, err := uuid.Parse(req.GetServiceAccountId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid service_account_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	keys, err := s.serviceAccountUsecase.ListAPIKeys(ctx, userCtx, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	pbKeys := make([]*pbauth.ApiKey, len(keys))
+	for i, key := range keys {
+		pbKeys[i] = convertAPIKeyToPB(key)
+	}
+
+	return &pbauth.ListApiKeysResponse{
+		ApiKeys: pbKeys,
+	}, nil
+}
+
+// CreateApiKey APIキー発行
+func (s *AuthServer) CreateApiKey(ctx context.Context, req *pbauth.CreateApiKeyRequest) (*pbauth.CreateApiKeyResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	serviceAccountID, err := uuid.Parse(req.GetServiceAccountId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid service_account_id: %v", err)
+	}
+	expiresAt, err := parseExpiresAt(req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユースケースを呼び出し
+	result, err := s.serviceAccountUsecase.CreateAPIKey(ctx, userCtx, serviceAccountID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.CreateApiKeyResponse{
+		ApiKey: convertAPIKeyToPB(result.APIKey),
+		Key:    result.Key,
+	}, nil
+}
+
+// RotateApiKey APIキーのローテーション
+func (s *AuthServer) RotateApiKey(ctx context.Context, req *pbauth.RotateApiKeyRequest) (*pbauth.RotateApiKeyResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	apiKeyID, err := uuid.Parse(req.GetApiKeyId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid api_key_id: %v", err)
+	}
+	gracePeriod := time.Duration(req.GetGracePeriodSeconds()) * time.Second
+	if gracePeriod < 0 || gracePeriod > usecase.MaxAPIKeyRotationGracePeriod {
+		return nil, status.Errorf(codes.InvalidArgument, "grace_period_seconds must be between 0 and %d", int64(usecase.MaxAPIKeyRotationGracePeriod.Seconds()))
+	}
+	expiresAt, err := parseExpiresAt(req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// ユースケースを呼び出し
+	result, err := s.serviceAccountUsecase.RotateAPIKey(ctx, userCtx, apiKeyID, gracePeriod, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.RotateApiKeyResponse{
+		ApiKey: convertAPIKeyToPB(result.APIKey),
+		Key:    result.Key,
+	}, nil
+}
+
+// RevokeApiKey APIキー取り消し
+func (s *AuthServer) RevokeApiKey(ctx context.Context, req *pbauth.RevokeApiKeyRequest) (*pbauth.RevokeApiKeyResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	apiKeyID, err := uuid.Parse(req.GetApiKeyId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid api_key_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	if err := s.serviceAccountUsecase.RevokeAPIKey(ctx, userCtx, apiKeyID); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.RevokeApiKeyResponse{}, nil
+}
+
+// parseExpiresAt 有効期限（ISO 8601、省略可）を解析
+func parseExpiresAt(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid expires_at: %v", err)
+	}
+	if !t.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "expires_at must be in the future")
+	}
+	return &t, nil
+}
+
+// convertServiceAccountToPB dbgen.ClientServiceAccountをpbauth.ServiceAccountに変換
+func convertServiceAccountToPB(account dbgen.ClientServiceAccount) *pbauth.ServiceAccount {
+	pbAccount := &pbauth.ServiceAccount{
+		ServiceAccountId: uuidFromPGType(account.ServiceAccountID).String(),
+		ClientId:         uuidFromPGType(account.ClientID).String(),
+		Name:             account.Name,
+		RoleId:           uuidFromPGType(account.RoleID).String(),
+		Status:           account.Status,
+		CreatedAt:        account.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:        account.UpdatedAt.Time.Format(time.RFC3339),
+	}
+	if account.Description.Valid {
+		pbAccount.Description = &account.Description.String
+	}
+	return pbAccount
+}
+
+// convertAPIKeyToPB dbgen.ClientApiKeyをpbauth.ApiKeyに変換（キーのハッシュは含めない）
+func convertAPIKeyToPB(key dbgen.ClientApiKey) *pbauth.ApiKey {
+	pbKey := &pbauth.ApiKey{
+		ApiKeyId:         uuidFromPGType(key.ApiKeyID).String(),
+		ServiceAccountId: uuidFromPGType(key.ServiceAccountID).String(),
+		KeyPrefix:        key.KeyPrefix,
+		CreatedAt:        key.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if key.ExpiresAt.Valid {
+		expiresAt := key.ExpiresAt.Time.Format(time.RFC3339)
+		pbKey.ExpiresAt = &expiresAt
+	}
+	if key.LastUsedAt.Valid {
+		lastUsedAt := key.LastUsedAt.Time.Format(time.RFC3339)
+		pbKey.LastUsedAt = &lastUsedAt
+	}
+	if key.RevokedAt.Valid {
+		revokedAt := key.RevokedAt.Time.Format(time.RFC3339)
+		pbKey.RevokedAt = &revokedAt
+	}
+	if key.RotatedFrom.Valid {
+		rotatedFrom := uuidFromPGType(key.RotatedFrom).String()
+		pbKey.RotatedFrom = &rotatedFrom
+	}
+	return pbKey
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"contract-pro-suite/internal/interceptor"
+	pbauth "contract-pro-suite/proto/contractpro/auth/v1"
+
+	"github.com/google/uuid"
+)
+
+// Logout 現在のトークンを失効
+func (s *AuthServer) Logout(ctx context.Context, req *pbauth.LogoutRequest) (*pbauth.LogoutResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	jwtUserCtx, ok := interceptor.GetUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+	if jwtUserCtx.APIKey != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "api keys must be revoked with RevokeApiKey")
+	}
+
+	// ユースケースを呼び出し
+	if err := s.authUsecase.Logout(ctx, userCtx, jwtUserCtx.Token); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.LogoutResponse{}, nil
+}
+
+// ForceLogout 指定したクライアントユーザーの発行済みトークンをすべて失効
+func (s *AuthServer) ForceLogout(ctx context.Context, req *pbauth.ForceLogoutRequest) (*pbauth.ForceLogoutResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// リクエストのバリデーション
+	clientUserID, err := uuid.Parse(req.GetClientUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid client_user_id: %v", err)
+	}
+
+	// ユースケースを呼び出し
+	if err := s.authUsecase.ForceLogout(ctx, userCtx, clientUserID); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.ForceLogoutResponse{}, nil
+}
+
+// ForceLogoutTenant クライアント内の全ユーザーの発行済みトークンを失効
+func (s *AuthServer) ForceLogoutTenant(ctx context.Context, req *pbauth.ForceLogoutTenantRequest) (*pbauth.ForceLogoutTenantResponse, error) {
+	// ユーザーコンテキストを取得
+	userCtx, ok := interceptor.GetEnhancedUserContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	// ユースケースを呼び出し
+	if err := s.authUsecase.ForceLogoutTenant(ctx, userCtx); err != nil {
+		return nil, err
+	}
+
+	// レスポンスを作成
+	return &pbauth.ForceLogoutTenantResponse{}, nil
+}
@@ -13,22 +13,51 @@ import (
 
 	"contract-pro-suite/internal/shared/config"
 	"contract-pro-suite/internal/shared/db"
+	"contract-pro-suite/internal/shared/tabular"
 	"contract-pro-suite/services/auth/domain"
 	"contract-pro-suite/services/auth/repository"
 
 	dbgen "contract-pro-suite/sqlc"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+var (
+	// ErrEmailAlreadyExists クライアント内でメールアドレスが重複している
+	ErrEmailAlreadyExists = domain.NewError(domain.ErrorKindConflict, domain.ReasonEmailAlreadyExists, "email already exists")
+	// ErrClientUserNotFound クライアントユーザーがクライアント内に存在しない
+	ErrClientUserNotFound = domain.NewError(domain.ErrorKindNotFound, domain.ReasonClientUserNotFound, "client user not found")
+	// ErrSlugAlreadyExists スラッグが他のクライアントで使用されている
+	ErrSlugAlreadyExists = domain.NewError(domain.ErrorKindConflict, domain.ReasonSlugAlreadyExists, "slug already exists")
+	// ErrCompanyCodeAlreadyExists 会社コードが他のクライアントで使用されている
+	ErrCompanyCodeAlreadyExists = domain.NewError(domain.ErrorKindConflict, domain.ReasonCompanyCodeAlreadyExists, "company_code already exists")
+	// ErrPermissionDenied 操作に必要な権限がない（CheckPermission）
+	ErrPermissionDenied = domain.NewError(domain.ErrorKindPermissionDenied, domain.ReasonPermissionDenied, "permission denied")
+	// ErrClientAccessDenied 対象のクライアントにアクセスできない（ValidateClientAccess）
+	ErrClientAccessDenied = domain.NewError(domain.ErrorKindPermissionDenied, domain.ReasonClientAccessDenied, "client access denied")
+	// ErrUserNotActive ユーザーのステータスがACTIVEではない（停止・無効化、SCIMでactive=false）
+	ErrUserNotActive = domain.NewError(domain.ErrorKindPermissionDenied, domain.ReasonUserNotActive, "user is not active")
+)
+
+// requireActiveUser ユーザーのステータスがACTIVEか確認
+// 停止・無効化したユーザーが新しく発行されたトークン（Supabaseのセッション更新、外部IdPの再ログイン）で
+// 認証できないよう、ユーザー情報の解決時に確認する（失効基準日時は発行済みのトークンのみが対象のため）
+func requireActiveUser(status string) error {
+	if domain.UserStatus(status) != domain.UserStatusActive {
+		return ErrUserNotActive
+	}
+	return nil
+}
+
 // SignupClientParams クライアント登録パラメータ
 type SignupClientParams struct {
 	// クライアント情報
 	Name                   string
 	CompanyCode            string
 	Slug                   string
-	ESignMode              string
+	ESignMode              domain.ESignMode // 未指定の場合はWITNESS_OTP
 	RetentionDefaultMonths int32
 	Settings               string
 
@@ -39,6 +68,9 @@ type SignupClientParams struct {
 	AdminLastName   string
 	AdminDepartment *string
 	AdminPosition   *string
+
+	// ボット対策のチャレンジトークン（チャレンジ検証が有効な場合は必須）
+	ChallengeToken string
 }
 
 // SignupClientResult クライアント登録結果
@@ -47,6 +79,15 @@ type SignupClientResult struct {
 	ClientName  string
 	AdminUserID uuid.UUID
 	AdminEmail  string
+	Status      domain.ClientStatus // 登録確認が完了するまでPENDING_VERIFICATION
+}
+
+// VerifySignupResult 登録確認結果
+type VerifySignupResult struct {
+	ClientID    uuid.UUID
+	AdminUserID uuid.UUID
+	AdminEmail  string
+	Status      domain.ClientStatus
 }
 
 // CreateClientUserParams クライアントユーザー作成パラメータ
@@ -58,6 +99,10 @@ type CreateClientUserParams struct {
 	Department *string
 	Position   *string
 	Settings   string
+	Status     domain.UserStatus // 作成時のステータス（空の場合はACTIVE、SCIMの無効状態でのプロビジョニング用）
+
+	// passwordGenerated パスワードをサーバー側でランダム生成した場合はパスワードポリシーを適用しない（SCIMプロビジョニング）
+	passwordGenerated bool
 }
 
 // UpdateClientUserParams クライアントユーザー更新パラメータ
@@ -69,6 +114,12 @@ type UpdateClientUserParams struct {
 	Position   *string
 	Settings   *string
 	Status     *string
+	// UpdateMask 更新するフィールド（FieldMaskのパス）
+	// 指定した場合はマスクに含まれるフィールドのみを更新し、settingsはJSON Merge Patchとして適用する
+	// 未指定の場合は値が指定されたフィールドを更新する（互換性のため、settingsは全体を置き換える）
+	UpdateMask []string
+	// ETag 取得時のETag（UpdateClientUserでは必須、一致しない場合はErrETagMismatch）
+	ETag string
 }
 
 // AuthUsecase 認証ユースケース
@@ -76,26 +127,69 @@ type AuthUsecase interface {
 	// GetUserContext JWTから取得したユーザーIDでデータベースからユーザー情報と権限を取得
 	GetUserContext(ctx context.Context, jwtUserID string) (*domain.UserContext, error)
 
+	// GetUserContextByExternalIdentity 外部IdPの (issuer, subject) からクライアントユーザーを解決（未紐付けの場合はJITプロビジョニング）
+	GetUserContextByExternalIdentity(ctx context.Context, identity domain.ExternalIdentity) (*domain.UserContext, error)
+
+	// GetUserContextByAPIKey APIキーで認証されたサービスアカウントを解決
+	GetUserContextByAPIKey(ctx context.Context, identity domain.APIKeyIdentity) (*domain.UserContext, error)
+
 	// ValidateClientAccess ユーザーのクライアントアクセス権限を検証
 	ValidateClientAccess(ctx context.Context, userCtx *domain.UserContext, clientID uuid.UUID) error
 
 	// CheckPermission 権限チェック（将来の実装用）
 	CheckPermission(ctx context.Context, userCtx *domain.UserContext, feature, action string) error
 
-	// SignupClient サービス利用開始時のアカウント登録（クライアント + 管理者ユーザー作成）
+	// GetMe 現在のユーザーのプロフィール・クライアント・ロール・実効権限を取得
+	GetMe(ctx context.Context, userCtx *domain.UserContext) (*MeResult, error)
+
+	// トークン失効
+	// CheckTokenRevocation トークンが失効リスト・失効基準日時により無効化されていないか確認
+	CheckTokenRevocation(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error
+	// Logout 現在のトークンを失効
+	Logout(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error
+	// ForceLogout 指定したクライアントユーザーの発行済みトークンをすべて失効（権限: users:WRITE）
+	ForceLogout(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) error
+	// ForceLogoutTenant クライアント内の全ユーザーの発行済みトークンを失効（権限: system_settings:WRITE）
+	ForceLogoutTenant(ctx context.Context, userCtx *domain.UserContext) error
+
+	// CheckMFA MFAポリシー（クライアント設定・ロール、オペレーターのmfa_enabled）を満たしているか確認
+	CheckMFA(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error
+
+	// RecordActivity 最終アクティビティ日時を記録（認証・テナントの検証をすべて通過したリクエストのみ）
+	RecordActivity(ctx context.Context, userCtx *domain.UserContext) error
+
+	// SignupClient サービス利用開始時のアカウント登録（クライアント + 管理者ユーザー作成、登録確認待ちで作成、確認待ちの再登録は確認メールを再送）
 	SignupClient(ctx context.Context, params SignupClientParams) (*SignupClientResult, error)
+	// VerifySignup 登録確認トークンを検証し、クライアントと管理者ユーザーを有効化
+	VerifySignup(ctx context.Context, token string) (*VerifySignupResult, error)
+
+	// セルフサービス（クライアントユーザー自身のレコードのみ、users:WRITE不要）
+	// UpdateMe 自分のプロフィール更新（メールアドレス・ステータス・ロールは変更不可）
+	UpdateMe(ctx context.Context, userCtx *domain.UserContext, params UpdateMeParams) (dbgen.ClientUser, error)
+	// ChangeMyPassword 自分のパスワード変更（現在のパスワードが必要、変更後は発行済みトークンを失効）
+	ChangeMyPassword(ctx context.Context, userCtx *domain.UserContext, currentPassword, newPassword string) error
+	// ChangeMyEmail 自分のメールアドレス変更を申請（変更後のメールアドレスに確認トークンを送信）
+	ChangeMyEmail(ctx context.Context, userCtx *domain.UserContext, newEmail string) error
+	// ConfirmMyEmailChange メールアドレス変更の確認トークンを検証し、変更を確定
+	ConfirmMyEmailChange(ctx context.Context, token string) (dbgen.ClientUser, error)
 
 	// クライアントユーザー管理
-	// ListClientUsers クライアントユーザー一覧取得（ページネーション対応）
-	ListClientUsers(ctx context.Context, userCtx *domain.UserContext, limit, offset int32) ([]dbgen.ClientUser, int32, error)
+	// ListClientUsers クライアントユーザー一覧取得（検索・絞り込み・並び替え、キーセットページネーション、総件数付き）
+	ListClientUsers(ctx context.Context, userCtx *domain.UserContext, filter ClientUserFilter, page PageRequest) (Page[dbgen.ClientUser], error)
+	// ExportClientUsers クライアントユーザーと有効なロールをCSV/XLSXで出力（ListClientUsersと同じ検索条件、権限: users:READ）
+	ExportClientUsers(ctx context.Context, userCtx *domain.UserContext, filter ClientUserFilter, format tabular.Format, w io.Writer) (*ExportClientUsersResult, error)
 	// GetClientUser クライアントユーザー詳細取得（クライアント分離チェック）
 	GetClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) (dbgen.ClientUser, error)
+	// GetClientUserDetail クライアントユーザー詳細取得（有効なロール・最終アクティビティ日時を含む、v2 API用）
+	GetClientUserDetail(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) (ClientUserDetail, error)
+	// ListClientUserDetails クライアントユーザー一覧取得（有効なロール・最終アクティビティ日時を含む、v2 API用）
+	ListClientUserDetails(ctx context.Context, userCtx *domain.UserContext, filter ClientUserFilter, page PageRequest) (Page[ClientUserDetail], error)
 	// CreateClientUser クライアントユーザー作成（Supabase Auth連携、デフォルトロール割り当て）
 	CreateClientUser(ctx context.Context, userCtx *domain.UserContext, params CreateClientUserParams) (dbgen.ClientUser, error)
-	// UpdateClientUser クライアントユーザー更新（クライアント分離チェック）
+	// UpdateClientUser クライアントユーザー更新（クライアント分離チェック、ETagによる楽観的排他制御）
 	UpdateClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID, params UpdateClientUserParams) (dbgen.ClientUser, error)
-	// DeleteClientUser クライアントユーザー削除（論理削除、クライアント分離チェック）
-	DeleteClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) error
+	// DeleteClientUser クライアントユーザー削除（論理削除、クライアント分離チェック、ETagによる楽観的排他制御）
+	DeleteClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID, etag string) error
 }
 
 type authUsecase struct {
@@ -106,6 +200,14 @@ type authUsecase struct {
 	clientRoleRepo           repository.ClientRoleRepository
 	clientRolePermissionRepo repository.ClientRolePermissionRepository
 	clientUserRoleRepo       repository.ClientUserRoleRepository
+	identityProviderRepo     repository.IdentityProviderRepository
+	clientUserIdentityRepo   repository.ClientUserIdentityRepository
+	serviceAccountRepo       repository.ServiceAccountRepository
+	tokenRevocationRepo      repository.TokenRevocationRepository
+	challengeVerifier        ChallengeVerifier
+	signupVerificationSender SignupVerificationSender
+	breachedPasswordChecker  BreachedPasswordChecker
+	emailChangeSender        EmailChangeSender
 	cfg                      *config.Config
 	database                 *db.DB
 }
@@ -119,6 +221,14 @@ func NewAuthUsecase(
 	clientRoleRepo repository.ClientRoleRepository,
 	clientRolePermissionRepo repository.ClientRolePermissionRepository,
 	clientUserRoleRepo repository.ClientUserRoleRepository,
+	identityProviderRepo repository.IdentityProviderRepository,
+	clientUserIdentityRepo repository.ClientUserIdentityRepository,
+	serviceAccountRepo repository.ServiceAccountRepository,
+	tokenRevocationRepo repository.TokenRevocationRepository,
+	challengeVerifier ChallengeVerifier,
+	signupVerificationSender SignupVerificationSender,
+	breachedPasswordChecker BreachedPasswordChecker,
+	emailChangeSender EmailChangeSender,
 	cfg *config.Config,
 	database *db.DB,
 ) AuthUsecase {
@@ -130,6 +240,14 @@ func NewAuthUsecase(
 		clientRoleRepo:           clientRoleRepo,
 		clientRolePermissionRepo: clientRolePermissionRepo,
 		clientUserRoleRepo:       clientUserRoleRepo,
+		identityProviderRepo:     identityProviderRepo,
+		clientUserIdentityRepo:   clientUserIdentityRepo,
+		serviceAccountRepo:       serviceAccountRepo,
+		tokenRevocationRepo:      tokenRevocationRepo,
+		challengeVerifier:        challengeVerifier,
+		signupVerificationSender: signupVerificationSender,
+		breachedPasswordChecker:  breachedPasswordChecker,
+		emailChangeSender:        emailChangeSender,
 		cfg:                      cfg,
 		database:                 database,
 	}
@@ -145,6 +263,9 @@ func (u *authUsecase) GetUserContext(ctx context.Context, jwtUserID string) (*do
 	// まずoperatorsテーブルで検索
 	operator, err := u.operatorRepo.GetByID(ctx, userUUID)
 	if err == nil && operator.OperatorID.Valid {
+		if err := requireActiveUser(operator.Status); err != nil {
+			return nil, err
+		}
 		// オペレーターの場合、operator_assignmentsテーブルからクライアントIDを取得
 		// 最初のアクティブな割り当てを取得（将来は複数クライアント対応が必要）
 		assignments, err := u.operatorAssignmentRepo.GetByOperatorID(ctx, userUUID)
@@ -171,6 +292,9 @@ func (u *authUsecase) GetUserContext(ctx context.Context, jwtUserID string) (*do
 	// client_user_idだけで検索（client_idは不要）
 	clientUser, err := u.clientUserRepo.GetByUserIDOnly(ctx, userUUID)
 	if err == nil && clientUser.ClientUserID.Valid {
+		if err := requireActiveUser(clientUser.Status); err != nil {
+			return nil, err
+		}
 		// クライアントユーザーの場合、client_usersテーブルからclient_idを取得
 		clientID := uuidFromPGType(clientUser.ClientID)
 		return &domain.UserContext{
@@ -184,6 +308,17 @@ func (u *authUsecase) GetUserContext(ctx context.Context, jwtUserID string) (*do
 	return nil, errors.New("user not found")
 }
 
+// RecordActivity 最終アクティビティ日時を記録（クライアントユーザーのみ、5分以内の更新はクエリ側で省略）
+func (u *authUsecase) RecordActivity(ctx context.Context, userCtx *domain.UserContext) error {
+	if userCtx == nil || userCtx.UserType != domain.UserTypeClientUser {
+		return nil
+	}
+	if err := u.clientUserRepo.TouchActivity(ctx, userCtx.ClientID, userCtx.UserID); err != nil {
+		return fmt.Errorf("failed to touch client user activity: %w", err)
+	}
+	return nil
+}
+
 // ValidateClientAccess ユーザーのクライアントアクセス権限を検証
 func (u *authUsecase) ValidateClientAccess(ctx context.Context, userCtx *domain.UserContext, clientID uuid.UUID) error {
 	switch userCtx.UserType {
@@ -199,15 +334,15 @@ func (u *authUsecase) ValidateClientAccess(ctx context.Context, userCtx *domain.
 				return nil
 			}
 		}
-		return errors.New("client access denied: operator not assigned to client")
-	case domain.UserTypeClientUser:
-		// クライアントユーザーの場合、client_idが一致するか確認
+		return fmt.Errorf("%w: operator not assigned to client", ErrClientAccessDenied)
+	case domain.UserTypeClientUser, domain.UserTypeServiceAccount:
+		// クライアントユーザー・サービスアカウントの場合、client_idが一致するか確認
 		if userCtx.ClientID != clientID {
-			return errors.New("client access denied")
+			return ErrClientAccessDenied
 		}
 		return nil
 	default:
-		return errors.New("unknown user type")
+		return fmt.Errorf("%w: unknown user type", ErrClientAccessDenied)
 	}
 }
 
@@ -217,31 +352,31 @@ func (u *authUsecase) CheckPermission(ctx context.Context, userCtx *domain.UserC
 	case domain.UserTypeOperator:
 		// オペレーターの場合、operator_assignmentsテーブルでロールを確認
 		if userCtx.ClientID == uuid.Nil {
-			return errors.New("operator not assigned to any client")
+			return fmt.Errorf("%w: operator not assigned to any client", ErrPermissionDenied)
 		}
 		assignment, err := u.operatorAssignmentRepo.GetByClientAndOperator(ctx, userCtx.ClientID, userCtx.UserID)
 		if err != nil {
 			return fmt.Errorf("failed to get operator assignment: %w", err)
 		}
 		if assignment.Status != "ACTIVE" {
-			return errors.New("operator assignment is not active")
+			return fmt.Errorf("%w: operator assignment is not active", ErrPermissionDenied)
 		}
 		// ロールに基づいて権限チェック
-		switch assignment.Role {
-		case "ADMIN":
+		switch domain.OperatorRole(assignment.Role) {
+		case domain.OperatorRoleAdmin:
 			// ADMIN: 全操作可能
 			return nil
-		case "OPERATOR":
+		case domain.OperatorRoleOperator:
 			// OPERATOR: 一部操作可能（将来拡張可能、現時点では全操作可能）
 			return nil
-		case "VIEWER":
+		case domain.OperatorRoleViewer:
 			// VIEWER: 閲覧のみ
 			if action != "READ" {
-				return errors.New("viewer can only read")
+				return fmt.Errorf("%w: viewer can only read", ErrPermissionDenied)
 			}
 			return nil
 		default:
-			return fmt.Errorf("unknown operator role: %s", assignment.Role)
+			return fmt.Errorf("%w: unknown operator role: %s", ErrPermissionDenied, assignment.Role)
 		}
 	case domain.UserTypeClientUser:
 		// クライアントユーザーの場合、client_user_roles → client_role_permissionsで権限確認
@@ -251,7 +386,7 @@ func (u *authUsecase) CheckPermission(ctx context.Context, userCtx *domain.UserC
 			return fmt.Errorf("failed to get user roles: %w", err)
 		}
 		if len(userRoles) == 0 {
-			return errors.New("user has no roles assigned")
+			return fmt.Errorf("%w: user has no roles assigned", ErrPermissionDenied)
 		}
 		// 2. 各ロールのclient_role_permissionsテーブルから権限を確認
 		for _, userRole := range userRoles {
@@ -267,29 +402,64 @@ func (u *authUsecase) CheckPermission(ctx context.Context, userCtx *domain.UserC
 				}
 			}
 		}
-		return errors.New("permission denied")
+		return ErrPermissionDenied
+	case domain.UserTypeServiceAccount:
+		// サービスアカウントの場合、紐付けられたロールのclient_role_permissionsで権限確認
+		account, err := u.serviceAccountRepo.GetByID(ctx, userCtx.ClientID, userCtx.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to get service account: %w", err)
+		}
+		permissions, err := u.clientRolePermissionRepo.GetByRoleID(ctx, uuidFromPGType(account.RoleID))
+		if err != nil {
+			return fmt.Errorf("failed to get role permissions: %w", err)
+		}
+		for _, perm := range permissions {
+			if perm.Feature == feature && perm.Action == action && perm.Granted {
+				return nil
+			}
+		}
+		return ErrPermissionDenied
 	default:
-		return errors.New("unknown user type")
+		return fmt.Errorf("%w: unknown user type", ErrPermissionDenied)
 	}
 }
 
 // SignupClient サービス利用開始時のアカウント登録（クライアント + 管理者ユーザー作成）
 func (u *authUsecase) SignupClient(ctx context.Context, params SignupClientParams) (*SignupClientResult, error) {
+	// 0. ボット・不正利用対策（データベースへの書き込み前に確認）
+	if err := u.checkSignupAbuse(ctx, params); err != nil {
+		return nil, err
+	}
+
+	// 0-2. パスワードポリシー（クライアント作成前のためサービス全体の設定のみ）
+	if err := u.validatePassword(ctx, uuid.Nil, PasswordCandidate{
+		Password:  params.AdminPassword,
+		Email:     params.AdminEmail,
+		FirstName: params.AdminFirstName,
+		LastName:  params.AdminLastName,
+	}); err != nil {
+		return nil, withPasswordField(err, "admin_password")
+	}
+
 	// 1. クライアント情報のバリデーション（slug, company_codeの重複チェック）
-	if _, err := u.clientRepo.GetBySlug(ctx, params.Slug); err == nil {
-		return nil, fmt.Errorf("slug already exists: %s", params.Slug)
+	if existing, err := u.clientRepo.GetBySlug(ctx, params.Slug); err == nil {
+		// 確認メールの送信に失敗した登録の再試行は、登録確認待ちのクライアントを再利用して確認メールを再送
+		if existing.Status == string(domain.ClientStatusPendingVerification) {
+			return u.resendSignupVerification(ctx, existing, params.AdminEmail)
+		}
+		return nil, fmt.Errorf("%w: %s", ErrSlugAlreadyExists, params.Slug)
 	}
 	// company_codeが指定されている場合のみ重複チェック
 	if params.CompanyCode != "" {
 		if _, err := u.clientRepo.GetByCompanyCode(ctx, params.CompanyCode); err == nil {
-			return nil, fmt.Errorf("company_code already exists: %s", params.CompanyCode)
+			return nil, fmt.Errorf("%w: %s", ErrCompanyCodeAlreadyExists, params.CompanyCode)
 		}
 	}
 
 	// デフォルト値の設定
 	eSignMode := params.ESignMode
 	if eSignMode == "" {
-		eSignMode = "WITNESS_OTP"
+		eSignMode = domain.ESignModeWitnessOTP
 	}
 	retentionMonths := params.RetentionDefaultMonths
 	if retentionMonths == 0 {
@@ -325,9 +495,9 @@ func (u *authUsecase) SignupClient(ctx context.Context, params SignupClientParam
 		Slug:                   params.Slug,
 		CompanyCode:            companyCode,
 		Name:                   params.Name,
-		ESignMode:              eSignMode,
+		ESignMode:              string(eSignMode),
 		RetentionDefaultMonths: retentionMonths,
-		Status:                 "ACTIVE",
+		Status:                 string(domain.ClientStatusPendingVerification), // 登録確認の完了後に有効化
 		Settings:               []byte(settingsJSON),
 	}
 
@@ -369,8 +539,8 @@ func (u *authUsecase) SignupClient(ctx context.Context, params SignupClientParam
 		return nil, fmt.Errorf("failed to create default roles: %w", err)
 	}
 
-	// 4. Supabase Auth Admin APIで管理者ユーザーを作成
-	adminUserID, err := u.createSupabaseUser(ctx, params.AdminEmail, params.AdminPassword, params.AdminFirstName, params.AdminLastName)
+	// 4. Supabase Auth Admin APIで管理者ユーザーを作成（メールアドレスは登録確認で確認済みにする）
+	adminUserID, err := u.createSupabaseUser(ctx, params.AdminEmail, params.AdminPassword, params.AdminFirstName, params.AdminLastName, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create supabase user: %w", err)
 	}
@@ -394,7 +564,7 @@ func (u *authUsecase) SignupClient(ctx context.Context, params SignupClientParam
 		Department:   department,
 		Position:     position,
 		Settings:     []byte("{}"),
-		Status:       "ACTIVE",
+		Status:       string(domain.UserStatusActive),
 	}
 
 	_, err = queries.CreateClientUser(ctx, clientUserParams)
@@ -421,28 +591,189 @@ func (u *authUsecase) SignupClient(ctx context.Context, params SignupClientParam
 		return nil, fmt.Errorf("failed to assign system_admin role: %w", err)
 	}
 
-	// 7. トランザクションコミット
+	// 7. 登録確認トークンを作成（トークンはハッシュのみ保存）
+	verificationURL, err := u.createSignupVerification(ctx, queries, clientID, adminUserID, params.AdminEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	// 8. トランザクションコミット
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	// 6. レスポンス返却
+	// 9. コミット後に管理者メールアドレスに送信（コミットに失敗した場合に存在しないトークンを送信しない）
+	// 送信に失敗した場合、クライアントは登録確認待ちのまま残り、同じslug・管理者メールアドレスでの再試行で再送する
+	if err := u.signupVerificationSender.SendSignupVerification(ctx, params.AdminEmail, client.Name, verificationURL); err != nil {
+		return nil, fmt.Errorf("failed to send signup verification: %w", err)
+	}
+
+	// 10. レスポンス返却
 	return &SignupClientResult{
 		ClientID:    clientID,
 		ClientName:  client.Name,
 		AdminUserID: adminUserID,
 		AdminEmail:  params.AdminEmail,
+		Status:      domain.ClientStatus(client.Status),
+	}, nil
+}
+
+// checkSignupAbuse 使い捨てメールアドレスのドメインとチャレンジトークンを確認
+func (u *authUsecase) checkSignupAbuse(ctx context.Context, params SignupClientParams) error {
+	var extraDomains []string
+	if u.cfg != nil {
+		extraDomains = u.cfg.DisposableEmailDomains()
+	}
+	if isDisposableEmail(params.AdminEmail, extraDomains) {
+		return ErrDisposableEmailDomain
+	}
+
+	if u.challengeVerifier != nil {
+		if err := u.challengeVerifier.VerifyChallenge(ctx, params.ChallengeToken); err != nil {
+			if errors.Is(err, ErrChallengeFailed) {
+				return err
+			}
+			return fmt.Errorf("failed to verify challenge: %w", err)
+		}
+	}
+
+	if u.signupVerificationSender == nil {
+		return errors.New("signup verification sender is not configured")
+	}
+	return nil
+}
+
+// createSignupVerification 登録確認トークンを保存し、送信する確認URLを返す（トークンはハッシュのみ保存）
+func (u *authUsecase) createSignupVerification(ctx context.Context, queries *dbgen.Queries, clientID uuid.UUID, adminUserID uuid.UUID, email string) (string, error) {
+	token, verificationURL, err := u.newSignupVerificationToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = queries.CreateSignupVerification(ctx, dbgen.CreateSignupVerificationParams{
+		ClientID:    pgtype.UUID{Bytes: clientID, Valid: true},
+		AdminUserID: pgtype.UUID{Bytes: adminUserID, Valid: true},
+		Email:       email,
+		TokenHash:   hashVerificationToken(token),
+		ExpiresAt:   pgtype.Timestamptz{Time: time.Now().Add(u.cfg.SignupVerificationTTL), Valid: true},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create signup verification: %w", err)
+	}
+	return verificationURL, nil
+}
+
+// resendSignupVerification 登録確認待ちのクライアントの確認トークンを再発行して再送（管理者メールアドレスが一致する場合のみ）
+// 管理者ユーザー・クライアントの内容は最初の登録のまま変更しない（パスワードも最初に指定したものを使う）
+func (u *authUsecase) resendSignupVerification(ctx context.Context, client dbgen.Client, email string) (*SignupClientResult, error) {
+	token, verificationURL, err := u.newSignupVerificationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	clientID := uuidFromPGType(client.ClientID)
+	verification, err := u.clientRepo.RenewSignupVerification(ctx, clientID, email, hashVerificationToken(token), time.Now().Add(u.cfg.SignupVerificationTTL))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 別の管理者メールアドレスによる登録（または検証済み）の場合は通常の重複として扱う
+		return nil, fmt.Errorf("%w: %s", ErrSlugAlreadyExists, client.Slug)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to renew signup verification: %w", err)
+	}
+
+	if err := u.signupVerificationSender.SendSignupVerification(ctx, verification.Email, client.Name, verificationURL); err != nil {
+		return nil, fmt.Errorf("failed to send signup verification: %w", err)
+	}
+
+	return &SignupClientResult{
+		ClientID:    clientID,
+		ClientName:  client.Name,
+		AdminUserID: uuidFromPGType(verification.AdminUserID),
+		AdminEmail:  verification.Email,
+		Status:      domain.ClientStatus(client.Status),
+	}, nil
+}
+
+// newSignupVerificationToken 登録確認トークンと送信する確認URLを作成
+func (u *authUsecase) newSignupVerificationToken() (string, string, error) {
+	token, err := generateSecret(32)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate signup verification token: %w", err)
+	}
+	verificationURL, err := buildVerificationURL(u.cfg.SignupVerificationURL, token)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to build signup verification url: %w", err)
+	}
+	return token, verificationURL, nil
+}
+
+// VerifySignup 登録確認トークンを検証し、クライアントと管理者ユーザーを有効化
+func (u *authUsecase) VerifySignup(ctx context.Context, token string) (*VerifySignupResult, error) {
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 準備済みステートメントのキャッシュをクリア（pgbouncerのtransactionモード対策）
+	_, _ = tx.Exec(ctx, "DEALLOCATE ALL")
+
+	queries := dbgen.New(tx)
+
+	// 1. トークンの検証（未検証のトークンのみ、同時実行を防ぐため行ロック）
+	verification, err := queries.GetSignupVerificationByTokenHash(ctx, hashVerificationToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidSignupVerification
+		}
+		return nil, fmt.Errorf("failed to get signup verification: %w", err)
+	}
+	if time.Now().After(verification.ExpiresAt.Time) {
+		return nil, ErrSignupVerificationExpired
+	}
+
+	// 2. クライアントを有効化（登録確認待ち以外のクライアントは有効化しない）
+	activated, err := queries.ActivateClient(ctx, verification.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to activate client: %w", err)
+	}
+	if activated == 0 {
+		return nil, ErrInvalidSignupVerification
+	}
+
+	// 3. トークンを検証済みにする
+	if err := queries.MarkSignupVerified(ctx, verification.ClientID); err != nil {
+		return nil, fmt.Errorf("failed to mark signup verified: %w", err)
+	}
+
+	// 4. Supabase Authで管理者ユーザーのメールアドレスを確認済みにする（失敗した場合はロールバックして再試行可能にする）
+	adminUserID := uuidFromPGType(verification.AdminUserID)
+	if err := u.updateSupabaseUser(ctx, adminUserID, map[string]interface{}{"email_confirm": true}); err != nil {
+		return nil, fmt.Errorf("failed to confirm supabase user: %w", err)
+	}
+
+	// 5. トランザクションコミット
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &VerifySignupResult{
+		ClientID:    uuidFromPGType(verification.ClientID),
+		AdminUserID: adminUserID,
+		AdminEmail:  verification.Email,
+		Status:      domain.ClientStatusActive,
 	}, nil
 }
 
 // createSupabaseUser Supabase Auth Admin APIでユーザーを作成
-func (u *authUsecase) createSupabaseUser(ctx context.Context, email, password, firstName, lastName string) (uuid.UUID, error) {
+// emailConfirmがfalseの場合、メールアドレス未確認のユーザーとして作成する（確認されるまでログイン不可）
+func (u *authUsecase) createSupabaseUser(ctx context.Context, email, password, firstName, lastName string, emailConfirm bool) (uuid.UUID, error) {
 	url := fmt.Sprintf("%s/auth/v1/admin/users", u.cfg.SupabaseURL)
 
 	reqBody := map[string]interface{}{
 		"email":         email,
 		"password":      password,
-		"email_confirm": true,
+		"email_confirm": emailConfirm,
 		"user_metadata": map[string]string{
 			"first_name": firstName,
 			"last_name":  lastName,
@@ -494,6 +825,51 @@ func (u *authUsecase) createSupabaseUser(ctx context.Context, email, password, f
 	return userID, nil
 }
 
+// updateSupabaseUser Supabase Auth Admin APIでユーザーの属性（パスワード・メールアドレス・確認状態等）を更新
+func (u *authUsecase) updateSupabaseUser(ctx context.Context, userID uuid.UUID, attributes map[string]interface{}) error {
+	url := fmt.Sprintf("%s/auth/v1/admin/users/%s", u.cfg.SupabaseURL, userID)
+
+	jsonData, err := json.Marshal(attributes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("apikey", u.cfg.SupabaseServiceRoleKey)
+	req.Header.Set("Authorization", "Bearer "+u.cfg.SupabaseServiceRoleKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("supabase auth error: status %d, body: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// clientUserNotFoundOr pgx.ErrNoRowsをErrClientUserNotFoundに変換（それ以外はラップして返す）
+func clientUserNotFoundOr(err error, clientUserID uuid.UUID) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %s", ErrClientUserNotFound, clientUserID)
+	}
+	return fmt.Errorf("failed to get client user: %w", err)
+}
+
+// requiredFieldError 必須フィールドが未指定の入力エラー
+func requiredFieldError(field string) error {
+	return domain.NewValidationError(domain.ReasonRequiredField, field, field+" is required")
+}
+
 // uuidFromPGType pgtype.UUIDからuuid.UUIDに変換
 func uuidFromPGType(pgUUID pgtype.UUID) uuid.UUID {
 	if !pgUUID.Valid {
@@ -503,39 +879,81 @@ func uuidFromPGType(pgUUID pgtype.UUID) uuid.UUID {
 }
 
 // ListClientUsers クライアントユーザー一覧取得
-func (u *authUsecase) ListClientUsers(ctx context.Context, userCtx *domain.UserContext, limit, offset int32) ([]dbgen.ClientUser, int32, error) {
+func (u *authUsecase) ListClientUsers(ctx context.Context, userCtx *domain.UserContext, filter ClientUserFilter, page PageRequest) (Page[dbgen.ClientUser], error) {
 	// 1. クライアントアクセス権限チェック
 	if err := u.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
-		return nil, 0, err
+		return Page[dbgen.ClientUser]{}, err
 	}
 
 	// 2. 権限チェック: users:READ
 	if err := u.CheckPermission(ctx, userCtx, "users", "READ"); err != nil {
-		return nil, 0, fmt.Errorf("permission denied: %w", err)
+		return Page[dbgen.ClientUser]{}, err
 	}
 
 	// 3. パラメータのバリデーション
-	if limit <= 0 {
-		limit = 50
+	search, err := filter.search()
+	if err != nil {
+		return Page[dbgen.ClientUser]{}, err
+	}
+	size := page.size()
+	scope := clientUserSearchScope(search)
+	cursor, err := page.cursor(scope)
+	if err != nil {
+		return Page[dbgen.ClientUser]{}, err
+	}
+
+	// 4. 絞り込み・並び替えの指定がある場合は検索
+	if !isDefaultClientUserSearch(search) {
+		return u.searchClientUsers(ctx, userCtx.ClientID, search, cursor, size, scope)
 	}
-	if limit > 100 {
-		limit = 100
+
+	// 5. 一覧取得（次のページの有無を判定するため1件多く取得）
+	var users []dbgen.ClientUser
+	if offset := page.offset(); offset > 0 {
+		// 互換性のためのオフセット指定
+		users, err = u.clientUserRepo.List(ctx, userCtx.ClientID, size+1, offset)
+	} else {
+		users, err = u.clientUserRepo.ListPage(ctx, userCtx.ClientID, cursor, size+1)
 	}
-	if offset < 0 {
-		offset = 0
+	if err != nil {
+		return Page[dbgen.ClientUser]{}, fmt.Errorf("failed to list client users: %w", err)
 	}
 
-	// 4. 一覧取得
-	users, err := u.clientUserRepo.List(ctx, userCtx.ClientID, limit, offset)
+	// 6. 総件数取得
+	total, err := u.clientUserRepo.Count(ctx, userCtx.ClientID)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list client users: %w", err)
+		return Page[dbgen.ClientUser]{}, fmt.Errorf("failed to count client users: %w", err)
 	}
 
-	// 5. 総件数取得（簡易実装：実際の実装ではCOUNTクエリを使用）
-	// TODO: 総件数取得のためのクエリを追加
-	total := int32(len(users))
+	return newPage(users, size, total, scope, clientUserCursor), nil
+}
+
+// searchClientUsers クライアントユーザーの検索・絞り込み・並び替え（オフセット指定には対応しない）
+func (u *authUsecase) searchClientUsers(ctx context.Context, clientID uuid.UUID, search repository.ClientUserSearch, cursor *repository.KeysetCursor, size int32, scope string) (Page[dbgen.ClientUser], error) {
+	rows, err := u.clientUserRepo.Search(ctx, clientID, search, cursor, size+1)
+	if err != nil {
+		return Page[dbgen.ClientUser]{}, fmt.Errorf("failed to search client users: %w", err)
+	}
+	total, err := u.clientUserRepo.CountSearch(ctx, clientID, search)
+	if err != nil {
+		return Page[dbgen.ClientUser]{}, fmt.Errorf("failed to count client users: %w", err)
+	}
+
+	found := newPage(rows, size, total, scope, searchClientUserCursor(search.SortField))
+	result := Page[dbgen.ClientUser]{
+		Items:         make([]dbgen.ClientUser, 0, len(found.Items)),
+		TotalCount:    found.TotalCount,
+		NextPageToken: found.NextPageToken,
+	}
+	for _, row := range found.Items {
+		result.Items = append(result.Items, row.ClientUser)
+	}
+	return result, nil
+}
 
-	return users, total, nil
+// clientUserCursor クライアントユーザー一覧のカーソル（created_at, client_user_id）
+func clientUserCursor(user dbgen.ClientUser) repository.KeysetCursor {
+	return repository.KeysetCursor{Time: user.CreatedAt.Time, ID: uuidFromPGType(user.ClientUserID)}
 }
 
 // GetClientUser クライアントユーザー詳細取得
@@ -547,13 +965,13 @@ func (u *authUsecase) GetClientUser(ctx context.Context, userCtx *domain.UserCon
 
 	// 2. 権限チェック: users:READ
 	if err := u.CheckPermission(ctx, userCtx, "users", "READ"); err != nil {
-		return dbgen.ClientUser{}, fmt.Errorf("permission denied: %w", err)
+		return dbgen.ClientUser{}, err
 	}
 
 	// 3. ユーザー取得（クライアント分離チェック）
 	user, err := u.clientUserRepo.GetByID(ctx, userCtx.ClientID, clientUserID)
 	if err != nil {
-		return dbgen.ClientUser{}, fmt.Errorf("failed to get client user: %w", err)
+		return dbgen.ClientUser{}, clientUserNotFoundOr(err, clientUserID)
 	}
 
 	return user, nil
@@ -568,30 +986,47 @@ func (u *authUsecase) CreateClientUser(ctx context.Context, userCtx *domain.User
 
 	// 2. 権限チェック: users:WRITE
 	if err := u.CheckPermission(ctx, userCtx, "users", "WRITE"); err != nil {
-		return dbgen.ClientUser{}, fmt.Errorf("permission denied: %w", err)
+		return dbgen.ClientUser{}, err
 	}
 
+	return u.createClientUser(ctx, userCtx.ClientID, params)
+}
+
+// createClientUser クライアントユーザー作成の業務ルール（アクセス権限チェック済みの呼び出し元から使用）
+// gRPC（CreateClientUser）とSCIMプロビジョニングで同じ検証・作成処理を共有する
+func (u *authUsecase) createClientUser(ctx context.Context, clientID uuid.UUID, params CreateClientUserParams) (dbgen.ClientUser, error) {
 	// 3. リクエストバリデーション
 	if params.Email == "" {
-		return dbgen.ClientUser{}, fmt.Errorf("email is required")
+		return dbgen.ClientUser{}, requiredFieldError("email")
 	}
 	if params.Password == "" {
-		return dbgen.ClientUser{}, fmt.Errorf("password is required")
+		return dbgen.ClientUser{}, requiredFieldError("password")
 	}
 	if params.FirstName == "" {
-		return dbgen.ClientUser{}, fmt.Errorf("first_name is required")
+		return dbgen.ClientUser{}, requiredFieldError("first_name")
 	}
 	if params.LastName == "" {
-		return dbgen.ClientUser{}, fmt.Errorf("last_name is required")
+		return dbgen.ClientUser{}, requiredFieldError("last_name")
+	}
+	// パスワードポリシー（クライアント設定による上書きを含む）
+	if !params.passwordGenerated {
+		if err := u.validatePassword(ctx, clientID, PasswordCandidate{
+			Password:  params.Password,
+			Email:     params.Email,
+			FirstName: params.FirstName,
+			LastName:  params.LastName,
+		}); err != nil {
+			return dbgen.ClientUser{}, withPasswordField(err, "password")
+		}
 	}
 
 	// 4. メールアドレスの重複チェック（クライアント内）
-	if _, err := u.clientUserRepo.GetByEmail(ctx, userCtx.ClientID, params.Email); err == nil {
-		return dbgen.ClientUser{}, fmt.Errorf("email already exists: %s", params.Email)
+	if _, err := u.clientUserRepo.GetByEmail(ctx, clientID, params.Email); err == nil {
+		return dbgen.ClientUser{}, fmt.Errorf("%w: %s", ErrEmailAlreadyExists, params.Email)
 	}
 
 	// 5. Supabase Authでユーザー作成
-	userID, err := u.createSupabaseUser(ctx, params.Email, params.Password, params.FirstName, params.LastName)
+	userID, err := u.createSupabaseUser(ctx, params.Email, params.Password, params.FirstName, params.LastName, true)
 	if err != nil {
 		return dbgen.ClientUser{}, fmt.Errorf("failed to create supabase user: %w", err)
 	}
@@ -623,17 +1058,21 @@ func (u *authUsecase) CreateClientUser(ctx context.Context, userCtx *domain.User
 	if settingsJSON == "" {
 		settingsJSON = "{}"
 	}
+	status := params.Status
+	if status == "" {
+		status = domain.UserStatusActive
+	}
 
 	clientUserParams := dbgen.CreateClientUserParams{
 		ClientUserID: pgtype.UUID{Bytes: userID, Valid: true},
-		ClientID:     pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
 		Email:        params.Email,
 		FirstName:    params.FirstName,
 		LastName:     params.LastName,
 		Department:   department,
 		Position:     position,
 		Settings:     []byte(settingsJSON),
-		Status:       "ACTIVE",
+		Status:       string(status),
 	}
 
 	user, err := queries.CreateClientUser(ctx, clientUserParams)
@@ -643,13 +1082,13 @@ func (u *authUsecase) CreateClientUser(ctx context.Context, userCtx *domain.User
 
 	// 8. デフォルトロール（member）を自動割り当て
 	memberRole, err := queries.GetClientRoleByCode(ctx, dbgen.GetClientRoleByCodeParams{
-		ClientID: pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
 		Code:     "member",
 	})
 	if err == nil {
 		now := time.Now()
 		_, err = queries.CreateClientUserRole(ctx, dbgen.CreateClientUserRoleParams{
-			ClientID:     pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+			ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
 			ClientUserID: pgtype.UUID{Bytes: userID, Valid: true},
 			RoleID:       memberRole.RoleID,
 			AssignedAt:   pgtype.Timestamptz{Time: now, Valid: true},
@@ -676,26 +1115,53 @@ func (u *authUsecase) UpdateClientUser(ctx context.Context, userCtx *domain.User
 
 	// 2. 権限チェック: users:WRITE
 	if err := u.CheckPermission(ctx, userCtx, "users", "WRITE"); err != nil {
-		return dbgen.ClientUser{}, fmt.Errorf("permission denied: %w", err)
+		return dbgen.ClientUser{}, err
 	}
 
-	// 3. 既存ユーザーの存在確認（クライアント分離チェック）
-	existingUser, err := u.clientUserRepo.GetByID(ctx, userCtx.ClientID, clientUserID)
+	// 管理者による更新は同時編集による上書きを防ぐためETagを必須とする
+	if params.ETag == "" {
+		return dbgen.ClientUser{}, ErrETagRequired
+	}
+
+	return u.updateClientUser(ctx, userCtx.ClientID, clientUserID, params)
+}
+
+// updateClientUser クライアントユーザー更新の業務ルール（アクセス権限チェック済みの呼び出し元から使用）
+// 更新マスクを指定する場合は、呼び出し元で許可するパスに限定した上で渡す（ここではUpdateClientUserで更新可能なパスを検証）
+func (u *authUsecase) updateClientUser(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, params UpdateClientUserParams) (dbgen.ClientUser, error) {
+	// 3. 更新マスクの検証（DBアクセス前に不正なパスを拒否）
+	var mask map[string]bool
+	if len(params.UpdateMask) > 0 {
+		var err error
+		if mask, err = validateUpdateMask(params.UpdateMask, clientUserUpdatablePaths); err != nil {
+			return dbgen.ClientUser{}, err
+		}
+		// メールアドレスの重複チェックはマスクに含まれる場合のみ
+		if !mask[updatePathEmail] {
+			params.Email = nil
+		}
+	}
+
+	// 4. 既存ユーザーの存在確認（クライアント分離チェック）
+	existingUser, err := u.clientUserRepo.GetByID(ctx, clientID, clientUserID)
 	if err != nil {
-		return dbgen.ClientUser{}, fmt.Errorf("failed to get client user: %w", err)
+		return dbgen.ClientUser{}, clientUserNotFoundOr(err, clientUserID)
+	}
+	if err := checkETag(params.ETag, existingUser.UpdatedAt); err != nil {
+		return dbgen.ClientUser{}, err
 	}
 
-	// 4. メールアドレス変更時は重複チェック
+	// 5. メールアドレス変更時は重複チェック
 	if params.Email != nil && *params.Email != existingUser.Email {
-		if _, err := u.clientUserRepo.GetByEmail(ctx, userCtx.ClientID, *params.Email); err == nil {
-			return dbgen.ClientUser{}, fmt.Errorf("email already exists: %s", *params.Email)
+		if _, err := u.clientUserRepo.GetByEmail(ctx, clientID, *params.Email); err == nil {
+			return dbgen.ClientUser{}, fmt.Errorf("%w: %s", ErrEmailAlreadyExists, *params.Email)
 		}
 	}
 
-	// 5. 更新パラメータの構築
+	// 6. 更新パラメータの構築
 	updateParams := dbgen.UpdateClientUserParams{
 		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
-		ClientID:     pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
 		Email:        existingUser.Email, // デフォルト値として既存の値を設定
 		FirstName:    existingUser.FirstName,
 		LastName:     existingUser.LastName,
@@ -703,8 +1169,68 @@ func (u *authUsecase) UpdateClientUser(ctx context.Context, userCtx *domain.User
 		Position:     existingUser.Position,
 		Settings:     existingUser.Settings,
 		Status:       existingUser.Status,
+		UpdatedAt:    existingUser.UpdatedAt, // 取得後に他の更新があった場合は更新しない
 	}
 
+	if mask != nil {
+		if err := applyClientUserUpdateMask(&updateParams, mask, params); err != nil {
+			return dbgen.ClientUser{}, err
+		}
+	} else {
+		applyClientUserUpdateParams(&updateParams, params)
+	}
+
+	// 7. データベース更新（停止・無効化する場合は発行済みトークンの失効と同じトランザクションで更新）
+	var user dbgen.ClientUser
+	if deactivatesClientUser(existingUser.Status, updateParams.Status) {
+		user, err = u.updateClientUserAndRevokeTokens(ctx, updateParams)
+	} else {
+		user, err = u.clientUserRepo.Update(ctx, clientID, updateParams)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dbgen.ClientUser{}, ErrConcurrentModification
+		}
+		return dbgen.ClientUser{}, fmt.Errorf("failed to update client user: %w", err)
+	}
+
+	return user, nil
+}
+
+// deactivatesClientUser 有効なユーザーを停止・無効化する更新か（発行済みトークンを失効する）
+func deactivatesClientUser(currentStatus, newStatus string) bool {
+	return currentStatus == string(domain.UserStatusActive) && newStatus != string(domain.UserStatusActive)
+}
+
+// updateClientUserAndRevokeTokens クライアントユーザーを更新し、同じトランザクションで発行済みトークンを失効
+// 失効の記録に失敗した場合は更新もロールバックし、停止したユーザーのトークンが有効なまま残らないようにする
+func (u *authUsecase) updateClientUserAndRevokeTokens(ctx context.Context, params dbgen.UpdateClientUserParams) (dbgen.ClientUser, error) {
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 準備済みステートメントのキャッシュをクリア（pgbouncerのtransactionモード対策）
+	_, _ = tx.Exec(ctx, "DEALLOCATE ALL")
+
+	queries := dbgen.New(tx)
+	user, err := queries.UpdateClientUser(ctx, params)
+	if err != nil {
+		return dbgen.ClientUser{}, err
+	}
+	if err := queries.BumpTokenRevocationWatermark(ctx, newWatermarkParams(revocationSubjectUser, uuidFromPGType(params.ClientUserID), revocationReasonUserSuspended)); err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return user, nil
+}
+
+// applyClientUserUpdateParams 値が指定されたフィールドを既存の値に適用（更新マスク未指定時、department/positionは空文字で削除）
+func applyClientUserUpdateParams(updateParams *dbgen.UpdateClientUserParams, params UpdateClientUserParams) {
 	if params.Email != nil {
 		updateParams.Email = *params.Email
 	}
@@ -734,18 +1260,10 @@ func (u *authUsecase) UpdateClientUser(ctx context.Context, userCtx *domain.User
 	if params.Status != nil {
 		updateParams.Status = *params.Status
 	}
-
-	// 6. データベース更新
-	user, err := u.clientUserRepo.Update(ctx, userCtx.ClientID, updateParams)
-	if err != nil {
-		return dbgen.ClientUser{}, fmt.Errorf("failed to update client user: %w", err)
-	}
-
-	return user, nil
 }
 
 // DeleteClientUser クライアントユーザー削除（論理削除）
-func (u *authUsecase) DeleteClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) error {
+func (u *authUsecase) DeleteClientUser(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID, etag string) error {
 	// 1. クライアントアクセス権限チェック
 	if err := u.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
 		return err
@@ -753,18 +1271,36 @@ func (u *authUsecase) DeleteClientUser(ctx context.Context, userCtx *domain.User
 
 	// 2. 権限チェック: users:DELETE
 	if err := u.CheckPermission(ctx, userCtx, "users", "DELETE"); err != nil {
-		return fmt.Errorf("permission denied: %w", err)
+		return err
 	}
 
+	if etag == "" {
+		return ErrETagRequired
+	}
+
+	return u.deleteClientUser(ctx, userCtx.ClientID, clientUserID, userCtx.UserID, etag)
+}
+
+// deleteClientUser クライアントユーザー論理削除の業務ルール（アクセス権限チェック済みの呼び出し元から使用）
+func (u *authUsecase) deleteClientUser(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, deletedBy uuid.UUID, etag string) error {
 	// 3. 既存ユーザーの存在確認（クライアント分離チェック）
-	if _, err := u.clientUserRepo.GetByID(ctx, userCtx.ClientID, clientUserID); err != nil {
-		return fmt.Errorf("failed to get client user: %w", err)
+	existingUser, err := u.clientUserRepo.GetByID(ctx, clientID, clientUserID)
+	if err != nil {
+		return clientUserNotFoundOr(err, clientUserID)
+	}
+	if err := checkETag(etag, existingUser.UpdatedAt); err != nil {
+		return err
 	}
 
-	// 4. 論理削除
-	if err := u.clientUserRepo.Delete(ctx, userCtx.ClientID, clientUserID, userCtx.UserID); err != nil {
+	// 4. 論理削除（取得後に他の更新があった場合は削除しない）
+	deleted, err := u.clientUserRepo.Delete(ctx, clientID, clientUserID, deletedBy, existingUser.UpdatedAt.Time)
+	if err != nil {
 		return fmt.Errorf("failed to delete client user: %w", err)
 	}
+	if deleted == 0 {
+		return ErrConcurrentModification
+	}
 
-	return nil
+	// 5. 発行済みトークンを失効
+	return u.revokeUserTokens(ctx, clientUserID, revocationReasonUserDeleted)
 }
@@ -3,13 +3,16 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	"contract-pro-suite/internal/shared/config"
 	"contract-pro-suite/internal/shared/db"
 	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
 	dbgen "contract-pro-suite/sqlc"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -36,6 +39,14 @@ func (m *MockOperatorRepository) GetByEmail(ctx context.Context, email string) (
 	return args.Get(0).(dbgen.Operator), args.Error(1)
 }
 
+func (m *MockOperatorRepository) GetProfile(ctx context.Context, operatorID uuid.UUID) (dbgen.GetOperatorProfileRow, error) {
+	args := m.Called(ctx, operatorID)
+	if args.Get(0) == nil {
+		return dbgen.GetOperatorProfileRow{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.GetOperatorProfileRow), args.Error(1)
+}
+
 func (m *MockOperatorRepository) List(ctx context.Context, limit, offset int32) ([]dbgen.Operator, error) {
 	args := m.Called(ctx, limit, offset)
 	if args.Get(0) == nil {
@@ -86,6 +97,14 @@ func (m *MockClientUserRepository) GetByUserIDOnly(ctx context.Context, clientUs
 	return args.Get(0).(dbgen.ClientUser), args.Error(1)
 }
 
+func (m *MockClientUserRepository) GetProfile(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) (dbgen.GetClientUserProfileRow, error) {
+	args := m.Called(ctx, clientID, clientUserID)
+	if args.Get(0) == nil {
+		return dbgen.GetClientUserProfileRow{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.GetClientUserProfileRow), args.Error(1)
+}
+
 func (m *MockClientUserRepository) GetByEmail(ctx context.Context, clientID uuid.UUID, email string) (dbgen.ClientUser, error) {
 	args := m.Called(ctx, clientID, email)
 	if args.Get(0) == nil {
@@ -102,6 +121,48 @@ func (m *MockClientUserRepository) List(ctx context.Context, clientID uuid.UUID,
 	return args.Get(0).([]dbgen.ClientUser), args.Error(1)
 }
 
+func (m *MockClientUserRepository) ListPage(ctx context.Context, clientID uuid.UUID, cursor *repository.KeysetCursor, limit int32) ([]dbgen.ClientUser, error) {
+	args := m.Called(ctx, clientID, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockClientUserRepository) ListAll(ctx context.Context, clientID uuid.UUID) ([]dbgen.ClientUser, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockClientUserRepository) Search(ctx context.Context, clientID uuid.UUID, search repository.ClientUserSearch, cursor *repository.KeysetCursor, limit int32) ([]repository.ClientUserSearchRow, error) {
+	args := m.Called(ctx, clientID, search, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.ClientUserSearchRow), args.Error(1)
+}
+
+func (m *MockClientUserRepository) SearchByOffset(ctx context.Context, clientID uuid.UUID, search repository.ClientUserSearch, limit, offset int32) ([]dbgen.ClientUser, error) {
+	args := m.Called(ctx, clientID, search, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientUser), args.Error(1)
+}
+
+func (m *MockClientUserRepository) Count(ctx context.Context, clientID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, clientID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockClientUserRepository) CountSearch(ctx context.Context, clientID uuid.UUID, search repository.ClientUserSearch) (int64, error) {
+	args := m.Called(ctx, clientID, search)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockClientUserRepository) Create(ctx context.Context, params dbgen.CreateClientUserParams) (dbgen.ClientUser, error) {
 	args := m.Called(ctx, params)
 	if args.Get(0) == nil {
@@ -118,11 +179,29 @@ func (m *MockClientUserRepository) Update(ctx context.Context, clientID uuid.UUI
 	return args.Get(0).(dbgen.ClientUser), args.Error(1)
 }
 
-func (m *MockClientUserRepository) Delete(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, deletedBy uuid.UUID) error {
-	args := m.Called(ctx, clientID, clientUserID, deletedBy)
+func (m *MockClientUserRepository) UpdatePasswordChangedAt(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) error {
+	args := m.Called(ctx, clientID, clientUserID)
+	return args.Error(0)
+}
+
+func (m *MockClientUserRepository) Delete(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, deletedBy uuid.UUID, updatedAt time.Time) (int64, error) {
+	args := m.Called(ctx, clientID, clientUserID, deletedBy, updatedAt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockClientUserRepository) TouchActivity(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) error {
+	args := m.Called(ctx, clientID, clientUserID)
 	return args.Error(0)
 }
 
+func (m *MockClientUserRepository) ListActivitiesByUserIDs(ctx context.Context, clientID uuid.UUID, clientUserIDs []uuid.UUID) ([]dbgen.ClientUserActivity, error) {
+	args := m.Called(ctx, clientID, clientUserIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientUserActivity), args.Error(1)
+}
+
 // MockClientRepository モッククライアントリポジトリ
 type MockClientRepository struct {
 	mock.Mock
@@ -176,9 +255,14 @@ func (m *MockClientRepository) Update(ctx context.Context, params dbgen.UpdateCl
 	return args.Get(0).(dbgen.Client), args.Error(1)
 }
 
-func (m *MockClientRepository) Delete(ctx context.Context, clientID uuid.UUID, deletedBy uuid.UUID) error {
-	args := m.Called(ctx, clientID, deletedBy)
-	return args.Error(0)
+func (m *MockClientRepository) Delete(ctx context.Context, clientID uuid.UUID, deletedBy uuid.UUID, updatedAt time.Time) (int64, error) {
+	args := m.Called(ctx, clientID, deletedBy, updatedAt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockClientRepository) RenewSignupVerification(ctx context.Context, clientID uuid.UUID, email string, tokenHash string, expiresAt time.Time) (dbgen.ClientSignupVerification, error) {
+	args := m.Called(ctx, clientID, email, tokenHash, expiresAt)
+	return args.Get(0).(dbgen.ClientSignupVerification), args.Error(1)
 }
 
 // MockOperatorAssignmentRepository モックオペレーター割当リポジトリ
@@ -365,6 +449,30 @@ func (m *MockClientUserRoleRepository) GetByRoleID(ctx context.Context, clientID
 	return args.Get(0).([]dbgen.ClientUserRole), args.Error(1)
 }
 
+func (m *MockClientUserRoleRepository) ListActiveByClient(ctx context.Context, clientID uuid.UUID) ([]dbgen.ClientUserRole, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientUserRole), args.Error(1)
+}
+
+func (m *MockClientUserRoleRepository) ListActiveByUserIDs(ctx context.Context, clientID uuid.UUID, clientUserIDs []uuid.UUID) ([]dbgen.ListActiveClientUserRolesByUserIDsRow, error) {
+	args := m.Called(ctx, clientID, clientUserIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ListActiveClientUserRolesByUserIDsRow), args.Error(1)
+}
+
+func (m *MockClientUserRoleRepository) Assign(ctx context.Context, clientID, clientUserID, roleID uuid.UUID) (dbgen.ClientUserRole, error) {
+	args := m.Called(ctx, clientID, clientUserID, roleID)
+	if args.Get(0) == nil {
+		return dbgen.ClientUserRole{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUserRole), args.Error(1)
+}
+
 func (m *MockClientUserRoleRepository) Create(ctx context.Context, params dbgen.CreateClientUserRoleParams) (dbgen.ClientUserRole, error) {
 	args := m.Called(ctx, params)
 	if args.Get(0) == nil {
@@ -383,6 +491,82 @@ func (m *MockClientUserRoleRepository) Delete(ctx context.Context, clientID, cli
 	return args.Error(0)
 }
 
+// MockIdentityProviderRepository モック外部IdP設定リポジトリ
+type MockIdentityProviderRepository struct {
+	mock.Mock
+}
+
+func (m *MockIdentityProviderRepository) GetByIssuer(ctx context.Context, issuer string) (dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, issuer)
+	if args.Get(0) == nil {
+		return dbgen.ClientIdentityProvider{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) ListByClientID(ctx context.Context, clientID uuid.UUID) ([]dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) Create(ctx context.Context, params dbgen.CreateIdentityProviderParams) (dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientIdentityProvider{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) Get(ctx context.Context, clientID uuid.UUID, providerID uuid.UUID) (dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, clientID, providerID)
+	if args.Get(0) == nil {
+		return dbgen.ClientIdentityProvider{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) Update(ctx context.Context, params dbgen.UpdateIdentityProviderParams) (dbgen.ClientIdentityProvider, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientIdentityProvider{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIdentityProvider), args.Error(1)
+}
+
+func (m *MockIdentityProviderRepository) Delete(ctx context.Context, clientID uuid.UUID, providerID uuid.UUID, deletedBy uuid.UUID) (int64, error) {
+	args := m.Called(ctx, clientID, providerID, deletedBy)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockClientUserIdentityRepository モック外部ID紐付けリポジトリ
+type MockClientUserIdentityRepository struct {
+	mock.Mock
+}
+
+func (m *MockClientUserIdentityRepository) Get(ctx context.Context, issuer, subject string) (dbgen.ClientUserIdentity, error) {
+	args := m.Called(ctx, issuer, subject)
+	if args.Get(0) == nil {
+		return dbgen.ClientUserIdentity{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUserIdentity), args.Error(1)
+}
+
+func (m *MockClientUserIdentityRepository) Create(ctx context.Context, params dbgen.CreateClientUserIdentityParams) (dbgen.ClientUserIdentity, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientUserIdentity{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientUserIdentity), args.Error(1)
+}
+
+func (m *MockClientUserIdentityRepository) Touch(ctx context.Context, issuer, subject string) error {
+	args := m.Called(ctx, issuer, subject)
+	return args.Error(0)
+}
+
 func TestGetUserContext(t *testing.T) {
 	mockOperatorRepo := new(MockOperatorRepository)
 	mockClientUserRepo := new(MockClientUserRepository)
@@ -409,6 +593,14 @@ func TestGetUserContext(t *testing.T) {
 		mockClientRoleRepo,
 		mockClientRolePermissionRepo,
 		mockClientUserRoleRepo,
+		nil, // identityProviderRepo
+		nil, // clientUserIdentityRepo
+		nil, // serviceAccountRepo
+		nil, // tokenRevocationRepo
+		nil, // challengeVerifier
+		nil, // signupVerificationSender
+		nil, // breachedPasswordChecker
+		nil, // emailChangeSender
 		cfg,
 		database,
 	)
@@ -507,6 +699,56 @@ func TestGetUserContext(t *testing.T) {
 	}
 }
 
+func TestRecordActivity(t *testing.T) {
+	clientID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name      string
+		userCtx   *domain.UserContext
+		setupMock func(*MockClientUserRepository)
+		wantErr   bool
+	}{
+		{
+			name:    "クライアントユーザーは記録する",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			setupMock: func(m *MockClientUserRepository) {
+				m.On("TouchActivity", mock.Anything, clientID, userID).Return(nil)
+			},
+		},
+		{
+			name:    "記録の失敗はエラーを返す",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			setupMock: func(m *MockClientUserRepository) {
+				m.On("TouchActivity", mock.Anything, clientID, userID).Return(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "オペレーターは記録しない",
+			userCtx:   &domain.UserContext{UserID: userID, UserType: domain.UserTypeOperator, ClientID: clientID},
+			setupMock: func(m *MockClientUserRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClientUserRepo := new(MockClientUserRepository)
+			tt.setupMock(mockClientUserRepo)
+			usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+
+			err := usecase.RecordActivity(context.Background(), tt.userCtx)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, assert.AnError)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockClientUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestValidateClientAccess(t *testing.T) {
 	mockOperatorRepo := new(MockOperatorRepository)
 	mockClientUserRepo := new(MockClientUserRepository)
@@ -533,6 +775,14 @@ func TestValidateClientAccess(t *testing.T) {
 		mockClientRoleRepo,
 		mockClientRolePermissionRepo,
 		mockClientUserRoleRepo,
+		nil, // identityProviderRepo
+		nil, // clientUserIdentityRepo
+		nil, // serviceAccountRepo
+		nil, // tokenRevocationRepo
+		nil, // challengeVerifier
+		nil, // signupVerificationSender
+		nil, // breachedPasswordChecker
+		nil, // emailChangeSender
 		cfg,
 		database,
 	)
@@ -652,6 +902,14 @@ func TestCheckPermission(t *testing.T) {
 		mockClientRoleRepo,
 		mockClientRolePermissionRepo,
 		mockClientUserRoleRepo,
+		nil, // identityProviderRepo
+		nil, // clientUserIdentityRepo
+		nil, // serviceAccountRepo
+		nil, // tokenRevocationRepo
+		nil, // challengeVerifier
+		nil, // signupVerificationSender
+		nil, // breachedPasswordChecker
+		nil, // emailChangeSender
 		cfg,
 		database,
 	)
@@ -868,6 +1126,14 @@ func TestListClientUsers(t *testing.T) {
 		mockClientRoleRepo,
 		mockClientRolePermissionRepo,
 		mockClientUserRoleRepo,
+		nil, // identityProviderRepo
+		nil, // clientUserIdentityRepo
+		nil, // serviceAccountRepo
+		nil, // tokenRevocationRepo
+		nil, // challengeVerifier
+		nil, // signupVerificationSender
+		nil, // breachedPasswordChecker
+		nil, // emailChangeSender
 		nil, // config
 		nil, // database
 	)
@@ -880,35 +1146,122 @@ func TestListClientUsers(t *testing.T) {
 		ClientID: clientID,
 	}
 
+	roleID := uuid.New()
+	mockClientUserRoleRepo.On("GetByUserID", mock.Anything, clientID, userCtx.UserID).Return([]dbgen.ClientUserRole{
+		{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}},
+	}, nil)
+	mockClientRolePermissionRepo.On("GetByRoleID", mock.Anything, roleID).Return([]dbgen.ClientRolePermission{
+		{Feature: "users", Action: "READ", Granted: true},
+	}, nil)
+
+	newUser := func(email string, createdAt time.Time) dbgen.ClientUser {
+		return dbgen.ClientUser{
+			ClientUserID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			Email:        email,
+			CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+		}
+	}
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	user1 := newUser("user1@example.com", baseTime.Add(3*time.Minute))
+	user2 := newUser("user2@example.com", baseTime.Add(2*time.Minute))
+	user3 := newUser("user3@example.com", baseTime.Add(1*time.Minute))
+	cursor := repository.KeysetCursor{Time: user2.CreatedAt.Time, ID: uuidFromPGType(user2.ClientUserID)}
+
 	tests := []struct {
-		name      string
-		limit     int32
-		offset    int32
-		setupMock func()
-		wantErr   bool
-		wantCount int
+		name          string
+		filter        ClientUserFilter
+		page          PageRequest
+		setupMock     func()
+		wantErr       error
+		wantCount     int
+		wantTotal     int64
+		wantNextToken bool
 	}{
 		{
-			name:   "正常系: 一覧取得成功",
-			limit:  10,
-			offset: 0,
+			name: "正常系: 一覧取得成功",
+			page: PageRequest{PageSize: 10},
 			setupMock: func() {
-				roleID := uuid.New()
-				// ValidateClientAccessのモック（CheckPermission内で呼ばれる）
-				mockClientUserRoleRepo.On("GetByUserID", mock.Anything, clientID, userCtx.UserID).Return([]dbgen.ClientUserRole{
-					{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}},
-				}, nil)
-				mockClientRolePermissionRepo.On("GetByRoleID", mock.Anything, roleID).Return([]dbgen.ClientRolePermission{
-					{Feature: "users", Action: "READ", Granted: true},
-				}, nil)
-				// Listのモック
-				mockClientUserRepo.On("List", mock.Anything, clientID, int32(10), int32(0)).Return([]dbgen.ClientUser{
-					{Email: "user1@example.com"},
-					{Email: "user2@example.com"},
-				}, nil)
+				// 次のページの有無を判定するため1件多く取得
+				mockClientUserRepo.On("ListPage", mock.Anything, clientID, (*repository.KeysetCursor)(nil), int32(11)).Return([]dbgen.ClientUser{user1, user2}, nil)
+				mockClientUserRepo.On("Count", mock.Anything, clientID).Return(int64(2), nil)
 			},
-			wantErr:   false,
 			wantCount: 2,
+			wantTotal: 2,
+		},
+		{
+			name: "正常系: 総件数は取得件数ではなくCOUNTの結果",
+			page: PageRequest{PageSize: 2},
+			setupMock: func() {
+				mockClientUserRepo.On("ListPage", mock.Anything, clientID, (*repository.KeysetCursor)(nil), int32(3)).Return([]dbgen.ClientUser{user1, user2, user3}, nil)
+				mockClientUserRepo.On("Count", mock.Anything, clientID).Return(int64(25), nil)
+			},
+			wantCount:     2,
+			wantTotal:     25,
+			wantNextToken: true,
+		},
+		{
+			name: "正常系: ページトークン指定",
+			page: PageRequest{PageSize: 2, PageToken: encodePageToken(cursor, "")},
+			setupMock: func() {
+				mockClientUserRepo.On("ListPage", mock.Anything, clientID, mock.MatchedBy(func(c *repository.KeysetCursor) bool {
+					return c != nil && c.ID == cursor.ID && c.Time.Equal(cursor.Time)
+				}), int32(3)).Return([]dbgen.ClientUser{user3}, nil)
+				mockClientUserRepo.On("Count", mock.Anything, clientID).Return(int64(3), nil)
+			},
+			wantCount: 1,
+			wantTotal: 3,
+		},
+		{
+			name: "正常系: オフセット指定（互換性）",
+			page: PageRequest{PageSize: 2, Offset: 2},
+			setupMock: func() {
+				mockClientUserRepo.On("List", mock.Anything, clientID, int32(3), int32(2)).Return([]dbgen.ClientUser{user3}, nil)
+				mockClientUserRepo.On("Count", mock.Anything, clientID).Return(int64(3), nil)
+			},
+			wantCount: 1,
+			wantTotal: 3,
+		},
+		{
+			name:   "正常系: 検索・絞り込み・並び替え",
+			filter: ClientUserFilter{Query: " yama_da ", Status: "active", RoleCode: "ADMIN", OrderBy: "name"},
+			page:   PageRequest{PageSize: 2},
+			setupMock: func() {
+				// LIKEのワイルドカードはエスケープされる
+				search := repository.ClientUserSearch{
+					QueryPattern: `%yama\_da%`,
+					Status:       "ACTIVE",
+					RoleCode:     "ADMIN",
+					SortField:    repository.ClientUserSortByName,
+				}
+				mockClientUserRepo.On("Search", mock.Anything, clientID, search, (*repository.KeysetCursor)(nil), int32(3)).Return([]repository.ClientUserSearchRow{
+					{ClientUser: user1}, {ClientUser: user2}, {ClientUser: user3},
+				}, nil)
+				mockClientUserRepo.On("CountSearch", mock.Anything, clientID, search).Return(int64(5), nil)
+			},
+			wantCount:     2,
+			wantTotal:     5,
+			wantNextToken: true,
+		},
+		{
+			name:    "異常系: 不正なページトークン",
+			page:    PageRequest{PageToken: "not-a-token"},
+			wantErr: ErrInvalidPageToken,
+		},
+		{
+			name:    "異常系: 検索条件が異なるページトークン",
+			filter:  ClientUserFilter{OrderBy: "name desc"},
+			page:    PageRequest{PageToken: encodePageToken(cursor, "")},
+			wantErr: ErrInvalidPageToken,
+		},
+		{
+			name:    "異常系: 不正な並び順",
+			filter:  ClientUserFilter{OrderBy: "email"},
+			wantErr: ErrInvalidOrderBy,
+		},
+		{
+			name:    "異常系: 不正なステータス",
+			filter:  ClientUserFilter{Status: "DELETED"},
+			wantErr: ErrInvalidStatusFilter,
 		},
 	}
 
@@ -920,15 +1273,133 @@ func TestListClientUsers(t *testing.T) {
 				tt.setupMock()
 			}
 			ctx := context.Background()
-			users, total, err := usecase.ListClientUsers(ctx, userCtx, tt.limit, tt.offset)
+			page, err := usecase.ListClientUsers(ctx, userCtx, tt.filter, tt.page)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCount, len(page.Items))
+				assert.Equal(t, tt.wantTotal, page.TotalCount)
+				if tt.wantNextToken {
+					// 次のページのトークンは最後の行を指す（検索条件と同じスコープでのみ有効）
+					search, _ := tt.filter.search()
+					next, err := decodePageToken(page.NextPageToken, clientUserSearchScope(search))
+					assert.NoError(t, err)
+					assert.Equal(t, uuidFromPGType(page.Items[len(page.Items)-1].ClientUserID), next.ID)
+				} else {
+					assert.Empty(t, page.NextPageToken)
+				}
+			}
+			mockClientUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetUserContextByExternalIdentity(t *testing.T) {
+	const issuer = "https://idp.example.com"
+	testClientID := uuid.New()
+	testUserID := uuid.New()
+
+	provider := dbgen.ClientIdentityProvider{
+		ProviderID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		ClientID:   pgtype.UUID{Bytes: testClientID, Valid: true},
+		Issuer:     issuer,
+		Status:     "ACTIVE",
+	}
+	clientUser := dbgen.ClientUser{
+		ClientUserID: pgtype.UUID{Bytes: testUserID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: testClientID, Valid: true},
+		Email:        "sso@example.com",
+		Status:       "ACTIVE",
+	}
+	identity := domain.ExternalIdentity{
+		Issuer:  issuer,
+		Subject: "external-subject",
+		Email:   "sso@example.com",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*MockIdentityProviderRepository, *MockClientUserIdentityRepository, *MockClientUserRepository)
+		wantErr   bool
+	}{
+		{
+			name: "linked identity",
+			setupMock: func(idpRepo *MockIdentityProviderRepository, identityRepo *MockClientUserIdentityRepository, clientUserRepo *MockClientUserRepository) {
+				idpRepo.On("GetByIssuer", mock.Anything, issuer).Return(provider, nil)
+				identityRepo.On("Get", mock.Anything, issuer, identity.Subject).Return(dbgen.ClientUserIdentity{
+					Issuer:       issuer,
+					Subject:      identity.Subject,
+					ClientID:     pgtype.UUID{Bytes: testClientID, Valid: true},
+					ClientUserID: pgtype.UUID{Bytes: testUserID, Valid: true},
+				}, nil)
+				clientUserRepo.On("GetByID", mock.Anything, testClientID, testUserID).Return(clientUser, nil)
+				identityRepo.On("Touch", mock.Anything, issuer, identity.Subject).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "inactive provider",
+			setupMock: func(idpRepo *MockIdentityProviderRepository, identityRepo *MockClientUserIdentityRepository, clientUserRepo *MockClientUserRepository) {
+				inactive := provider
+				inactive.Status = "INACTIVE"
+				idpRepo.On("GetByIssuer", mock.Anything, issuer).Return(inactive, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "not linked and JIT provisioning disabled",
+			setupMock: func(idpRepo *MockIdentityProviderRepository, identityRepo *MockClientUserIdentityRepository, clientUserRepo *MockClientUserRepository) {
+				idpRepo.On("GetByIssuer", mock.Anything, issuer).Return(provider, nil)
+				identityRepo.On("Get", mock.Anything, issuer, identity.Subject).Return(dbgen.ClientUserIdentity{}, pgx.ErrNoRows)
+			},
+			wantErr: true,
+		},
+	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIdentityProviderRepo := new(MockIdentityProviderRepository)
+			mockClientUserIdentityRepo := new(MockClientUserIdentityRepository)
+			mockClientUserRepo := new(MockClientUserRepository)
+			tt.setupMock(mockIdentityProviderRepo, mockClientUserIdentityRepo, mockClientUserRepo)
+
+			usecase := NewAuthUsecase(
+				new(MockOperatorRepository),
+				mockClientUserRepo,
+				new(MockClientRepository),
+				new(MockOperatorAssignmentRepository),
+				new(MockClientRoleRepository),
+				new(MockClientRolePermissionRepository),
+				new(MockClientUserRoleRepository),
+				mockIdentityProviderRepo,
+				mockClientUserIdentityRepo,
+				nil, // serviceAccountRepo
+				nil, // tokenRevocationRepo
+				nil, // challengeVerifier
+				nil, // signupVerificationSender
+				nil, // breachedPasswordChecker
+				nil, // emailChangeSender
+				nil, // config
+				nil, // database（JITプロビジョニングを行わないケースのみ）
+			)
+
+			userCtx, err := usecase.GetUserContextByExternalIdentity(context.Background(), identity)
 			if tt.wantErr {
 				assert.Error(t, err)
+				assert.Nil(t, userCtx)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, len(users), tt.wantCount)
-				assert.Equal(t, int32(len(users)), total)
+				assert.NotNil(t, userCtx)
+				assert.Equal(t, testUserID, userCtx.UserID)
+				assert.Equal(t, testClientID, userCtx.ClientID)
+				assert.Equal(t, domain.UserTypeClientUser, userCtx.UserType)
 			}
+
+			mockIdentityProviderRepo.AssertExpectations(t)
+			mockClientUserIdentityRepo.AssertExpectations(t)
+			mockClientUserRepo.AssertExpectations(t)
 		})
 	}
 }
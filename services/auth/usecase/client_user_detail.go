@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+)
+
+// ClientUserDetail 有効なロール・最終アクティビティ日時を含むクライアントユーザー（v2 API用）
+type ClientUserDetail struct {
+	User         dbgen.ClientUser
+	Roles        []dbgen.ListActiveClientUserRolesByUserIDsRow // 有効なロール（コード順）
+	LastActiveAt pgtype.Timestamptz                            // 認証済みリクエストの最終日時（記録がない場合はValid=false）
+}
+
+// GetClientUserDetail クライアントユーザー詳細取得（ロール・最終アクティビティ日時を含む、権限: users:READ）
+func (u *authUsecase) GetClientUserDetail(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) (ClientUserDetail, error) {
+	user, err := u.GetClientUser(ctx, userCtx, clientUserID)
+	if err != nil {
+		return ClientUserDetail{}, err
+	}
+	details, err := u.clientUserDetails(ctx, userCtx.ClientID, []dbgen.ClientUser{user})
+	if err != nil {
+		return ClientUserDetail{}, err
+	}
+	return details[0], nil
+}
+
+// ListClientUserDetails クライアントユーザー一覧取得（ロール・最終アクティビティ日時を含む、検索条件・ページネーションはListClientUsersと同じ）
+func (u *authUsecase) ListClientUserDetails(ctx context.Context, userCtx *domain.UserContext, filter ClientUserFilter, page PageRequest) (Page[ClientUserDetail], error) {
+	users, err := u.ListClientUsers(ctx, userCtx, filter, page)
+	if err != nil {
+		return Page[ClientUserDetail]{}, err
+	}
+	details, err := u.clientUserDetails(ctx, userCtx.ClientID, users.Items)
+	if err != nil {
+		return Page[ClientUserDetail]{}, err
+	}
+	return Page[ClientUserDetail]{
+		Items:         details,
+		TotalCount:    users.TotalCount,
+		NextPageToken: users.NextPageToken,
+	}, nil
+}
+
+// clientUserDetails ユーザーごとのロール・最終アクティビティ日時をまとめて取得（ユーザー数によらずクエリは2回）
+func (u *authUsecase) clientUserDetails(ctx context.Context, clientID uuid.UUID, users []dbgen.ClientUser) ([]ClientUserDetail, error) {
+	details := make([]ClientUserDetail, len(users))
+	if len(users) == 0 {
+		return details, nil
+	}
+	userIDs := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		userIDs[i] = uuidFromPGType(user.ClientUserID)
+	}
+
+	roles, err := u.activeRolesByUser(ctx, clientID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	activities, err := u.clientUserRepo.ListActivitiesByUserIDs(ctx, clientID, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client user activities: %w", err)
+	}
+	lastActiveAt := make(map[uuid.UUID]pgtype.Timestamptz, len(activities))
+	for _, activity := range activities {
+		lastActiveAt[uuidFromPGType(activity.ClientUserID)] = activity.LastActiveAt
+	}
+
+	for i, user := range users {
+		details[i] = ClientUserDetail{
+			User:         user,
+			Roles:        roles[userIDs[i]],
+			LastActiveAt: lastActiveAt[userIDs[i]],
+		}
+	}
+	return details, nil
+}
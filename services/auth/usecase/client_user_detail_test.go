@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+)
+
+func TestClientUserDetails(t *testing.T) {
+	clientID := uuid.New()
+	userCtx := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: clientID}
+
+	newUsecase := func() (*authUsecase, *MockClientUserRepository, *MockClientUserRoleRepository) {
+		clientUserRepo := new(MockClientUserRepository)
+		clientUserRoleRepo := new(MockClientUserRoleRepository)
+		rolePermissionRepo := new(MockClientRolePermissionRepository)
+		roleID := uuid.New()
+		clientUserRoleRepo.On("GetByUserID", mock.Anything, clientID, userCtx.UserID).Return([]dbgen.ClientUserRole{
+			{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}},
+		}, nil)
+		rolePermissionRepo.On("GetByRoleID", mock.Anything, roleID).Return([]dbgen.ClientRolePermission{
+			{Feature: "users", Action: "READ", Granted: true},
+		}, nil)
+		return &authUsecase{
+			clientUserRepo:           clientUserRepo,
+			clientUserRoleRepo:       clientUserRoleRepo,
+			clientRolePermissionRepo: rolePermissionRepo,
+		}, clientUserRepo, clientUserRoleRepo
+	}
+	newUser := func(i int) dbgen.ClientUser {
+		return dbgen.ClientUser{
+			ClientUserID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+			Status:       "ACTIVE",
+			CreatedAt:    pgtype.Timestamptz{Time: time.Date(2026, 1, 1, 0, 0, i, 0, time.UTC), Valid: true},
+		}
+	}
+
+	t.Run("一覧のユーザーごとにロールと最終アクティビティ日時をまとめて取得する", func(t *testing.T) {
+		usecase, clientUserRepo, clientUserRoleRepo := newUsecase()
+		users := []dbgen.ClientUser{newUser(1), newUser(0)}
+		userIDs := []uuid.UUID{uuidFromPGType(users[0].ClientUserID), uuidFromPGType(users[1].ClientUserID)}
+		lastActiveAt := pgtype.Timestamptz{Time: time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC), Valid: true}
+
+		clientUserRepo.On("ListPage", mock.Anything, clientID, (*repository.KeysetCursor)(nil), DefaultPageSize+1).Return(users, nil)
+		clientUserRepo.On("Count", mock.Anything, clientID).Return(int64(2), nil)
+		clientUserRoleRepo.On("ListActiveByUserIDs", mock.Anything, clientID, userIDs).Return([]dbgen.ListActiveClientUserRolesByUserIDsRow{
+			{ClientUserID: users[1].ClientUserID, Code: "admin", Name: "管理者"},
+			{ClientUserID: users[1].ClientUserID, Code: "member", Name: "メンバー"},
+		}, nil)
+		clientUserRepo.On("ListActivitiesByUserIDs", mock.Anything, clientID, userIDs).Return([]dbgen.ClientUserActivity{
+			{ClientUserID: users[0].ClientUserID, LastActiveAt: lastActiveAt},
+		}, nil)
+
+		page, err := usecase.ListClientUserDetails(context.Background(), userCtx, ClientUserFilter{}, PageRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), page.TotalCount)
+		if assert.Len(t, page.Items, 2) {
+			assert.Equal(t, users[0], page.Items[0].User)
+			assert.Empty(t, page.Items[0].Roles)
+			assert.Equal(t, lastActiveAt, page.Items[0].LastActiveAt)
+			assert.Len(t, page.Items[1].Roles, 2)
+			assert.False(t, page.Items[1].LastActiveAt.Valid)
+		}
+		clientUserRoleRepo.AssertNumberOfCalls(t, "ListActiveByUserIDs", 1)
+		clientUserRepo.AssertNumberOfCalls(t, "ListActivitiesByUserIDs", 1)
+	})
+
+	t.Run("存在しないユーザーはロール等を取得しない", func(t *testing.T) {
+		usecase, clientUserRepo, clientUserRoleRepo := newUsecase()
+		clientUserID := uuid.New()
+		clientUserRepo.On("GetByID", mock.Anything, clientID, clientUserID).Return(dbgen.ClientUser{}, pgx.ErrNoRows)
+
+		_, err := usecase.GetClientUserDetail(context.Background(), userCtx, clientUserID)
+		assert.ErrorIs(t, err, ErrClientUserNotFound)
+		clientUserRoleRepo.AssertNotCalled(t, "ListActiveByUserIDs", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+)
+
+// ErrInvalidOrderBy 並び順の指定が不正
+var ErrInvalidOrderBy = domain.NewValidationError(domain.ReasonInvalidOrderBy, "order_by", "invalid order_by")
+
+// ErrInvalidStatusFilter ステータスの絞り込み条件が不正
+var ErrInvalidStatusFilter = domain.NewValidationError(domain.ReasonInvalidStatusFilter, "status", "invalid status filter")
+
+// defaultClientUserOrderBy クライアントユーザー一覧の既定の並び順（新しい順）
+const defaultClientUserOrderBy = "created_at desc"
+
+// ClientUserFilter クライアントユーザー一覧の検索・絞り込み・並び替え条件（空文字の項目では絞り込まない）
+type ClientUserFilter struct {
+	Query      string // 氏名（姓 名・名 姓）・メールアドレスの部分一致
+	Status     string // ACTIVE, INACTIVE, SUSPENDED
+	Department string
+	Position   string
+	RoleCode   string // 割り当て済みロールのコード
+	OrderBy    string // "name", "created_at", "last_active_at"（" desc"で降順）、空の場合は"created_at desc"
+}
+
+// search 検索条件をリポジトリの検索条件に変換
+func (f ClientUserFilter) search() (repository.ClientUserSearch, error) {
+	search := repository.ClientUserSearch{
+		QueryPattern: likePattern(strings.TrimSpace(f.Query)),
+		Status:       strings.ToUpper(strings.TrimSpace(f.Status)),
+		Department:   strings.TrimSpace(f.Department),
+		Position:     strings.TrimSpace(f.Position),
+		RoleCode:     strings.TrimSpace(f.RoleCode),
+	}
+	if search.Status != "" && !domain.UserStatus(search.Status).Valid() {
+		return repository.ClientUserSearch{}, ErrInvalidStatusFilter
+	}
+
+	orderBy := strings.ToLower(strings.Join(strings.Fields(f.OrderBy), " "))
+	if orderBy == "" {
+		orderBy = defaultClientUserOrderBy
+	}
+	field, direction, _ := strings.Cut(orderBy, " ")
+	switch repository.ClientUserSortField(field) {
+	case repository.ClientUserSortByCreatedAt, repository.ClientUserSortByName, repository.ClientUserSortByLastActiveAt:
+		search.SortField = repository.ClientUserSortField(field)
+	default:
+		return repository.ClientUserSearch{}, ErrInvalidOrderBy
+	}
+	switch direction {
+	case "", "asc":
+	case "desc":
+		search.SortDesc = true
+	default:
+		return repository.ClientUserSearch{}, ErrInvalidOrderBy
+	}
+	return search, nil
+}
+
+// isDefaultClientUserSearch 絞り込みなし・既定の並び順か（インデックスのみで取得できるListPageを使用する）
+func isDefaultClientUserSearch(search repository.ClientUserSearch) bool {
+	return search == repository.ClientUserSearch{SortField: repository.ClientUserSortByCreatedAt, SortDesc: true}
+}
+
+// clientUserSearchScope 検索条件からページトークンのスコープを作成（既定の条件の場合は空文字）
+func clientUserSearchScope(search repository.ClientUserSearch) string {
+	if isDefaultClientUserSearch(search) {
+		return ""
+	}
+	direction := "asc"
+	if search.SortDesc {
+		direction = "desc"
+	}
+	return pageScope(search.QueryPattern, search.Status, search.Department, search.Position, search.RoleCode, string(search.SortField), direction)
+}
+
+// searchClientUserCursor 検索結果のカーソル（並び替えキー, client_user_id）
+func searchClientUserCursor(sortField repository.ClientUserSortField) func(repository.ClientUserSearchRow) repository.KeysetCursor {
+	return func(row repository.ClientUserSearchRow) repository.KeysetCursor {
+		cursor := repository.KeysetCursor{ID: uuidFromPGType(row.ClientUser.ClientUserID)}
+		switch sortField {
+		case repository.ClientUserSortByName:
+			cursor.Text = row.ClientUser.LastName + " " + row.ClientUser.FirstName
+		case repository.ClientUserSortByLastActiveAt:
+			// 未アクティビティはクエリ側で1970-01-01として並べている
+			cursor.Time = time.Unix(0, 0).UTC()
+			if row.LastActiveAt.Valid {
+				cursor.Time = row.LastActiveAt.Time
+			}
+		default:
+			cursor.Time = row.ClientUser.CreatedAt.Time
+		}
+		return cursor
+	}
+}
+
+// likePattern 部分一致検索のILIKEパターンを作成（空文字の場合は絞り込まない）
+func likePattern(query string) string {
+	if query == "" {
+		return ""
+	}
+	return "%" + escapeLike(query) + "%"
+}
+
+// escapeLike ILIKEパターンのワイルドカード（%、_）とエスケープ文字をエスケープ
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
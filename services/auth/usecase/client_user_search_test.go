@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientUserFilter_Search(t *testing.T) {
+	tests := []struct {
+		orderBy   string
+		wantField repository.ClientUserSortField
+		wantDesc  bool
+		wantErr   bool
+	}{
+		{orderBy: "", wantField: repository.ClientUserSortByCreatedAt, wantDesc: true},
+		{orderBy: "name", wantField: repository.ClientUserSortByName},
+		{orderBy: "  last_active_at   DESC ", wantField: repository.ClientUserSortByLastActiveAt, wantDesc: true},
+		{orderBy: "created_at asc", wantField: repository.ClientUserSortByCreatedAt},
+		{orderBy: "name sideways", wantErr: true},
+		{orderBy: "email", wantErr: true},
+	}
+	for _, tt := range tests {
+		search, err := ClientUserFilter{OrderBy: tt.orderBy}.search()
+		if tt.wantErr {
+			assert.ErrorIs(t, err, ErrInvalidOrderBy, tt.orderBy)
+			continue
+		}
+		assert.NoError(t, err, tt.orderBy)
+		assert.Equal(t, tt.wantField, search.SortField, tt.orderBy)
+		assert.Equal(t, tt.wantDesc, search.SortDesc, tt.orderBy)
+	}
+
+	// 既定の条件のみ高速な一覧取得を使用し、ページトークンのスコープは空
+	search, _ := ClientUserFilter{}.search()
+	assert.True(t, isDefaultClientUserSearch(search))
+	assert.Empty(t, clientUserSearchScope(search))
+	search, _ = ClientUserFilter{Department: "Legal"}.search()
+	assert.False(t, isDefaultClientUserSearch(search))
+	assert.NotEmpty(t, clientUserSearchScope(search))
+}
+
+func TestLikePattern(t *testing.T) {
+	assert.Equal(t, "", likePattern(""))
+	assert.Equal(t, "%yamada%", likePattern("yamada"))
+	assert.Equal(t, `%100\%\_a\\b%`, likePattern(`100%_a\b`))
+}
+
+func TestSearchClientUserCursor(t *testing.T) {
+	activeAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	row := repository.ClientUserSearchRow{
+		ClientUser: dbgen.ClientUser{FirstName: "太郎", LastName: "山田", CreatedAt: pgtype.Timestamptz{Time: activeAt.Add(-time.Hour), Valid: true}},
+	}
+
+	assert.Equal(t, "山田 太郎", searchClientUserCursor(repository.ClientUserSortByName)(row).Text)
+	assert.True(t, row.ClientUser.CreatedAt.Time.Equal(searchClientUserCursor(repository.ClientUserSortByCreatedAt)(row).Time))
+	// 未アクティビティはクエリと同じく1970-01-01として扱う
+	assert.True(t, time.Unix(0, 0).Equal(searchClientUserCursor(repository.ClientUserSortByLastActiveAt)(row).Time))
+	row.LastActiveAt = pgtype.Timestamptz{Time: activeAt, Valid: true}
+	assert.True(t, activeAt.Equal(searchClientUserCursor(repository.ClientUserSortByLastActiveAt)(row).Time))
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"strconv"
+
+	"contract-pro-suite/services/auth/domain"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// 楽観的排他制御のエラー
+var (
+	ErrETagRequired           = domain.NewValidationError(domain.ReasonETagRequired, "etag", "etag is required")
+	ErrETagMismatch           = domain.NewError(domain.ErrorKindPreconditionFailed, domain.ReasonETagMismatch, "etag does not match the current version")
+	ErrConcurrentModification = domain.NewError(domain.ErrorKindAborted, domain.ReasonConcurrentModification, "resource was modified concurrently")
+)
+
+// ETag リソースのバージョンを表すETag（updated_atのマイクロ秒を16進数にした不透明な文字列）
+// updated_atはトリガーで更新のたびに変わるため、読み取り時のETagと比較して更新の競合を検出する
+func ETag(updatedAt pgtype.Timestamptz) string {
+	if !updatedAt.Valid {
+		return ""
+	}
+	return strconv.FormatInt(updatedAt.Time.UnixMicro(), 16)
+}
+
+// checkETag 指定されたETagが現在のバージョンと一致するか確認（未指定の場合は確認しない）
+func checkETag(etag string, updatedAt pgtype.Timestamptz) error {
+	if etag == "" || etag == ETag(updatedAt) {
+		return nil
+	}
+	return ErrETagMismatch
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestETag(t *testing.T) {
+	updatedAt := pgtype.Timestamptz{Time: time.Date(2026, 1, 1, 0, 0, 0, 123456000, time.UTC), Valid: true}
+	etag := ETag(updatedAt)
+	assert.NotEmpty(t, etag)
+	assert.NoError(t, checkETag(etag, updatedAt))
+	assert.NoError(t, checkETag("", updatedAt))
+
+	// マイクロ秒単位の違いも別のバージョンとして扱う
+	later := pgtype.Timestamptz{Time: updatedAt.Time.Add(time.Microsecond), Valid: true}
+	assert.NotEqual(t, etag, ETag(later))
+	assert.ErrorIs(t, checkETag(etag, later), ErrETagMismatch)
+	assert.Empty(t, ETag(pgtype.Timestamptz{}))
+}
+
+func TestUpdateClientUser_ETag(t *testing.T) {
+	clientID := uuid.New()
+	userID := uuid.New()
+	updatedAt := pgtype.Timestamptz{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	existing := dbgen.ClientUser{
+		ClientUserID: pgtype.UUID{Bytes: userID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		Email:        "user@example.com",
+		FirstName:    "Taro",
+		LastName:     "Yamada",
+		Settings:     []byte(`{}`),
+		Status:       "ACTIVE",
+		UpdatedAt:    updatedAt,
+	}
+	firstName := "Jiro"
+
+	t.Run("管理者による更新ではETagが必須", func(t *testing.T) {
+		adminCtx := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: clientID}
+		clientUserRoleRepo := new(MockClientUserRoleRepository)
+		rolePermissionRepo := new(MockClientRolePermissionRepository)
+		roleID := uuid.New()
+		clientUserRoleRepo.On("GetByUserID", mock.Anything, clientID, adminCtx.UserID).Return([]dbgen.ClientUserRole{
+			{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}},
+		}, nil)
+		rolePermissionRepo.On("GetByRoleID", mock.Anything, roleID).Return([]dbgen.ClientRolePermission{
+			{Feature: "users", Action: "WRITE", Granted: true},
+			{Feature: "users", Action: "DELETE", Granted: true},
+		}, nil)
+		mockClientUserRepo := new(MockClientUserRepository)
+		usecase := &authUsecase{
+			clientUserRepo:           mockClientUserRepo,
+			clientUserRoleRepo:       clientUserRoleRepo,
+			clientRolePermissionRepo: rolePermissionRepo,
+		}
+
+		_, err := usecase.UpdateClientUser(context.Background(), adminCtx, userID, UpdateClientUserParams{FirstName: &firstName})
+		assert.ErrorIs(t, err, ErrETagRequired)
+		err = usecase.DeleteClientUser(context.Background(), adminCtx, userID, "")
+		assert.ErrorIs(t, err, ErrETagRequired)
+		mockClientUserRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("古いETagは更新前に拒否する", func(t *testing.T) {
+		mockClientUserRepo := new(MockClientUserRepository)
+		mockClientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(existing, nil)
+		usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+
+		stale := ETag(pgtype.Timestamptz{Time: updatedAt.Time.Add(-time.Second), Valid: true})
+		_, err := usecase.updateClientUser(context.Background(), clientID, userID, UpdateClientUserParams{FirstName: &firstName, ETag: stale})
+		assert.ErrorIs(t, err, ErrETagMismatch)
+		mockClientUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("取得時のupdated_atを条件に更新し、競合した場合はErrConcurrentModification", func(t *testing.T) {
+		mockClientUserRepo := new(MockClientUserRepository)
+		mockClientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(existing, nil)
+		mockClientUserRepo.On("Update", mock.Anything, clientID, mock.MatchedBy(func(params dbgen.UpdateClientUserParams) bool {
+			return params.UpdatedAt == updatedAt && params.FirstName == firstName
+		})).Return(dbgen.ClientUser{}, pgx.ErrNoRows)
+		usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+
+		_, err := usecase.updateClientUser(context.Background(), clientID, userID, UpdateClientUserParams{FirstName: &firstName, ETag: ETag(updatedAt)})
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+		mockClientUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("削除も取得時のupdated_atを条件とする", func(t *testing.T) {
+		deletedBy := uuid.New()
+		mockClientUserRepo := new(MockClientUserRepository)
+		mockClientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(existing, nil)
+		mockClientUserRepo.On("Delete", mock.Anything, clientID, userID, deletedBy, updatedAt.Time).Return(int64(0), nil).Once()
+		mockClientUserRepo.On("Delete", mock.Anything, clientID, userID, deletedBy, updatedAt.Time).Return(int64(1), nil).Once()
+		mockTokenRevocationRepo := new(MockTokenRevocationRepository)
+		mockTokenRevocationRepo.On("BumpWatermark", mock.Anything, mock.Anything).Return(nil)
+		usecase := &authUsecase{clientUserRepo: mockClientUserRepo, tokenRevocationRepo: mockTokenRevocationRepo}
+
+		err := usecase.deleteClientUser(context.Background(), clientID, userID, deletedBy, ETag(updatedAt))
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+		mockTokenRevocationRepo.AssertNotCalled(t, "BumpWatermark", mock.Anything, mock.Anything)
+
+		err = usecase.deleteClientUser(context.Background(), clientID, userID, deletedBy, ETag(updatedAt))
+		assert.NoError(t, err)
+		mockClientUserRepo.AssertExpectations(t)
+		mockTokenRevocationRepo.AssertExpectations(t)
+	})
+}
+
+func TestClientUserNotFound(t *testing.T) {
+	clientID := uuid.New()
+	userID := uuid.New()
+	mockClientUserRepo := new(MockClientUserRepository)
+	mockClientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(dbgen.ClientUser{}, pgx.ErrNoRows)
+	usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+
+	// 存在しないユーザーは内部エラーではなくErrClientUserNotFound
+	firstName := "Jiro"
+	_, err := usecase.updateClientUser(context.Background(), clientID, userID, UpdateClientUserParams{FirstName: &firstName})
+	assert.ErrorIs(t, err, ErrClientUserNotFound)
+	err = usecase.deleteClientUser(context.Background(), clientID, userID, uuid.New(), "")
+	assert.ErrorIs(t, err, ErrClientUserNotFound)
+	assert.ErrorIs(t, notFoundOr(err, "User", userID), ErrSCIMResourceNotFound)
+}
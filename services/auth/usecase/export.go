@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"contract-pro-suite/internal/shared/tabular"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnsupportedExportFormat 未対応の出力形式（tabular.ErrUnsupportedFormatをラップ）
+var ErrUnsupportedExportFormat = domain.NewValidationError(domain.ReasonUnsupportedExportFormat, "format", "unsupported export format")
+
+// exportBatchSize エクスポートで1回のクエリで取得する件数（全件をメモリに保持しない）
+const exportBatchSize int32 = 500
+
+// clientUserExportHeader エクスポートの列（監査向けに列名は固定）
+var clientUserExportHeader = []string{
+	"client_user_id",
+	"email",
+	"last_name",
+	"first_name",
+	"department",
+	"position",
+	"status",
+	"role_codes",
+	"role_names",
+	"created_at",
+	"last_active_at",
+}
+
+// ExportClientUsersResult エクスポートの結果（監査ログ用）
+type ExportClientUsersResult struct {
+	Format tabular.Format
+	Rows   int // 出力したユーザー数（ヘッダー行を除く）
+}
+
+// ExportClientUsers クライアントユーザーと有効なロールをCSV/XLSXでwに出力（ListClientUsersと同じ検索条件・並び順）
+func (u *authUsecase) ExportClientUsers(ctx context.Context, userCtx *domain.UserContext, filter ClientUserFilter, format tabular.Format, w io.Writer) (*ExportClientUsersResult, error) {
+	// 1. クライアントアクセス権限チェック
+	if err := u.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
+		return nil, err
+	}
+
+	// 2. 権限チェック: users:READ
+	if err := u.CheckPermission(ctx, userCtx, "users", "READ"); err != nil {
+		return nil, err
+	}
+
+	// 3. パラメータのバリデーション（出力を開始する前に行う）
+	search, err := filter.search()
+	if err != nil {
+		return nil, err
+	}
+	writer, err := tabular.NewWriter(format, w)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedExportFormat, err)
+	}
+
+	// 4. キーセットページネーションで順に取得して出力
+	result := &ExportClientUsersResult{Format: format}
+	if err := writer.WriteRow(clientUserExportHeader); err != nil {
+		return nil, fmt.Errorf("failed to write export: %w", err)
+	}
+	cursorOf := searchClientUserCursor(search.SortField)
+	var cursor *repository.KeysetCursor
+	for {
+		rows, err := u.clientUserRepo.Search(ctx, userCtx.ClientID, search, cursor, exportBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search client users: %w", err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		userIDs := make([]uuid.UUID, len(rows))
+		for i, row := range rows {
+			userIDs[i] = uuidFromPGType(row.ClientUser.ClientUserID)
+		}
+		roles, err := u.activeRolesByUser(ctx, userCtx.ClientID, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if err := writer.WriteRow(clientUserExportRow(row, roles[uuidFromPGType(row.ClientUser.ClientUserID)])); err != nil {
+				return nil, fmt.Errorf("failed to write export: %w", err)
+			}
+		}
+		result.Rows += len(rows)
+		if int32(len(rows)) < exportBatchSize {
+			break
+		}
+		next := cursorOf(rows[len(rows)-1])
+		cursor = &next
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write export: %w", err)
+	}
+	return result, nil
+}
+
+// activeRolesByUser ユーザーごとの有効なロールをまとめて取得
+func (u *authUsecase) activeRolesByUser(ctx context.Context, clientID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID][]dbgen.ListActiveClientUserRolesByUserIDsRow, error) {
+	roles, err := u.clientUserRoleRepo.ListActiveByUserIDs(ctx, clientID, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client user roles: %w", err)
+	}
+	byUser := make(map[uuid.UUID][]dbgen.ListActiveClientUserRolesByUserIDsRow, len(userIDs))
+	for _, role := range roles {
+		userID := uuidFromPGType(role.ClientUserID)
+		byUser[userID] = append(byUser[userID], role)
+	}
+	return byUser, nil
+}
+
+// clientUserExportRow エクスポートの1行を作成（日時はUTCのRFC3339、ロールは";"区切り）
+func clientUserExportRow(row repository.ClientUserSearchRow, roles []dbgen.ListActiveClientUserRolesByUserIDsRow) []string {
+	user := row.ClientUser
+	codes := make([]string, len(roles))
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		codes[i] = role.Code
+		names[i] = role.Name
+	}
+	lastActiveAt := ""
+	if row.LastActiveAt.Valid {
+		lastActiveAt = row.LastActiveAt.Time.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		uuidFromPGType(user.ClientUserID).String(),
+		user.Email,
+		user.LastName,
+		user.FirstName,
+		user.Department.String,
+		user.Position.String,
+		user.Status,
+		strings.Join(codes, ";"),
+		strings.Join(names, ";"),
+		user.CreatedAt.Time.UTC().Format(time.RFC3339),
+		lastActiveAt,
+	}
+}
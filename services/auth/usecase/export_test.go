@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"contract-pro-suite/internal/shared/tabular"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportClientUsers(t *testing.T) {
+	clientID := uuid.New()
+	userCtx := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: clientID}
+
+	newUsecase := func() (*authUsecase, *MockClientUserRepository, *MockClientUserRoleRepository) {
+		clientUserRepo := new(MockClientUserRepository)
+		clientUserRoleRepo := new(MockClientUserRoleRepository)
+		rolePermissionRepo := new(MockClientRolePermissionRepository)
+		roleID := uuid.New()
+		clientUserRoleRepo.On("GetByUserID", mock.Anything, clientID, userCtx.UserID).Return([]dbgen.ClientUserRole{
+			{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}},
+		}, nil)
+		rolePermissionRepo.On("GetByRoleID", mock.Anything, roleID).Return([]dbgen.ClientRolePermission{
+			{Feature: "users", Action: "READ", Granted: true},
+		}, nil)
+		return &authUsecase{
+			clientUserRepo:           clientUserRepo,
+			clientUserRoleRepo:       clientUserRoleRepo,
+			clientRolePermissionRepo: rolePermissionRepo,
+		}, clientUserRepo, clientUserRoleRepo
+	}
+	newRow := func(i int) repository.ClientUserSearchRow {
+		return repository.ClientUserSearchRow{ClientUser: dbgen.ClientUser{
+			ClientUserID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			Email:        fmt.Sprintf("user%d@example.com", i),
+			FirstName:    "太郎",
+			LastName:     "山田",
+			Department:   pgtype.Text{String: "法務部", Valid: true},
+			Status:       "ACTIVE",
+			CreatedAt:    pgtype.Timestamptz{Time: time.Date(2026, 1, 1, 0, 0, i, 0, time.UTC), Valid: true},
+		}}
+	}
+
+	t.Run("ロールを含めてCSVで出力し、バッチごとにカーソルで続きを取得する", func(t *testing.T) {
+		usecase, clientUserRepo, clientUserRoleRepo := newUsecase()
+		search := repository.ClientUserSearch{Department: "法務部", SortField: repository.ClientUserSortByCreatedAt, SortDesc: true}
+
+		first := make([]repository.ClientUserSearchRow, exportBatchSize)
+		for i := range first {
+			first[i] = newRow(i)
+		}
+		second := []repository.ClientUserSearchRow{newRow(int(exportBatchSize))}
+		last := first[len(first)-1]
+		clientUserRepo.On("Search", mock.Anything, clientID, search, (*repository.KeysetCursor)(nil), exportBatchSize).Return(first, nil)
+		clientUserRepo.On("Search", mock.Anything, clientID, search, mock.MatchedBy(func(c *repository.KeysetCursor) bool {
+			return c != nil && c.ID == uuidFromPGType(last.ClientUser.ClientUserID) && c.Time.Equal(last.ClientUser.CreatedAt.Time)
+		}), exportBatchSize).Return(second, nil)
+		clientUserRoleRepo.On("ListActiveByUserIDs", mock.Anything, clientID, mock.MatchedBy(func(ids []uuid.UUID) bool {
+			return len(ids) == len(first)
+		})).Return([]dbgen.ListActiveClientUserRolesByUserIDsRow{
+			{ClientUserID: first[0].ClientUser.ClientUserID, Code: "ADMIN", Name: "管理者"},
+			{ClientUserID: first[0].ClientUser.ClientUserID, Code: "MEMBER", Name: "一般"},
+		}, nil)
+		clientUserRoleRepo.On("ListActiveByUserIDs", mock.Anything, clientID, []uuid.UUID{uuidFromPGType(second[0].ClientUser.ClientUserID)}).
+			Return([]dbgen.ListActiveClientUserRolesByUserIDsRow{}, nil)
+
+		var buf bytes.Buffer
+		result, err := usecase.ExportClientUsers(context.Background(), userCtx, ClientUserFilter{Department: "法務部"}, tabular.FormatCSV, &buf)
+		assert.NoError(t, err)
+		assert.Equal(t, int(exportBatchSize)+1, result.Rows)
+
+		lines := strings.Split(strings.TrimSuffix(strings.TrimPrefix(buf.String(), "\uFEFF"), "\r\n"), "\r\n")
+		assert.Len(t, lines, int(exportBatchSize)+2)
+		assert.Equal(t, strings.Join(clientUserExportHeader, ","), lines[0])
+		assert.Equal(t, uuidFromPGType(first[0].ClientUser.ClientUserID).String()+
+			",user0@example.com,山田,太郎,法務部,,ACTIVE,ADMIN;MEMBER,管理者;一般,2026-01-01T00:00:00Z,", lines[1])
+		clientUserRepo.AssertExpectations(t)
+		clientUserRoleRepo.AssertExpectations(t)
+	})
+
+	t.Run("不正な条件の場合は何も出力しない", func(t *testing.T) {
+		usecase, _, _ := newUsecase()
+		var buf bytes.Buffer
+		_, err := usecase.ExportClientUsers(context.Background(), userCtx, ClientUserFilter{OrderBy: "email"}, tabular.FormatCSV, &buf)
+		assert.ErrorIs(t, err, ErrInvalidOrderBy)
+		_, err = usecase.ExportClientUsers(context.Background(), userCtx, ClientUserFilter{}, tabular.Format("pdf"), &buf)
+		assert.ErrorIs(t, err, tabular.ErrUnsupportedFormat)
+		assert.ErrorIs(t, err, ErrUnsupportedExportFormat)
+		assert.Zero(t, buf.Len())
+	})
+}
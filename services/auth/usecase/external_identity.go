@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GetUserContextByExternalIdentity 外部IdPの (issuer, subject) からクライアントユーザーを解決
+// 紐付けが存在しない場合、IdP設定でJITプロビジョニングが有効であればユーザーを作成して紐付ける
+func (u *authUsecase) GetUserContextByExternalIdentity(ctx context.Context, identity domain.ExternalIdentity) (*domain.UserContext, error) {
+	// 1. issuerからIdP設定（所属クライアント）を取得
+	provider, err := u.identityProviderRepo.GetByIssuer(ctx, identity.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity provider: %w", err)
+	}
+	if provider.Status != string(domain.IdentityProviderStatusActive) {
+		return nil, errors.New("identity provider is not active")
+	}
+	clientID := uuidFromPGType(provider.ClientID)
+
+	// 2. 既存の紐付けを検索
+	link, err := u.clientUserIdentityRepo.Get(ctx, identity.Issuer, identity.Subject)
+	if err == nil {
+		// 紐付け先はIdPの所属クライアント内のユーザーに限定（クライアント分離チェック）
+		clientUser, err := u.clientUserRepo.GetByID(ctx, clientID, uuidFromPGType(link.ClientUserID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get linked client user: %w", err)
+		}
+		if err := requireActiveUser(clientUser.Status); err != nil {
+			return nil, err
+		}
+		// 最終ログイン日時の更新に失敗しても認証は継続する
+		_ = u.clientUserIdentityRepo.Touch(ctx, identity.Issuer, identity.Subject)
+
+		return &domain.UserContext{
+			UserID:   uuidFromPGType(clientUser.ClientUserID),
+			UserType: domain.UserTypeClientUser,
+			Email:    clientUser.Email,
+			ClientID: clientID,
+		}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get client user identity: %w", err)
+	}
+
+	// 3. 未紐付けの場合はJITプロビジョニング
+	if !provider.JitProvisioning {
+		return nil, errors.New("user not found: identity is not linked and JIT provisioning is disabled")
+	}
+
+	clientUser, err := u.provisionExternalUser(ctx, provider, identity)
+	if err != nil {
+		return nil, err
+	}
+	// 既存ユーザーに紐付けた場合、停止・無効化されたユーザーは認証しない
+	if err := requireActiveUser(clientUser.Status); err != nil {
+		return nil, err
+	}
+
+	return &domain.UserContext{
+		UserID:   uuidFromPGType(clientUser.ClientUserID),
+		UserType: domain.UserTypeClientUser,
+		Email:    clientUser.Email,
+		ClientID: clientID,
+	}, nil
+}
+
+// provisionExternalUser 外部IdPのユーザーをクライアントユーザーとして作成（または既存ユーザーに紐付け）
+func (u *authUsecase) provisionExternalUser(ctx context.Context, provider dbgen.ClientIdentityProvider, identity domain.ExternalIdentity) (dbgen.ClientUser, error) {
+	if identity.Email == "" {
+		return dbgen.ClientUser{}, errors.New("email claim is required for JIT provisioning")
+	}
+
+	// データベーストランザクション開始
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 準備済みステートメントのキャッシュをクリア（再発防止）
+	if _, err := tx.Exec(ctx, "DEALLOCATE ALL"); err != nil {
+		_ = err // エラーを無視
+	}
+
+	queries := dbgen.New(tx)
+	clientID := provider.ClientID
+
+	// 同じメールアドレスの既存ユーザーがいる場合は紐付けのみ行う
+	// IdPがメールアドレスを検証していない場合は、なりすまし防止のため紐付けを拒否する
+	user, err := queries.GetClientUserByEmail(ctx, dbgen.GetClientUserByEmailParams{
+		ClientID: clientID,
+		Email:    identity.Email,
+	})
+	switch {
+	case err == nil:
+		if !identity.EmailVerified {
+			return dbgen.ClientUser{}, fmt.Errorf("email already exists and is not verified by identity provider: %s", identity.Email)
+		}
+	case errors.Is(err, pgx.ErrNoRows):
+		user, err = u.createExternalClientUser(ctx, queries, provider, identity)
+		if err != nil {
+			return dbgen.ClientUser{}, err
+		}
+	default:
+		return dbgen.ClientUser{}, fmt.Errorf("failed to get client user by email: %w", err)
+	}
+
+	// 外部IDとクライアントユーザーを紐付け
+	_, err = queries.CreateClientUserIdentity(ctx, dbgen.CreateClientUserIdentityParams{
+		Issuer:       identity.Issuer,
+		Subject:      identity.Subject,
+		ProviderID:   provider.ProviderID,
+		ClientID:     clientID,
+		ClientUserID: user.ClientUserID,
+		Email:        pgtype.Text{String: identity.Email, Valid: true},
+		LastLoginAt:  pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to create client user identity: %w", err)
+	}
+
+	// トランザクションコミット
+	if err := tx.Commit(ctx); err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return user, nil
+}
+
+// createExternalClientUser 外部IdPのクレームからクライアントユーザーを作成し、IdP設定のデフォルトロールを割り当てる
+func (u *authUsecase) createExternalClientUser(ctx context.Context, queries *dbgen.Queries, provider dbgen.ClientIdentityProvider, identity domain.ExternalIdentity) (dbgen.ClientUser, error) {
+	// given_nameがない場合はメールアドレスのローカル部を名として使用
+	firstName := identity.FirstName
+	if firstName == "" {
+		firstName = strings.SplitN(identity.Email, "@", 2)[0]
+	}
+
+	// 外部IdPのユーザーはSupabase Authに存在しないため、client_user_idは新規に採番する
+	user, err := queries.CreateClientUser(ctx, dbgen.CreateClientUserParams{
+		ClientUserID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		ClientID:     provider.ClientID,
+		Email:        identity.Email,
+		FirstName:    firstName,
+		LastName:     identity.LastName,
+		Settings:     []byte("{}"),
+		Status:       string(domain.UserStatusActive),
+	})
+	if err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to create client user: %w", err)
+	}
+
+	role, err := queries.GetClientRoleByCode(ctx, dbgen.GetClientRoleByCodeParams{
+		ClientID: provider.ClientID,
+		Code:     provider.DefaultRoleCode,
+	})
+	if err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to get default role %s: %w", provider.DefaultRoleCode, err)
+	}
+	_, err = queries.CreateClientUserRole(ctx, dbgen.CreateClientUserRoleParams{
+		ClientID:     provider.ClientID,
+		ClientUserID: user.ClientUserID,
+		RoleID:       role.RoleID,
+		AssignedAt:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to assign default role: %w", err)
+	}
+
+	return user, nil
+}
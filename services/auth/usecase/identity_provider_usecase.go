@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrIdentityProviderNotFound IdP設定がクライアント内に存在しない
+	ErrIdentityProviderNotFound = domain.NewError(domain.ErrorKindNotFound, domain.ReasonIdentityProviderNotFound, "identity provider not found")
+	// ErrIdentityProviderAlreadyExists 同じissuerのIdP設定が既に登録されている（issuerは全クライアントで一意）
+	ErrIdentityProviderAlreadyExists = domain.NewError(domain.ErrorKindConflict, domain.ReasonIdentityProviderAlreadyExists, "identity provider already exists")
+	// ErrInvalidDefaultRole JITプロビジョニングのデフォルトロールがクライアント内に存在しない
+	ErrInvalidDefaultRole = domain.NewValidationError(domain.ReasonInvalidRole, "default_role_code", "invalid default role")
+)
+
+// defaultIdentityProviderRoleCode JITプロビジョニングのデフォルトロール（未指定の場合）
+const defaultIdentityProviderRoleCode = "member"
+
+// CreateIdentityProviderParams IdP設定登録パラメータ
+type CreateIdentityProviderParams struct {
+	Name            string
+	Issuer          string  // JWTのiss（https、全クライアントで一意、登録後は変更不可）
+	Audience        string  // JWTのaud
+	JwksURI         *string // 未指定の場合はissuerのOpenID Connect Discoveryから取得
+	JITProvisioning bool
+	DefaultRoleCode string // 未指定の場合は"member"
+	Status          string // 未指定の場合はACTIVE
+}
+
+// UpdateIdentityProviderParams IdP設定更新パラメータ（nilのフィールドは変更しない）
+type UpdateIdentityProviderParams struct {
+	Name            *string
+	Audience        *string
+	JwksURI         *string // 空文字の場合は削除（OpenID Connect Discoveryから取得）
+	JITProvisioning *bool
+	DefaultRoleCode *string
+	Status          *string
+}
+
+// IdentityProviderUsecase クライアントの外部IdP（OIDC）設定管理ユースケース
+type IdentityProviderUsecase interface {
+	// ListIdentityProviders IdP設定一覧取得（権限: system_settings:READ）
+	ListIdentityProviders(ctx context.Context, userCtx *domain.UserContext) ([]dbgen.ClientIdentityProvider, error)
+	// CreateIdentityProvider IdP設定登録（権限: system_settings:WRITE）
+	CreateIdentityProvider(ctx context.Context, userCtx *domain.UserContext, params CreateIdentityProviderParams) (dbgen.ClientIdentityProvider, error)
+	// UpdateIdentityProvider IdP設定更新（権限: system_settings:WRITE）
+	UpdateIdentityProvider(ctx context.Context, userCtx *domain.UserContext, providerID uuid.UUID, params UpdateIdentityProviderParams) (dbgen.ClientIdentityProvider, error)
+	// DeleteIdentityProvider IdP設定削除（権限: system_settings:WRITE、紐付け済みの外部IDも削除する）
+	DeleteIdentityProvider(ctx context.Context, userCtx *domain.UserContext, providerID uuid.UUID) error
+}
+
+type identityProviderUsecase struct {
+	authUsecase          AuthUsecase
+	identityProviderRepo repository.IdentityProviderRepository
+	clientRoleRepo       repository.ClientRoleRepository
+}
+
+// NewIdentityProviderUsecase 外部IdP設定ユースケースを作成
+func NewIdentityProviderUsecase(
+	authUsecase AuthUsecase,
+	identityProviderRepo repository.IdentityProviderRepository,
+	clientRoleRepo repository.ClientRoleRepository,
+) IdentityProviderUsecase {
+	return &identityProviderUsecase{
+		authUsecase:          authUsecase,
+		identityProviderRepo: identityProviderRepo,
+		clientRoleRepo:       clientRoleRepo,
+	}
+}
+
+// authorize IdP設定管理の権限チェック（クライアントアクセス + system_settings）
+func (u *identityProviderUsecase) authorize(ctx context.Context, userCtx *domain.UserContext, action string) error {
+	// 1. クライアントアクセス権限チェック
+	if err := u.authUsecase.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
+		return err
+	}
+
+	// 2. 権限チェック: system_settings
+	if err := u.authUsecase.CheckPermission(ctx, userCtx, "system_settings", action); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListIdentityProviders IdP設定一覧取得
+func (u *identityProviderUsecase) ListIdentityProviders(ctx context.Context, userCtx *domain.UserContext) ([]dbgen.ClientIdentityProvider, error) {
+	if err := u.authorize(ctx, userCtx, "READ"); err != nil {
+		return nil, err
+	}
+
+	providers, err := u.identityProviderRepo.ListByClientID(ctx, userCtx.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identity providers: %w", err)
+	}
+	return providers, nil
+}
+
+// CreateIdentityProvider IdP設定登録
+func (u *identityProviderUsecase) CreateIdentityProvider(ctx context.Context, userCtx *domain.UserContext, params CreateIdentityProviderParams) (dbgen.ClientIdentityProvider, error) {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return dbgen.ClientIdentityProvider{}, err
+	}
+
+	// 1. 入力チェック
+	if params.DefaultRoleCode == "" {
+		params.DefaultRoleCode = defaultIdentityProviderRoleCode
+	}
+	if params.Status == "" {
+		params.Status = string(domain.IdentityProviderStatusActive)
+	}
+	if err := validateHTTPSURL("issuer", params.Issuer); err != nil {
+		return dbgen.ClientIdentityProvider{}, err
+	}
+	if params.JwksURI != nil && *params.JwksURI != "" {
+		if err := validateHTTPSURL("jwks_uri", *params.JwksURI); err != nil {
+			return dbgen.ClientIdentityProvider{}, err
+		}
+	}
+	if err := u.validateDefaultRole(ctx, userCtx.ClientID, params.DefaultRoleCode); err != nil {
+		return dbgen.ClientIdentityProvider{}, err
+	}
+
+	// 2. issuerの重複チェック（JWTのissからIdP設定を特定するため、他のクライアントを含めて一意）
+	_, err := u.identityProviderRepo.GetByIssuer(ctx, params.Issuer)
+	if err == nil {
+		return dbgen.ClientIdentityProvider{}, fmt.Errorf("%w: %s", ErrIdentityProviderAlreadyExists, params.Issuer)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return dbgen.ClientIdentityProvider{}, fmt.Errorf("failed to get identity provider by issuer: %w", err)
+	}
+
+	// 3. 登録
+	provider, err := u.identityProviderRepo.Create(ctx, dbgen.CreateIdentityProviderParams{
+		ClientID:        pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		Name:            params.Name,
+		Issuer:          params.Issuer,
+		Audience:        params.Audience,
+		JwksUri:         optionalText(params.JwksURI),
+		JitProvisioning: params.JITProvisioning,
+		DefaultRoleCode: params.DefaultRoleCode,
+		Status:          params.Status,
+	})
+	if err != nil {
+		return dbgen.ClientIdentityProvider{}, fmt.Errorf("failed to create identity provider: %w", err)
+	}
+	return provider, nil
+}
+
+// UpdateIdentityProvider IdP設定更新
+func (u *identityProviderUsecase) UpdateIdentityProvider(ctx context.Context, userCtx *domain.UserContext, providerID uuid.UUID, params UpdateIdentityProviderParams) (dbgen.ClientIdentityProvider, error) {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return dbgen.ClientIdentityProvider{}, err
+	}
+
+	// 1. 既存のIdP設定を取得（クライアント分離チェック）
+	provider, err := u.identityProviderRepo.Get(ctx, userCtx.ClientID, providerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return dbgen.ClientIdentityProvider{}, fmt.Errorf("%w: %s", ErrIdentityProviderNotFound, providerID)
+	}
+	if err != nil {
+		return dbgen.ClientIdentityProvider{}, fmt.Errorf("failed to get identity provider: %w", err)
+	}
+
+	// 2. 指定されたフィールドのみ変更
+	update := dbgen.UpdateIdentityProviderParams{
+		ClientID:        provider.ClientID,
+		ProviderID:      provider.ProviderID,
+		Name:            provider.Name,
+		Audience:        provider.Audience,
+		JwksUri:         provider.JwksUri,
+		JitProvisioning: provider.JitProvisioning,
+		DefaultRoleCode: provider.DefaultRoleCode,
+		Status:          provider.Status,
+	}
+	if params.Name != nil {
+		update.Name = *params.Name
+	}
+	if params.Audience != nil {
+		// 空のaudでは検証できないため、省略（nil）と異なり空文字は受け付けない
+		if strings.TrimSpace(*params.Audience) == "" {
+			return dbgen.ClientIdentityProvider{}, requiredFieldError("audience")
+		}
+		update.Audience = *params.Audience
+	}
+	if params.JwksURI != nil {
+		if *params.JwksURI != "" {
+			if err := validateHTTPSURL("jwks_uri", *params.JwksURI); err != nil {
+				return dbgen.ClientIdentityProvider{}, err
+			}
+		}
+		update.JwksUri = optionalText(params.JwksURI)
+	}
+	if params.JITProvisioning != nil {
+		update.JitProvisioning = *params.JITProvisioning
+	}
+	if params.DefaultRoleCode != nil {
+		if err := u.validateDefaultRole(ctx, userCtx.ClientID, *params.DefaultRoleCode); err != nil {
+			return dbgen.ClientIdentityProvider{}, err
+		}
+		update.DefaultRoleCode = *params.DefaultRoleCode
+	}
+	if params.Status != nil {
+		update.Status = *params.Status
+	}
+
+	// 3. 更新
+	updated, err := u.identityProviderRepo.Update(ctx, update)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return dbgen.ClientIdentityProvider{}, fmt.Errorf("%w: %s", ErrIdentityProviderNotFound, providerID)
+	}
+	if err != nil {
+		return dbgen.ClientIdentityProvider{}, fmt.Errorf("failed to update identity provider: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteIdentityProvider IdP設定削除
+func (u *identityProviderUsecase) DeleteIdentityProvider(ctx context.Context, userCtx *domain.UserContext, providerID uuid.UUID) error {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return err
+	}
+
+	deleted, err := u.identityProviderRepo.Delete(ctx, userCtx.ClientID, providerID, userCtx.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to delete identity provider: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %s", ErrIdentityProviderNotFound, providerID)
+	}
+	return nil
+}
+
+// validateDefaultRole JITプロビジョニングのデフォルトロールが自クライアントに存在するか確認
+func (u *identityProviderUsecase) validateDefaultRole(ctx context.Context, clientID uuid.UUID, code string) error {
+	if _, err := u.clientRoleRepo.GetByCode(ctx, clientID, code); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %s", ErrInvalidDefaultRole, code)
+		}
+		return fmt.Errorf("failed to get client role by code: %w", err)
+	}
+	return nil
+}
+
+// validateHTTPSURL httpsの絶対URLであることを確認（issuer・jwks_uri）
+func validateHTTPSURL(field, value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return domain.NewValidationError(domain.ReasonInvalidURL, field, field+" must be an https URL")
+	}
+	return nil
+}
+
+// optionalText 空文字・nilをNULLとして扱うpgtype.Text
+func optionalText(value *string) pgtype.Text {
+	if value == nil || *value == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *value, Valid: true}
+}
@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newIdentityProviderTestUsecase system_settingsの権限（writeがfalseの場合はREADのみ）を持つサービスアカウントで呼び出すIdP設定ユースケース
+func newIdentityProviderTestUsecase(t *testing.T, write bool) (IdentityProviderUsecase, *domain.UserContext, *MockIdentityProviderRepository, *MockClientRoleRepository) {
+	t.Helper()
+	clientID := uuid.New()
+	serviceAccountID := uuid.New()
+	roleID := uuid.New()
+	userCtx := &domain.UserContext{UserID: serviceAccountID, UserType: domain.UserTypeServiceAccount, ClientID: clientID}
+
+	mockServiceAccountRepo := new(MockServiceAccountRepository)
+	mockServiceAccountRepo.On("GetByID", mock.Anything, clientID, serviceAccountID).Return(dbgen.ClientServiceAccount{
+		ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+		ClientID:         pgtype.UUID{Bytes: clientID, Valid: true},
+		RoleID:           pgtype.UUID{Bytes: roleID, Valid: true},
+		Status:           "ACTIVE",
+	}, nil)
+	mockClientRolePermissionRepo := new(MockClientRolePermissionRepository)
+	mockClientRolePermissionRepo.On("GetByRoleID", mock.Anything, roleID).Return([]dbgen.ClientRolePermission{
+		{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}, Feature: "system_settings", Action: "READ", Granted: true},
+		{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}, Feature: "system_settings", Action: "WRITE", Granted: write},
+	}, nil)
+
+	authUsecase := NewAuthUsecase(
+		new(MockOperatorRepository),
+		new(MockClientUserRepository),
+		new(MockClientRepository),
+		new(MockOperatorAssignmentRepository),
+		new(MockClientRoleRepository),
+		mockClientRolePermissionRepo,
+		new(MockClientUserRoleRepository),
+		nil, // identityProviderRepo
+		nil, // clientUserIdentityRepo
+		mockServiceAccountRepo,
+		nil, // tokenRevocationRepo
+		nil, // challengeVerifier
+		nil, // signupVerificationSender
+		nil, // breachedPasswordChecker
+		nil, // emailChangeSender
+		nil, // config
+		nil, // database
+	)
+
+	mockIdentityProviderRepo := new(MockIdentityProviderRepository)
+	mockClientRoleRepo := new(MockClientRoleRepository)
+	return NewIdentityProviderUsecase(authUsecase, mockIdentityProviderRepo, mockClientRoleRepo), userCtx, mockIdentityProviderRepo, mockClientRoleRepo
+}
+
+func TestCreateIdentityProvider(t *testing.T) {
+	const issuer = "https://idp.example.com"
+
+	tests := []struct {
+		name       string
+		write      bool
+		params     CreateIdentityProviderParams
+		roleErr    error // デフォルトロールの取得結果（nilの場合は存在する）
+		issuerErr  error // issuerの重複チェックの取得結果（nilの場合は登録済み）
+		wantCreate bool
+		wantReason domain.ErrorReason
+	}{
+		{
+			name:       "成功: デフォルトロール・ステータスを補完",
+			write:      true,
+			params:     CreateIdentityProviderParams{Name: "社内IdP", Issuer: issuer, Audience: "contract-pro-suite"},
+			issuerErr:  pgx.ErrNoRows,
+			wantCreate: true,
+		},
+		{
+			name:       "失敗: 権限なし",
+			params:     CreateIdentityProviderParams{Name: "社内IdP", Issuer: issuer, Audience: "contract-pro-suite"},
+			wantReason: domain.ReasonPermissionDenied,
+		},
+		{
+			name:       "失敗: issuerがhttpsでない",
+			write:      true,
+			params:     CreateIdentityProviderParams{Name: "社内IdP", Issuer: "http://idp.example.com", Audience: "contract-pro-suite"},
+			wantReason: domain.ReasonInvalidURL,
+		},
+		{
+			name:       "失敗: デフォルトロールが存在しない",
+			write:      true,
+			params:     CreateIdentityProviderParams{Name: "社内IdP", Issuer: issuer, Audience: "contract-pro-suite", DefaultRoleCode: "unknown"},
+			roleErr:    pgx.ErrNoRows,
+			wantReason: domain.ReasonInvalidRole,
+		},
+		{
+			name:       "失敗: 登録済みのissuer",
+			write:      true,
+			params:     CreateIdentityProviderParams{Name: "社内IdP", Issuer: issuer, Audience: "contract-pro-suite"},
+			wantReason: domain.ReasonIdentityProviderAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, userCtx, mockIdentityProviderRepo, mockClientRoleRepo := newIdentityProviderTestUsecase(t, tt.write)
+			roleCode := tt.params.DefaultRoleCode
+			if roleCode == "" {
+				roleCode = "member"
+			}
+			mockClientRoleRepo.On("GetByCode", mock.Anything, userCtx.ClientID, roleCode).Return(dbgen.ClientRole{}, tt.roleErr).Maybe()
+			mockIdentityProviderRepo.On("GetByIssuer", mock.Anything, issuer).Return(dbgen.ClientIdentityProvider{}, tt.issuerErr).Maybe()
+			if tt.wantCreate {
+				mockIdentityProviderRepo.On("Create", mock.Anything, dbgen.CreateIdentityProviderParams{
+					ClientID:        pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+					Name:            "社内IdP",
+					Issuer:          issuer,
+					Audience:        "contract-pro-suite",
+					DefaultRoleCode: "member",
+					Status:          "ACTIVE",
+				}).Return(dbgen.ClientIdentityProvider{Issuer: issuer}, nil)
+			}
+
+			provider, err := usecase.CreateIdentityProvider(context.Background(), userCtx, tt.params)
+			if tt.wantReason != "" {
+				var domainErr *domain.Error
+				require.ErrorAs(t, err, &domainErr)
+				assert.Equal(t, tt.wantReason, domainErr.Reason)
+				mockIdentityProviderRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, issuer, provider.Issuer)
+			mockIdentityProviderRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateIdentityProvider(t *testing.T) {
+	providerID := uuid.New()
+
+	t.Run("成功: 指定したフィールドのみ変更", func(t *testing.T) {
+		usecase, userCtx, mockIdentityProviderRepo, _ := newIdentityProviderTestUsecase(t, true)
+		existing := dbgen.ClientIdentityProvider{
+			ProviderID:      pgtype.UUID{Bytes: providerID, Valid: true},
+			ClientID:        pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+			Name:            "社内IdP",
+			Issuer:          "https://idp.example.com",
+			Audience:        "contract-pro-suite",
+			JwksUri:         pgtype.Text{String: "https://idp.example.com/jwks", Valid: true},
+			DefaultRoleCode: "member",
+			Status:          "ACTIVE",
+		}
+		mockIdentityProviderRepo.On("Get", mock.Anything, userCtx.ClientID, providerID).Return(existing, nil)
+		mockIdentityProviderRepo.On("Update", mock.Anything, dbgen.UpdateIdentityProviderParams{
+			ClientID:        existing.ClientID,
+			ProviderID:      existing.ProviderID,
+			Name:            "社内IdP",
+			Audience:        "contract-pro-suite",
+			JitProvisioning: true,
+			DefaultRoleCode: "member",
+			Status:          "INACTIVE",
+		}).Return(existing, nil)
+
+		jitProvisioning := true
+		status := "INACTIVE"
+		jwksURI := "" // 空文字はOpenID Connect Discoveryから取得
+		_, err := usecase.UpdateIdentityProvider(context.Background(), userCtx, providerID, UpdateIdentityProviderParams{
+			JwksURI:         &jwksURI,
+			JITProvisioning: &jitProvisioning,
+			Status:          &status,
+		})
+		require.NoError(t, err)
+		mockIdentityProviderRepo.AssertExpectations(t)
+	})
+
+	t.Run("失敗: audienceを空文字に変更", func(t *testing.T) {
+		usecase, userCtx, mockIdentityProviderRepo, _ := newIdentityProviderTestUsecase(t, true)
+		mockIdentityProviderRepo.On("Get", mock.Anything, userCtx.ClientID, providerID).Return(dbgen.ClientIdentityProvider{
+			ProviderID: pgtype.UUID{Bytes: providerID, Valid: true},
+			ClientID:   pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+			Audience:   "contract-pro-suite",
+			Status:     "ACTIVE",
+		}, nil)
+
+		audience := ""
+		_, err := usecase.UpdateIdentityProvider(context.Background(), userCtx, providerID, UpdateIdentityProviderParams{Audience: &audience})
+		var domainErr *domain.Error
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, domain.ReasonRequiredField, domainErr.Reason)
+		mockIdentityProviderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("失敗: 他のクライアントのIdP設定", func(t *testing.T) {
+		usecase, userCtx, mockIdentityProviderRepo, _ := newIdentityProviderTestUsecase(t, true)
+		mockIdentityProviderRepo.On("Get", mock.Anything, userCtx.ClientID, providerID).Return(dbgen.ClientIdentityProvider{}, pgx.ErrNoRows)
+
+		name := "変更"
+		_, err := usecase.UpdateIdentityProvider(context.Background(), userCtx, providerID, UpdateIdentityProviderParams{Name: &name})
+		assert.ErrorIs(t, err, ErrIdentityProviderNotFound)
+		mockIdentityProviderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteIdentityProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		deleted int64
+		wantErr error
+	}{
+		{name: "成功", deleted: 1},
+		{name: "失敗: 存在しない", deleted: 0, wantErr: ErrIdentityProviderNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, userCtx, mockIdentityProviderRepo, _ := newIdentityProviderTestUsecase(t, true)
+			providerID := uuid.New()
+			mockIdentityProviderRepo.On("Delete", mock.Anything, userCtx.ClientID, providerID, userCtx.UserID).Return(tt.deleted, nil)
+
+			err := usecase.DeleteIdentityProvider(context.Background(), userCtx, providerID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
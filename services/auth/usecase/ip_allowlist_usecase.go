@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrIPNotAllowed リクエスト元のIPアドレスがクライアントの許可リストに含まれない
+	ErrIPNotAllowed = errors.New("ip address not allowed")
+	// ErrIPAllowlistEntryNotFound 許可リストのエントリがクライアント内に存在しない
+	ErrIPAllowlistEntryNotFound = domain.NewError(domain.ErrorKindNotFound, domain.ReasonIPAllowlistEntryNotFound, "ip allowlist entry not found")
+	// ErrIPAllowlistEntryAlreadyExists 同じアドレス範囲が既に登録されている
+	ErrIPAllowlistEntryAlreadyExists = domain.NewError(domain.ErrorKindConflict, domain.ReasonIPAllowlistEntryAlreadyExists, "ip allowlist entry already exists")
+)
+
+// IPAllowlistUsecase クライアントのIPアドレス許可リスト管理ユースケース
+type IPAllowlistUsecase interface {
+	// ListIPAllowlistEntries 許可リスト取得（権限: system_settings:READ）
+	ListIPAllowlistEntries(ctx context.Context, userCtx *domain.UserContext) ([]dbgen.ClientIpAllowlistEntry, error)
+	// AddIPAllowlistEntry 許可リストにアドレス範囲を追加（権限: system_settings:WRITE）
+	AddIPAllowlistEntry(ctx context.Context, userCtx *domain.UserContext, cidr netip.Prefix, description *string) (dbgen.ClientIpAllowlistEntry, error)
+	// RemoveIPAllowlistEntry 許可リストからエントリを削除（権限: system_settings:WRITE）
+	RemoveIPAllowlistEntry(ctx context.Context, userCtx *domain.UserContext, entryID uuid.UUID) error
+
+	// CheckIPAllowed リクエスト元のIPアドレスがクライアントの許可リストに含まれるか確認（エントリがない場合は制限なし）
+	CheckIPAllowed(ctx context.Context, userCtx *domain.UserContext, clientID uuid.UUID, addr netip.Addr) error
+}
+
+type ipAllowlistUsecase struct {
+	authUsecase     AuthUsecase
+	ipAllowlistRepo repository.IPAllowlistRepository
+	cfg             *config.Config
+}
+
+// NewIPAllowlistUsecase IPアドレス許可リストユースケースを作成
+func NewIPAllowlistUsecase(
+	authUsecase AuthUsecase,
+	ipAllowlistRepo repository.IPAllowlistRepository,
+	cfg *config.Config,
+) IPAllowlistUsecase {
+	return &ipAllowlistUsecase{
+		authUsecase:     authUsecase,
+		ipAllowlistRepo: ipAllowlistRepo,
+		cfg:             cfg,
+	}
+}
+
+// authorize 許可リスト管理の権限チェック（クライアントアクセス + system_settings）
+func (u *ipAllowlistUsecase) authorize(ctx context.Context, userCtx *domain.UserContext, action string) error {
+	// 1. クライアントアクセス権限チェック
+	if err := u.authUsecase.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
+		return err
+	}
+
+	// 2. 権限チェック: system_settings
+	if err := u.authUsecase.CheckPermission(ctx, userCtx, "system_settings", action); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListIPAllowlistEntries 許可リスト取得
+func (u *ipAllowlistUsecase) ListIPAllowlistEntries(ctx context.Context, userCtx *domain.UserContext) ([]dbgen.ClientIpAllowlistEntry, error) {
+	if err := u.authorize(ctx, userCtx, "READ"); err != nil {
+		return nil, err
+	}
+
+	entries, err := u.ipAllowlistRepo.List(ctx, userCtx.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ip allowlist entries: %w", err)
+	}
+	return entries, nil
+}
+
+// AddIPAllowlistEntry 許可リストにアドレス範囲を追加
+func (u *ipAllowlistUsecase) AddIPAllowlistEntry(ctx context.Context, userCtx *domain.UserContext, cidr netip.Prefix, description *string) (dbgen.ClientIpAllowlistEntry, error) {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return dbgen.ClientIpAllowlistEntry{}, err
+	}
+
+	// 1. ホストビットを除いて正規化（10.1.2.3/8 → 10.0.0.0/8）
+	cidr = cidr.Masked()
+
+	// 2. 重複チェック
+	entries, err := u.ipAllowlistRepo.List(ctx, userCtx.ClientID)
+	if err != nil {
+		return dbgen.ClientIpAllowlistEntry{}, fmt.Errorf("failed to list ip allowlist entries: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.Cidr == cidr {
+			return dbgen.ClientIpAllowlistEntry{}, fmt.Errorf("%w: %s", ErrIPAllowlistEntryAlreadyExists, cidr)
+		}
+	}
+
+	// 3. 登録
+	params := dbgen.CreateIpAllowlistEntryParams{
+		EntryID:   pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		ClientID:  pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		Cidr:      cidr,
+		CreatedBy: pgtype.UUID{Bytes: userCtx.UserID, Valid: true},
+	}
+	if description != nil {
+		params.Description = pgtype.Text{String: *description, Valid: true}
+	}
+	entry, err := u.ipAllowlistRepo.Create(ctx, params)
+	if err != nil {
+		return dbgen.ClientIpAllowlistEntry{}, fmt.Errorf("failed to create ip allowlist entry: %w", err)
+	}
+	return entry, nil
+}
+
+// RemoveIPAllowlistEntry 許可リストからエントリを削除
+func (u *ipAllowlistUsecase) RemoveIPAllowlistEntry(ctx context.Context, userCtx *domain.UserContext, entryID uuid.UUID) error {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return err
+	}
+
+	deleted, err := u.ipAllowlistRepo.Delete(ctx, userCtx.ClientID, entryID)
+	if err != nil {
+		return fmt.Errorf("failed to delete ip allowlist entry: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %s", ErrIPAllowlistEntryNotFound, entryID)
+	}
+	return nil
+}
+
+// CheckIPAllowed リクエスト元のIPアドレスがクライアントの許可リストに含まれるか確認
+func (u *ipAllowlistUsecase) CheckIPAllowed(ctx context.Context, userCtx *domain.UserContext, clientID uuid.UUID, addr netip.Addr) error {
+	// オペレーターは複数クライアントを担当するため、設定により許可リストの対象外とする
+	if userCtx != nil && userCtx.UserType == domain.UserTypeOperator && u.cfg != nil && u.cfg.IPAllowlistOperatorBypass {
+		return nil
+	}
+
+	entries, err := u.ipAllowlistRepo.List(ctx, clientID)
+	if err != nil {
+		return fmt.Errorf("failed to list ip allowlist entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	// IPv4射影IPv6アドレス（::ffff:10.0.0.1）はIPv4として照合する
+	addr = addr.Unmap()
+	if addr.IsValid() {
+		for _, entry := range entries {
+			if entry.Cidr.Contains(addr) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrIPNotAllowed, addr)
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockIPAllowlistRepository モックIPアドレス許可リストリポジトリ
+type MockIPAllowlistRepository struct {
+	mock.Mock
+}
+
+func (m *MockIPAllowlistRepository) List(ctx context.Context, clientID uuid.UUID) ([]dbgen.ClientIpAllowlistEntry, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientIpAllowlistEntry), args.Error(1)
+}
+
+func (m *MockIPAllowlistRepository) Create(ctx context.Context, params dbgen.CreateIpAllowlistEntryParams) (dbgen.ClientIpAllowlistEntry, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientIpAllowlistEntry{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientIpAllowlistEntry), args.Error(1)
+}
+
+func (m *MockIPAllowlistRepository) Delete(ctx context.Context, clientID uuid.UUID, entryID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, clientID, entryID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestCheckIPAllowed(t *testing.T) {
+	clientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	clientUser := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: clientID}
+	operator := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeOperator, ClientID: clientID}
+	entries := []dbgen.ClientIpAllowlistEntry{
+		{Cidr: netip.MustParsePrefix("203.0.113.0/24")},
+		{Cidr: netip.MustParsePrefix("2001:db8::/32")},
+	}
+
+	tests := []struct {
+		name           string
+		userCtx        *domain.UserContext
+		addr           string
+		entries        []dbgen.ClientIpAllowlistEntry
+		operatorBypass bool
+		expectList     bool
+		wantErr        error
+	}{
+		{name: "成功: 許可リスト未登録", userCtx: clientUser, addr: "198.51.100.1", entries: []dbgen.ClientIpAllowlistEntry{}, expectList: true},
+		{name: "成功: 許可範囲内（IPv4）", userCtx: clientUser, addr: "203.0.113.25", entries: entries, expectList: true},
+		{name: "成功: 許可範囲内（IPv6）", userCtx: clientUser, addr: "2001:db8::1", entries: entries, expectList: true},
+		{name: "成功: IPv4射影IPv6アドレス", userCtx: clientUser, addr: "::ffff:203.0.113.25", entries: entries, expectList: true},
+		{name: "失敗: 許可範囲外", userCtx: clientUser, addr: "198.51.100.1", entries: entries, expectList: true, wantErr: ErrIPNotAllowed},
+		{name: "失敗: アドレス不明", userCtx: clientUser, addr: "", entries: entries, expectList: true, wantErr: ErrIPNotAllowed},
+		{name: "成功: オペレーターは対象外", userCtx: operator, addr: "198.51.100.1", entries: entries, operatorBypass: true},
+		{name: "失敗: オペレーターの除外を無効化", userCtx: operator, addr: "198.51.100.1", entries: entries, expectList: true, wantErr: ErrIPNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIPAllowlistRepo := new(MockIPAllowlistRepository)
+			if tt.expectList {
+				mockIPAllowlistRepo.On("List", mock.Anything, clientID).Return(tt.entries, nil)
+			}
+
+			usecase := NewIPAllowlistUsecase(nil, mockIPAllowlistRepo, &config.Config{IPAllowlistOperatorBypass: tt.operatorBypass})
+
+			var addr netip.Addr
+			if tt.addr != "" {
+				addr = netip.MustParseAddr(tt.addr)
+			}
+			err := usecase.CheckIPAllowed(context.Background(), tt.userCtx, clientID, addr)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockIPAllowlistRepo.AssertExpectations(t)
+		})
+	}
+}
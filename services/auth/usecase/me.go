@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+)
+
+// PermissionWildcard オペレーターの権限（ロールで決まり、機能を限定しない）を表すワイルドカード
+const PermissionWildcard = "*"
+
+// MeTenant GetMeで返すクライアント（テナント）情報
+type MeTenant struct {
+	ClientID  uuid.UUID `json:"client_id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	ESignMode string    `json:"e_sign_mode"`
+	Status    string    `json:"status"`
+	Role      string    `json:"role,omitempty"` // オペレーターの割り当てロール（ADMIN, OPERATOR, VIEWER）
+	ETag      string    `json:"etag"`           // クライアントのバージョン（更新・削除時に指定）
+}
+
+// MeRole GetMeで返す割り当て済みロール
+type MeRole struct {
+	RoleID uuid.UUID `json:"role_id"`
+	Code   string    `json:"code"`
+	Name   string    `json:"name"`
+}
+
+// MePermission GetMeで返す実効権限（付与されたもののみ）
+type MePermission struct {
+	Feature string `json:"feature"`
+	Action  string `json:"action"`
+}
+
+// MeResult GetMeの結果（ユーザータイプに応じてClientUser/Operator/ServiceAccountのいずれかを設定）
+type MeResult struct {
+	UserContext     *domain.UserContext
+	ClientUser      *dbgen.ClientUser
+	Operator        *dbgen.Operator
+	ServiceAccount  *dbgen.ClientServiceAccount
+	Tenant          *MeTenant      // 現在のクライアント（オペレーターで割り当てがない場合はnil）
+	Roles           []MeRole       // クライアントユーザー・サービスアカウントのロール
+	Permissions     []MePermission // 実効権限（feature, actionの順にソート）
+	AssignedClients []MeTenant     // オペレーターの割り当て済みクライアント（新しい順）
+}
+
+// GetMe 現在のユーザーのプロフィール・クライアント・ロール・実効権限を取得（SPAの初期化用）
+func (u *authUsecase) GetMe(ctx context.Context, userCtx *domain.UserContext) (*MeResult, error) {
+	switch userCtx.UserType {
+	case domain.UserTypeClientUser:
+		return u.getClientUserMe(ctx, userCtx)
+	case domain.UserTypeOperator:
+		return u.getOperatorMe(ctx, userCtx)
+	case domain.UserTypeServiceAccount:
+		return u.getServiceAccountMe(ctx, userCtx)
+	default:
+		return nil, errors.New("unknown user type")
+	}
+}
+
+// getClientUserMe クライアントユーザーのGetMe（1回のクエリで取得）
+func (u *authUsecase) getClientUserMe(ctx context.Context, userCtx *domain.UserContext) (*MeResult, error) {
+	profile, err := u.clientUserRepo.GetProfile(ctx, userCtx.ClientID, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client user profile: %w", err)
+	}
+
+	result := &MeResult{
+		UserContext: userCtx,
+		ClientUser:  &profile.ClientUser,
+		Tenant:      tenantFromClient(profile.Client),
+	}
+	if err := json.Unmarshal(profile.Roles, &result.Roles); err != nil {
+		return nil, fmt.Errorf("failed to parse roles: %w", err)
+	}
+	if err := json.Unmarshal(profile.Permissions, &result.Permissions); err != nil {
+		return nil, fmt.Errorf("failed to parse permissions: %w", err)
+	}
+	sortPermissions(result.Permissions)
+	return result, nil
+}
+
+// getOperatorMe オペレーターのGetMe（1回のクエリで取得、権限は現在のクライアントの割り当てロールで決まる）
+func (u *authUsecase) getOperatorMe(ctx context.Context, userCtx *domain.UserContext) (*MeResult, error) {
+	profile, err := u.operatorRepo.GetProfile(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator profile: %w", err)
+	}
+
+	result := &MeResult{
+		UserContext: userCtx,
+		Operator:    &profile.Operator,
+		Permissions: []MePermission{},
+	}
+	if err := json.Unmarshal(profile.AssignedClients, &result.AssignedClients); err != nil {
+		return nil, fmt.Errorf("failed to parse assigned clients: %w", err)
+	}
+	for i := range result.AssignedClients {
+		if result.AssignedClients[i].ClientID == userCtx.ClientID {
+			tenant := result.AssignedClients[i]
+			result.Tenant = &tenant
+			result.Permissions = operatorRolePermissions(tenant.Role)
+			break
+		}
+	}
+	return result, nil
+}
+
+// getServiceAccountMe サービスアカウントのGetMe
+func (u *authUsecase) getServiceAccountMe(ctx context.Context, userCtx *domain.UserContext) (*MeResult, error) {
+	account, err := u.serviceAccountRepo.GetByID(ctx, userCtx.ClientID, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	client, err := u.clientRepo.GetByID(ctx, userCtx.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+	role, err := u.clientRoleRepo.GetByID(ctx, uuidFromPGType(account.RoleID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+	permissions, err := u.clientRolePermissionRepo.GetByRoleID(ctx, uuidFromPGType(account.RoleID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+
+	result := &MeResult{
+		UserContext:    userCtx,
+		ServiceAccount: &account,
+		Tenant:         tenantFromClient(client),
+		Roles:          []MeRole{{RoleID: uuidFromPGType(role.RoleID), Code: role.Code, Name: role.Name}},
+		Permissions:    []MePermission{},
+	}
+	for _, perm := range permissions {
+		if perm.Granted {
+			result.Permissions = append(result.Permissions, MePermission{Feature: perm.Feature, Action: perm.Action})
+		}
+	}
+	sortPermissions(result.Permissions)
+	return result, nil
+}
+
+// tenantFromClient クライアントからGetMeのテナント情報を作成
+func tenantFromClient(client dbgen.Client) *MeTenant {
+	return &MeTenant{
+		ClientID:  uuidFromPGType(client.ClientID),
+		Name:      client.Name,
+		Slug:      client.Slug,
+		ESignMode: client.ESignMode,
+		Status:    client.Status,
+		ETag:      ETag(client.UpdatedAt),
+	}
+}
+
+// operatorRolePermissions オペレーターの割り当てロールに対応する権限（CheckPermissionと同じ判定）
+func operatorRolePermissions(role string) []MePermission {
+	switch domain.OperatorRole(role) {
+	case domain.OperatorRoleAdmin, domain.OperatorRoleOperator:
+		return []MePermission{{Feature: PermissionWildcard, Action: PermissionWildcard}}
+	case domain.OperatorRoleViewer:
+		return []MePermission{{Feature: PermissionWildcard, Action: "READ"}}
+	default:
+		return []MePermission{}
+	}
+}
+
+// sortPermissions 権限をfeature, actionの順にソート
+func sortPermissions(permissions []MePermission) {
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].Feature != permissions[j].Feature {
+			return permissions[i].Feature < permissions[j].Feature
+		}
+		return permissions[i].Action < permissions[j].Action
+	})
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetMe_ClientUser(t *testing.T) {
+	clientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	userID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	userCtx := &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID}
+
+	mockClientUserRepo := new(MockClientUserRepository)
+	mockClientUserRepo.On("GetProfile", mock.Anything, clientID, userID).Return(dbgen.GetClientUserProfileRow{
+		ClientUser: dbgen.ClientUser{ClientUserID: pgtype.UUID{Bytes: userID, Valid: true}, Email: "user@example.com"},
+		Client: dbgen.Client{
+			ClientID:  pgtype.UUID{Bytes: clientID, Valid: true},
+			Name:      "Test Company",
+			Slug:      "test-company",
+			ESignMode: "WITNESS_OTP",
+			Status:    "ACTIVE",
+		},
+		Roles:       []byte(`[{"role_id":"123e4567-e89b-12d3-a456-426614174002","code":"ADMIN","name":"管理者"}]`),
+		Permissions: []byte(`[{"feature":"users","action":"WRITE"},{"feature":"contracts","action":"READ"},{"feature":"users","action":"READ"}]`),
+	}, nil)
+
+	usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+	me, err := usecase.GetMe(context.Background(), userCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", me.ClientUser.Email)
+	assert.Equal(t, &MeTenant{ClientID: clientID, Name: "Test Company", Slug: "test-company", ESignMode: "WITNESS_OTP", Status: "ACTIVE"}, me.Tenant)
+	assert.Equal(t, []MeRole{{RoleID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"), Code: "ADMIN", Name: "管理者"}}, me.Roles)
+	// feature, actionの順にソートされる
+	assert.Equal(t, []MePermission{
+		{Feature: "contracts", Action: "READ"},
+		{Feature: "users", Action: "READ"},
+		{Feature: "users", Action: "WRITE"},
+	}, me.Permissions)
+	mockClientUserRepo.AssertExpectations(t)
+}
+
+func TestGetMe_Operator(t *testing.T) {
+	operatorID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	currentClientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	assignedClients := []byte(`[
+		{"client_id":"123e4567-e89b-12d3-a456-426614174001","name":"A","slug":"a","e_sign_mode":"WITNESS_OTP","status":"ACTIVE","role":"VIEWER"},
+		{"client_id":"123e4567-e89b-12d3-a456-426614174002","name":"B","slug":"b","e_sign_mode":"OTP_ONLY","status":"ACTIVE","role":"ADMIN"}
+	]`)
+
+	tests := []struct {
+		name            string
+		clientID        uuid.UUID
+		wantTenantSlug  string
+		wantPermissions []MePermission
+	}{
+		{
+			name:            "現在のクライアントの割り当てロールで権限が決まる",
+			clientID:        currentClientID,
+			wantTenantSlug:  "a",
+			wantPermissions: []MePermission{{Feature: PermissionWildcard, Action: "READ"}},
+		},
+		{
+			name:            "割り当てなし",
+			clientID:        uuid.Nil,
+			wantPermissions: []MePermission{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOperatorRepo := new(MockOperatorRepository)
+			mockOperatorRepo.On("GetProfile", mock.Anything, operatorID).Return(dbgen.GetOperatorProfileRow{
+				Operator:        dbgen.Operator{OperatorID: pgtype.UUID{Bytes: operatorID, Valid: true}, Email: "operator@example.com"},
+				AssignedClients: assignedClients,
+			}, nil)
+
+			usecase := &authUsecase{operatorRepo: mockOperatorRepo}
+			me, err := usecase.GetMe(context.Background(), &domain.UserContext{UserID: operatorID, UserType: domain.UserTypeOperator, ClientID: tt.clientID})
+			assert.NoError(t, err)
+			assert.Equal(t, "operator@example.com", me.Operator.Email)
+			assert.Len(t, me.AssignedClients, 2)
+			if tt.wantTenantSlug != "" {
+				assert.Equal(t, tt.wantTenantSlug, me.Tenant.Slug)
+			} else {
+				assert.Nil(t, me.Tenant)
+			}
+			assert.Equal(t, tt.wantPermissions, me.Permissions)
+			mockOperatorRepo.AssertExpectations(t)
+		})
+	}
+}
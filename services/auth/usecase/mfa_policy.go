@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"contract-pro-suite/services/auth/domain"
+
+	"github.com/google/uuid"
+)
+
+// MFARequiredAAL MFA完了とみなす認証保証レベル
+const MFARequiredAAL = "aal2"
+
+// ErrMFARequired MFAが必要なユーザーがMFA未完了のトークンでアクセスした
+var ErrMFARequired = errors.New("mfa required")
+
+// authenticationFactors 認証方式（amr、RFC 8176）ごとの要素の種類
+// aalクレームがない場合は、amrにmfaを含むか、2種類以上の要素（知識・所持・生体）を含む場合にMFA完了とみなす
+// otp・sms等の単独では、パスワードレスの1要素認証と区別できないためMFAとみなさない
+var authenticationFactors = map[string]string{
+	"pwd":    "knowledge",
+	"pin":    "knowledge",
+	"kba":    "knowledge",
+	"otp":    "possession",
+	"hwk":    "possession",
+	"swk":    "possession",
+	"sms":    "possession",
+	"tel":    "possession",
+	"sc":     "possession",
+	"fpt":    "inherence",
+	"face":   "inherence",
+	"iris":   "inherence",
+	"retina": "inherence",
+	"vbm":    "inherence",
+}
+
+// MFAPolicy クライアントのMFAポリシー（clients.settingsのmfaキー）
+//
+//	{"mfa": {"required": false, "roles": {"system_admin": true}}}
+type MFAPolicy struct {
+	Required bool            `json:"required"`        // クライアント全体でMFAを必須にするか
+	Roles    map[string]bool `json:"roles,omitempty"` // ロールコードごとの上書き（trueは必須、falseは免除）
+}
+
+// parseMFAPolicy クライアント設定からMFAポリシーを取得（未設定の場合はMFA不要）
+func parseMFAPolicy(settings []byte) (MFAPolicy, error) {
+	var parsed struct {
+		MFA MFAPolicy `json:"mfa"`
+	}
+	if len(settings) == 0 {
+		return parsed.MFA, nil
+	}
+	if err := json.Unmarshal(settings, &parsed); err != nil {
+		return MFAPolicy{}, fmt.Errorf("failed to parse mfa policy: %w", err)
+	}
+	return parsed.MFA, nil
+}
+
+// RequiresMFA 指定したロールを持つユーザーにMFAが必要か判定
+// いずれかのロールが必須ならMFA必須、すべてのロールが免除ならMFA不要、それ以外はクライアント全体の設定に従う
+func (p MFAPolicy) RequiresMFA(roleCodes []string) bool {
+	exempt := len(roleCodes) > 0
+	for _, code := range roleCodes {
+		required, ok := p.Roles[code]
+		if !ok {
+			exempt = false
+			continue
+		}
+		if required {
+			return true
+		}
+	}
+	if exempt {
+		return false
+	}
+	return p.Required
+}
+
+// isMFAVerified トークンがMFA完了後に発行されたか判定
+// aalクレームがある場合（Supabase Auth）はaal2以上、ない場合（外部IdP）はamrで判定する
+func isMFAVerified(token domain.TokenMetadata) bool {
+	switch token.AuthenticatorAssuranceLevel {
+	case "aal2", "aal3":
+		return true
+	case "":
+		if slices.Contains(token.AuthenticationMethods, "mfa") {
+			return true
+		}
+		factors := map[string]bool{}
+		for _, method := range token.AuthenticationMethods {
+			if factor, ok := authenticationFactors[method]; ok {
+				factors[factor] = true
+			}
+		}
+		return len(factors) >= 2
+	default:
+		return false
+	}
+}
+
+// CheckMFA MFAポリシーを満たしているか確認
+func (u *authUsecase) CheckMFA(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	if isMFAVerified(token) {
+		return nil
+	}
+
+	required, err := u.isMFARequired(ctx, userCtx)
+	if err != nil {
+		return err
+	}
+	if required {
+		return ErrMFARequired
+	}
+	return nil
+}
+
+// isMFARequired ユーザーにMFAが必要か判定
+func (u *authUsecase) isMFARequired(ctx context.Context, userCtx *domain.UserContext) (bool, error) {
+	switch userCtx.UserType {
+	case domain.UserTypeOperator:
+		// mfa_enabledのオペレーターは常にMFA必須
+		operator, err := u.operatorRepo.GetByID(ctx, userCtx.UserID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get operator: %w", err)
+		}
+		if operator.MfaEnabled {
+			return true, nil
+		}
+		// 担当クライアントがMFA必須の場合はオペレーターにも適用（ロールによる上書きはクライアントユーザーのみ）
+		if userCtx.ClientID == uuid.Nil {
+			return false, nil
+		}
+		policy, err := u.getMFAPolicy(ctx, userCtx.ClientID)
+		if err != nil {
+			return false, err
+		}
+		return policy.Required, nil
+	case domain.UserTypeClientUser:
+		policy, err := u.getMFAPolicy(ctx, userCtx.ClientID)
+		if err != nil {
+			return false, err
+		}
+		if !policy.Required && len(policy.Roles) == 0 {
+			return false, nil
+		}
+		roleCodes, err := u.getRoleCodes(ctx, userCtx.ClientID, userCtx.UserID)
+		if err != nil {
+			return false, err
+		}
+		return policy.RequiresMFA(roleCodes), nil
+	default:
+		// サービスアカウントはAPIキーで認証するためMFAの対象外
+		return false, nil
+	}
+}
+
+// getMFAPolicy クライアントのMFAポリシーを取得
+func (u *authUsecase) getMFAPolicy(ctx context.Context, clientID uuid.UUID) (MFAPolicy, error) {
+	client, err := u.clientRepo.GetByID(ctx, clientID)
+	if err != nil {
+		return MFAPolicy{}, fmt.Errorf("failed to get client: %w", err)
+	}
+	return parseMFAPolicy(client.Settings)
+}
+
+// getRoleCodes クライアントユーザーに割り当てられたロールのコードを取得
+func (u *authUsecase) getRoleCodes(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID) ([]string, error) {
+	userRoles, err := u.clientUserRoleRepo.GetByUserID(ctx, clientID, clientUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+	roleCodes := make([]string, 0, len(userRoles))
+	for _, userRole := range userRoles {
+		role, err := u.clientRoleRepo.GetByID(ctx, uuidFromPGType(userRole.RoleID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role: %w", err)
+		}
+		roleCodes = append(roleCodes, role.Code)
+	}
+	return roleCodes, nil
+}
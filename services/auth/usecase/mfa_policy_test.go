@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMFAPolicy_RequiresMFA(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    MFAPolicy
+		roleCodes []string
+		want      bool
+	}{
+		{name: "ポリシー未設定", policy: MFAPolicy{}, roleCodes: []string{"viewer"}, want: false},
+		{name: "クライアント全体で必須", policy: MFAPolicy{Required: true}, roleCodes: []string{"viewer"}, want: true},
+		{name: "ロールで必須", policy: MFAPolicy{Roles: map[string]bool{"system_admin": true}}, roleCodes: []string{"viewer", "system_admin"}, want: true},
+		{name: "対象外のロール", policy: MFAPolicy{Roles: map[string]bool{"system_admin": true}}, roleCodes: []string{"viewer"}, want: false},
+		{name: "すべてのロールが免除", policy: MFAPolicy{Required: true, Roles: map[string]bool{"viewer": false}}, roleCodes: []string{"viewer"}, want: false},
+		{name: "一部のロールのみ免除", policy: MFAPolicy{Required: true, Roles: map[string]bool{"viewer": false}}, roleCodes: []string{"viewer", "business_admin"}, want: true},
+		{name: "ロールなし", policy: MFAPolicy{Required: true, Roles: map[string]bool{"viewer": false}}, roleCodes: nil, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.RequiresMFA(tt.roleCodes))
+		})
+	}
+}
+
+func TestIsMFAVerified(t *testing.T) {
+	tests := []struct {
+		name  string
+		token domain.TokenMetadata
+		want  bool
+	}{
+		// Supabase Authはaalで判定
+		{name: "aal2", token: domain.TokenMetadata{AuthenticatorAssuranceLevel: "aal2"}, want: true},
+		{name: "aal1（amrは参照しない）", token: domain.TokenMetadata{AuthenticatorAssuranceLevel: "aal1", AuthenticationMethods: []string{"pwd", "otp"}}, want: false},
+		// 外部IdPはamrで判定
+		{name: "amrにmfa", token: domain.TokenMetadata{AuthenticationMethods: []string{"pwd", "mfa"}}, want: true},
+		{name: "パスワードとOTP", token: domain.TokenMetadata{AuthenticationMethods: []string{"pwd", "otp"}}, want: true},
+		{name: "ハードウェアキーと生体認証", token: domain.TokenMetadata{AuthenticationMethods: []string{"hwk", "fpt"}}, want: true},
+		{name: "パスワードのみ", token: domain.TokenMetadata{AuthenticationMethods: []string{"pwd"}}, want: false},
+		{name: "OTPのみ", token: domain.TokenMetadata{AuthenticationMethods: []string{"otp"}}, want: false},
+		{name: "SMSのみ", token: domain.TokenMetadata{AuthenticationMethods: []string{"sms"}}, want: false},
+		{name: "電話のみ", token: domain.TokenMetadata{AuthenticationMethods: []string{"tel"}}, want: false},
+		{name: "ハードウェアキーのみ", token: domain.TokenMetadata{AuthenticationMethods: []string{"hwk"}}, want: false},
+		{name: "同じ種類の要素の組み合わせ", token: domain.TokenMetadata{AuthenticationMethods: []string{"otp", "sms", "hwk"}}, want: false},
+		{name: "amrなし", token: domain.TokenMetadata{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isMFAVerified(tt.token))
+		})
+	}
+}
+
+func TestCheckMFA(t *testing.T) {
+	clientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	userID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	roleID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	aal1 := domain.TokenMetadata{AuthenticatorAssuranceLevel: "aal1"}
+
+	tests := []struct {
+		name      string
+		userCtx   *domain.UserContext
+		token     domain.TokenMetadata
+		setupMock func(*MockOperatorRepository, *MockClientRepository, *MockClientUserRoleRepository, *MockClientRoleRepository)
+		wantErr   error
+	}{
+		{
+			name:    "成功: MFA完了済みのトークン",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			token:   domain.TokenMetadata{AuthenticatorAssuranceLevel: "aal2"},
+			setupMock: func(*MockOperatorRepository, *MockClientRepository, *MockClientUserRoleRepository, *MockClientRoleRepository) {
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "失敗: system_adminロールにMFA必須",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			token:   aal1,
+			setupMock: func(_ *MockOperatorRepository, clientRepo *MockClientRepository, userRoleRepo *MockClientUserRoleRepository, roleRepo *MockClientRoleRepository) {
+				clientRepo.On("GetByID", mock.Anything, clientID).Return(dbgen.Client{Settings: []byte(`{"mfa":{"roles":{"system_admin":true}}}`)}, nil)
+				userRoleRepo.On("GetByUserID", mock.Anything, clientID, userID).Return([]dbgen.ClientUserRole{{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}}}, nil)
+				roleRepo.On("GetByID", mock.Anything, roleID).Return(dbgen.ClientRole{Code: "system_admin"}, nil)
+			},
+			wantErr: ErrMFARequired,
+		},
+		{
+			name:    "成功: MFAポリシー未設定のクライアント",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			token:   aal1,
+			setupMock: func(_ *MockOperatorRepository, clientRepo *MockClientRepository, _ *MockClientUserRoleRepository, _ *MockClientRoleRepository) {
+				clientRepo.On("GetByID", mock.Anything, clientID).Return(dbgen.Client{Settings: []byte(`{}`)}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "失敗: mfa_enabledのオペレーター",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeOperator, ClientID: clientID},
+			token:   aal1,
+			setupMock: func(operatorRepo *MockOperatorRepository, _ *MockClientRepository, _ *MockClientUserRoleRepository, _ *MockClientRoleRepository) {
+				operatorRepo.On("GetByID", mock.Anything, userID).Return(dbgen.Operator{MfaEnabled: true}, nil)
+			},
+			wantErr: ErrMFARequired,
+		},
+		{
+			name:    "成功: サービスアカウントは対象外",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeServiceAccount, ClientID: clientID},
+			token:   domain.TokenMetadata{},
+			setupMock: func(*MockOperatorRepository, *MockClientRepository, *MockClientUserRoleRepository, *MockClientRoleRepository) {
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOperatorRepo := new(MockOperatorRepository)
+			mockClientRepo := new(MockClientRepository)
+			mockClientUserRoleRepo := new(MockClientUserRoleRepository)
+			mockClientRoleRepo := new(MockClientRoleRepository)
+			tt.setupMock(mockOperatorRepo, mockClientRepo, mockClientUserRoleRepo, mockClientRoleRepo)
+
+			usecase := &authUsecase{
+				operatorRepo:       mockOperatorRepo,
+				clientRepo:         mockClientRepo,
+				clientUserRoleRepo: mockClientUserRoleRepo,
+				clientRoleRepo:     mockClientRoleRepo,
+			}
+
+			err := usecase.CheckMFA(context.Background(), tt.userCtx, tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockOperatorRepo.AssertExpectations(t)
+			mockClientRepo.AssertExpectations(t)
+			mockClientUserRoleRepo.AssertExpectations(t)
+			mockClientRoleRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+
+	"github.com/google/uuid"
+)
+
+// 一覧取得の取得件数（List系RPC共通）
+const (
+	DefaultPageSize int32 = 50
+	MaxPageSize     int32 = 100
+)
+
+// ErrInvalidPageToken ページトークンの形式が不正
+var ErrInvalidPageToken = domain.NewValidationError(domain.ReasonInvalidPageToken, "page_token", "invalid page token")
+
+// PageRequest 一覧取得のページ指定（List系RPC共通）
+// PageTokenを指定した場合はキーセットページネーション、Offsetは互換性のためにのみ残している
+type PageRequest struct {
+	PageSize  int32  // 取得件数（0以下の場合はDefaultPageSize、最大MaxPageSize）
+	PageToken string // 前のページのNextPageToken（空の場合は先頭から）
+	Offset    int32  // 非推奨: PageToken未指定の場合のみ使用
+}
+
+// Page 一覧取得の結果（List系RPC共通）
+type Page[T any] struct {
+	Items         []T
+	TotalCount    int64  // 条件に一致する総件数
+	NextPageToken string // 次のページのトークン（最後のページの場合は空）
+}
+
+// pageTokenPayload ページトークンの内容（クライアントには不透明な文字列として返す）
+// Scopeは検索条件・並び順のハッシュで、条件を変えて使い回したトークンを拒否するために使う
+type pageTokenPayload struct {
+	Time  time.Time `json:"c,omitempty"`
+	Text  string    `json:"k,omitempty"`
+	ID    uuid.UUID `json:"i"`
+	Scope string    `json:"s,omitempty"`
+}
+
+// size 取得件数を正規化
+func (r PageRequest) size() int32 {
+	if r.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	if r.PageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return r.PageSize
+}
+
+// cursor ページトークンをカーソルに変換（未指定の場合はnil、scopeが一致しない場合はErrInvalidPageToken）
+func (r PageRequest) cursor(scope string) (*repository.KeysetCursor, error) {
+	if r.PageToken == "" {
+		return nil, nil
+	}
+	return decodePageToken(r.PageToken, scope)
+}
+
+// offset オフセットを正規化（PageToken指定時は使用しない）
+func (r PageRequest) offset() int32 {
+	if r.PageToken != "" || r.Offset < 0 {
+		return 0
+	}
+	return r.Offset
+}
+
+// pageScope 検索条件・並び順からページトークンのスコープを作成（条件がすべて空の場合は空文字）
+func pageScope(parts ...string) string {
+	if strings.Join(parts, "") == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return base64.RawURLEncoding.EncodeToString(sum[:9])
+}
+
+// encodePageToken カーソルをページトークンに変換
+func encodePageToken(cursor repository.KeysetCursor, scope string) string {
+	payload, _ := json.Marshal(pageTokenPayload{Time: cursor.Time, Text: cursor.Text, ID: cursor.ID, Scope: scope})
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// decodePageToken ページトークンをカーソルに変換
+func decodePageToken(token string, scope string) (*repository.KeysetCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var payload pageTokenPayload
+	if err := json.Unmarshal(raw, &payload); err != nil || payload.ID == uuid.Nil || payload.Scope != scope {
+		return nil, ErrInvalidPageToken
+	}
+	return &repository.KeysetCursor{Time: payload.Time, Text: payload.Text, ID: payload.ID}, nil
+}
+
+// newPage 一覧取得の結果を作成
+// itemsはsize+1件まで取得したもので、size件を超える場合は次のページがあると判定する
+func newPage[T any](items []T, size int32, total int64, scope string, cursorOf func(T) repository.KeysetCursor) Page[T] {
+	page := Page[T]{Items: items, TotalCount: total}
+	if int32(len(items)) > size {
+		page.Items = items[:size]
+		page.NextPageToken = encodePageToken(cursorOf(page.Items[size-1]), scope)
+	}
+	if page.Items == nil {
+		page.Items = []T{}
+	}
+	return page
+}
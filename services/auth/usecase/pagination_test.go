@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"contract-pro-suite/services/auth/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageRequest_Size(t *testing.T) {
+	assert.Equal(t, DefaultPageSize, PageRequest{}.size())
+	assert.Equal(t, int32(20), PageRequest{PageSize: 20}.size())
+	assert.Equal(t, MaxPageSize, PageRequest{PageSize: 1000}.size())
+}
+
+func TestPageToken(t *testing.T) {
+	// マイクロ秒精度（timestamptz）のカーソルが往復で保たれる
+	cursor := repository.KeysetCursor{Time: time.Date(2026, 1, 2, 3, 4, 5, 678901000, time.UTC), ID: uuid.New()}
+	decoded, err := decodePageToken(encodePageToken(cursor, ""), "")
+	assert.NoError(t, err)
+	assert.True(t, cursor.Time.Equal(decoded.Time))
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	for _, token := range []string{"%%%", "e30", "bm90LWpzb24"} {
+		_, err := decodePageToken(token, "")
+		assert.ErrorIs(t, err, ErrInvalidPageToken, token)
+	}
+
+	// 文字列の並び替えキーも往復で保たれ、スコープが異なるトークンは拒否する
+	scope := pageScope("%yamada%", "name", "asc")
+	textCursor := repository.KeysetCursor{Text: "山田 太郎", ID: uuid.New()}
+	decoded, err = decodePageToken(encodePageToken(textCursor, scope), scope)
+	assert.NoError(t, err)
+	assert.Equal(t, textCursor.Text, decoded.Text)
+	_, err = decodePageToken(encodePageToken(textCursor, scope), pageScope("%tanaka%", "name", "asc"))
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+	_, err = decodePageToken(encodePageToken(textCursor, scope), "")
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+	// ページトークン指定時はオフセットを無視
+	assert.Equal(t, int32(0), PageRequest{Offset: 10, PageToken: "token"}.offset())
+	assert.Equal(t, int32(10), PageRequest{Offset: 10}.offset())
+}
+
+func TestNewPage(t *testing.T) {
+	cursorOf := func(id uuid.UUID) repository.KeysetCursor {
+		return repository.KeysetCursor{Time: time.Unix(1, 0), ID: id}
+	}
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	page := newPage(ids, 2, 10, "", cursorOf)
+	assert.Equal(t, ids[:2], page.Items)
+	assert.NotEmpty(t, page.NextPageToken)
+
+	page = newPage(ids, 3, 3, "", cursorOf)
+	assert.Len(t, page.Items, 3)
+	assert.Empty(t, page.NextPageToken)
+
+	page = newPage([]uuid.UUID(nil), 3, 0, "", cursorOf)
+	assert.NotNil(t, page.Items)
+}
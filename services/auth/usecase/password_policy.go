@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/pwned"
+	"contract-pro-suite/services/auth/domain"
+
+	"github.com/google/uuid"
+)
+
+// ErrPasswordPolicyViolation パスワードがポリシーを満たしていない（詳細はPasswordPolicyError）
+var ErrPasswordPolicyViolation = domain.NewError(domain.ErrorKindValidation, domain.ReasonPasswordPolicyViolation, "password does not satisfy policy")
+
+// passwordMaxBytes パスワードの最大バイト数（Supabase Authのbcryptが扱える上限）
+const passwordMaxBytes = 72
+
+// personalInfoMinLength パスワードに含めてはならない個人情報（メールアドレスのローカル部・氏名）の最小文字数
+const personalInfoMinLength = 3
+
+// パスワードポリシー違反の種類
+const (
+	PasswordViolationTooShort         = "TOO_SHORT"
+	PasswordViolationTooLong          = "TOO_LONG"
+	PasswordViolationMissingUppercase = "MISSING_UPPERCASE"
+	PasswordViolationMissingLowercase = "MISSING_LOWERCASE"
+	PasswordViolationMissingDigit     = "MISSING_DIGIT"
+	PasswordViolationMissingSymbol    = "MISSING_SYMBOL"
+	PasswordViolationPersonalInfo     = "CONTAINS_PERSONAL_INFO"
+	PasswordViolationBreached         = "BREACHED"
+)
+
+// PasswordViolation パスワードポリシー違反
+type PasswordViolation struct {
+	Code        string
+	Description string
+}
+
+// PasswordPolicyError パスワードポリシー違反の一覧（errors.Is(err, ErrPasswordPolicyViolation)で判定可能）
+type PasswordPolicyError struct {
+	Field      string // 違反したリクエストのフィールド名（未設定の場合は"password"）
+	Violations []PasswordViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	codes := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		codes = append(codes, violation.Code)
+	}
+	return fmt.Sprintf("%s: %s", ErrPasswordPolicyViolation, strings.Join(codes, ", "))
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrPasswordPolicyViolation
+}
+
+// FieldViolations 違反の種類ごとにフィールド単位の違反として返す
+func (e *PasswordPolicyError) FieldViolations() []domain.FieldViolation {
+	field := e.Field
+	if field == "" {
+		field = "password"
+	}
+	violations := make([]domain.FieldViolation, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		violations = append(violations, domain.FieldViolation{Field: field, Reason: violation.Code, Description: violation.Description})
+	}
+	return violations
+}
+
+// withPasswordField パスワードポリシー違反に違反したリクエストのフィールド名を設定
+func withPasswordField(err error, field string) error {
+	var policyErr *PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		policyErr.Field = field
+	}
+	return err
+}
+
+// PasswordPolicy パスワードポリシー（clients.settingsのpassword_policyキーで上書き）
+//
+//	{"password_policy": {"min_length": 16, "require_symbol": true}}
+type PasswordPolicy struct {
+	MinLength        int  `json:"min_length,omitempty"`
+	RequireUppercase bool `json:"require_uppercase,omitempty"`
+	RequireLowercase bool `json:"require_lowercase,omitempty"`
+	RequireDigit     bool `json:"require_digit,omitempty"`
+	RequireSymbol    bool `json:"require_symbol,omitempty"`
+}
+
+// defaultPasswordPolicy 環境変数で設定したサービス全体のパスワードポリシー
+func defaultPasswordPolicy(cfg *config.Config) PasswordPolicy {
+	if cfg == nil {
+		return PasswordPolicy{}
+	}
+	return PasswordPolicy{
+		MinLength:        cfg.PasswordMinLength,
+		RequireUppercase: cfg.PasswordRequireUppercase,
+		RequireLowercase: cfg.PasswordRequireLowercase,
+		RequireDigit:     cfg.PasswordRequireDigit,
+		RequireSymbol:    cfg.PasswordRequireSymbol,
+	}
+}
+
+// parsePasswordPolicy クライアント設定からパスワードポリシーの上書きを取得
+func parsePasswordPolicy(settings []byte) (PasswordPolicy, error) {
+	var parsed struct {
+		PasswordPolicy PasswordPolicy `json:"password_policy"`
+	}
+	if len(settings) == 0 {
+		return parsed.PasswordPolicy, nil
+	}
+	if err := json.Unmarshal(settings, &parsed); err != nil {
+		return PasswordPolicy{}, fmt.Errorf("failed to parse password policy: %w", err)
+	}
+	return parsed.PasswordPolicy, nil
+}
+
+// Merge クライアントの上書きを適用（サービス全体の設定より緩和はできない）
+func (p PasswordPolicy) Merge(override PasswordPolicy) PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:        max(p.MinLength, override.MinLength),
+		RequireUppercase: p.RequireUppercase || override.RequireUppercase,
+		RequireLowercase: p.RequireLowercase || override.RequireLowercase,
+		RequireDigit:     p.RequireDigit || override.RequireDigit,
+		RequireSymbol:    p.RequireSymbol || override.RequireSymbol,
+	}
+}
+
+// PasswordCandidate 検証対象のパスワードとユーザー情報（個人情報を含むパスワードの判定用）
+type PasswordCandidate struct {
+	Password  string
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+// Check パスワードがポリシーを満たしているか確認（漏洩チェックを除く）
+func (p PasswordPolicy) Check(candidate PasswordCandidate) []PasswordViolation {
+	var violations []PasswordViolation
+	password := candidate.Password
+
+	if length := utf8.RuneCountInString(password); length < p.MinLength {
+		violations = append(violations, PasswordViolation{
+			Code:        PasswordViolationTooShort,
+			Description: fmt.Sprintf("must be at least %d characters", p.MinLength),
+		})
+	}
+	if len(password) > passwordMaxBytes {
+		violations = append(violations, PasswordViolation{
+			Code:        PasswordViolationTooLong,
+			Description: fmt.Sprintf("must be at most %d bytes", passwordMaxBytes),
+		})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationMissingUppercase, Description: "must contain an uppercase letter"})
+	}
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationMissingLowercase, Description: "must contain a lowercase letter"})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationMissingDigit, Description: "must contain a digit"})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationMissingSymbol, Description: "must contain a symbol"})
+	}
+
+	if containsPersonalInfo(candidate) {
+		violations = append(violations, PasswordViolation{Code: PasswordViolationPersonalInfo, Description: "must not contain the email address or name"})
+	}
+	return violations
+}
+
+// containsPersonalInfo パスワードにメールアドレスのローカル部・氏名が含まれるか判定（大文字小文字を区別しない）
+func containsPersonalInfo(candidate PasswordCandidate) bool {
+	password := strings.ToLower(candidate.Password)
+	localPart, _, _ := strings.Cut(candidate.Email, "@")
+	for _, value := range []string{localPart, candidate.FirstName, candidate.LastName} {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if utf8.RuneCountInString(value) >= personalInfoMinLength && strings.Contains(password, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// BreachedPasswordChecker 漏洩済みパスワードの判定
+type BreachedPasswordChecker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+// NewBreachedPasswordChecker 漏洩済みパスワードの判定を作成（PASSWORD_BREACHED_HASHES_FILE未設定の場合はnilを返し、判定を行わない）
+func NewBreachedPasswordChecker(cfg *config.Config) (BreachedPasswordChecker, error) {
+	if cfg == nil || cfg.PasswordBreachedHashesFile == "" {
+		return nil, nil
+	}
+	list, err := pwned.LoadHashList(cfg.PasswordBreachedHashesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load breached password list: %w", err)
+	}
+	return list, nil
+}
+
+// validatePassword パスワードポリシーを確認（clientIDがuuid.Nilの場合はサービス全体の設定のみ）
+// Supabase Authにパスワードを送信する前に呼び出す
+func (u *authUsecase) validatePassword(ctx context.Context, clientID uuid.UUID, candidate PasswordCandidate) error {
+	policy := defaultPasswordPolicy(u.cfg)
+	if clientID != uuid.Nil {
+		client, err := u.clientRepo.GetByID(ctx, clientID)
+		if err != nil {
+			return fmt.Errorf("failed to get client: %w", err)
+		}
+		override, err := parsePasswordPolicy(client.Settings)
+		if err != nil {
+			return err
+		}
+		policy = policy.Merge(override)
+	}
+
+	violations := policy.Check(candidate)
+	if u.breachedPasswordChecker != nil {
+		breached, err := u.breachedPasswordChecker.IsBreached(ctx, candidate.Password)
+		if err != nil {
+			return fmt.Errorf("failed to check breached password: %w", err)
+		}
+		if breached {
+			violations = append(violations, PasswordViolation{Code: PasswordViolationBreached, Description: "has appeared in a data breach"})
+		}
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"contract-pro-suite/internal/shared/config"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// stubBreachedPasswordChecker 指定したパスワードのみ漏洩済みと判定
+type stubBreachedPasswordChecker struct {
+	breached map[string]bool
+	err      error
+}
+
+func (c *stubBreachedPasswordChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	return c.breached[password], c.err
+}
+
+func violationCodes(violations []PasswordViolation) []string {
+	codes := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		codes = append(codes, violation.Code)
+	}
+	return codes
+}
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	policy := PasswordPolicy{MinLength: 12, RequireUppercase: true, RequireLowercase: true, RequireDigit: true}
+	base := PasswordCandidate{Email: "taro.yamada@example.com", FirstName: "Taro", LastName: "Yamada"}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		want     []string
+	}{
+		{name: "成功", policy: policy, password: "Kx7mQ2vLp9wZ", want: []string{}},
+		{name: "短すぎる", policy: policy, password: "Kx7mQ2", want: []string{PasswordViolationTooShort}},
+		{name: "文字種不足", policy: policy, password: "kx7mq2vlp9wz", want: []string{PasswordViolationMissingUppercase}},
+		{name: "記号必須", policy: PasswordPolicy{MinLength: 12, RequireSymbol: true}, password: "Kx7mQ2vLp9wZ", want: []string{PasswordViolationMissingSymbol}},
+		{name: "長すぎる（72バイト超）", policy: policy, password: "Kx7mQ2vLp9wZ" + string(make([]byte, 61)), want: []string{PasswordViolationTooLong}},
+		{name: "氏名を含む", policy: policy, password: "Yamada2024Pass", want: []string{PasswordViolationPersonalInfo}},
+		{name: "メールアドレスのローカル部を含む", policy: policy, password: "TARO.YAMADA99x", want: []string{PasswordViolationPersonalInfo}},
+		{name: "複数違反", policy: policy, password: "short", want: []string{PasswordViolationTooShort, PasswordViolationMissingUppercase, PasswordViolationMissingDigit}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidate := base
+			candidate.Password = tt.password
+			assert.Equal(t, tt.want, violationCodes(tt.policy.Check(candidate)))
+		})
+	}
+}
+
+func TestPasswordPolicy_Merge(t *testing.T) {
+	global := PasswordPolicy{MinLength: 12, RequireUppercase: true, RequireDigit: true}
+
+	// クライアント設定では強化のみ可能（短い最小文字数・falseの指定は無視）
+	merged := global.Merge(PasswordPolicy{MinLength: 8, RequireSymbol: true})
+	assert.Equal(t, PasswordPolicy{MinLength: 12, RequireUppercase: true, RequireDigit: true, RequireSymbol: true}, merged)
+
+	merged = global.Merge(PasswordPolicy{MinLength: 16})
+	assert.Equal(t, 16, merged.MinLength)
+}
+
+func TestValidatePassword(t *testing.T) {
+	clientID := uuid.New()
+	cfg := &config.Config{PasswordMinLength: 12, PasswordRequireUppercase: true, PasswordRequireLowercase: true, PasswordRequireDigit: true}
+	candidate := PasswordCandidate{Password: "Kx7mQ2vLp9wZ", Email: "admin@example.com", FirstName: "Admin", LastName: "User"}
+
+	tests := []struct {
+		name      string
+		clientID  uuid.UUID
+		settings  string
+		checker   BreachedPasswordChecker
+		wantCodes []string
+		wantErr   bool
+	}{
+		{
+			name:     "成功: サービス全体の設定のみ（サインアップ）",
+			clientID: uuid.Nil,
+		},
+		{
+			name:      "失敗: クライアント設定で記号必須",
+			clientID:  clientID,
+			settings:  `{"password_policy":{"require_symbol":true}}`,
+			wantCodes: []string{PasswordViolationMissingSymbol},
+		},
+		{
+			name:      "失敗: 漏洩済みパスワード",
+			clientID:  clientID,
+			settings:  `{}`,
+			checker:   &stubBreachedPasswordChecker{breached: map[string]bool{"Kx7mQ2vLp9wZ": true}},
+			wantCodes: []string{PasswordViolationBreached},
+		},
+		{
+			name:     "失敗: 漏洩チェックのエラー",
+			clientID: uuid.Nil,
+			checker:  &stubBreachedPasswordChecker{err: errors.New("unavailable")},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClientRepo := new(MockClientRepository)
+			if tt.clientID != uuid.Nil {
+				mockClientRepo.On("GetByID", mock.Anything, tt.clientID).Return(dbgen.Client{Settings: []byte(tt.settings)}, nil)
+			}
+			usecase := &authUsecase{clientRepo: mockClientRepo, breachedPasswordChecker: tt.checker, cfg: cfg}
+
+			err := usecase.validatePassword(context.Background(), tt.clientID, candidate)
+			switch {
+			case tt.wantErr:
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, ErrPasswordPolicyViolation)
+			case tt.wantCodes != nil:
+				var policyErr *PasswordPolicyError
+				assert.ErrorAs(t, err, &policyErr)
+				assert.ErrorIs(t, err, ErrPasswordPolicyViolation)
+				assert.Equal(t, tt.wantCodes, violationCodes(policyErr.Violations))
+			default:
+				assert.NoError(t, err)
+			}
+			mockClientRepo.AssertExpectations(t)
+		})
+	}
+}
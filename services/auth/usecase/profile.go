@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/mail"
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrSelfServiceNotSupported セルフサービスはクライアントユーザーのみ利用可能
+	ErrSelfServiceNotSupported = domain.NewError(domain.ErrorKindPermissionDenied, domain.ReasonSelfServiceNotSupported, "self-service is only available to client users")
+	// ErrInvalidCurrentPassword 現在のパスワードが一致しない
+	ErrInvalidCurrentPassword = domain.NewValidationError(domain.ReasonInvalidCurrentPassword, "current_password", "current password is incorrect")
+	// ErrEmailUnchanged 変更後のメールアドレスが現在と同じ
+	ErrEmailUnchanged = domain.NewValidationError(domain.ReasonEmailUnchanged, "new_email", "email is unchanged")
+	// ErrInvalidEmailChange メールアドレス変更の確認トークンが存在しない（または確定済み）
+	ErrInvalidEmailChange = domain.NewError(domain.ErrorKindNotFound, domain.ReasonEmailChangeNotFound, "invalid email change token")
+	// ErrEmailChangeExpired メールアドレス変更の確認トークンの有効期限切れ
+	ErrEmailChangeExpired = domain.NewError(domain.ErrorKindPreconditionFailed, domain.ReasonEmailChangeExpired, "email change token expired")
+)
+
+// PasswordViolationSameAsCurrent 新しいパスワードが現在のパスワードと同じ
+const PasswordViolationSameAsCurrent = "SAME_AS_CURRENT"
+
+// UpdateMeParams 自分のプロフィール更新パラメータ（メールアドレス・ステータス・ロールは変更不可）
+type UpdateMeParams struct {
+	FirstName  *string
+	LastName   *string
+	Department *string
+	Position   *string
+	Settings   *string
+	UpdateMask []string // 更新するフィールド（UpdateClientUserParams.UpdateMaskと同じ、email・statusは指定不可）
+	ETag       string   // 取得時のETag（省略可、指定した場合は一致しなければErrETagMismatch）
+}
+
+// EmailChangeSender メールアドレス変更の確認トークン（確認URL）を変更後のメールアドレスに送信
+type EmailChangeSender interface {
+	SendEmailChangeVerification(ctx context.Context, email string, verificationURL string) error
+}
+
+// MailEmailChangeSender 確認URLをメール（SMTP）で送信
+type MailEmailChangeSender struct {
+	mailer *mail.Sender
+}
+
+// LogEmailChangeSender ログ出力のみを行う送信（APP_ENV=developmentでのみ使用する）
+type LogEmailChangeSender struct{}
+
+// NewEmailChangeSender メールアドレス変更の確認の送信を作成
+// SMTP未設定の場合、確認URLをログに出力する送信は開発環境でのみ許可し、それ以外の環境ではエラー（起動しない）
+func NewEmailChangeSender(cfg *config.Config, mailer *mail.Sender) (EmailChangeSender, error) {
+	if mailer != nil {
+		return &MailEmailChangeSender{mailer: mailer}, nil
+	}
+	if cfg != nil && cfg.IsDevelopment() {
+		return &LogEmailChangeSender{}, nil
+	}
+	return nil, errors.New("email change sender is not configured: set SMTP_HOST and MAIL_FROM (logging verification urls is only allowed with APP_ENV=development)")
+}
+
+// SendEmailChangeVerification 確認URLをメールで送信
+func (s *MailEmailChangeSender) SendEmailChangeVerification(ctx context.Context, email string, verificationURL string) error {
+	body := fmt.Sprintf("ContractProSuiteのメールアドレスの変更を受け付けました。\n以下のURLを開いて変更を確定してください。\n\n%s\n\nこのメールに心当たりがない場合は破棄してください（メールアドレスは変更されません）。\n", verificationURL)
+	return s.mailer.Send(ctx, email, "【ContractProSuite】メールアドレス変更の確認", body)
+}
+
+// SendEmailChangeVerification 確認URLをログに出力
+func (s *LogEmailChangeSender) SendEmailChangeVerification(ctx context.Context, email string, verificationURL string) error {
+	log.Printf("Email change verification for %s: %s", email, verificationURL)
+	return nil
+}
+
+// requireClientUser セルフサービスの対象（クライアントユーザー）か確認
+func requireClientUser(userCtx *domain.UserContext) error {
+	if userCtx == nil || userCtx.UserType != domain.UserTypeClientUser {
+		return ErrSelfServiceNotSupported
+	}
+	return nil
+}
+
+// UpdateMe 自分のプロフィール更新（users:WRITE不要）
+func (u *authUsecase) UpdateMe(ctx context.Context, userCtx *domain.UserContext, params UpdateMeParams) (dbgen.ClientUser, error) {
+	if err := requireClientUser(userCtx); err != nil {
+		return dbgen.ClientUser{}, err
+	}
+
+	// 1. バリデーション（氏名は空にできない、更新マスクは変更可能な項目に限定）
+	if _, err := validateUpdateMask(params.UpdateMask, selfUpdatablePaths); err != nil {
+		return dbgen.ClientUser{}, err
+	}
+	if params.FirstName != nil && strings.TrimSpace(*params.FirstName) == "" {
+		return dbgen.ClientUser{}, domain.NewValidationError(domain.ReasonRequiredField, "first_name", "first_name must not be empty")
+	}
+	if params.LastName != nil && strings.TrimSpace(*params.LastName) == "" {
+		return dbgen.ClientUser{}, domain.NewValidationError(domain.ReasonRequiredField, "last_name", "last_name must not be empty")
+	}
+
+	// 2. 更新（自分自身のレコードのみ、変更可能な項目に限定）
+	return u.updateClientUser(ctx, userCtx.ClientID, userCtx.UserID, UpdateClientUserParams{
+		FirstName:  params.FirstName,
+		LastName:   params.LastName,
+		Department: params.Department,
+		Position:   params.Position,
+		Settings:   params.Settings,
+		UpdateMask: params.UpdateMask,
+		ETag:       params.ETag,
+	})
+}
+
+// ChangeMyPassword 自分のパスワード変更（現在のパスワードを確認し、変更後は発行済みトークンを失効）
+func (u *authUsecase) ChangeMyPassword(ctx context.Context, userCtx *domain.UserContext, currentPassword, newPassword string) error {
+	if err := requireClientUser(userCtx); err != nil {
+		return err
+	}
+
+	user, err := u.clientUserRepo.GetByID(ctx, userCtx.ClientID, userCtx.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get client user: %w", err)
+	}
+
+	// 1. パスワードポリシー（Supabase Authに送信する前に確認）
+	if newPassword == currentPassword {
+		return &PasswordPolicyError{Field: "new_password", Violations: []PasswordViolation{
+			{Code: PasswordViolationSameAsCurrent, Description: "must differ from the current password"},
+		}}
+	}
+	if err := u.validatePassword(ctx, userCtx.ClientID, PasswordCandidate{
+		Password:  newPassword,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}); err != nil {
+		return withPasswordField(err, "new_password")
+	}
+
+	// 2. 現在のパスワードを確認（トークンを盗まれた場合にパスワードを変更されないように）
+	if err := u.verifySupabasePassword(ctx, user.Email, currentPassword); err != nil {
+		return err
+	}
+
+	// 3. Supabase Authでパスワードを更新
+	if err := u.updateSupabaseUser(ctx, userCtx.UserID, map[string]interface{}{"password": newPassword}); err != nil {
+		return fmt.Errorf("failed to update supabase user: %w", err)
+	}
+
+	// 4. パスワード変更日時を記録
+	if err := u.clientUserRepo.UpdatePasswordChangedAt(ctx, userCtx.ClientID, userCtx.UserID); err != nil {
+		return fmt.Errorf("failed to update password_changed_at: %w", err)
+	}
+
+	// 5. 発行済みトークンを失効（現在のトークンを含むため、変更後は再ログインが必要）
+	return u.revokeUserTokens(ctx, userCtx.UserID, revocationReasonPasswordChanged)
+}
+
+// ChangeMyEmail 自分のメールアドレス変更を申請（変更後のメールアドレスに確認トークンを送信し、確認後に確定）
+func (u *authUsecase) ChangeMyEmail(ctx context.Context, userCtx *domain.UserContext, newEmail string) error {
+	if err := requireClientUser(userCtx); err != nil {
+		return err
+	}
+	if u.emailChangeSender == nil {
+		return errors.New("email change sender is not configured")
+	}
+
+	user, err := u.clientUserRepo.GetByID(ctx, userCtx.ClientID, userCtx.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get client user: %w", err)
+	}
+
+	// 1. バリデーション（現在と同じ、クライアント内で重複するメールアドレスは不可）
+	if strings.EqualFold(newEmail, user.Email) {
+		return ErrEmailUnchanged
+	}
+	if _, err := u.clientUserRepo.GetByEmail(ctx, userCtx.ClientID, newEmail); err == nil {
+		return fmt.Errorf("%w: %s", ErrEmailAlreadyExists, newEmail)
+	}
+
+	// 2. 確認トークンを作成（確認待ちはユーザーごとに1件、再申請で上書き）
+	token, err := generateSecret(32)
+	if err != nil {
+		return fmt.Errorf("failed to generate email change token: %w", err)
+	}
+	verificationURL, err := buildVerificationURL(u.cfg.EmailChangeVerificationURL, token)
+	if err != nil {
+		return fmt.Errorf("failed to build email change verification url: %w", err)
+	}
+
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 準備済みステートメントのキャッシュをクリア（pgbouncerのtransactionモード対策）
+	_, _ = tx.Exec(ctx, "DEALLOCATE ALL")
+
+	queries := dbgen.New(tx)
+	_, err = queries.UpsertEmailChange(ctx, dbgen.UpsertEmailChangeParams{
+		ClientUserID: pgtype.UUID{Bytes: userCtx.UserID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		NewEmail:     newEmail,
+		TokenHash:    hashVerificationToken(token),
+		ExpiresAt:    pgtype.Timestamptz{Time: time.Now().Add(u.cfg.EmailChangeVerificationTTL), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create email change: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// 3. コミット後に変更後のメールアドレスに送信（送信に失敗した場合は再申請でトークンを上書きする）
+	if err := u.emailChangeSender.SendEmailChangeVerification(ctx, newEmail, verificationURL); err != nil {
+		return fmt.Errorf("failed to send email change verification: %w", err)
+	}
+	return nil
+}
+
+// ConfirmMyEmailChange メールアドレス変更の確認トークンを検証し、変更を確定
+func (u *authUsecase) ConfirmMyEmailChange(ctx context.Context, token string) (dbgen.ClientUser, error) {
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 準備済みステートメントのキャッシュをクリア（pgbouncerのtransactionモード対策）
+	_, _ = tx.Exec(ctx, "DEALLOCATE ALL")
+
+	queries := dbgen.New(tx)
+
+	// 1. トークンの検証（同時実行を防ぐため行ロック）
+	change, err := queries.GetEmailChangeByTokenHash(ctx, hashVerificationToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dbgen.ClientUser{}, ErrInvalidEmailChange
+		}
+		return dbgen.ClientUser{}, fmt.Errorf("failed to get email change: %w", err)
+	}
+	if time.Now().After(change.ExpiresAt.Time) {
+		return dbgen.ClientUser{}, ErrEmailChangeExpired
+	}
+
+	// 2. 申請後に同じメールアドレスのユーザーが作成されていないか確認
+	if _, err := queries.GetClientUserByEmail(ctx, dbgen.GetClientUserByEmailParams{
+		ClientID: change.ClientID,
+		Email:    change.NewEmail,
+	}); err == nil {
+		return dbgen.ClientUser{}, fmt.Errorf("%w: %s", ErrEmailAlreadyExists, change.NewEmail)
+	}
+
+	// 3. client_usersのメールアドレスを更新し、確認待ちを削除
+	user, err := queries.UpdateClientUserEmail(ctx, dbgen.UpdateClientUserEmailParams{
+		ClientUserID: change.ClientUserID,
+		ClientID:     change.ClientID,
+		Email:        change.NewEmail,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dbgen.ClientUser{}, ErrInvalidEmailChange
+		}
+		return dbgen.ClientUser{}, fmt.Errorf("failed to update client user email: %w", err)
+	}
+	if err := queries.DeleteEmailChange(ctx, change.ClientUserID); err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to delete email change: %w", err)
+	}
+
+	// 4. Supabase Authのメールアドレスを更新（確認済みとして、失敗した場合はロールバックして再試行可能にする）
+	if err := u.updateSupabaseUser(ctx, uuidFromPGType(change.ClientUserID), map[string]interface{}{
+		"email":         change.NewEmail,
+		"email_confirm": true,
+	}); err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to update supabase user: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return dbgen.ClientUser{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return user, nil
+}
+
+// verifySupabasePassword Supabase Auth（パスワードグラント）で現在のパスワードを確認
+func (u *authUsecase) verifySupabasePassword(ctx context.Context, email, password string) error {
+	url := fmt.Sprintf("%s/auth/v1/token?grant_type=password", u.cfg.SupabaseURL)
+
+	jsonData, err := json.Marshal(map[string]interface{}{
+		"email":    email,
+		"password": password,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("apikey", u.cfg.SupabaseServiceRoleKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body) // 発行されたセッションは使用しない
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest, http.StatusUnauthorized:
+		// invalid_grant（メールアドレスまたはパスワードの不一致）
+		return ErrInvalidCurrentPassword
+	default:
+		return fmt.Errorf("supabase auth error: status %d", resp.StatusCode)
+	}
+}
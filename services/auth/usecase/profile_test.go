@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequireClientUser(t *testing.T) {
+	assert.NoError(t, requireClientUser(&domain.UserContext{UserType: domain.UserTypeClientUser}))
+	assert.ErrorIs(t, requireClientUser(&domain.UserContext{UserType: domain.UserTypeOperator}), ErrSelfServiceNotSupported)
+	assert.ErrorIs(t, requireClientUser(nil), ErrSelfServiceNotSupported)
+}
+
+func TestUpdateMe(t *testing.T) {
+	clientID := uuid.New()
+	userID := uuid.New()
+	userCtx := &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID}
+	existing := dbgen.ClientUser{
+		ClientUserID: pgtype.UUID{Bytes: userID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		Email:        "user@example.com",
+		FirstName:    "Taro",
+		LastName:     "Yamada",
+		Settings:     []byte(`{}`),
+		Status:       "ACTIVE",
+	}
+
+	mockClientUserRepo := new(MockClientUserRepository)
+	mockClientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(existing, nil)
+	// メールアドレス・ステータスは既存の値のまま更新される
+	mockClientUserRepo.On("Update", mock.Anything, clientID, mock.MatchedBy(func(params dbgen.UpdateClientUserParams) bool {
+		return params.FirstName == "Jiro" &&
+			params.LastName == "Yamada" &&
+			params.Department == pgtype.Text{String: "Legal", Valid: true} &&
+			params.Email == existing.Email &&
+			params.Status == existing.Status
+	})).Return(existing, nil)
+
+	usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+	department := "Legal"
+	firstName := "Jiro"
+	_, err := usecase.UpdateMe(context.Background(), userCtx, UpdateMeParams{FirstName: &firstName, Department: &department})
+	assert.NoError(t, err)
+	mockClientUserRepo.AssertExpectations(t)
+
+	// 氏名を空にはできない
+	empty := " "
+	_, err = usecase.UpdateMe(context.Background(), userCtx, UpdateMeParams{LastName: &empty})
+	assert.Error(t, err)
+}
+
+func TestChangeMyPassword_SameAsCurrent(t *testing.T) {
+	clientID := uuid.New()
+	userID := uuid.New()
+	userCtx := &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID}
+
+	mockClientUserRepo := new(MockClientUserRepository)
+	mockClientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(dbgen.ClientUser{Email: "user@example.com"}, nil)
+
+	// Supabase Authに送信する前に拒否される
+	usecase := &authUsecase{clientUserRepo: mockClientUserRepo, cfg: &config.Config{}}
+	err := usecase.ChangeMyPassword(context.Background(), userCtx, "Kx7mQ2vLp9wZ", "Kx7mQ2vLp9wZ")
+	var policyErr *PasswordPolicyError
+	if assert.ErrorAs(t, err, &policyErr) {
+		assert.Equal(t, []string{PasswordViolationSameAsCurrent}, violationCodes(policyErr.Violations))
+	}
+	mockClientUserRepo.AssertExpectations(t)
+}
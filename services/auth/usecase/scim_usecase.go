@@ -0,0 +1,686 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"contract-pro-suite/internal/shared/db"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// scimTokenPrefix SCIMトークンの接頭辞（漏洩時のシークレットスキャン用）
+const scimTokenPrefix = "scim_"
+
+var (
+	// ErrInvalidSCIMToken SCIMトークンが存在しない、取り消し済み、または有効期限切れ
+	ErrInvalidSCIMToken = errors.New("invalid scim token")
+	// ErrSCIMResourceNotFound SCIMリソース（ユーザー・グループ）がクライアント内に存在しない
+	ErrSCIMResourceNotFound = domain.NewError(domain.ErrorKindNotFound, domain.ReasonSCIMResourceNotFound, "scim resource not found")
+	// ErrSCIMMemberNotFound グループメンバーに指定されたユーザーがクライアント内に存在しない
+	ErrSCIMMemberNotFound = domain.NewValidationError(domain.ReasonSCIMMemberNotFound, "members", "group member not found")
+	// ErrGroupAlreadyExists 同じ表示名のロールが既に存在する
+	ErrGroupAlreadyExists = domain.NewError(domain.ErrorKindConflict, domain.ReasonGroupAlreadyExists, "group already exists")
+	// ErrSystemRoleImmutable システムロールは名前変更・削除できない
+	ErrSystemRoleImmutable = domain.NewError(domain.ErrorKindPreconditionFailed, domain.ReasonSystemRoleImmutable, "system role cannot be modified")
+)
+
+// SCIMPrincipal SCIMトークンで認証された呼び出し元（IdP）
+type SCIMPrincipal struct {
+	TokenID  uuid.UUID
+	ClientID uuid.UUID
+}
+
+// SCIMUserFilter SCIMのユーザー一覧の絞り込み条件（データベースで評価できる条件のみ、ゼロ値の項目では絞り込まない）
+type SCIMUserFilter struct {
+	UserName       string // userName（メールアドレス）の比較値（大文字小文字を区別しない）
+	UserNamePrefix bool   // trueの場合はuserNameの前方一致（sw）、falseの場合は完全一致（eq）
+	Active         *bool
+}
+
+// SCIMGroup SCIMのGroupリソース（client_roles + 有効なclient_user_roles）
+type SCIMGroup struct {
+	Role      dbgen.ClientRole
+	MemberIDs []uuid.UUID
+}
+
+// SCIMGroupUpdate グループ更新内容（PUT/PATCHの解釈結果）
+type SCIMGroupUpdate struct {
+	DisplayName    *string
+	AddMembers     []uuid.UUID
+	RemoveMembers  []uuid.UUID
+	ReplaceMembers *[]uuid.UUID // nilの場合はメンバー全体の置き換えなし
+}
+
+// CreateSCIMTokenResult SCIMトークン発行結果
+type CreateSCIMTokenResult struct {
+	Token     string // 平文トークン（発行時のみ返却）
+	TokenInfo dbgen.ClientScimToken
+}
+
+// SCIMUsecase SCIM 2.0プロビジョニングユースケース
+// ユーザーの作成・更新・削除はAuthUsecaseと同じ業務ルールを使用し、クライアント分離はトークンの所属クライアントで行う
+type SCIMUsecase interface {
+	// Authenticate Bearerトークンを検証し、所属クライアントを解決
+	Authenticate(ctx context.Context, token string) (*SCIMPrincipal, error)
+	// CreateToken SCIMトークン発行（権限: system_settings:WRITE）
+	CreateToken(ctx context.Context, userCtx *domain.UserContext, description string, expiresAt *time.Time) (*CreateSCIMTokenResult, error)
+	// RevokeToken SCIMトークン取り消し（権限: system_settings:WRITE）
+	RevokeToken(ctx context.Context, userCtx *domain.UserContext, tokenID uuid.UUID) error
+
+	// Users
+	ListUsers(ctx context.Context, principal *SCIMPrincipal, filter SCIMUserFilter, limit, offset int32) ([]dbgen.ClientUser, int64, error)
+	ListAllUsers(ctx context.Context, principal *SCIMPrincipal) ([]dbgen.ClientUser, error)
+	GetUser(ctx context.Context, principal *SCIMPrincipal, clientUserID uuid.UUID) (dbgen.ClientUser, error)
+	GetUserGroups(ctx context.Context, principal *SCIMPrincipal, clientUserID uuid.UUID) ([]dbgen.ClientRole, error)
+	CreateUser(ctx context.Context, principal *SCIMPrincipal, params CreateClientUserParams) (dbgen.ClientUser, error)
+	UpdateUser(ctx context.Context, principal *SCIMPrincipal, clientUserID uuid.UUID, params UpdateClientUserParams) (dbgen.ClientUser, error)
+	DeleteUser(ctx context.Context, principal *SCIMPrincipal, clientUserID uuid.UUID) error
+
+	// Groups
+	ListGroups(ctx context.Context, principal *SCIMPrincipal) ([]SCIMGroup, error)
+	GetGroup(ctx context.Context, principal *SCIMPrincipal, roleID uuid.UUID) (SCIMGroup, error)
+	CreateGroup(ctx context.Context, principal *SCIMPrincipal, displayName string, memberIDs []uuid.UUID) (SCIMGroup, error)
+	UpdateGroup(ctx context.Context, principal *SCIMPrincipal, roleID uuid.UUID, update SCIMGroupUpdate) (SCIMGroup, error)
+	DeleteGroup(ctx context.Context, principal *SCIMPrincipal, roleID uuid.UUID) error
+}
+
+// clientUserManager SCIMと共有するクライアントユーザーの業務ルール（authUsecaseが実装する）
+// 権限チェックは行わないため、呼び出し側でクライアントを限定する（SCIMはトークンの所属クライアント）
+type clientUserManager interface {
+	AuthUsecase
+	createClientUser(ctx context.Context, clientID uuid.UUID, params CreateClientUserParams) (dbgen.ClientUser, error)
+	updateClientUser(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, params UpdateClientUserParams) (dbgen.ClientUser, error)
+	deleteClientUser(ctx context.Context, clientID uuid.UUID, clientUserID uuid.UUID, deletedBy uuid.UUID, etag string) error
+}
+
+type scimUsecase struct {
+	// users クライアントユーザーの業務ルール（createClientUser等）を共有するための認証ユースケース
+	users              clientUserManager
+	scimTokenRepo      repository.SCIMTokenRepository
+	clientUserRepo     repository.ClientUserRepository
+	clientRoleRepo     repository.ClientRoleRepository
+	clientUserRoleRepo repository.ClientUserRoleRepository
+	database           *db.DB
+}
+
+// NewSCIMUsecase SCIMユースケースを作成
+// ユーザーの作成・更新・削除はfxで提供される認証ユースケース（NewAuthUsecase）に委譲する
+func NewSCIMUsecase(
+	authUsecase AuthUsecase,
+	clientUserRepo repository.ClientUserRepository,
+	clientRoleRepo repository.ClientRoleRepository,
+	clientUserRoleRepo repository.ClientUserRoleRepository,
+	scimTokenRepo repository.SCIMTokenRepository,
+	database *db.DB,
+) (SCIMUsecase, error) {
+	users, ok := authUsecase.(clientUserManager)
+	if !ok {
+		return nil, fmt.Errorf("auth usecase %T does not support scim provisioning", authUsecase)
+	}
+	return &scimUsecase{
+		users:              users,
+		scimTokenRepo:      scimTokenRepo,
+		clientUserRepo:     clientUserRepo,
+		clientRoleRepo:     clientRoleRepo,
+		clientUserRoleRepo: clientUserRoleRepo,
+		database:           database,
+	}, nil
+}
+
+// hashSCIMToken トークンのSHA-256（16進数）を計算
+func hashSCIMToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateSecret 暗号論的乱数からURLセーフな文字列を生成
+func generateSecret(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Authenticate Bearerトークンを検証し、所属クライアントを解決
+func (u *scimUsecase) Authenticate(ctx context.Context, token string) (*SCIMPrincipal, error) {
+	if !strings.HasPrefix(token, scimTokenPrefix) {
+		return nil, ErrInvalidSCIMToken
+	}
+
+	tokenInfo, err := u.scimTokenRepo.GetByHash(ctx, hashSCIMToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidSCIMToken
+		}
+		return nil, fmt.Errorf("failed to get scim token: %w", err)
+	}
+	if tokenInfo.ExpiresAt.Valid && time.Now().After(tokenInfo.ExpiresAt.Time) {
+		return nil, ErrInvalidSCIMToken
+	}
+
+	tokenID := uuidFromPGType(tokenInfo.TokenID)
+	// 最終利用日時の更新に失敗してもリクエストは継続する
+	_ = u.scimTokenRepo.Touch(ctx, tokenID)
+
+	return &SCIMPrincipal{
+		TokenID:  tokenID,
+		ClientID: uuidFromPGType(tokenInfo.ClientID),
+	}, nil
+}
+
+// CreateToken SCIMトークン発行
+func (u *scimUsecase) CreateToken(ctx context.Context, userCtx *domain.UserContext, description string, expiresAt *time.Time) (*CreateSCIMTokenResult, error) {
+	// 1. クライアントアクセス権限チェック
+	if err := u.users.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
+		return nil, err
+	}
+
+	// 2. 権限チェック: system_settings:WRITE
+	if err := u.users.CheckPermission(ctx, userCtx, "system_settings", "WRITE"); err != nil {
+		return nil, err
+	}
+
+	// 3. トークン生成（平文は保存せず、ハッシュのみ保存）
+	secret, err := generateSecret(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+	token := scimTokenPrefix + secret
+
+	params := dbgen.CreateScimTokenParams{
+		TokenID:     pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		ClientID:    pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		TokenHash:   hashSCIMToken(token),
+		TokenPrefix: token[:len(scimTokenPrefix)+6],
+		CreatedBy:   pgtype.UUID{Bytes: userCtx.UserID, Valid: true},
+	}
+	if description != "" {
+		params.Description = pgtype.Text{String: description, Valid: true}
+	}
+	if expiresAt != nil {
+		params.ExpiresAt = pgtype.Timestamptz{Time: *expiresAt, Valid: true}
+	}
+
+	tokenInfo, err := u.scimTokenRepo.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scim token: %w", err)
+	}
+
+	return &CreateSCIMTokenResult{
+		Token:     token,
+		TokenInfo: tokenInfo,
+	}, nil
+}
+
+// RevokeToken SCIMトークン取り消し
+func (u *scimUsecase) RevokeToken(ctx context.Context, userCtx *domain.UserContext, tokenID uuid.UUID) error {
+	// 1. クライアントアクセス権限チェック
+	if err := u.users.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
+		return err
+	}
+
+	// 2. 権限チェック: system_settings:WRITE
+	if err := u.users.CheckPermission(ctx, userCtx, "system_settings", "WRITE"); err != nil {
+		return err
+	}
+
+	// 3. 取り消し（クライアント分離: 自クライアントのトークンのみ）
+	revoked, err := u.scimTokenRepo.Revoke(ctx, userCtx.ClientID, tokenID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke scim token: %w", err)
+	}
+	if revoked == 0 {
+		return fmt.Errorf("%w: scim token %s", ErrSCIMResourceNotFound, tokenID)
+	}
+
+	return nil
+}
+
+// notFoundOr pgx.ErrNoRows・ErrClientUserNotFoundをErrSCIMResourceNotFoundに変換
+func notFoundOr(err error, resource string, id uuid.UUID) error {
+	if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, ErrClientUserNotFound) {
+		return fmt.Errorf("%w: %s %s", ErrSCIMResourceNotFound, resource, id)
+	}
+	return err
+}
+
+// ListUsers クライアントユーザー一覧取得（SCIMのstartIndex/countに対応、絞り込みはデータベースで行う）
+func (u *scimUsecase) ListUsers(ctx context.Context, principal *SCIMPrincipal, filter SCIMUserFilter, limit, offset int32) ([]dbgen.ClientUser, int64, error) {
+	search := repository.ClientUserSearch{Active: filter.Active}
+	if filter.UserName != "" {
+		search.EmailPattern = escapeLike(filter.UserName)
+		if filter.UserNamePrefix {
+			search.EmailPattern += "%"
+		}
+	}
+
+	total, err := u.clientUserRepo.CountSearch(ctx, principal.ClientID, search)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count client users: %w", err)
+	}
+
+	users, err := u.clientUserRepo.SearchByOffset(ctx, principal.ClientID, search, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list client users: %w", err)
+	}
+
+	return users, total, nil
+}
+
+// ListAllUsers クライアントユーザー全件取得（データベースで評価できないフィルタの評価用）
+func (u *scimUsecase) ListAllUsers(ctx context.Context, principal *SCIMPrincipal) ([]dbgen.ClientUser, error) {
+	users, err := u.clientUserRepo.ListAll(ctx, principal.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client users: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser クライアントユーザー取得（クライアント分離チェック）
+func (u *scimUsecase) GetUser(ctx context.Context, principal *SCIMPrincipal, clientUserID uuid.UUID) (dbgen.ClientUser, error) {
+	user, err := u.clientUserRepo.GetByID(ctx, principal.ClientID, clientUserID)
+	if err != nil {
+		return dbgen.ClientUser{}, notFoundOr(fmt.Errorf("failed to get client user: %w", err), "user", clientUserID)
+	}
+	return user, nil
+}
+
+// GetUserGroups ユーザーに割り当てられた有効なロール（SCIMのgroups属性）を取得
+func (u *scimUsecase) GetUserGroups(ctx context.Context, principal *SCIMPrincipal, clientUserID uuid.UUID) ([]dbgen.ClientRole, error) {
+	// 削除済みロールはクエリ側で除外される
+	userRoles, err := u.clientUserRoleRepo.ListActiveByUserIDs(ctx, principal.ClientID, []uuid.UUID{clientUserID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	roles := make([]dbgen.ClientRole, 0, len(userRoles))
+	for _, userRole := range userRoles {
+		roles = append(roles, dbgen.ClientRole{
+			RoleID:   userRole.RoleID,
+			ClientID: pgtype.UUID{Bytes: principal.ClientID, Valid: true},
+			Code:     userRole.Code,
+			Name:     userRole.Name,
+		})
+	}
+	return roles, nil
+}
+
+// CreateUser クライアントユーザー作成
+// IdPからパスワードが連携されない場合はランダムなパスワードを設定する（SSOまたはパスワードリセットでログイン）
+func (u *scimUsecase) CreateUser(ctx context.Context, principal *SCIMPrincipal, params CreateClientUserParams) (dbgen.ClientUser, error) {
+	if params.Password == "" {
+		password, err := generateSecret(24)
+		if err != nil {
+			return dbgen.ClientUser{}, fmt.Errorf("failed to generate password: %w", err)
+		}
+		params.Password = password
+		params.passwordGenerated = true
+	}
+
+	return u.users.createClientUser(ctx, principal.ClientID, params)
+}
+
+// UpdateUser クライアントユーザー更新
+func (u *scimUsecase) UpdateUser(ctx context.Context, principal *SCIMPrincipal, clientUserID uuid.UUID, params UpdateClientUserParams) (dbgen.ClientUser, error) {
+	user, err := u.users.updateClientUser(ctx, principal.ClientID, clientUserID, params)
+	if err != nil {
+		return dbgen.ClientUser{}, notFoundOr(err, "user", clientUserID)
+	}
+	return user, nil
+}
+
+// DeleteUser クライアントユーザー論理削除（deleted_byにはSCIMトークンIDを記録）
+func (u *scimUsecase) DeleteUser(ctx context.Context, principal *SCIMPrincipal, clientUserID uuid.UUID) error {
+	if err := u.users.deleteClientUser(ctx, principal.ClientID, clientUserID, principal.TokenID, ""); err != nil {
+		return notFoundOr(err, "user", clientUserID)
+	}
+	return nil
+}
+
+// ListGroups クライアントのロール一覧をメンバー付きで取得（メンバーはクライアント全体で1回のクエリで取得）
+func (u *scimUsecase) ListGroups(ctx context.Context, principal *SCIMPrincipal) ([]SCIMGroup, error) {
+	roles, err := u.clientRoleRepo.List(ctx, principal.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client roles: %w", err)
+	}
+	userRoles, err := u.clientUserRoleRepo.ListActiveByClient(ctx, principal.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role members: %w", err)
+	}
+
+	memberIDs := make(map[uuid.UUID][]uuid.UUID, len(roles))
+	for _, userRole := range userRoles {
+		roleID := uuidFromPGType(userRole.RoleID)
+		memberIDs[roleID] = append(memberIDs[roleID], uuidFromPGType(userRole.ClientUserID))
+	}
+
+	groups := make([]SCIMGroup, 0, len(roles))
+	for _, role := range roles {
+		members := memberIDs[uuidFromPGType(role.RoleID)]
+		if members == nil {
+			members = []uuid.UUID{}
+		}
+		groups = append(groups, SCIMGroup{Role: role, MemberIDs: members})
+	}
+	return groups, nil
+}
+
+// GetGroup ロールをメンバー付きで取得（クライアント分離チェック）
+func (u *scimUsecase) GetGroup(ctx context.Context, principal *SCIMPrincipal, roleID uuid.UUID) (SCIMGroup, error) {
+	role, err := u.getRole(ctx, principal.ClientID, roleID)
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+	return u.loadGroup(ctx, principal.ClientID, role)
+}
+
+// CreateGroup ロールを作成し、メンバーを割り当てる
+// SCIMで作成したロールには権限が付与されないため、権限はアプリケーション側で設定する
+func (u *scimUsecase) CreateGroup(ctx context.Context, principal *SCIMPrincipal, displayName string, memberIDs []uuid.UUID) (SCIMGroup, error) {
+	if err := u.checkDisplayName(ctx, principal.ClientID, uuid.Nil, displayName); err != nil {
+		return SCIMGroup{}, err
+	}
+	if err := u.checkMembers(ctx, principal.ClientID, memberIDs); err != nil {
+		return SCIMGroup{}, err
+	}
+
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return SCIMGroup{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 準備済みステートメントのキャッシュをクリア（再発防止）
+	if _, err := tx.Exec(ctx, "DEALLOCATE ALL"); err != nil {
+		_ = err // エラーを無視
+	}
+
+	queries := dbgen.New(tx)
+
+	roleID := uuid.New()
+	role, err := queries.CreateClientRole(ctx, dbgen.CreateClientRoleParams{
+		RoleID:      pgtype.UUID{Bytes: roleID, Valid: true},
+		ClientID:    pgtype.UUID{Bytes: principal.ClientID, Valid: true},
+		Code:        "scim_" + strings.ReplaceAll(roleID.String(), "-", "")[:12],
+		Name:        displayName,
+		Description: pgtype.Text{String: "SCIMプロビジョニングで作成", Valid: true},
+		IsSystem:    false,
+	})
+	if err != nil {
+		return SCIMGroup{}, fmt.Errorf("failed to create client role: %w", err)
+	}
+
+	for _, memberID := range memberIDs {
+		if err := assignRole(ctx, queries, principal.ClientID, memberID, roleID); err != nil {
+			return SCIMGroup{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return SCIMGroup{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return SCIMGroup{Role: role, MemberIDs: uniqueIDs(memberIDs)}, nil
+}
+
+// UpdateGroup ロール名の変更とメンバーの追加・削除・置き換え
+func (u *scimUsecase) UpdateGroup(ctx context.Context, principal *SCIMPrincipal, roleID uuid.UUID, update SCIMGroupUpdate) (SCIMGroup, error) {
+	role, err := u.getRole(ctx, principal.ClientID, roleID)
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+
+	rename := update.DisplayName != nil && *update.DisplayName != role.Name
+	if rename {
+		if role.IsSystem {
+			return SCIMGroup{}, fmt.Errorf("%w: %s", ErrSystemRoleImmutable, role.Code)
+		}
+		if err := u.checkDisplayName(ctx, principal.ClientID, roleID, *update.DisplayName); err != nil {
+			return SCIMGroup{}, err
+		}
+	}
+
+	current, err := u.loadGroup(ctx, principal.ClientID, role)
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+
+	// 変更後のメンバー集合を計算
+	members := make(map[uuid.UUID]bool, len(current.MemberIDs))
+	if update.ReplaceMembers != nil {
+		for _, id := range *update.ReplaceMembers {
+			members[id] = true
+		}
+	} else {
+		for _, id := range current.MemberIDs {
+			members[id] = true
+		}
+	}
+	for _, id := range update.AddMembers {
+		members[id] = true
+	}
+	for _, id := range update.RemoveMembers {
+		delete(members, id)
+	}
+
+	var toAdd, toRemove []uuid.UUID
+	for id := range members {
+		if !containsID(current.MemberIDs, id) {
+			toAdd = append(toAdd, id)
+		}
+	}
+	for _, id := range current.MemberIDs {
+		if !members[id] {
+			toRemove = append(toRemove, id)
+		}
+	}
+	if err := u.checkMembers(ctx, principal.ClientID, toAdd); err != nil {
+		return SCIMGroup{}, err
+	}
+
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return SCIMGroup{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 準備済みステートメントのキャッシュをクリア（再発防止）
+	if _, err := tx.Exec(ctx, "DEALLOCATE ALL"); err != nil {
+		_ = err // エラーを無視
+	}
+
+	queries := dbgen.New(tx)
+
+	if rename {
+		role, err = queries.UpdateClientRole(ctx, dbgen.UpdateClientRoleParams{
+			RoleID:      role.RoleID,
+			Name:        *update.DisplayName,
+			Description: role.Description,
+		})
+		if err != nil {
+			return SCIMGroup{}, fmt.Errorf("failed to update client role: %w", err)
+		}
+	}
+	for _, id := range toAdd {
+		if err := assignRole(ctx, queries, principal.ClientID, id, roleID); err != nil {
+			return SCIMGroup{}, err
+		}
+	}
+	for _, id := range toRemove {
+		if err := revokeRole(ctx, queries, principal.ClientID, id, roleID); err != nil {
+			return SCIMGroup{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return SCIMGroup{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return u.loadGroup(ctx, principal.ClientID, role)
+}
+
+// DeleteGroup ロールを論理削除し、全メンバーの割り当てを取り消す
+func (u *scimUsecase) DeleteGroup(ctx context.Context, principal *SCIMPrincipal, roleID uuid.UUID) error {
+	role, err := u.getRole(ctx, principal.ClientID, roleID)
+	if err != nil {
+		return err
+	}
+	if role.IsSystem {
+		return fmt.Errorf("%w: %s", ErrSystemRoleImmutable, role.Code)
+	}
+
+	group, err := u.loadGroup(ctx, principal.ClientID, role)
+	if err != nil {
+		return err
+	}
+
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 準備済みステートメントのキャッシュをクリア（再発防止）
+	if _, err := tx.Exec(ctx, "DEALLOCATE ALL"); err != nil {
+		_ = err // エラーを無視
+	}
+
+	queries := dbgen.New(tx)
+
+	// 削除済みロールの権限が残らないよう、先に割り当てを取り消す
+	for _, id := range group.MemberIDs {
+		if err := revokeRole(ctx, queries, principal.ClientID, id, roleID); err != nil {
+			return err
+		}
+	}
+	if err := queries.DeleteClientRole(ctx, dbgen.DeleteClientRoleParams{
+		RoleID:    role.RoleID,
+		DeletedBy: pgtype.UUID{Bytes: principal.TokenID, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to delete client role: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// getRole ロール取得（他クライアントのロールは存在しないものとして扱う）
+func (u *scimUsecase) getRole(ctx context.Context, clientID, roleID uuid.UUID) (dbgen.ClientRole, error) {
+	role, err := u.clientRoleRepo.GetByID(ctx, roleID)
+	if err != nil {
+		return dbgen.ClientRole{}, notFoundOr(fmt.Errorf("failed to get client role: %w", err), "group", roleID)
+	}
+	if uuidFromPGType(role.ClientID) != clientID {
+		return dbgen.ClientRole{}, fmt.Errorf("%w: group %s", ErrSCIMResourceNotFound, roleID)
+	}
+	return role, nil
+}
+
+// loadGroup ロールの有効なメンバーを取得
+func (u *scimUsecase) loadGroup(ctx context.Context, clientID uuid.UUID, role dbgen.ClientRole) (SCIMGroup, error) {
+	userRoles, err := u.clientUserRoleRepo.GetByRoleID(ctx, clientID, uuidFromPGType(role.RoleID))
+	if err != nil {
+		return SCIMGroup{}, fmt.Errorf("failed to get role members: %w", err)
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(userRoles))
+	for _, userRole := range userRoles {
+		memberIDs = append(memberIDs, uuidFromPGType(userRole.ClientUserID))
+	}
+	return SCIMGroup{Role: role, MemberIDs: memberIDs}, nil
+}
+
+// checkDisplayName クライアント内でロール名が重複しないことを確認
+func (u *scimUsecase) checkDisplayName(ctx context.Context, clientID, roleID uuid.UUID, displayName string) error {
+	roles, err := u.clientRoleRepo.List(ctx, clientID)
+	if err != nil {
+		return fmt.Errorf("failed to list client roles: %w", err)
+	}
+	for _, role := range roles {
+		if uuidFromPGType(role.RoleID) != roleID && strings.EqualFold(role.Name, displayName) {
+			return fmt.Errorf("%w: %s", ErrGroupAlreadyExists, displayName)
+		}
+	}
+	return nil
+}
+
+// checkMembers メンバーが全て同じクライアントのユーザーであることを確認（クライアント分離チェック）
+func (u *scimUsecase) checkMembers(ctx context.Context, clientID uuid.UUID, memberIDs []uuid.UUID) error {
+	for _, id := range memberIDs {
+		if _, err := u.clientUserRepo.GetByID(ctx, clientID, id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("%w: %s", ErrSCIMMemberNotFound, id)
+			}
+			return fmt.Errorf("failed to get client user: %w", err)
+		}
+	}
+	return nil
+}
+
+// assignRole ユーザーにロールを割り当てる（取り消し済みの場合は再有効化）
+func assignRole(ctx context.Context, queries *dbgen.Queries, clientID, clientUserID, roleID uuid.UUID) error {
+	_, err := queries.AssignClientUserRole(ctx, dbgen.AssignClientUserRoleParams{
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
+		RoleID:       pgtype.UUID{Bytes: roleID, Valid: true},
+		AssignedAt:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to assign role: %w", err)
+	}
+	return nil
+}
+
+// revokeRole ユーザーのロール割り当てを取り消し、発行済みトークンを失効
+func revokeRole(ctx context.Context, queries *dbgen.Queries, clientID, clientUserID, roleID uuid.UUID) error {
+	err := queries.RevokeClientUserRole(ctx, dbgen.RevokeClientUserRoleParams{
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		ClientUserID: pgtype.UUID{Bytes: clientUserID, Valid: true},
+		RoleID:       pgtype.UUID{Bytes: roleID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke role: %w", err)
+	}
+	if err := queries.BumpTokenRevocationWatermark(ctx, newWatermarkParams(revocationSubjectUser, clientUserID, revocationReasonRoleRevoked)); err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+// containsID スライスにIDが含まれるか
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// uniqueIDs 重複を除いたIDのスライスを返す（順序は維持）
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !containsID(result, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
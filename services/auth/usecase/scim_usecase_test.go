@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSCIMTokenRepository モックSCIMトークンリポジトリ
+type MockSCIMTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockSCIMTokenRepository) GetByHash(ctx context.Context, tokenHash string) (dbgen.ClientScimToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return dbgen.ClientScimToken{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientScimToken), args.Error(1)
+}
+
+func (m *MockSCIMTokenRepository) Create(ctx context.Context, params dbgen.CreateScimTokenParams) (dbgen.ClientScimToken, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientScimToken{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientScimToken), args.Error(1)
+}
+
+func (m *MockSCIMTokenRepository) Touch(ctx context.Context, tokenID uuid.UUID) error {
+	args := m.Called(ctx, tokenID)
+	return args.Error(0)
+}
+
+func (m *MockSCIMTokenRepository) Revoke(ctx context.Context, clientID uuid.UUID, tokenID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, clientID, tokenID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestSCIMAuthenticate(t *testing.T) {
+	tokenID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174100")
+	clientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	token := "scim_abcdefghijklmnopqrstuvwxyz"
+
+	tokenInfo := func(expiresAt pgtype.Timestamptz) dbgen.ClientScimToken {
+		return dbgen.ClientScimToken{
+			TokenID:   pgtype.UUID{Bytes: tokenID, Valid: true},
+			ClientID:  pgtype.UUID{Bytes: clientID, Valid: true},
+			TokenHash: hashSCIMToken(token),
+			ExpiresAt: expiresAt,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		setupMock func(*MockSCIMTokenRepository)
+		wantErr   error
+	}{
+		{
+			name:  "成功: 有効なトークン",
+			token: token,
+			setupMock: func(m *MockSCIMTokenRepository) {
+				m.On("GetByHash", mock.Anything, hashSCIMToken(token)).Return(tokenInfo(pgtype.Timestamptz{}), nil)
+				m.On("Touch", mock.Anything, tokenID).Return(nil)
+			},
+		},
+		{
+			name:  "成功: 最終利用日時の更新失敗は無視",
+			token: token,
+			setupMock: func(m *MockSCIMTokenRepository) {
+				m.On("GetByHash", mock.Anything, hashSCIMToken(token)).Return(tokenInfo(pgtype.Timestamptz{}), nil)
+				m.On("Touch", mock.Anything, tokenID).Return(errors.New("connection reset"))
+			},
+		},
+		{
+			name:      "失敗: 接頭辞なし（データベースを参照しない）",
+			token:     "eyJhbGciOiJIUzI1NiJ9.e30.x",
+			setupMock: func(m *MockSCIMTokenRepository) {},
+			wantErr:   ErrInvalidSCIMToken,
+		},
+		{
+			name:  "失敗: 存在しないまたは取り消し済み",
+			token: token,
+			setupMock: func(m *MockSCIMTokenRepository) {
+				m.On("GetByHash", mock.Anything, hashSCIMToken(token)).Return(nil, pgx.ErrNoRows)
+			},
+			wantErr: ErrInvalidSCIMToken,
+		},
+		{
+			name:  "失敗: 有効期限切れ",
+			token: token,
+			setupMock: func(m *MockSCIMTokenRepository) {
+				expired := pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true}
+				m.On("GetByHash", mock.Anything, hashSCIMToken(token)).Return(tokenInfo(expired), nil)
+			},
+			wantErr: ErrInvalidSCIMToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSCIMTokenRepo := new(MockSCIMTokenRepository)
+			tt.setupMock(mockSCIMTokenRepo)
+
+			scimUsecase, err := NewSCIMUsecase(
+				&authUsecase{},
+				new(MockClientUserRepository),
+				new(MockClientRoleRepository),
+				new(MockClientUserRoleRepository),
+				mockSCIMTokenRepo,
+				nil, // database
+			)
+			assert.NoError(t, err)
+
+			principal, err := scimUsecase.Authenticate(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, principal)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tokenID, principal.TokenID)
+				assert.Equal(t, clientID, principal.ClientID)
+			}
+
+			mockSCIMTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSCIMTokenHash(t *testing.T) {
+	// 平文トークンは保存せず、同じトークンから常に同じハッシュを得る
+	assert.Equal(t, hashSCIMToken("scim_token"), hashSCIMToken("scim_token"))
+	assert.NotEqual(t, hashSCIMToken("scim_token"), hashSCIMToken("scim_token2"))
+	assert.Len(t, hashSCIMToken("scim_token"), 64)
+}
+
+func TestNewSCIMUsecase(t *testing.T) {
+	// ユーザーの作成・更新・削除はfxで提供される認証ユースケースと同じインスタンスで行う
+	auth := &authUsecase{}
+	usecase, err := NewSCIMUsecase(auth, nil, nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Same(t, auth, usecase.(*scimUsecase).users)
+
+	_, err = NewSCIMUsecase(nil, nil, nil, nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestSCIMListUsers(t *testing.T) {
+	principal := &SCIMPrincipal{TokenID: uuid.New(), ClientID: uuid.New()}
+	inactive := false
+
+	tests := []struct {
+		name   string
+		filter SCIMUserFilter
+		search repository.ClientUserSearch
+	}{
+		{
+			name:   "絞り込みなし",
+			filter: SCIMUserFilter{},
+			search: repository.ClientUserSearch{},
+		},
+		{
+			name:   "userNameの完全一致（ワイルドカードはエスケープ）",
+			filter: SCIMUserFilter{UserName: "taro_yamada@example.com"},
+			search: repository.ClientUserSearch{EmailPattern: `taro\_yamada@example.com`},
+		},
+		{
+			name:   "userNameの前方一致とactive",
+			filter: SCIMUserFilter{UserName: "taro", UserNamePrefix: true, Active: &inactive},
+			search: repository.ClientUserSearch{EmailPattern: "taro%", Active: &inactive},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientUserRepo := new(MockClientUserRepository)
+			clientUserRepo.On("CountSearch", mock.Anything, principal.ClientID, tt.search).Return(int64(21), nil)
+			clientUserRepo.On("SearchByOffset", mock.Anything, principal.ClientID, tt.search, int32(10), int32(20)).
+				Return([]dbgen.ClientUser{{Email: "taro@example.com"}}, nil)
+			usecase := &scimUsecase{clientUserRepo: clientUserRepo}
+
+			users, total, err := usecase.ListUsers(context.Background(), principal, tt.filter, 10, 20)
+
+			assert.NoError(t, err)
+			assert.Len(t, users, 1)
+			assert.Equal(t, int64(21), total)
+			clientUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSCIMListGroups(t *testing.T) {
+	principal := &SCIMPrincipal{TokenID: uuid.New(), ClientID: uuid.New()}
+	adminRoleID, memberRoleID := uuid.New(), uuid.New()
+	userA, userB := uuid.New(), uuid.New()
+
+	clientRoleRepo := new(MockClientRoleRepository)
+	clientRoleRepo.On("List", mock.Anything, principal.ClientID).Return([]dbgen.ClientRole{
+		{RoleID: pgtype.UUID{Bytes: adminRoleID, Valid: true}, Name: "管理者"},
+		{RoleID: pgtype.UUID{Bytes: memberRoleID, Valid: true}, Name: "メンバー"},
+	}, nil)
+	// メンバーはロールごとではなくクライアント全体で1回だけ取得する
+	clientUserRoleRepo := new(MockClientUserRoleRepository)
+	clientUserRoleRepo.On("ListActiveByClient", mock.Anything, principal.ClientID).Return([]dbgen.ClientUserRole{
+		{RoleID: pgtype.UUID{Bytes: adminRoleID, Valid: true}, ClientUserID: pgtype.UUID{Bytes: userA, Valid: true}},
+		{RoleID: pgtype.UUID{Bytes: adminRoleID, Valid: true}, ClientUserID: pgtype.UUID{Bytes: userB, Valid: true}},
+	}, nil).Once()
+	usecase := &scimUsecase{clientRoleRepo: clientRoleRepo, clientUserRoleRepo: clientUserRoleRepo}
+
+	groups, err := usecase.ListGroups(context.Background(), principal)
+
+	assert.NoError(t, err)
+	if assert.Len(t, groups, 2) {
+		assert.Equal(t, []uuid.UUID{userA, userB}, groups[0].MemberIDs)
+		assert.Equal(t, []uuid.UUID{}, groups[1].MemberIDs)
+	}
+	clientUserRoleRepo.AssertExpectations(t)
+	clientUserRoleRepo.AssertNotCalled(t, "GetByRoleID", mock.Anything, mock.Anything, mock.Anything)
+}
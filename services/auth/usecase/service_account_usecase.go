@@ -0,0 +1,415 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"contract-pro-suite/internal/shared/db"
+	"contract-pro-suite/services/auth/domain"
+	"contract-pro-suite/services/auth/repository"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// APIKeyPrefix APIキーの接頭辞（漏洩時のシークレットスキャン用、Bearerトークンとの判別にも使用）
+const APIKeyPrefix = "cps_"
+
+// MaxAPIKeyRotationGracePeriod ローテーション時に旧キーを併用できる最長期間
+const MaxAPIKeyRotationGracePeriod = 7 * 24 * time.Hour
+
+var (
+	// ErrServiceAccountNotFound サービスアカウントがクライアント内に存在しない
+	ErrServiceAccountNotFound = domain.NewError(domain.ErrorKindNotFound, domain.ReasonServiceAccountNotFound, "service account not found")
+	// ErrServiceAccountAlreadyExists 同じ名前のサービスアカウントが既に存在する
+	ErrServiceAccountAlreadyExists = domain.NewError(domain.ErrorKindConflict, domain.ReasonServiceAccountAlreadyExists, "service account already exists")
+	// ErrAPIKeyNotFound APIキーがクライアント内に存在しない、または取り消し済み
+	ErrAPIKeyNotFound = domain.NewError(domain.ErrorKindNotFound, domain.ReasonAPIKeyNotFound, "api key not found")
+	// ErrInvalidRole 指定されたロールがクライアント内に存在しない
+	ErrInvalidRole = domain.NewValidationError(domain.ReasonInvalidRole, "role_id", "invalid role")
+)
+
+// CreateServiceAccountParams サービスアカウント作成パラメータ
+type CreateServiceAccountParams struct {
+	Name        string
+	Description *string
+	RoleID      uuid.UUID
+}
+
+// CreateAPIKeyResult APIキー発行結果
+type CreateAPIKeyResult struct {
+	Key    string // 平文キー（発行時のみ返却）
+	APIKey dbgen.ClientApiKey
+}
+
+// ServiceAccountUsecase サービスアカウント・APIキー管理ユースケース
+// 管理操作は人間のユーザーに限定し、サービスアカウント自身による管理は許可しない
+type ServiceAccountUsecase interface {
+	// ListServiceAccounts サービスアカウント一覧取得（権限: system_settings:READ）
+	ListServiceAccounts(ctx context.Context, userCtx *domain.UserContext) ([]dbgen.ClientServiceAccount, error)
+	// CreateServiceAccount サービスアカウント作成（権限: system_settings:WRITE）
+	CreateServiceAccount(ctx context.Context, userCtx *domain.UserContext, params CreateServiceAccountParams) (dbgen.ClientServiceAccount, error)
+	// DeleteServiceAccount サービスアカウント削除（論理削除、発行済みAPIキーはすべて取り消し、権限: system_settings:WRITE）
+	DeleteServiceAccount(ctx context.Context, userCtx *domain.UserContext, serviceAccountID uuid.UUID) error
+
+	// ListAPIKeys APIキー一覧取得（平文・ハッシュは返さない、権限: system_settings:READ）
+	ListAPIKeys(ctx context.Context, userCtx *domain.UserContext, serviceAccountID uuid.UUID) ([]dbgen.ClientApiKey, error)
+	// CreateAPIKey APIキー発行（権限: system_settings:WRITE）
+	CreateAPIKey(ctx context.Context, userCtx *domain.UserContext, serviceAccountID uuid.UUID, expiresAt *time.Time) (*CreateAPIKeyResult, error)
+	// RotateAPIKey APIキーのローテーション（新キーを発行し、旧キーは猶予期間後に失効、権限: system_settings:WRITE）
+	RotateAPIKey(ctx context.Context, userCtx *domain.UserContext, apiKeyID uuid.UUID, gracePeriod time.Duration, expiresAt *time.Time) (*CreateAPIKeyResult, error)
+	// RevokeAPIKey APIキー取り消し（権限: system_settings:WRITE）
+	RevokeAPIKey(ctx context.Context, userCtx *domain.UserContext, apiKeyID uuid.UUID) error
+}
+
+type serviceAccountUsecase struct {
+	authUsecase        AuthUsecase
+	serviceAccountRepo repository.ServiceAccountRepository
+	apiKeyRepo         repository.APIKeyRepository
+	clientRoleRepo     repository.ClientRoleRepository
+	database           *db.DB
+}
+
+// NewServiceAccountUsecase サービスアカウントユースケースを作成
+func NewServiceAccountUsecase(
+	authUsecase AuthUsecase,
+	serviceAccountRepo repository.ServiceAccountRepository,
+	apiKeyRepo repository.APIKeyRepository,
+	clientRoleRepo repository.ClientRoleRepository,
+	database *db.DB,
+) ServiceAccountUsecase {
+	return &serviceAccountUsecase{
+		authUsecase:        authUsecase,
+		serviceAccountRepo: serviceAccountRepo,
+		apiKeyRepo:         apiKeyRepo,
+		clientRoleRepo:     clientRoleRepo,
+		database:           database,
+	}
+}
+
+// HashAPIKey APIキーのSHA-256（16進数）を計算
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetUserContextByAPIKey APIキーで認証されたサービスアカウントを解決
+func (u *authUsecase) GetUserContextByAPIKey(ctx context.Context, identity domain.APIKeyIdentity) (*domain.UserContext, error) {
+	account, err := u.serviceAccountRepo.GetByID(ctx, identity.ClientID, identity.ServiceAccountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("user not found: service account is deleted")
+		}
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	if account.Status != "ACTIVE" {
+		return nil, errors.New("service account is not active")
+	}
+
+	return &domain.UserContext{
+		UserID:   uuidFromPGType(account.ServiceAccountID),
+		UserType: domain.UserTypeServiceAccount,
+		ClientID: uuidFromPGType(account.ClientID),
+	}, nil
+}
+
+// authorize 管理操作の権限チェック（サービスアカウント自身による管理は不可）
+func (u *serviceAccountUsecase) authorize(ctx context.Context, userCtx *domain.UserContext, action string) error {
+	if userCtx.UserType == domain.UserTypeServiceAccount {
+		return fmt.Errorf("%w: service accounts cannot manage service accounts or api keys", ErrPermissionDenied)
+	}
+
+	// 1. クライアントアクセス権限チェック
+	if err := u.authUsecase.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
+		return err
+	}
+
+	// 2. 権限チェック: system_settings
+	if err := u.authUsecase.CheckPermission(ctx, userCtx, "system_settings", action); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getServiceAccount クライアント内のサービスアカウントを取得
+func (u *serviceAccountUsecase) getServiceAccount(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID) (dbgen.ClientServiceAccount, error) {
+	account, err := u.serviceAccountRepo.GetByID(ctx, clientID, serviceAccountID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dbgen.ClientServiceAccount{}, fmt.Errorf("%w: %s", ErrServiceAccountNotFound, serviceAccountID)
+		}
+		return dbgen.ClientServiceAccount{}, fmt.Errorf("failed to get service account: %w", err)
+	}
+	return account, nil
+}
+
+// ListServiceAccounts サービスアカウント一覧取得
+func (u *serviceAccountUsecase) ListServiceAccounts(ctx context.Context, userCtx *domain.UserContext) ([]dbgen.ClientServiceAccount, error) {
+	if err := u.authorize(ctx, userCtx, "READ"); err != nil {
+		return nil, err
+	}
+
+	accounts, err := u.serviceAccountRepo.List(ctx, userCtx.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// CreateServiceAccount サービスアカウント作成
+func (u *serviceAccountUsecase) CreateServiceAccount(ctx context.Context, userCtx *domain.UserContext, params CreateServiceAccountParams) (dbgen.ClientServiceAccount, error) {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return dbgen.ClientServiceAccount{}, err
+	}
+
+	// 1. ロールが自クライアントのものか確認（クライアント分離チェック）
+	role, err := u.clientRoleRepo.GetByID(ctx, params.RoleID)
+	if err != nil || uuidFromPGType(role.ClientID) != userCtx.ClientID {
+		return dbgen.ClientServiceAccount{}, fmt.Errorf("%w: %s", ErrInvalidRole, params.RoleID)
+	}
+
+	// 2. 名前の重複チェック
+	accounts, err := u.serviceAccountRepo.List(ctx, userCtx.ClientID)
+	if err != nil {
+		return dbgen.ClientServiceAccount{}, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	for _, account := range accounts {
+		if account.Name == params.Name {
+			return dbgen.ClientServiceAccount{}, fmt.Errorf("%w: %s", ErrServiceAccountAlreadyExists, params.Name)
+		}
+	}
+
+	// 3. 作成
+	createParams := dbgen.CreateServiceAccountParams{
+		ServiceAccountID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		ClientID:         pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		Name:             params.Name,
+		RoleID:           pgtype.UUID{Bytes: params.RoleID, Valid: true},
+		CreatedBy:        pgtype.UUID{Bytes: userCtx.UserID, Valid: true},
+	}
+	if params.Description != nil {
+		createParams.Description = pgtype.Text{String: *params.Description, Valid: true}
+	}
+
+	account, err := u.serviceAccountRepo.Create(ctx, createParams)
+	if err != nil {
+		return dbgen.ClientServiceAccount{}, fmt.Errorf("failed to create service account: %w", err)
+	}
+	return account, nil
+}
+
+// DeleteServiceAccount サービスアカウント削除
+func (u *serviceAccountUsecase) DeleteServiceAccount(ctx context.Context, userCtx *domain.UserContext, serviceAccountID uuid.UUID) error {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return err
+	}
+
+	// データベーストランザクション開始（論理削除とAPIキーの取り消しを同時に行う）
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// トランザクション内で準備済みステートメントをクリア
+	_, _ = tx.Exec(ctx, "DEALLOCATE ALL")
+
+	queries := dbgen.New(tx)
+
+	deleted, err := queries.DeleteServiceAccount(ctx, dbgen.DeleteServiceAccountParams{
+		ClientID:         pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+		DeletedBy:        pgtype.UUID{Bytes: userCtx.UserID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete service account: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %s", ErrServiceAccountNotFound, serviceAccountID)
+	}
+
+	if err := queries.RevokeServiceAccountApiKeys(ctx, dbgen.RevokeServiceAccountApiKeysParams{
+		ClientID:         pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to revoke api keys: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListAPIKeys APIキー一覧取得
+func (u *serviceAccountUsecase) ListAPIKeys(ctx context.Context, userCtx *domain.UserContext, serviceAccountID uuid.UUID) ([]dbgen.ClientApiKey, error) {
+	if err := u.authorize(ctx, userCtx, "READ"); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.getServiceAccount(ctx, userCtx.ClientID, serviceAccountID); err != nil {
+		return nil, err
+	}
+
+	keys, err := u.apiKeyRepo.List(ctx, userCtx.ClientID, serviceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+// CreateAPIKey APIキー発行
+func (u *serviceAccountUsecase) CreateAPIKey(ctx context.Context, userCtx *domain.UserContext, serviceAccountID uuid.UUID, expiresAt *time.Time) (*CreateAPIKeyResult, error) {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.getServiceAccount(ctx, userCtx.ClientID, serviceAccountID); err != nil {
+		return nil, err
+	}
+
+	params, key, err := u.newAPIKeyParams(userCtx, serviceAccountID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := u.apiKeyRepo.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return &CreateAPIKeyResult{
+		Key:    key,
+		APIKey: apiKey,
+	}, nil
+}
+
+// RotateAPIKey APIキーのローテーション
+// 猶予期間が0の場合、旧キーは即時に取り消す
+func (u *serviceAccountUsecase) RotateAPIKey(ctx context.Context, userCtx *domain.UserContext, apiKeyID uuid.UUID, gracePeriod time.Duration, expiresAt *time.Time) (*CreateAPIKeyResult, error) {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return nil, err
+	}
+	if gracePeriod < 0 || gracePeriod > MaxAPIKeyRotationGracePeriod {
+		return nil, domain.NewValidationError(domain.ReasonInvalidGracePeriod, "grace_period", fmt.Sprintf("grace period must be between 0 and %s", MaxAPIKeyRotationGracePeriod))
+	}
+
+	// 1. 旧キーの取得（クライアント分離チェック）
+	oldKey, err := u.apiKeyRepo.GetByID(ctx, userCtx.ClientID, apiKeyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", ErrAPIKeyNotFound, apiKeyID)
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	if oldKey.RevokedAt.Valid {
+		return nil, fmt.Errorf("%w: %s", ErrAPIKeyNotFound, apiKeyID)
+	}
+	serviceAccountID := uuidFromPGType(oldKey.ServiceAccountID)
+	if _, err := u.getServiceAccount(ctx, userCtx.ClientID, serviceAccountID); err != nil {
+		return nil, err
+	}
+
+	params, key, err := u.newAPIKeyParams(userCtx, serviceAccountID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	params.RotatedFrom = oldKey.ApiKeyID
+
+	// データベーストランザクション開始（新キーの発行と旧キーの失効設定を同時に行う）
+	tx, err := u.database.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// トランザクション内で準備済みステートメントをクリア
+	_, _ = tx.Exec(ctx, "DEALLOCATE ALL")
+
+	queries := dbgen.New(tx)
+
+	// 2. 新キーの発行
+	apiKey, err := queries.CreateApiKey(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	// 3. 旧キーの失効（猶予期間内は併用可能、既存の有効期限がより早い場合はそのまま）
+	if gracePeriod == 0 {
+		_, err = queries.RevokeApiKey(ctx, dbgen.RevokeApiKeyParams{
+			ClientID: oldKey.ClientID,
+			ApiKeyID: oldKey.ApiKeyID,
+		})
+	} else {
+		graceExpiresAt := time.Now().Add(gracePeriod)
+		if oldKey.ExpiresAt.Valid && oldKey.ExpiresAt.Time.Before(graceExpiresAt) {
+			graceExpiresAt = oldKey.ExpiresAt.Time
+		}
+		_, err = queries.SetApiKeyExpiry(ctx, dbgen.SetApiKeyExpiryParams{
+			ClientID:  oldKey.ClientID,
+			ApiKeyID:  oldKey.ApiKeyID,
+			ExpiresAt: pgtype.Timestamptz{Time: graceExpiresAt, Valid: true},
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire old api key: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &CreateAPIKeyResult{
+		Key:    key,
+		APIKey: apiKey,
+	}, nil
+}
+
+// RevokeAPIKey APIキー取り消し
+func (u *serviceAccountUsecase) RevokeAPIKey(ctx context.Context, userCtx *domain.UserContext, apiKeyID uuid.UUID) error {
+	if err := u.authorize(ctx, userCtx, "WRITE"); err != nil {
+		return err
+	}
+
+	// 取り消し（クライアント分離: 自クライアントのキーのみ）
+	revoked, err := u.apiKeyRepo.Revoke(ctx, userCtx.ClientID, apiKeyID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	if revoked == 0 {
+		return fmt.Errorf("%w: %s", ErrAPIKeyNotFound, apiKeyID)
+	}
+	return nil
+}
+
+// newAPIKeyParams APIキーを生成し、作成パラメータを組み立てる（平文は保存せず、ハッシュのみ保存）
+func (u *serviceAccountUsecase) newAPIKeyParams(userCtx *domain.UserContext, serviceAccountID uuid.UUID, expiresAt *time.Time) (dbgen.CreateApiKeyParams, string, error) {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return dbgen.CreateApiKeyParams{}, "", domain.NewValidationError(domain.ReasonInvalidExpiresAt, "expires_at", "expires_at must be in the future")
+	}
+
+	secret, err := generateSecret(32)
+	if err != nil {
+		return dbgen.CreateApiKeyParams{}, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	key := APIKeyPrefix + secret
+
+	params := dbgen.CreateApiKeyParams{
+		ApiKeyID:         pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		ClientID:         pgtype.UUID{Bytes: userCtx.ClientID, Valid: true},
+		ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+		KeyHash:          HashAPIKey(key),
+		KeyPrefix:        key[:len(APIKeyPrefix)+8],
+		CreatedBy:        pgtype.UUID{Bytes: userCtx.UserID, Valid: true},
+	}
+	if expiresAt != nil {
+		params.ExpiresAt = pgtype.Timestamptz{Time: *expiresAt, Valid: true}
+	}
+	return params, key, nil
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockServiceAccountRepository モックサービスアカウントリポジトリ
+type MockServiceAccountRepository struct {
+	mock.Mock
+}
+
+func (m *MockServiceAccountRepository) GetByID(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID) (dbgen.ClientServiceAccount, error) {
+	args := m.Called(ctx, clientID, serviceAccountID)
+	if args.Get(0) == nil {
+		return dbgen.ClientServiceAccount{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientServiceAccount), args.Error(1)
+}
+
+func (m *MockServiceAccountRepository) List(ctx context.Context, clientID uuid.UUID) ([]dbgen.ClientServiceAccount, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.ClientServiceAccount), args.Error(1)
+}
+
+func (m *MockServiceAccountRepository) Create(ctx context.Context, params dbgen.CreateServiceAccountParams) (dbgen.ClientServiceAccount, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return dbgen.ClientServiceAccount{}, args.Error(1)
+	}
+	return args.Get(0).(dbgen.ClientServiceAccount), args.Error(1)
+}
+
+func (m *MockServiceAccountRepository) Delete(ctx context.Context, clientID uuid.UUID, serviceAccountID uuid.UUID, deletedBy uuid.UUID) (int64, error) {
+	args := m.Called(ctx, clientID, serviceAccountID, deletedBy)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestGetUserContextByAPIKey(t *testing.T) {
+	clientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	serviceAccountID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174200")
+	identity := domain.APIKeyIdentity{
+		APIKeyID:         uuid.MustParse("123e4567-e89b-12d3-a456-426614174201"),
+		ServiceAccountID: serviceAccountID,
+		ClientID:         clientID,
+	}
+
+	account := func(status string) dbgen.ClientServiceAccount {
+		return dbgen.ClientServiceAccount{
+			ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+			ClientID:         pgtype.UUID{Bytes: clientID, Valid: true},
+			Name:             "erp-sync",
+			Status:           status,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*MockServiceAccountRepository)
+		wantErr   bool
+	}{
+		{
+			name: "成功: 有効なサービスアカウント",
+			setupMock: func(m *MockServiceAccountRepository) {
+				m.On("GetByID", mock.Anything, clientID, serviceAccountID).Return(account("ACTIVE"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "失敗: 削除済み",
+			setupMock: func(m *MockServiceAccountRepository) {
+				m.On("GetByID", mock.Anything, clientID, serviceAccountID).Return(nil, pgx.ErrNoRows)
+			},
+			wantErr: true,
+		},
+		{
+			name: "失敗: 無効化されている",
+			setupMock: func(m *MockServiceAccountRepository) {
+				m.On("GetByID", mock.Anything, clientID, serviceAccountID).Return(account("INACTIVE"), nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiceAccountRepo := new(MockServiceAccountRepository)
+			tt.setupMock(mockServiceAccountRepo)
+
+			usecase := NewAuthUsecase(
+				new(MockOperatorRepository),
+				new(MockClientUserRepository),
+				new(MockClientRepository),
+				new(MockOperatorAssignmentRepository),
+				new(MockClientRoleRepository),
+				new(MockClientRolePermissionRepository),
+				new(MockClientUserRoleRepository),
+				nil, // identityProviderRepo
+				nil, // clientUserIdentityRepo
+				mockServiceAccountRepo,
+				nil, // tokenRevocationRepo
+				nil, // challengeVerifier
+				nil, // signupVerificationSender
+				nil, // breachedPasswordChecker
+				nil, // emailChangeSender
+				nil, // config
+				nil, // database
+			)
+
+			userCtx, err := usecase.GetUserContextByAPIKey(context.Background(), identity)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, userCtx)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, serviceAccountID, userCtx.UserID)
+				assert.Equal(t, domain.UserTypeServiceAccount, userCtx.UserType)
+				assert.Equal(t, clientID, userCtx.ClientID)
+			}
+
+			mockServiceAccountRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCheckPermission_ServiceAccount(t *testing.T) {
+	clientID := uuid.New()
+	serviceAccountID := uuid.New()
+	roleID := uuid.New()
+
+	userCtx := &domain.UserContext{
+		UserID:   serviceAccountID,
+		UserType: domain.UserTypeServiceAccount,
+		ClientID: clientID,
+	}
+
+	mockServiceAccountRepo := new(MockServiceAccountRepository)
+	mockServiceAccountRepo.On("GetByID", mock.Anything, clientID, serviceAccountID).Return(dbgen.ClientServiceAccount{
+		ServiceAccountID: pgtype.UUID{Bytes: serviceAccountID, Valid: true},
+		ClientID:         pgtype.UUID{Bytes: clientID, Valid: true},
+		RoleID:           pgtype.UUID{Bytes: roleID, Valid: true},
+		Status:           "ACTIVE",
+	}, nil)
+
+	mockClientRolePermissionRepo := new(MockClientRolePermissionRepository)
+	mockClientRolePermissionRepo.On("GetByRoleID", mock.Anything, roleID).Return([]dbgen.ClientRolePermission{
+		{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}, Feature: "contracts", Action: "READ", Granted: true},
+		{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}, Feature: "contracts", Action: "WRITE", Granted: false},
+	}, nil)
+
+	usecase := NewAuthUsecase(
+		new(MockOperatorRepository),
+		new(MockClientUserRepository),
+		new(MockClientRepository),
+		new(MockOperatorAssignmentRepository),
+		new(MockClientRoleRepository),
+		mockClientRolePermissionRepo,
+		new(MockClientUserRoleRepository),
+		nil, // identityProviderRepo
+		nil, // clientUserIdentityRepo
+		mockServiceAccountRepo,
+		nil, // tokenRevocationRepo
+		nil, // challengeVerifier
+		nil, // signupVerificationSender
+		nil, // breachedPasswordChecker
+		nil, // emailChangeSender
+		nil, // config
+		nil, // database
+	)
+
+	// ロールで許可された権限のみ通過する
+	assert.NoError(t, usecase.CheckPermission(context.Background(), userCtx, "contracts", "READ"))
+	assert.Error(t, usecase.CheckPermission(context.Background(), userCtx, "contracts", "WRITE"))
+	assert.Error(t, usecase.CheckPermission(context.Background(), userCtx, "users", "READ"))
+
+	// クライアント分離: 所属クライアント以外へのアクセスは拒否
+	assert.NoError(t, usecase.ValidateClientAccess(context.Background(), userCtx, clientID))
+	assert.Error(t, usecase.ValidateClientAccess(context.Background(), userCtx, uuid.New()))
+}
+
+func TestServiceAccountUsecase_RejectsServiceAccountCaller(t *testing.T) {
+	// サービスアカウント自身はサービスアカウント・APIキーを管理できない（権限の自己拡張を防ぐ）
+	serviceAccountUsecase := NewServiceAccountUsecase(nil, nil, nil, nil, nil)
+	userCtx := &domain.UserContext{
+		UserID:   uuid.New(),
+		UserType: domain.UserTypeServiceAccount,
+		ClientID: uuid.New(),
+	}
+
+	_, err := serviceAccountUsecase.CreateAPIKey(context.Background(), userCtx, uuid.New(), nil)
+	assert.ErrorContains(t, err, "permission denied")
+
+	err = serviceAccountUsecase.RevokeAPIKey(context.Background(), userCtx, uuid.New())
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+func TestHashAPIKey(t *testing.T) {
+	// 平文キーは保存せず、同じキーから常に同じハッシュを得る
+	assert.Equal(t, HashAPIKey("cps_key"), HashAPIKey("cps_key"))
+	assert.NotEqual(t, HashAPIKey("cps_key"), HashAPIKey("cps_key2"))
+	assert.Len(t, HashAPIKey("cps_key"), 64)
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/mail"
+	"contract-pro-suite/services/auth/domain"
+)
+
+var (
+	// ErrDisposableEmailDomain 使い捨てメールアドレスのドメインでは登録できない
+	ErrDisposableEmailDomain = domain.NewValidationError(domain.ReasonDisposableEmailDomain, "admin_email", "disposable email domain is not allowed")
+	// ErrChallengeFailed ボット対策のチャレンジトークンが未指定または検証に失敗した
+	ErrChallengeFailed = domain.NewError(domain.ErrorKindPermissionDenied, domain.ReasonChallengeFailed, "challenge verification failed")
+	// ErrInvalidSignupVerification 登録確認トークンが存在しない（または検証済み）
+	ErrInvalidSignupVerification = domain.NewError(domain.ErrorKindNotFound, domain.ReasonSignupVerificationNotFound, "invalid signup verification token")
+	// ErrSignupVerificationExpired 登録確認トークンの有効期限切れ
+	ErrSignupVerificationExpired = domain.NewError(domain.ErrorKindPreconditionFailed, domain.ReasonSignupVerificationExpired, "signup verification token expired")
+)
+
+// disposableEmailDomains 使い捨てメールアドレスとして登録を拒否するドメイン（サブドメインも対象）
+// 追加分は環境変数DISPOSABLE_EMAIL_DOMAINSで指定する
+var disposableEmailDomains = []string{
+	"10minutemail.com",
+	"20minutemail.com",
+	"discard.email",
+	"dispostable.com",
+	"fakeinbox.com",
+	"getnada.com",
+	"guerrillamail.com",
+	"guerrillamail.net",
+	"mailinator.com",
+	"maildrop.cc",
+	"mailnesia.com",
+	"mintemail.com",
+	"mohmal.com",
+	"sharklasers.com",
+	"spamgourmet.com",
+	"temp-mail.org",
+	"tempmail.com",
+	"tempmailo.com",
+	"throwawaymail.com",
+	"trashmail.com",
+	"yopmail.com",
+}
+
+// isDisposableEmail メールアドレスのドメイン（またはその親ドメイン）が使い捨てメールのドメインか判定
+func isDisposableEmail(email string, extraDomains []string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(email[at+1:])), ".")
+	for domain != "" {
+		for _, list := range [][]string{disposableEmailDomains, extraDomains} {
+			for _, disposable := range list {
+				if domain == disposable {
+					return true
+				}
+			}
+		}
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			break
+		}
+		domain = domain[dot+1:]
+	}
+	return false
+}
+
+// ChallengeVerifier ボット対策のチャレンジトークン（CAPTCHA等）を検証
+// 検証に失敗した場合はErrChallengeFailedを返す
+type ChallengeVerifier interface {
+	VerifyChallenge(ctx context.Context, token string) error
+}
+
+// LocalChallengeVerifier ローカル開発用のチャレンジ検証（設定したトークンのみ受け付ける）
+type LocalChallengeVerifier struct {
+	token string
+}
+
+// NewChallengeVerifier チャレンジ検証を作成（SIGNUP_CHALLENGE_LOCAL_TOKEN未設定の場合はnilを返し、検証を行わない）
+func NewChallengeVerifier(cfg *config.Config) ChallengeVerifier {
+	if cfg == nil || cfg.SignupChallengeLocalToken == "" {
+		return nil
+	}
+	return &LocalChallengeVerifier{token: cfg.SignupChallengeLocalToken}
+}
+
+// VerifyChallenge トークンが設定値と一致するか検証
+func (v *LocalChallengeVerifier) VerifyChallenge(ctx context.Context, token string) error {
+	if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(v.token)) != 1 {
+		return ErrChallengeFailed
+	}
+	return nil
+}
+
+// SignupVerificationSender 登録確認トークン（確認URL）を管理者メールアドレスに送信
+type SignupVerificationSender interface {
+	SendSignupVerification(ctx context.Context, email string, clientName string, verificationURL string) error
+}
+
+// MailSignupVerificationSender 確認URLをメール（SMTP）で送信
+type MailSignupVerificationSender struct {
+	mailer *mail.Sender
+}
+
+// LogSignupVerificationSender ログ出力のみを行う送信（APP_ENV=developmentでのみ使用する）
+type LogSignupVerificationSender struct{}
+
+// NewSignupVerificationSender 登録確認の送信を作成
+// SMTP未設定の場合、確認URLをログに出力する送信は開発環境でのみ許可し、それ以外の環境ではエラー（起動しない）
+func NewSignupVerificationSender(cfg *config.Config, mailer *mail.Sender) (SignupVerificationSender, error) {
+	if mailer != nil {
+		return &MailSignupVerificationSender{mailer: mailer}, nil
+	}
+	if cfg != nil && cfg.IsDevelopment() {
+		return &LogSignupVerificationSender{}, nil
+	}
+	return nil, errors.New("signup verification sender is not configured: set SMTP_HOST and MAIL_FROM (logging verification urls is only allowed with APP_ENV=development)")
+}
+
+// SendSignupVerification 確認URLをメールで送信
+func (s *MailSignupVerificationSender) SendSignupVerification(ctx context.Context, email string, clientName string, verificationURL string) error {
+	body := fmt.Sprintf("ContractProSuiteへの%sの登録を受け付けました。\n以下のURLを開いて登録を完了してください。\n\n%s\n\nこのメールに心当たりがない場合は破棄してください。\n", clientName, verificationURL)
+	return s.mailer.Send(ctx, email, "【ContractProSuite】登録確認", body)
+}
+
+// SendSignupVerification 確認URLをログに出力
+func (s *LogSignupVerificationSender) SendSignupVerification(ctx context.Context, email string, clientName string, verificationURL string) error {
+	log.Printf("Signup verification for %s (%s): %s", email, clientName, verificationURL)
+	return nil
+}
+
+// buildVerificationURL 確認URLを作成（既存のクエリパラメータは維持）
+func buildVerificationURL(baseURL string, token string) (string, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	query := parsed.Query()
+	query.Set("token", token)
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+// hashVerificationToken 確認トークンのSHA-256ハッシュ（16進）
+func hashVerificationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"contract-pro-suite/internal/shared/config"
+	"contract-pro-suite/internal/shared/mail"
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDisposableEmail(t *testing.T) {
+	tests := []struct {
+		name         string
+		email        string
+		extraDomains []string
+		want         bool
+	}{
+		{name: "通常のドメイン", email: "admin@example.co.jp", want: false},
+		{name: "組み込みリスト", email: "admin@mailinator.com", want: true},
+		{name: "大文字・末尾のドット", email: "Admin@YopMail.com.", want: true},
+		{name: "サブドメイン", email: "admin@inbox.guerrillamail.com", want: true},
+		{name: "部分一致は対象外", email: "admin@notmailinator.com", want: false},
+		{name: "追加ドメイン", email: "admin@spam.example", extraDomains: []string{"spam.example"}, want: true},
+		{name: "@なし", email: "admin", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isDisposableEmail(tt.email, tt.extraDomains))
+		})
+	}
+}
+
+func TestNewChallengeVerifier(t *testing.T) {
+	// 未設定の場合はチャレンジ検証を行わない
+	assert.Nil(t, NewChallengeVerifier(&config.Config{}))
+
+	verifier := NewChallengeVerifier(&config.Config{SignupChallengeLocalToken: "local-token"})
+	assert.NotNil(t, verifier)
+	assert.NoError(t, verifier.VerifyChallenge(context.Background(), "local-token"))
+	assert.ErrorIs(t, verifier.VerifyChallenge(context.Background(), "other-token"), ErrChallengeFailed)
+	assert.ErrorIs(t, verifier.VerifyChallenge(context.Background(), ""), ErrChallengeFailed)
+}
+
+func TestCheckSignupAbuse(t *testing.T) {
+	cfg := &config.Config{
+		SignupChallengeLocalToken: "local-token",
+		DisposableEmailDomainsStr: "spam.example",
+	}
+
+	tests := []struct {
+		name    string
+		params  SignupClientParams
+		wantErr error
+	}{
+		{
+			name:   "成功",
+			params: SignupClientParams{AdminEmail: "admin@example.com", ChallengeToken: "local-token"},
+		},
+		{
+			name:    "失敗: 使い捨てメールアドレス",
+			params:  SignupClientParams{AdminEmail: "admin@spam.example", ChallengeToken: "local-token"},
+			wantErr: ErrDisposableEmailDomain,
+		},
+		{
+			name:    "失敗: チャレンジトークン未指定",
+			params:  SignupClientParams{AdminEmail: "admin@example.com"},
+			wantErr: ErrChallengeFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// データベースへの書き込み前に判定するため、リポジトリ・データベースは不要
+			usecase := &authUsecase{
+				challengeVerifier:        NewChallengeVerifier(cfg),
+				signupVerificationSender: &LogSignupVerificationSender{},
+				cfg:                      cfg,
+			}
+			err := usecase.checkSignupAbuse(context.Background(), tt.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// stubSignupVerificationSender 送信結果を指定できる登録確認の送信
+type stubSignupVerificationSender struct {
+	err  error
+	sent []string // 送信した確認URL
+}
+
+func (s *stubSignupVerificationSender) SendSignupVerification(ctx context.Context, email string, clientName string, verificationURL string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, verificationURL)
+	return nil
+}
+
+func TestSignupClient_ResendPendingVerification(t *testing.T) {
+	clientID := uuid.New()
+	adminUserID := uuid.New()
+	pending := dbgen.Client{
+		ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
+		Slug:     "acme",
+		Name:     "Acme",
+		Status:   string(domain.ClientStatusPendingVerification),
+	}
+	verification := dbgen.ClientSignupVerification{
+		ClientID:    pending.ClientID,
+		AdminUserID: pgtype.UUID{Bytes: adminUserID, Valid: true},
+		Email:       "admin@example.com",
+	}
+
+	tests := []struct {
+		name      string
+		client    dbgen.Client
+		renewErr  error // 確認トークンの再発行結果（nilの場合は再発行できる）
+		sendErr   error
+		wantRenew bool
+		wantErr   error
+		wantSent  bool
+	}{
+		{
+			name:      "成功: 登録確認待ちのクライアントに確認メールを再送",
+			client:    pending,
+			wantRenew: true,
+			wantSent:  true,
+		},
+		{
+			name:      "失敗: 確認メールの送信に失敗（再試行で再送できる）",
+			client:    pending,
+			sendErr:   errors.New("smtp unavailable"),
+			wantRenew: true,
+		},
+		{
+			name:      "失敗: 管理者メールアドレスが異なる",
+			client:    pending,
+			renewErr:  pgx.ErrNoRows,
+			wantRenew: true,
+			wantErr:   ErrSlugAlreadyExists,
+		},
+		{
+			name:    "失敗: 有効化済みのクライアント",
+			client:  dbgen.Client{ClientID: pending.ClientID, Slug: "acme", Status: string(domain.ClientStatusActive)},
+			wantErr: ErrSlugAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{SignupVerificationURL: "https://app.example.com/signup/verify", SignupVerificationTTL: time.Hour}
+			mockClientRepo := new(MockClientRepository)
+			mockClientRepo.On("GetBySlug", mock.Anything, "acme").Return(tt.client, nil)
+			if tt.wantRenew {
+				mockClientRepo.On("RenewSignupVerification", mock.Anything, clientID, "admin@example.com", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+					Return(verification, tt.renewErr)
+			}
+			sender := &stubSignupVerificationSender{err: tt.sendErr}
+
+			// 確認待ちのクライアントを再利用するため、トランザクション（データベース）は使わない
+			usecase := NewAuthUsecase(
+				new(MockOperatorRepository),
+				new(MockClientUserRepository),
+				mockClientRepo,
+				new(MockOperatorAssignmentRepository),
+				new(MockClientRoleRepository),
+				new(MockClientRolePermissionRepository),
+				new(MockClientUserRoleRepository),
+				nil, // identityProviderRepo
+				nil, // clientUserIdentityRepo
+				nil, // serviceAccountRepo
+				nil, // tokenRevocationRepo
+				nil, // challengeVerifier
+				sender,
+				nil, // breachedPasswordChecker
+				nil, // emailChangeSender
+				cfg,
+				nil, // database
+			)
+
+			result, err := usecase.SignupClient(context.Background(), SignupClientParams{
+				Name:           "Acme",
+				Slug:           "acme",
+				AdminEmail:     "admin@example.com",
+				AdminPassword:  "Str0ng-Passw0rd!",
+				AdminFirstName: "太郎",
+				AdminLastName:  "山田",
+			})
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.sendErr != nil:
+				assert.ErrorIs(t, err, tt.sendErr)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, clientID, result.ClientID)
+				assert.Equal(t, adminUserID, result.AdminUserID)
+				assert.Equal(t, domain.ClientStatusPendingVerification, result.Status)
+			}
+			if tt.wantSent {
+				require.Len(t, sender.sent, 1)
+				assert.Contains(t, sender.sent[0], "https://app.example.com/signup/verify?token=")
+			} else {
+				assert.Empty(t, sender.sent)
+			}
+			mockClientRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewVerificationSenders(t *testing.T) {
+	mailer, err := mail.NewSender(&config.Config{SMTPHost: "smtp.example.com", SMTPPort: "587", MailFrom: "noreply@example.com"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		appEnv  string
+		mailer  *mail.Sender
+		wantLog bool
+		wantErr bool
+	}{
+		{name: "SMTP設定済み", appEnv: "production", mailer: mailer},
+		{name: "開発環境でSMTP未設定はログ出力", appEnv: "development", wantLog: true},
+		{name: "本番環境でSMTP未設定は起動しない", appEnv: "production", wantErr: true},
+		{name: "ステージング環境でSMTP未設定は起動しない", appEnv: "staging", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{AppEnv: tt.appEnv}
+
+			signupSender, err := NewSignupVerificationSender(cfg, tt.mailer)
+			emailChangeSender, emailChangeErr := NewEmailChangeSender(cfg, tt.mailer)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Error(t, emailChangeErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, emailChangeErr)
+
+			_, signupLog := signupSender.(*LogSignupVerificationSender)
+			_, emailChangeLog := emailChangeSender.(*LogEmailChangeSender)
+			assert.Equal(t, tt.wantLog, signupLog)
+			assert.Equal(t, tt.wantLog, emailChangeLog)
+		})
+	}
+}
+
+func TestBuildVerificationURL(t *testing.T) {
+	got, err := buildVerificationURL("https://app.example.com/signup/verify?lang=ja", "abc_123")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://app.example.com/signup/verify?lang=ja&token=abc_123", got)
+}
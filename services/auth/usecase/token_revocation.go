@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// 失効基準日時の対象種別
+const (
+	revocationSubjectUser   = "USER"
+	revocationSubjectClient = "CLIENT"
+)
+
+// 失効理由（token_revocation_watermarks.reason）
+const (
+	revocationReasonForceLogout     = "FORCE_LOGOUT"
+	revocationReasonUserDeleted     = "USER_DELETED"
+	revocationReasonUserSuspended   = "USER_SUSPENDED"
+	revocationReasonRoleRevoked     = "ROLE_REVOKED"
+	revocationReasonPasswordChanged = "PASSWORD_CHANGED"
+)
+
+var (
+	// ErrTokenRevoked トークンが失効済み（強制ログアウト、ユーザー削除・停止等）
+	ErrTokenRevoked = errors.New("token revoked")
+	// ErrTokenNotRevocable トークンに識別子（jti/session_id）または有効期限がなく、個別に失効できない
+	ErrTokenNotRevocable = domain.NewError(domain.ErrorKindPreconditionFailed, domain.ReasonTokenNotRevocable, "token cannot be revoked individually")
+)
+
+// newWatermarkParams 失効基準日時の更新パラメータを作成（現在時刻より前に発行されたトークンを無効にする）
+func newWatermarkParams(subjectType string, subjectID uuid.UUID, reason string) dbgen.BumpTokenRevocationWatermarkParams {
+	return dbgen.BumpTokenRevocationWatermarkParams{
+		SubjectType:   subjectType,
+		SubjectID:     pgtype.UUID{Bytes: subjectID, Valid: true},
+		RevokedBefore: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		Reason:        pgtype.Text{String: reason, Valid: true},
+	}
+}
+
+// revokeUserTokens ユーザーの発行済みトークンをすべて失効
+func (u *authUsecase) revokeUserTokens(ctx context.Context, userID uuid.UUID, reason string) error {
+	if err := u.tokenRevocationRepo.BumpWatermark(ctx, newWatermarkParams(revocationSubjectUser, userID, reason)); err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+// CheckTokenRevocation トークンが失効していないか確認
+func (u *authUsecase) CheckTokenRevocation(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	// 1. 失効リスト（トークン単位）
+	if token.ID != "" {
+		revoked, err := u.tokenRevocationRepo.IsRevoked(ctx, token.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check revoked tokens: %w", err)
+		}
+		if revoked {
+			return ErrTokenRevoked
+		}
+	}
+
+	// 2. 失効基準日時（ユーザー単位・クライアント単位）
+	// オペレーターは複数クライアントを担当するため、クライアント単位の強制ログアウトの対象外
+	clientID := uuid.Nil
+	if userCtx.UserType == domain.UserTypeClientUser {
+		clientID = userCtx.ClientID
+	}
+	watermarks, err := u.tokenRevocationRepo.ListWatermarks(ctx, userCtx.UserID, clientID)
+	if err != nil {
+		return fmt.Errorf("failed to get token revocation watermarks: %w", err)
+	}
+	for _, watermark := range watermarks {
+		// iatは秒単位のため、基準日時も秒単位に切り捨てて比較する（失効直後に再ログインしたトークンを誤って無効にしない）
+		if token.IssuedAt.Before(watermark.RevokedBefore.Time.Truncate(time.Second)) {
+			return ErrTokenRevoked
+		}
+	}
+
+	return nil
+}
+
+// Logout 現在のトークンを失効（トークンの有効期限まで失効リストに保持）
+func (u *authUsecase) Logout(ctx context.Context, userCtx *domain.UserContext, token domain.TokenMetadata) error {
+	if token.ID == "" || token.ExpiresAt.IsZero() {
+		return ErrTokenNotRevocable
+	}
+
+	params := dbgen.RevokeTokenParams{
+		Jti:       token.ID,
+		UserID:    pgtype.UUID{Bytes: userCtx.UserID, Valid: true},
+		ExpiresAt: pgtype.Timestamptz{Time: token.ExpiresAt, Valid: true},
+		RevokedBy: pgtype.UUID{Bytes: userCtx.UserID, Valid: true},
+	}
+	if userCtx.ClientID != uuid.Nil {
+		params.ClientID = pgtype.UUID{Bytes: userCtx.ClientID, Valid: true}
+	}
+	if err := u.tokenRevocationRepo.Revoke(ctx, params); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	// 有効期限切れのエントリの削除に失敗してもログアウトは継続する
+	_, _ = u.tokenRevocationRepo.DeleteExpired(ctx)
+
+	return nil
+}
+
+// ForceLogout 指定したクライアントユーザーの発行済みトークンをすべて失効
+func (u *authUsecase) ForceLogout(ctx context.Context, userCtx *domain.UserContext, clientUserID uuid.UUID) error {
+	// 1. クライアントアクセス権限チェック
+	if err := u.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
+		return err
+	}
+
+	// 2. 権限チェック: users:WRITE
+	if err := u.CheckPermission(ctx, userCtx, "users", "WRITE"); err != nil {
+		return err
+	}
+
+	// 3. 対象ユーザーの存在確認（クライアント分離チェック）
+	if _, err := u.clientUserRepo.GetByID(ctx, userCtx.ClientID, clientUserID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %s", ErrClientUserNotFound, clientUserID)
+		}
+		return fmt.Errorf("failed to get client user: %w", err)
+	}
+
+	// 4. 失効
+	return u.revokeUserTokens(ctx, clientUserID, revocationReasonForceLogout)
+}
+
+// ForceLogoutTenant クライアント内の全ユーザーの発行済みトークンを失効（実行したユーザー自身も含む）
+func (u *authUsecase) ForceLogoutTenant(ctx context.Context, userCtx *domain.UserContext) error {
+	// 1. クライアントアクセス権限チェック
+	if err := u.ValidateClientAccess(ctx, userCtx, userCtx.ClientID); err != nil {
+		return err
+	}
+
+	// 2. 権限チェック: system_settings:WRITE
+	if err := u.CheckPermission(ctx, userCtx, "system_settings", "WRITE"); err != nil {
+		return err
+	}
+
+	// 3. 失効
+	if err := u.tokenRevocationRepo.BumpWatermark(ctx, newWatermarkParams(revocationSubjectClient, userCtx.ClientID, revocationReasonForceLogout)); err != nil {
+		return fmt.Errorf("failed to revoke client tokens: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTokenRevocationRepository モックトークン失効リポジトリ
+type MockTokenRevocationRepository struct {
+	mock.Mock
+}
+
+func (m *MockTokenRevocationRepository) ListWatermarks(ctx context.Context, userID uuid.UUID, clientID uuid.UUID) ([]dbgen.TokenRevocationWatermark, error) {
+	args := m.Called(ctx, userID, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dbgen.TokenRevocationWatermark), args.Error(1)
+}
+
+func (m *MockTokenRevocationRepository) BumpWatermark(ctx context.Context, params dbgen.BumpTokenRevocationWatermarkParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockTokenRevocationRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	args := m.Called(ctx, jti)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTokenRevocationRepository) Revoke(ctx context.Context, params dbgen.RevokeTokenParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockTokenRevocationRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestCheckTokenRevocation(t *testing.T) {
+	userID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	clientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	revokedBefore := time.Date(2026, 1, 1, 12, 0, 0, 500000000, time.UTC)
+
+	watermark := func(subjectType string, subjectID uuid.UUID) dbgen.TokenRevocationWatermark {
+		return dbgen.TokenRevocationWatermark{
+			SubjectType:   subjectType,
+			SubjectID:     pgtype.UUID{Bytes: subjectID, Valid: true},
+			RevokedBefore: pgtype.Timestamptz{Time: revokedBefore, Valid: true},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		userCtx   *domain.UserContext
+		token     domain.TokenMetadata
+		setupMock func(*MockTokenRevocationRepository)
+		wantErr   error
+	}{
+		{
+			name:    "成功: 失効基準日時なし",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			token:   domain.TokenMetadata{ID: "jti-1", IssuedAt: revokedBefore.Add(-time.Hour)},
+			setupMock: func(m *MockTokenRevocationRepository) {
+				m.On("IsRevoked", mock.Anything, "jti-1").Return(false, nil)
+				m.On("ListWatermarks", mock.Anything, userID, clientID).Return([]dbgen.TokenRevocationWatermark{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "失敗: 失効リストに登録済み",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			token:   domain.TokenMetadata{ID: "jti-1", IssuedAt: revokedBefore.Add(time.Hour)},
+			setupMock: func(m *MockTokenRevocationRepository) {
+				m.On("IsRevoked", mock.Anything, "jti-1").Return(true, nil)
+			},
+			wantErr: ErrTokenRevoked,
+		},
+		{
+			name:    "失敗: ユーザーの失効基準日時より前に発行",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			token:   domain.TokenMetadata{ID: "jti-1", IssuedAt: revokedBefore.Add(-time.Minute)},
+			setupMock: func(m *MockTokenRevocationRepository) {
+				m.On("IsRevoked", mock.Anything, "jti-1").Return(false, nil)
+				m.On("ListWatermarks", mock.Anything, userID, clientID).Return([]dbgen.TokenRevocationWatermark{watermark("USER", userID)}, nil)
+			},
+			wantErr: ErrTokenRevoked,
+		},
+		{
+			name:    "成功: 失効基準日時と同じ秒に発行（失効直後の再ログイン）",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID},
+			token:   domain.TokenMetadata{IssuedAt: revokedBefore.Truncate(time.Second)},
+			setupMock: func(m *MockTokenRevocationRepository) {
+				m.On("ListWatermarks", mock.Anything, userID, clientID).Return([]dbgen.TokenRevocationWatermark{watermark("USER", userID)}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "成功: オペレーターはクライアント単位の失効の対象外",
+			userCtx: &domain.UserContext{UserID: userID, UserType: domain.UserTypeOperator, ClientID: clientID},
+			token:   domain.TokenMetadata{IssuedAt: revokedBefore.Add(-time.Hour)},
+			setupMock: func(m *MockTokenRevocationRepository) {
+				m.On("ListWatermarks", mock.Anything, userID, uuid.Nil).Return([]dbgen.TokenRevocationWatermark{}, nil)
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTokenRevocationRepo := new(MockTokenRevocationRepository)
+			tt.setupMock(mockTokenRevocationRepo)
+
+			usecase := &authUsecase{tokenRevocationRepo: mockTokenRevocationRepo}
+
+			err := usecase.CheckTokenRevocation(context.Background(), tt.userCtx, tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockTokenRevocationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestForceLogout_ClientUserLookup(t *testing.T) {
+	clientID := uuid.New()
+	targetID := uuid.New()
+	adminCtx := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: clientID}
+	roleID := uuid.New()
+
+	tests := []struct {
+		name         string
+		lookupErr    error
+		wantNotFound bool
+	}{
+		{name: "存在しないユーザーはNotFound", lookupErr: pgx.ErrNoRows, wantNotFound: true},
+		{name: "データベースのエラーは内部エラー", lookupErr: errors.New("connection refused"), wantNotFound: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientUserRoleRepo := new(MockClientUserRoleRepository)
+			rolePermissionRepo := new(MockClientRolePermissionRepository)
+			clientUserRoleRepo.On("GetByUserID", mock.Anything, clientID, adminCtx.UserID).Return([]dbgen.ClientUserRole{
+				{RoleID: pgtype.UUID{Bytes: roleID, Valid: true}},
+			}, nil)
+			rolePermissionRepo.On("GetByRoleID", mock.Anything, roleID).Return([]dbgen.ClientRolePermission{
+				{Feature: "users", Action: "WRITE", Granted: true},
+			}, nil)
+			mockClientUserRepo := new(MockClientUserRepository)
+			mockClientUserRepo.On("GetByID", mock.Anything, clientID, targetID).Return(dbgen.ClientUser{}, tt.lookupErr)
+			mockTokenRevocationRepo := new(MockTokenRevocationRepository)
+			usecase := &authUsecase{
+				clientUserRepo:           mockClientUserRepo,
+				clientUserRoleRepo:       clientUserRoleRepo,
+				clientRolePermissionRepo: rolePermissionRepo,
+				tokenRevocationRepo:      mockTokenRevocationRepo,
+			}
+
+			err := usecase.ForceLogout(context.Background(), adminCtx, targetID)
+			assert.Error(t, err)
+			assert.Equal(t, tt.wantNotFound, errors.Is(err, ErrClientUserNotFound))
+			if !tt.wantNotFound {
+				assert.ErrorIs(t, err, tt.lookupErr)
+			}
+			mockTokenRevocationRepo.AssertNotCalled(t, "BumpWatermark", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestLogout_RequiresTokenID(t *testing.T) {
+	// 識別子のないトークンは個別に失効できない
+	usecase := &authUsecase{tokenRevocationRepo: new(MockTokenRevocationRepository)}
+	userCtx := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: uuid.New()}
+
+	err := usecase.Logout(context.Background(), userCtx, domain.TokenMetadata{ExpiresAt: time.Now().Add(time.Hour)})
+	assert.ErrorIs(t, err, ErrTokenNotRevocable)
+}
+
+func TestGetUserContext_RejectsInactiveUser(t *testing.T) {
+	userID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	clientID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	const issuer = "https://idp.example.com"
+	suspendedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	clientUser := func(status domain.UserStatus) dbgen.ClientUser {
+		return dbgen.ClientUser{
+			ClientUserID: pgtype.UUID{Bytes: userID, Valid: true},
+			ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+			Email:        "user@example.com",
+			Status:       string(status),
+		}
+	}
+	identity := domain.ExternalIdentity{Issuer: issuer, Subject: "external-subject", Email: "user@example.com"}
+
+	tests := []struct {
+		name    string
+		resolve func(u *authUsecase, operatorRepo *MockOperatorRepository, clientUserRepo *MockClientUserRepository) (*domain.UserContext, error)
+	}{
+		{
+			name: "停止中のクライアントユーザー（Supabaseのセッション更新で発行されたトークン）",
+			resolve: func(u *authUsecase, operatorRepo *MockOperatorRepository, clientUserRepo *MockClientUserRepository) (*domain.UserContext, error) {
+				operatorRepo.On("GetByID", mock.Anything, userID).Return(dbgen.Operator{}, assert.AnError)
+				clientUserRepo.On("GetByUserIDOnly", mock.Anything, userID).Return(clientUser(domain.UserStatusSuspended), nil)
+				return u.GetUserContext(context.Background(), userID.String())
+			},
+		},
+		{
+			name: "SCIMで無効化（active=false）されたクライアントユーザー",
+			resolve: func(u *authUsecase, operatorRepo *MockOperatorRepository, clientUserRepo *MockClientUserRepository) (*domain.UserContext, error) {
+				operatorRepo.On("GetByID", mock.Anything, userID).Return(dbgen.Operator{}, assert.AnError)
+				clientUserRepo.On("GetByUserIDOnly", mock.Anything, userID).Return(clientUser(domain.UserStatusInactive), nil)
+				return u.GetUserContext(context.Background(), userID.String())
+			},
+		},
+		{
+			name: "停止中のオペレーター",
+			resolve: func(u *authUsecase, operatorRepo *MockOperatorRepository, clientUserRepo *MockClientUserRepository) (*domain.UserContext, error) {
+				operatorRepo.On("GetByID", mock.Anything, userID).Return(dbgen.Operator{
+					OperatorID: pgtype.UUID{Bytes: userID, Valid: true},
+					Status:     string(domain.UserStatusSuspended),
+				}, nil)
+				return u.GetUserContext(context.Background(), userID.String())
+			},
+		},
+		{
+			name: "停止中のクライアントユーザー（外部IdPで新しく発行されたトークン）",
+			resolve: func(u *authUsecase, operatorRepo *MockOperatorRepository, clientUserRepo *MockClientUserRepository) (*domain.UserContext, error) {
+				idpRepo := new(MockIdentityProviderRepository)
+				identityRepo := new(MockClientUserIdentityRepository)
+				idpRepo.On("GetByIssuer", mock.Anything, issuer).Return(dbgen.ClientIdentityProvider{
+					ClientID: pgtype.UUID{Bytes: clientID, Valid: true},
+					Issuer:   issuer,
+					Status:   "ACTIVE",
+				}, nil)
+				identityRepo.On("Get", mock.Anything, issuer, identity.Subject).Return(dbgen.ClientUserIdentity{
+					ClientUserID: pgtype.UUID{Bytes: userID, Valid: true},
+				}, nil)
+				clientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(clientUser(domain.UserStatusSuspended), nil)
+				u.identityProviderRepo = idpRepo
+				u.clientUserIdentityRepo = identityRepo
+				return u.GetUserContextByExternalIdentity(context.Background(), identity)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOperatorRepo := new(MockOperatorRepository)
+			mockClientUserRepo := new(MockClientUserRepository)
+			mockTokenRevocationRepo := new(MockTokenRevocationRepository)
+			usecase := &authUsecase{
+				operatorRepo:        mockOperatorRepo,
+				clientUserRepo:      mockClientUserRepo,
+				tokenRevocationRepo: mockTokenRevocationRepo,
+			}
+
+			// 停止後に発行されたトークンは失効基準日時では拒否できない
+			mockTokenRevocationRepo.On("ListWatermarks", mock.Anything, userID, clientID).Return([]dbgen.TokenRevocationWatermark{{
+				SubjectType:   revocationSubjectUser,
+				SubjectID:     pgtype.UUID{Bytes: userID, Valid: true},
+				RevokedBefore: pgtype.Timestamptz{Time: suspendedAt, Valid: true},
+			}}, nil)
+			freshToken := domain.TokenMetadata{IssuedAt: suspendedAt.Add(time.Minute)}
+			assert.NoError(t, usecase.CheckTokenRevocation(context.Background(), &domain.UserContext{
+				UserID: userID, UserType: domain.UserTypeClientUser, ClientID: clientID,
+			}, freshToken))
+
+			// ユーザー情報の解決時にステータスで拒否する
+			userCtx, err := tt.resolve(usecase, mockOperatorRepo, mockClientUserRepo)
+			assert.ErrorIs(t, err, ErrUserNotActive)
+			assert.Nil(t, userCtx)
+		})
+	}
+}
+
+func TestDeactivatesClientUser(t *testing.T) {
+	tests := []struct {
+		name          string
+		currentStatus domain.UserStatus
+		newStatus     domain.UserStatus
+		want          bool
+	}{
+		{name: "有効から停止", currentStatus: domain.UserStatusActive, newStatus: domain.UserStatusSuspended, want: true},
+		{name: "有効から無効", currentStatus: domain.UserStatusActive, newStatus: domain.UserStatusInactive, want: true},
+		{name: "有効のまま", currentStatus: domain.UserStatusActive, newStatus: domain.UserStatusActive, want: false},
+		{name: "停止から有効", currentStatus: domain.UserStatusSuspended, newStatus: domain.UserStatusActive, want: false},
+		{name: "停止から無効", currentStatus: domain.UserStatusSuspended, newStatus: domain.UserStatusInactive, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, deactivatesClientUser(string(tt.currentStatus), string(tt.newStatus)))
+		})
+	}
+}
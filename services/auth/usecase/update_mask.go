@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrInvalidUpdateMask 更新マスク（FieldMask）のパスが不正
+var ErrInvalidUpdateMask = domain.NewValidationError(domain.ReasonInvalidUpdateMask, "update_mask", "invalid update mask")
+
+// UpdateMaskError 更新マスクのパスごとのエラー（errors.Is(err, ErrInvalidUpdateMask)で判定できる）
+type UpdateMaskError struct {
+	Path   string
+	Reason string
+}
+
+func (e *UpdateMaskError) Error() string {
+	return fmt.Sprintf("invalid update_mask path %q: %s", e.Path, e.Reason)
+}
+
+func (e *UpdateMaskError) Unwrap() error {
+	return ErrInvalidUpdateMask
+}
+
+// FieldViolations 違反したパスをupdate_maskフィールドの違反として返す（パスは説明に含める）
+func (e *UpdateMaskError) FieldViolations() []domain.FieldViolation {
+	return []domain.FieldViolation{{Field: "update_mask", Reason: string(domain.ReasonInvalidUpdateMask), Description: e.Error()}}
+}
+
+// 更新マスクのパス（ClientUserのフィールド名）
+const (
+	updatePathEmail      = "email"
+	updatePathFirstName  = "first_name"
+	updatePathLastName   = "last_name"
+	updatePathDepartment = "department"
+	updatePathPosition   = "position"
+	updatePathSettings   = "settings"
+	updatePathStatus     = "status"
+)
+
+// clientUserImmutablePaths 更新できないフィールド
+var clientUserImmutablePaths = map[string]bool{
+	"client_user_id":      true,
+	"client_id":           true,
+	"created_at":          true,
+	"updated_at":          true,
+	"password_changed_at": true,
+}
+
+// clientUserUpdatablePaths UpdateClientUserで更新できるフィールド
+var clientUserUpdatablePaths = []string{
+	updatePathEmail,
+	updatePathFirstName,
+	updatePathLastName,
+	updatePathDepartment,
+	updatePathPosition,
+	updatePathSettings,
+	updatePathStatus,
+}
+
+// selfUpdatablePaths UpdateMeで更新できるフィールド（メールアドレス・ステータスは変更不可）
+var selfUpdatablePaths = []string{
+	updatePathFirstName,
+	updatePathLastName,
+	updatePathDepartment,
+	updatePathPosition,
+	updatePathSettings,
+}
+
+// validateUpdateMask 更新マスクのパスを検証（不明・更新不可のパスはUpdateMaskError、重複は無視）
+func validateUpdateMask(paths []string, allowed []string) (map[string]bool, error) {
+	mask := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		switch {
+		case slices.Contains(allowed, path):
+			mask[path] = true
+		case clientUserImmutablePaths[path] || path == updatePathEmail || path == updatePathStatus:
+			return nil, &UpdateMaskError{Path: path, Reason: "field is immutable"}
+		default:
+			return nil, &UpdateMaskError{Path: path, Reason: "unknown field"}
+		}
+	}
+	return mask, nil
+}
+
+// applyClientUserUpdateMask 更新マスクに含まれるフィールドのみを既存の値に適用
+// マスクに含まれるフィールドの値が未指定の場合は空文字として扱う（department/positionは削除、settingsはJSON Merge Patch）
+func applyClientUserUpdateMask(updateParams *dbgen.UpdateClientUserParams, mask map[string]bool, params UpdateClientUserParams) error {
+	value := func(p *string) string {
+		if p == nil {
+			return ""
+		}
+		return *p
+	}
+	required := func(path string, p *string) (string, error) {
+		v := strings.TrimSpace(value(p))
+		if v == "" {
+			return "", &UpdateMaskError{Path: path, Reason: "must not be empty"}
+		}
+		return v, nil
+	}
+	optional := func(p *string) pgtype.Text {
+		v := value(p)
+		return pgtype.Text{String: v, Valid: v != ""}
+	}
+
+	var err error
+	if mask[updatePathEmail] {
+		if updateParams.Email, err = required(updatePathEmail, params.Email); err != nil {
+			return err
+		}
+	}
+	if mask[updatePathFirstName] {
+		if updateParams.FirstName, err = required(updatePathFirstName, params.FirstName); err != nil {
+			return err
+		}
+	}
+	if mask[updatePathLastName] {
+		if updateParams.LastName, err = required(updatePathLastName, params.LastName); err != nil {
+			return err
+		}
+	}
+	if mask[updatePathDepartment] {
+		updateParams.Department = optional(params.Department)
+	}
+	if mask[updatePathPosition] {
+		updateParams.Position = optional(params.Position)
+	}
+	if mask[updatePathSettings] {
+		settings, err := mergePatchSettings(updateParams.Settings, []byte(value(params.Settings)))
+		if err != nil {
+			return &UpdateMaskError{Path: updatePathSettings, Reason: err.Error()}
+		}
+		updateParams.Settings = settings
+	}
+	if mask[updatePathStatus] {
+		if updateParams.Status, err = required(updatePathStatus, params.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergePatchSettings 設定にJSON Merge Patch（RFC 7386）を適用（値がnullのキーは削除、結果はJSONオブジェクトに限る）
+func mergePatchSettings(target []byte, patch []byte) ([]byte, error) {
+	patchValue, err := decodeJSON(patch)
+	if err != nil {
+		return nil, fmt.Errorf("settings must be a JSON merge patch: %w", err)
+	}
+	var targetValue any = map[string]any{}
+	if len(bytes.TrimSpace(target)) > 0 {
+		if targetValue, err = decodeJSON(target); err != nil {
+			return nil, fmt.Errorf("failed to parse current settings: %w", err)
+		}
+	}
+
+	merged := applyMergePatch(targetValue, patchValue)
+	if merged == nil {
+		// 設定全体をnullにした場合は空のオブジェクトに戻す（settingsはNOT NULL）
+		merged = map[string]any{}
+	}
+	if _, ok := merged.(map[string]any); !ok {
+		return nil, errors.New("settings must be a JSON object")
+	}
+	return json.Marshal(merged)
+}
+
+// applyMergePatch JSON Merge Patch（RFC 7386）のMergePatch関数
+func applyMergePatch(target any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = applyMergePatch(targetObject[key], value)
+		}
+	}
+	return targetObject
+}
+
+// decodeJSON 数値の精度を保ったままJSONをデコード
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return value, nil
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"contract-pro-suite/services/auth/domain"
+	dbgen "contract-pro-suite/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidateUpdateMask(t *testing.T) {
+	mask, err := validateUpdateMask([]string{"first_name", "settings", "first_name"}, clientUserUpdatablePaths)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"first_name": true, "settings": true}, mask)
+
+	tests := []struct {
+		paths   []string
+		allowed []string
+		reason  string
+	}{
+		{[]string{"client_id"}, clientUserUpdatablePaths, "field is immutable"},
+		{[]string{"first_name", "created_at"}, clientUserUpdatablePaths, "field is immutable"},
+		{[]string{"email"}, selfUpdatablePaths, "field is immutable"},
+		{[]string{"nickname"}, clientUserUpdatablePaths, "unknown field"},
+		{[]string{"settings.theme"}, clientUserUpdatablePaths, "unknown field"},
+	}
+	for _, tt := range tests {
+		_, err := validateUpdateMask(tt.paths, tt.allowed)
+		var maskErr *UpdateMaskError
+		if assert.ErrorAs(t, err, &maskErr, "paths=%v", tt.paths) {
+			assert.Equal(t, tt.reason, maskErr.Reason)
+		}
+		assert.ErrorIs(t, err, ErrInvalidUpdateMask)
+	}
+}
+
+func TestMergePatchSettings(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		patch  string
+		want   string
+	}{
+		{"キーの追加・上書き", `{"theme":"dark","lang":"ja"}`, `{"lang":"en","tz":"Asia/Tokyo"}`, `{"lang":"en","theme":"dark","tz":"Asia/Tokyo"}`},
+		{"nullのキーは削除", `{"theme":"dark","lang":"ja"}`, `{"theme":null}`, `{"lang":"ja"}`},
+		{"ネストしたオブジェクトはマージ", `{"notify":{"email":true,"slack":true}}`, `{"notify":{"slack":false}}`, `{"notify":{"email":true,"slack":false}}`},
+		{"配列は置き換え", `{"tags":[1,2]}`, `{"tags":[3]}`, `{"tags":[3]}`},
+		{"数値の精度を保つ", `{"limit":12345678901234567890}`, `{}`, `{"limit":12345678901234567890}`},
+		{"null全体は空のオブジェクト", `{"theme":"dark"}`, `null`, `{}`},
+		{"既存の設定が空", ``, `{"theme":"dark"}`, `{"theme":"dark"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergePatchSettings([]byte(tt.target), []byte(tt.patch))
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+
+	// オブジェクト以外になるパッチ・不正なJSONは拒否
+	for _, patch := range []string{`"dark"`, `[1]`, `{`, ``, `{} {}`} {
+		_, err := mergePatchSettings([]byte(`{}`), []byte(patch))
+		assert.Error(t, err, "patch=%q", patch)
+	}
+}
+
+func TestUpdateClientUser_UpdateMask(t *testing.T) {
+	clientID := uuid.New()
+	userID := uuid.New()
+	existing := dbgen.ClientUser{
+		ClientUserID: pgtype.UUID{Bytes: userID, Valid: true},
+		ClientID:     pgtype.UUID{Bytes: clientID, Valid: true},
+		Email:        "user@example.com",
+		FirstName:    "Taro",
+		LastName:     "Yamada",
+		Department:   pgtype.Text{String: "Legal", Valid: true},
+		Position:     pgtype.Text{String: "Manager", Valid: true},
+		Settings:     []byte(`{"theme":"dark","lang":"ja"}`),
+		Status:       "ACTIVE",
+	}
+
+	t.Run("マスクに含まれるフィールドのみ更新する", func(t *testing.T) {
+		mockClientUserRepo := new(MockClientUserRepository)
+		mockClientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(existing, nil)
+		mockClientUserRepo.On("Update", mock.Anything, clientID, mock.MatchedBy(func(params dbgen.UpdateClientUserParams) bool {
+			return params.FirstName == "Jiro" &&
+				params.LastName == existing.LastName && // 値は指定されているがマスクに含まれない
+				params.Email == existing.Email &&
+				!params.Department.Valid && // マスクに含まれ値が未指定のため削除
+				params.Position == existing.Position &&
+				string(params.Settings) == `{"lang":"en","theme":"dark"}` &&
+				params.Status == existing.Status
+		})).Return(existing, nil)
+
+		usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+		firstName, lastName, email, settings := "Jiro", "Suzuki", "other@example.com", `{"lang":"en"}`
+		_, err := usecase.updateClientUser(context.Background(), clientID, userID, UpdateClientUserParams{
+			Email:      &email,
+			FirstName:  &firstName,
+			LastName:   &lastName,
+			Settings:   &settings,
+			UpdateMask: []string{"first_name", "department", "settings"},
+		})
+		assert.NoError(t, err)
+		// メールアドレスはマスクに含まれないため重複チェックも行わない
+		mockClientUserRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything, mock.Anything)
+		mockClientUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("更新できないパスはDBアクセス前に拒否する", func(t *testing.T) {
+		mockClientUserRepo := new(MockClientUserRepository)
+		usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+		_, err := usecase.updateClientUser(context.Background(), clientID, userID, UpdateClientUserParams{
+			UpdateMask: []string{"first_name", "client_id"},
+		})
+		var maskErr *UpdateMaskError
+		if assert.ErrorAs(t, err, &maskErr) {
+			assert.Equal(t, "client_id", maskErr.Path)
+		}
+		mockClientUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("必須フィールドを空にはできない", func(t *testing.T) {
+		mockClientUserRepo := new(MockClientUserRepository)
+		mockClientUserRepo.On("GetByID", mock.Anything, clientID, userID).Return(existing, nil)
+		usecase := &authUsecase{clientUserRepo: mockClientUserRepo}
+		_, err := usecase.updateClientUser(context.Background(), clientID, userID, UpdateClientUserParams{
+			UpdateMask: []string{"last_name"},
+		})
+		assert.ErrorIs(t, err, ErrInvalidUpdateMask)
+		mockClientUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdateMe_UpdateMask(t *testing.T) {
+	clientID := uuid.New()
+	userCtx := &domain.UserContext{UserID: uuid.New(), UserType: domain.UserTypeClientUser, ClientID: clientID}
+	usecase := &authUsecase{clientUserRepo: new(MockClientUserRepository)}
+
+	// 自分ではメールアドレス・ステータスを変更できない
+	for _, path := range []string{"email", "status", "client_id"} {
+		_, err := usecase.UpdateMe(context.Background(), userCtx, UpdateMeParams{UpdateMask: []string{path}})
+		var maskErr *UpdateMaskError
+		if assert.ErrorAs(t, err, &maskErr, "path=%s", path) {
+			assert.Equal(t, "field is immutable", maskErr.Reason)
+		}
+	}
+}
@@ -11,6 +11,52 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const assignClientUserRole = `-- name: AssignClientUserRole :one
+INSERT INTO client_user_roles (
+    client_id,
+    client_user_id,
+    role_id,
+    assigned_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (client_id, client_user_id, role_id) DO UPDATE
+SET
+    assigned_at = EXCLUDED.assigned_at,
+    revoked_at = NULL,
+    deleted_at = NULL,
+    deleted_by = NULL
+RETURNING client_id, client_user_id, role_id, assigned_at, revoked_at, deleted_at, deleted_by
+`
+
+type AssignClientUserRoleParams struct {
+	ClientID     pgtype.UUID        `json:"client_id"`
+	ClientUserID pgtype.UUID        `json:"client_user_id"`
+	RoleID       pgtype.UUID        `json:"role_id"`
+	AssignedAt   pgtype.Timestamptz `json:"assigned_at"`
+}
+
+// 取り消し・削除済みの割り当てがある場合は再有効化する
+func (q *Queries) AssignClientUserRole(ctx context.Context, arg AssignClientUserRoleParams) (ClientUserRole, error) {
+	row := q.db.QueryRow(ctx, assignClientUserRole,
+		arg.ClientID,
+		arg.ClientUserID,
+		arg.RoleID,
+		arg.AssignedAt,
+	)
+	var i ClientUserRole
+	err := row.Scan(
+		&i.ClientID,
+		&i.ClientUserID,
+		&i.RoleID,
+		&i.AssignedAt,
+		&i.RevokedAt,
+		&i.DeletedAt,
+		&i.DeletedBy,
+	)
+	return i, err
+}
+
 const createClientUserRole = `-- name: CreateClientUserRole :one
 INSERT INTO client_user_roles (
     client_id,
@@ -191,6 +237,96 @@ func (q *Queries) GetClientUserRolesByUserID(ctx context.Context, arg GetClientU
 	return items, nil
 }
 
+const listActiveClientUserRolesByClient = `-- name: ListActiveClientUserRolesByClient :many
+SELECT client_id, client_user_id, role_id, assigned_at, revoked_at, deleted_at, deleted_by FROM client_user_roles
+WHERE client_id = $1
+  AND deleted_at IS NULL
+  AND revoked_at IS NULL
+ORDER BY assigned_at DESC
+`
+
+// クライアントの有効なロール割り当てをまとめて取得（SCIMのグループ一覧用）
+func (q *Queries) ListActiveClientUserRolesByClient(ctx context.Context, clientID pgtype.UUID) ([]ClientUserRole, error) {
+	rows, err := q.db.Query(ctx, listActiveClientUserRolesByClient, clientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClientUserRole{}
+	for rows.Next() {
+		var i ClientUserRole
+		if err := rows.Scan(
+			&i.ClientID,
+			&i.ClientUserID,
+			&i.RoleID,
+			&i.AssignedAt,
+			&i.RevokedAt,
+			&i.DeletedAt,
+			&i.DeletedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveClientUserRolesByUserIDs = `-- name: ListActiveClientUserRolesByUserIDs :many
+SELECT
+    ur.client_user_id,
+    r.role_id,
+    r.code,
+    r.name
+FROM client_user_roles ur
+JOIN client_roles r ON r.role_id = ur.role_id AND r.client_id = ur.client_id AND r.deleted_at IS NULL
+WHERE ur.client_id = $1
+  AND ur.client_user_id = ANY($2::uuid[])
+  AND ur.deleted_at IS NULL
+  AND ur.revoked_at IS NULL
+ORDER BY ur.client_user_id, r.code
+`
+
+type ListActiveClientUserRolesByUserIDsParams struct {
+	ClientID      pgtype.UUID   `json:"client_id"`
+	ClientUserIds []pgtype.UUID `json:"client_user_ids"`
+}
+
+type ListActiveClientUserRolesByUserIDsRow struct {
+	ClientUserID pgtype.UUID `json:"client_user_id"`
+	RoleID       pgtype.UUID `json:"role_id"`
+	Code         string      `json:"code"`
+	Name         string      `json:"name"`
+}
+
+// 複数ユーザーの有効なロールをまとめて取得（エクスポート・v2 API用）
+func (q *Queries) ListActiveClientUserRolesByUserIDs(ctx context.Context, arg ListActiveClientUserRolesByUserIDsParams) ([]ListActiveClientUserRolesByUserIDsRow, error) {
+	rows, err := q.db.Query(ctx, listActiveClientUserRolesByUserIDs, arg.ClientID, arg.ClientUserIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActiveClientUserRolesByUserIDsRow{}
+	for rows.Next() {
+		var i ListActiveClientUserRolesByUserIDsRow
+		if err := rows.Scan(
+			&i.ClientUserID,
+			&i.RoleID,
+			&i.Code,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const revokeClientUserRole = `-- name: RevokeClientUserRole :exec
 UPDATE client_user_roles
 SET
@@ -11,6 +11,77 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countClientUsers = `-- name: CountClientUsers :one
+SELECT count(*) FROM client_users
+WHERE client_id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) CountClientUsers(ctx context.Context, clientID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countClientUsers, clientID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchClientUsers = `-- name: CountSearchClientUsers :one
+SELECT count(*) FROM client_users
+WHERE client_users.client_id = $1
+  AND client_users.deleted_at IS NULL
+  AND (
+    $2::text IS NULL
+    OR client_users.email::text ILIKE $2
+    OR (client_users.last_name || ' ' || client_users.first_name) ILIKE $2
+    OR (client_users.first_name || ' ' || client_users.last_name) ILIKE $2
+  )
+  AND ($3::text IS NULL OR client_users.email::text ILIKE $3)
+  AND ($4::bool IS NULL OR (client_users.status = 'ACTIVE') = $4)
+  AND ($5::text IS NULL OR client_users.status = $5)
+  AND ($6::text IS NULL OR client_users.department = $6)
+  AND ($7::text IS NULL OR client_users.position = $7)
+  AND (
+    $8::text IS NULL
+    OR EXISTS (
+        SELECT 1
+        FROM client_user_roles ur
+        JOIN client_roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+        WHERE ur.client_id = client_users.client_id
+          AND ur.client_user_id = client_users.client_user_id
+          AND ur.deleted_at IS NULL
+          AND ur.revoked_at IS NULL
+          AND r.code = $8
+    )
+  )
+`
+
+type CountSearchClientUsersParams struct {
+	ClientID     pgtype.UUID `json:"client_id"`
+	QueryPattern pgtype.Text `json:"query_pattern"`
+	EmailPattern pgtype.Text `json:"email_pattern"`
+	Active       pgtype.Bool `json:"active"`
+	Status       pgtype.Text `json:"status"`
+	Department   pgtype.Text `json:"department"`
+	Position     pgtype.Text `json:"position"`
+	RoleCode     pgtype.Text `json:"role_code"`
+}
+
+// SearchClientUsersと同じ条件の総件数
+func (q *Queries) CountSearchClientUsers(ctx context.Context, arg CountSearchClientUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchClientUsers,
+		arg.ClientID,
+		arg.QueryPattern,
+		arg.EmailPattern,
+		arg.Active,
+		arg.Status,
+		arg.Department,
+		arg.Position,
+		arg.RoleCode,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createClientUser = `-- name: CreateClientUser :one
 INSERT INTO client_users (
     client_user_id,
@@ -25,7 +96,7 @@ INSERT INTO client_users (
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
-RETURNING client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at
+RETURNING client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at
 `
 
 type CreateClientUserParams struct {
@@ -67,11 +138,12 @@ func (q *Queries) CreateClientUser(ctx context.Context, arg CreateClientUserPara
 		&i.DeletedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PasswordChangedAt,
 	)
 	return i, err
 }
 
-const deleteClientUser = `-- name: DeleteClientUser :exec
+const deleteClientUser = `-- name: DeleteClientUser :execrows
 UPDATE client_users
 SET
     deleted_at = now(),
@@ -79,22 +151,32 @@ SET
     updated_at = now()
 WHERE client_user_id = $1
   AND client_id = $2
+  AND updated_at = $4
   AND deleted_at IS NULL
 `
 
 type DeleteClientUserParams struct {
-	ClientUserID pgtype.UUID `json:"client_user_id"`
-	ClientID     pgtype.UUID `json:"client_id"`
-	DeletedBy    pgtype.UUID `json:"deleted_by"`
+	ClientUserID pgtype.UUID        `json:"client_user_id"`
+	ClientID     pgtype.UUID        `json:"client_id"`
+	DeletedBy    pgtype.UUID        `json:"deleted_by"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
-func (q *Queries) DeleteClientUser(ctx context.Context, arg DeleteClientUserParams) error {
-	_, err := q.db.Exec(ctx, deleteClientUser, arg.ClientUserID, arg.ClientID, arg.DeletedBy)
-	return err
+func (q *Queries) DeleteClientUser(ctx context.Context, arg DeleteClientUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteClientUser,
+		arg.ClientUserID,
+		arg.ClientID,
+		arg.DeletedBy,
+		arg.UpdatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getClientUser = `-- name: GetClientUser :one
-SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at FROM client_users
+SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at FROM client_users
 WHERE client_user_id = $1
   AND client_id = $2
   AND deleted_at IS NULL
@@ -122,12 +204,13 @@ func (q *Queries) GetClientUser(ctx context.Context, arg GetClientUserParams) (C
 		&i.DeletedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PasswordChangedAt,
 	)
 	return i, err
 }
 
 const getClientUserByEmail = `-- name: GetClientUserByEmail :one
-SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at FROM client_users
+SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at FROM client_users
 WHERE client_id = $1
   AND email = $2
   AND deleted_at IS NULL
@@ -155,12 +238,13 @@ func (q *Queries) GetClientUserByEmail(ctx context.Context, arg GetClientUserByE
 		&i.DeletedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PasswordChangedAt,
 	)
 	return i, err
 }
 
 const getClientUserByUserIDOnly = `-- name: GetClientUserByUserIDOnly :one
-SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at FROM client_users
+SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at FROM client_users
 WHERE client_user_id = $1
   AND deleted_at IS NULL
 `
@@ -182,15 +266,137 @@ func (q *Queries) GetClientUserByUserIDOnly(ctx context.Context, clientUserID pg
 		&i.DeletedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PasswordChangedAt,
 	)
 	return i, err
 }
 
+const getClientUserProfile = `-- name: GetClientUserProfile :one
+SELECT
+    client_users.client_user_id, client_users.client_id, client_users.email, client_users.first_name, client_users.last_name, client_users.department, client_users.position, client_users.settings, client_users.status, client_users.deleted_at, client_users.deleted_by, client_users.created_at, client_users.updated_at, client_users.password_changed_at,
+    clients.client_id, clients.slug, clients.company_code, clients.name, clients.e_sign_mode, clients.retention_default_months, clients.status, clients.settings, clients.deleted_at, clients.deleted_by, clients.created_at, clients.updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object('role_id', r.role_id, 'code', r.code, 'name', r.name) ORDER BY r.code)
+        FROM client_user_roles ur
+        JOIN client_roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+        WHERE ur.client_id = client_users.client_id
+          AND ur.client_user_id = client_users.client_user_id
+          AND ur.deleted_at IS NULL
+          AND ur.revoked_at IS NULL
+    ), '[]')::jsonb AS roles,
+    COALESCE((
+        SELECT jsonb_agg(DISTINCT jsonb_build_object('feature', p.feature, 'action', p.action))
+        FROM client_user_roles ur
+        JOIN client_roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+        JOIN client_role_permissions p ON p.role_id = r.role_id AND p.deleted_at IS NULL AND p.granted
+        WHERE ur.client_id = client_users.client_id
+          AND ur.client_user_id = client_users.client_user_id
+          AND ur.deleted_at IS NULL
+          AND ur.revoked_at IS NULL
+    ), '[]')::jsonb AS permissions
+FROM client_users
+JOIN clients ON clients.client_id = client_users.client_id
+WHERE client_users.client_user_id = $1
+  AND client_users.client_id = $2
+  AND client_users.deleted_at IS NULL
+`
+
+type GetClientUserProfileParams struct {
+	ClientUserID pgtype.UUID `json:"client_user_id"`
+	ClientID     pgtype.UUID `json:"client_id"`
+}
+
+type GetClientUserProfileRow struct {
+	ClientUser  ClientUser `json:"client_user"`
+	Client      Client     `json:"client"`
+	Roles       []byte     `json:"roles"`
+	Permissions []byte     `json:"permissions"`
+}
+
+// GetMe用: ユーザー・所属クライアント・有効なロール・実効権限を1回のクエリで取得
+func (q *Queries) GetClientUserProfile(ctx context.Context, arg GetClientUserProfileParams) (GetClientUserProfileRow, error) {
+	row := q.db.QueryRow(ctx, getClientUserProfile, arg.ClientUserID, arg.ClientID)
+	var i GetClientUserProfileRow
+	err := row.Scan(
+		&i.ClientUser.ClientUserID,
+		&i.ClientUser.ClientID,
+		&i.ClientUser.Email,
+		&i.ClientUser.FirstName,
+		&i.ClientUser.LastName,
+		&i.ClientUser.Department,
+		&i.ClientUser.Position,
+		&i.ClientUser.Settings,
+		&i.ClientUser.Status,
+		&i.ClientUser.DeletedAt,
+		&i.ClientUser.DeletedBy,
+		&i.ClientUser.CreatedAt,
+		&i.ClientUser.UpdatedAt,
+		&i.ClientUser.PasswordChangedAt,
+		&i.Client.ClientID,
+		&i.Client.Slug,
+		&i.Client.CompanyCode,
+		&i.Client.Name,
+		&i.Client.ESignMode,
+		&i.Client.RetentionDefaultMonths,
+		&i.Client.Status,
+		&i.Client.Settings,
+		&i.Client.DeletedAt,
+		&i.Client.DeletedBy,
+		&i.Client.CreatedAt,
+		&i.Client.UpdatedAt,
+		&i.Roles,
+		&i.Permissions,
+	)
+	return i, err
+}
+
+const listAllClientUsers = `-- name: ListAllClientUsers :many
+SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at FROM client_users
+WHERE client_id = $1
+  AND deleted_at IS NULL
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListAllClientUsers(ctx context.Context, clientID pgtype.UUID) ([]ClientUser, error) {
+	rows, err := q.db.Query(ctx, listAllClientUsers, clientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClientUser{}
+	for rows.Next() {
+		var i ClientUser
+		if err := rows.Scan(
+			&i.ClientUserID,
+			&i.ClientID,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.Department,
+			&i.Position,
+			&i.Settings,
+			&i.Status,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PasswordChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listClientUsers = `-- name: ListClientUsers :many
-SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at FROM client_users
+SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at FROM client_users
 WHERE client_id = $1
   AND deleted_at IS NULL
-ORDER BY created_at DESC
+ORDER BY created_at DESC, client_user_id DESC
 LIMIT $2 OFFSET $3
 `
 
@@ -223,6 +429,210 @@ func (q *Queries) ListClientUsers(ctx context.Context, arg ListClientUsersParams
 			&i.DeletedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PasswordChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listClientUsersPage = `-- name: ListClientUsersPage :many
+SELECT client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at FROM client_users
+WHERE client_id = $1
+  AND deleted_at IS NULL
+  AND (
+    $2::timestamptz IS NULL
+    OR (created_at, client_user_id) < ($2::timestamptz, $3::uuid)
+  )
+ORDER BY created_at DESC, client_user_id DESC
+LIMIT $4
+`
+
+type ListClientUsersPageParams struct {
+	ClientID           pgtype.UUID        `json:"client_id"`
+	CursorCreatedAt    pgtype.Timestamptz `json:"cursor_created_at"`
+	CursorClientUserID pgtype.UUID        `json:"cursor_client_user_id"`
+	PageSize           int32              `json:"page_size"`
+}
+
+// キーセットページネーション（created_at, client_user_idの降順、カーソル未指定の場合は先頭から）
+func (q *Queries) ListClientUsersPage(ctx context.Context, arg ListClientUsersPageParams) ([]ClientUser, error) {
+	rows, err := q.db.Query(ctx, listClientUsersPage,
+		arg.ClientID,
+		arg.CursorCreatedAt,
+		arg.CursorClientUserID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClientUser{}
+	for rows.Next() {
+		var i ClientUser
+		if err := rows.Scan(
+			&i.ClientUserID,
+			&i.ClientID,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.Department,
+			&i.Position,
+			&i.Settings,
+			&i.Status,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PasswordChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchClientUsers = `-- name: SearchClientUsers :many
+SELECT
+    client_users.client_user_id, client_users.client_id, client_users.email, client_users.first_name, client_users.last_name, client_users.department, client_users.position, client_users.settings, client_users.status, client_users.deleted_at, client_users.deleted_by, client_users.created_at, client_users.updated_at, client_users.password_changed_at,
+    a.last_active_at
+FROM client_users
+LEFT JOIN client_user_activities a ON a.client_user_id = client_users.client_user_id
+WHERE client_users.client_id = $1
+  AND client_users.deleted_at IS NULL
+  AND (
+    $2::text IS NULL
+    OR client_users.email::text ILIKE $2
+    OR (client_users.last_name || ' ' || client_users.first_name) ILIKE $2
+    OR (client_users.first_name || ' ' || client_users.last_name) ILIKE $2
+  )
+  AND ($3::text IS NULL OR client_users.email::text ILIKE $3)
+  AND ($4::bool IS NULL OR (client_users.status = 'ACTIVE') = $4)
+  AND ($5::text IS NULL OR client_users.status = $5)
+  AND ($6::text IS NULL OR client_users.department = $6)
+  AND ($7::text IS NULL OR client_users.position = $7)
+  AND (
+    $8::text IS NULL
+    OR EXISTS (
+        SELECT 1
+        FROM client_user_roles ur
+        JOIN client_roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+        WHERE ur.client_id = client_users.client_id
+          AND ur.client_user_id = client_users.client_user_id
+          AND ur.deleted_at IS NULL
+          AND ur.revoked_at IS NULL
+          AND r.code = $8
+    )
+  )
+  AND (
+    $9::uuid IS NULL
+    OR CASE $10::text
+        WHEN 'created_at' THEN
+            CASE WHEN $11::bool
+                THEN (client_users.created_at, client_users.client_user_id) < ($12::timestamptz, $9::uuid)
+                ELSE (client_users.created_at, client_users.client_user_id) > ($12::timestamptz, $9::uuid)
+            END
+        WHEN 'last_active_at' THEN
+            CASE WHEN $11::bool
+                THEN (COALESCE(a.last_active_at, 'epoch'::timestamptz), client_users.client_user_id) < ($12::timestamptz, $9::uuid)
+                ELSE (COALESCE(a.last_active_at, 'epoch'::timestamptz), client_users.client_user_id) > ($12::timestamptz, $9::uuid)
+            END
+        WHEN 'name' THEN
+            CASE WHEN $11::bool
+                THEN ((client_users.last_name || ' ' || client_users.first_name) COLLATE "C", client_users.client_user_id) < ($13::text COLLATE "C", $9::uuid)
+                ELSE ((client_users.last_name || ' ' || client_users.first_name) COLLATE "C", client_users.client_user_id) > ($13::text COLLATE "C", $9::uuid)
+            END
+    END
+  )
+ORDER BY
+    CASE WHEN $10::text = 'created_at' AND NOT $11::bool THEN client_users.created_at END ASC,
+    CASE WHEN $10::text = 'created_at' AND $11::bool THEN client_users.created_at END DESC,
+    CASE WHEN $10::text = 'last_active_at' AND NOT $11::bool THEN COALESCE(a.last_active_at, 'epoch'::timestamptz) END ASC,
+    CASE WHEN $10::text = 'last_active_at' AND $11::bool THEN COALESCE(a.last_active_at, 'epoch'::timestamptz) END DESC,
+    CASE WHEN $10::text = 'name' AND NOT $11::bool THEN (client_users.last_name || ' ' || client_users.first_name) COLLATE "C" END ASC,
+    CASE WHEN $10::text = 'name' AND $11::bool THEN (client_users.last_name || ' ' || client_users.first_name) COLLATE "C" END DESC,
+    CASE WHEN NOT $11::bool THEN client_users.client_user_id END ASC,
+    CASE WHEN $11::bool THEN client_users.client_user_id END DESC
+LIMIT $14 OFFSET $15
+`
+
+type SearchClientUsersParams struct {
+	ClientID           pgtype.UUID        `json:"client_id"`
+	QueryPattern       pgtype.Text        `json:"query_pattern"`
+	EmailPattern       pgtype.Text        `json:"email_pattern"`
+	Active             pgtype.Bool        `json:"active"`
+	Status             pgtype.Text        `json:"status"`
+	Department         pgtype.Text        `json:"department"`
+	Position           pgtype.Text        `json:"position"`
+	RoleCode           pgtype.Text        `json:"role_code"`
+	CursorClientUserID pgtype.UUID        `json:"cursor_client_user_id"`
+	SortField          string             `json:"sort_field"`
+	SortDesc           bool               `json:"sort_desc"`
+	CursorTime         pgtype.Timestamptz `json:"cursor_time"`
+	CursorName         pgtype.Text        `json:"cursor_name"`
+	PageSize           int32              `json:"page_size"`
+	PageOffset         int32              `json:"page_offset"`
+}
+
+type SearchClientUsersRow struct {
+	ClientUser   ClientUser         `json:"client_user"`
+	LastActiveAt pgtype.Timestamptz `json:"last_active_at"`
+}
+
+// 検索・絞り込み・並び替え（sort_field: created_at・last_active_at・name、同順位はclient_user_idで並べる）
+// キーセットページネーション（カーソル未指定の場合は先頭から）、SCIMのstartIndex/countはpage_offsetで指定する
+// 最終アクティビティ日時は未アクティビティを1970-01-01扱い、姓 名はバイト順（COLLATE "C"）で並べる
+// 絞り込み条件はCountSearchClientUsersと同じ（sqlc/client_users_test.goで確認）
+func (q *Queries) SearchClientUsers(ctx context.Context, arg SearchClientUsersParams) ([]SearchClientUsersRow, error) {
+	rows, err := q.db.Query(ctx, searchClientUsers,
+		arg.ClientID,
+		arg.QueryPattern,
+		arg.EmailPattern,
+		arg.Active,
+		arg.Status,
+		arg.Department,
+		arg.Position,
+		arg.RoleCode,
+		arg.CursorClientUserID,
+		arg.SortField,
+		arg.SortDesc,
+		arg.CursorTime,
+		arg.CursorName,
+		arg.PageSize,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchClientUsersRow{}
+	for rows.Next() {
+		var i SearchClientUsersRow
+		if err := rows.Scan(
+			&i.ClientUser.ClientUserID,
+			&i.ClientUser.ClientID,
+			&i.ClientUser.Email,
+			&i.ClientUser.FirstName,
+			&i.ClientUser.LastName,
+			&i.ClientUser.Department,
+			&i.ClientUser.Position,
+			&i.ClientUser.Settings,
+			&i.ClientUser.Status,
+			&i.ClientUser.DeletedAt,
+			&i.ClientUser.DeletedBy,
+			&i.ClientUser.CreatedAt,
+			&i.ClientUser.UpdatedAt,
+			&i.ClientUser.PasswordChangedAt,
+			&i.LastActiveAt,
 		); err != nil {
 			return nil, err
 		}
@@ -247,20 +657,22 @@ SET
     updated_at = now()
 WHERE client_user_id = $1
   AND client_id = $2
+  AND updated_at = $10
   AND deleted_at IS NULL
-RETURNING client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at
+RETURNING client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at
 `
 
 type UpdateClientUserParams struct {
-	ClientUserID pgtype.UUID `json:"client_user_id"`
-	ClientID     pgtype.UUID `json:"client_id"`
-	Email        string      `json:"email"`
-	FirstName    string      `json:"first_name"`
-	LastName     string      `json:"last_name"`
-	Department   pgtype.Text `json:"department"`
-	Position     pgtype.Text `json:"position"`
-	Settings     []byte      `json:"settings"`
-	Status       string      `json:"status"`
+	ClientUserID pgtype.UUID        `json:"client_user_id"`
+	ClientID     pgtype.UUID        `json:"client_id"`
+	Email        string             `json:"email"`
+	FirstName    string             `json:"first_name"`
+	LastName     string             `json:"last_name"`
+	Department   pgtype.Text        `json:"department"`
+	Position     pgtype.Text        `json:"position"`
+	Settings     []byte             `json:"settings"`
+	Status       string             `json:"status"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
 func (q *Queries) UpdateClientUser(ctx context.Context, arg UpdateClientUserParams) (ClientUser, error) {
@@ -274,6 +686,7 @@ func (q *Queries) UpdateClientUser(ctx context.Context, arg UpdateClientUserPara
 		arg.Position,
 		arg.Settings,
 		arg.Status,
+		arg.UpdatedAt,
 	)
 	var i ClientUser
 	err := row.Scan(
@@ -290,6 +703,66 @@ func (q *Queries) UpdateClientUser(ctx context.Context, arg UpdateClientUserPara
 		&i.DeletedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PasswordChangedAt,
+	)
+	return i, err
+}
+
+const updateClientUserEmail = `-- name: UpdateClientUserEmail :one
+UPDATE client_users
+SET
+    email = $3,
+    updated_at = now()
+WHERE client_user_id = $1
+  AND client_id = $2
+  AND deleted_at IS NULL
+RETURNING client_user_id, client_id, email, first_name, last_name, department, position, settings, status, deleted_at, deleted_by, created_at, updated_at, password_changed_at
+`
+
+type UpdateClientUserEmailParams struct {
+	ClientUserID pgtype.UUID `json:"client_user_id"`
+	ClientID     pgtype.UUID `json:"client_id"`
+	Email        string      `json:"email"`
+}
+
+func (q *Queries) UpdateClientUserEmail(ctx context.Context, arg UpdateClientUserEmailParams) (ClientUser, error) {
+	row := q.db.QueryRow(ctx, updateClientUserEmail, arg.ClientUserID, arg.ClientID, arg.Email)
+	var i ClientUser
+	err := row.Scan(
+		&i.ClientUserID,
+		&i.ClientID,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.Department,
+		&i.Position,
+		&i.Settings,
+		&i.Status,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PasswordChangedAt,
 	)
 	return i, err
 }
+
+const updateClientUserPasswordChangedAt = `-- name: UpdateClientUserPasswordChangedAt :exec
+UPDATE client_users
+SET
+    password_changed_at = now(),
+    updated_at = now()
+WHERE client_user_id = $1
+  AND client_id = $2
+  AND deleted_at IS NULL
+`
+
+type UpdateClientUserPasswordChangedAtParams struct {
+	ClientUserID pgtype.UUID `json:"client_user_id"`
+	ClientID     pgtype.UUID `json:"client_id"`
+}
+
+func (q *Queries) UpdateClientUserPasswordChangedAt(ctx context.Context, arg UpdateClientUserPasswordChangedAtParams) error {
+	_, err := q.db.Exec(ctx, updateClientUserPasswordChangedAt, arg.ClientUserID, arg.ClientID)
+	return err
+}
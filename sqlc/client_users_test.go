@@ -0,0 +1,21 @@
+package db
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountSearchClientUsers_SameFilterAsSearch(t *testing.T) {
+	// 総件数（CountSearchClientUsers）は一覧（SearchClientUsers）と同じ絞り込み条件で数える
+	// 一覧のキーセットページネーションのカーソル（$9以降）は絞り込み条件に含めない
+	filter := func(query string) string {
+		_, where, found := strings.Cut(query, "\nWHERE ")
+		assert.True(t, found)
+		where, _, _ = strings.Cut(where, "\n  AND (\n    $9::uuid IS NULL")
+		return strings.TrimSpace(where)
+	}
+	assert.Equal(t, filter(countSearchClientUsers), filter(searchClientUsers))
+	assert.Contains(t, filter(searchClientUsers), "r.code = $8")
+}
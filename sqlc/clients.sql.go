@@ -11,6 +11,24 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const activateClient = `-- name: ActivateClient :execrows
+UPDATE clients
+SET
+    status = 'ACTIVE',
+    updated_at = now()
+WHERE client_id = $1
+  AND status = 'PENDING_VERIFICATION'
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) ActivateClient(ctx context.Context, clientID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, activateClient, clientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createClient = `-- name: CreateClient :one
 INSERT INTO clients (
     slug,
@@ -64,24 +82,29 @@ func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) (Cli
 	return i, err
 }
 
-const deleteClient = `-- name: DeleteClient :exec
+const deleteClient = `-- name: DeleteClient :execrows
 UPDATE clients
 SET
     deleted_at = now(),
     deleted_by = $2,
     updated_at = now()
 WHERE client_id = $1
+  AND updated_at = $3
   AND deleted_at IS NULL
 `
 
 type DeleteClientParams struct {
-	ClientID  pgtype.UUID `json:"client_id"`
-	DeletedBy pgtype.UUID `json:"deleted_by"`
+	ClientID  pgtype.UUID        `json:"client_id"`
+	DeletedBy pgtype.UUID        `json:"deleted_by"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
-func (q *Queries) DeleteClient(ctx context.Context, arg DeleteClientParams) error {
-	_, err := q.db.Exec(ctx, deleteClient, arg.ClientID, arg.DeletedBy)
-	return err
+func (q *Queries) DeleteClient(ctx context.Context, arg DeleteClientParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteClient, arg.ClientID, arg.DeletedBy, arg.UpdatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getClient = `-- name: GetClient :one
@@ -219,19 +242,21 @@ SET
     settings = COALESCE($8, settings),
     updated_at = now()
 WHERE client_id = $1
+  AND updated_at = $9
   AND deleted_at IS NULL
 RETURNING client_id, slug, company_code, name, e_sign_mode, retention_default_months, status, settings, deleted_at, deleted_by, created_at, updated_at
 `
 
 type UpdateClientParams struct {
-	ClientID               pgtype.UUID `json:"client_id"`
-	Slug                   string      `json:"slug"`
-	CompanyCode            pgtype.Text `json:"company_code"`
-	Name                   string      `json:"name"`
-	ESignMode              string      `json:"e_sign_mode"`
-	RetentionDefaultMonths int32       `json:"retention_default_months"`
-	Status                 string      `json:"status"`
-	Settings               []byte      `json:"settings"`
+	ClientID               pgtype.UUID        `json:"client_id"`
+	Slug                   string             `json:"slug"`
+	CompanyCode            pgtype.Text        `json:"company_code"`
+	Name                   string             `json:"name"`
+	ESignMode              string             `json:"e_sign_mode"`
+	RetentionDefaultMonths int32              `json:"retention_default_months"`
+	Status                 string             `json:"status"`
+	Settings               []byte             `json:"settings"`
+	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
 }
 
 func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (Client, error) {
@@ -244,6 +269,7 @@ func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (Cli
 		arg.RetentionDefaultMonths,
 		arg.Status,
 		arg.Settings,
+		arg.UpdatedAt,
 	)
 	var i Client
 	err := row.Scan(
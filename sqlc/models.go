@@ -5,6 +5,8 @@
 package db
 
 import (
+	"net/netip"
+
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -23,6 +25,45 @@ type Client struct {
 	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
 }
 
+type ClientApiKey struct {
+	ApiKeyID         pgtype.UUID        `json:"api_key_id"`
+	ClientID         pgtype.UUID        `json:"client_id"`
+	ServiceAccountID pgtype.UUID        `json:"service_account_id"`
+	KeyHash          string             `json:"key_hash"`
+	KeyPrefix        string             `json:"key_prefix"`
+	ExpiresAt        pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt       pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt        pgtype.Timestamptz `json:"revoked_at"`
+	RotatedFrom      pgtype.UUID        `json:"rotated_from"`
+	CreatedBy        pgtype.UUID        `json:"created_by"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+type ClientIdentityProvider struct {
+	ProviderID      pgtype.UUID        `json:"provider_id"`
+	ClientID        pgtype.UUID        `json:"client_id"`
+	Name            string             `json:"name"`
+	Issuer          string             `json:"issuer"`
+	Audience        string             `json:"audience"`
+	JwksUri         pgtype.Text        `json:"jwks_uri"`
+	JitProvisioning bool               `json:"jit_provisioning"`
+	DefaultRoleCode string             `json:"default_role_code"`
+	Status          string             `json:"status"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	DeletedBy       pgtype.UUID        `json:"deleted_by"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ClientIpAllowlistEntry struct {
+	EntryID     pgtype.UUID        `json:"entry_id"`
+	ClientID    pgtype.UUID        `json:"client_id"`
+	Cidr        netip.Prefix       `json:"cidr"`
+	Description pgtype.Text        `json:"description"`
+	CreatedBy   pgtype.UUID        `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
 type ClientRole struct {
 	RoleID      pgtype.UUID        `json:"role_id"`
 	ClientID    pgtype.UUID        `json:"client_id"`
@@ -46,20 +87,87 @@ type ClientRolePermission struct {
 	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
 
+type ClientScimToken struct {
+	TokenID     pgtype.UUID        `json:"token_id"`
+	ClientID    pgtype.UUID        `json:"client_id"`
+	TokenHash   string             `json:"token_hash"`
+	TokenPrefix string             `json:"token_prefix"`
+	Description pgtype.Text        `json:"description"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt   pgtype.Timestamptz `json:"revoked_at"`
+	CreatedBy   pgtype.UUID        `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type ClientServiceAccount struct {
+	ServiceAccountID pgtype.UUID        `json:"service_account_id"`
+	ClientID         pgtype.UUID        `json:"client_id"`
+	Name             string             `json:"name"`
+	Description      pgtype.Text        `json:"description"`
+	RoleID           pgtype.UUID        `json:"role_id"`
+	Status           string             `json:"status"`
+	CreatedBy        pgtype.UUID        `json:"created_by"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt        pgtype.Timestamptz `json:"deleted_at"`
+	DeletedBy        pgtype.UUID        `json:"deleted_by"`
+}
+
+type ClientSignupVerification struct {
+	ClientID    pgtype.UUID        `json:"client_id"`
+	AdminUserID pgtype.UUID        `json:"admin_user_id"`
+	Email       string             `json:"email"`
+	TokenHash   string             `json:"token_hash"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	VerifiedAt  pgtype.Timestamptz `json:"verified_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
 type ClientUser struct {
+	ClientUserID      pgtype.UUID        `json:"client_user_id"`
+	ClientID          pgtype.UUID        `json:"client_id"`
+	Email             string             `json:"email"`
+	FirstName         string             `json:"first_name"`
+	LastName          string             `json:"last_name"`
+	Department        pgtype.Text        `json:"department"`
+	Position          pgtype.Text        `json:"position"`
+	Settings          []byte             `json:"settings"`
+	Status            string             `json:"status"`
+	DeletedAt         pgtype.Timestamptz `json:"deleted_at"`
+	DeletedBy         pgtype.UUID        `json:"deleted_by"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	PasswordChangedAt pgtype.Timestamptz `json:"password_changed_at"`
+}
+
+type ClientUserActivity struct {
 	ClientUserID pgtype.UUID        `json:"client_user_id"`
 	ClientID     pgtype.UUID        `json:"client_id"`
-	Email        string             `json:"email"`
-	FirstName    string             `json:"first_name"`
-	LastName     string             `json:"last_name"`
-	Department   pgtype.Text        `json:"department"`
-	Position     pgtype.Text        `json:"position"`
-	Settings     []byte             `json:"settings"`
-	Status       string             `json:"status"`
+	LastActiveAt pgtype.Timestamptz `json:"last_active_at"`
+}
+
+type ClientUserEmailChange struct {
+	ClientUserID pgtype.UUID        `json:"client_user_id"`
+	ClientID     pgtype.UUID        `json:"client_id"`
+	NewEmail     string             `json:"new_email"`
+	TokenHash    string             `json:"token_hash"`
+	ExpiresAt    pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type ClientUserIdentity struct {
+	Issuer       string             `json:"issuer"`
+	Subject      string             `json:"subject"`
+	ProviderID   pgtype.UUID        `json:"provider_id"`
+	ClientID     pgtype.UUID        `json:"client_id"`
+	ClientUserID pgtype.UUID        `json:"client_user_id"`
+	Email        pgtype.Text        `json:"email"`
+	LastLoginAt  pgtype.Timestamptz `json:"last_login_at"`
 	DeletedAt    pgtype.Timestamptz `json:"deleted_at"`
 	DeletedBy    pgtype.UUID        `json:"deleted_by"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	IdentityID   pgtype.UUID        `json:"identity_id"`
 }
 
 type ClientUserRole struct {
@@ -99,3 +207,20 @@ type OperatorAssignment struct {
 	DeletedAt    pgtype.Timestamptz `json:"deleted_at"`
 	DeletedBy    pgtype.UUID        `json:"deleted_by"`
 }
+
+type RevokedToken struct {
+	Jti       string             `json:"jti"`
+	ClientID  pgtype.UUID        `json:"client_id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	RevokedBy pgtype.UUID        `json:"revoked_by"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type TokenRevocationWatermark struct {
+	SubjectType   string             `json:"subject_type"`
+	SubjectID     pgtype.UUID        `json:"subject_id"`
+	RevokedBefore pgtype.Timestamptz `json:"revoked_before"`
+	Reason        pgtype.Text        `json:"reason"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
@@ -139,6 +139,58 @@ func (q *Queries) GetOperatorByEmail(ctx context.Context, email string) (Operato
 	return i, err
 }
 
+const getOperatorProfile = `-- name: GetOperatorProfile :one
+SELECT
+    operators.operator_id, operators.email, operators.first_name, operators.last_name, operators.status, operators.mfa_enabled, operators.password_hash, operators.salt, operators.last_login_at, operators.password_changed_at, operators.deleted_at, operators.deleted_by, operators.created_at, operators.updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'client_id', c.client_id,
+            'name', c.name,
+            'slug', c.slug,
+            'e_sign_mode', c.e_sign_mode,
+            'status', c.status,
+            'role', oa.role
+        ) ORDER BY oa.assigned_at DESC)
+        FROM operator_assignments oa
+        JOIN clients c ON c.client_id = oa.client_id AND c.deleted_at IS NULL
+        WHERE oa.operator_id = operators.operator_id
+          AND oa.deleted_at IS NULL
+          AND oa.status = 'ACTIVE'
+    ), '[]')::jsonb AS assigned_clients
+FROM operators
+WHERE operators.operator_id = $1
+  AND operators.deleted_at IS NULL
+`
+
+type GetOperatorProfileRow struct {
+	Operator        Operator `json:"operator"`
+	AssignedClients []byte   `json:"assigned_clients"`
+}
+
+// GetMe用: オペレーターと割り当て済みクライアント（有効な割り当てのみ、新しい順）を1回のクエリで取得
+func (q *Queries) GetOperatorProfile(ctx context.Context, operatorID pgtype.UUID) (GetOperatorProfileRow, error) {
+	row := q.db.QueryRow(ctx, getOperatorProfile, operatorID)
+	var i GetOperatorProfileRow
+	err := row.Scan(
+		&i.Operator.OperatorID,
+		&i.Operator.Email,
+		&i.Operator.FirstName,
+		&i.Operator.LastName,
+		&i.Operator.Status,
+		&i.Operator.MfaEnabled,
+		&i.Operator.PasswordHash,
+		&i.Operator.Salt,
+		&i.Operator.LastLoginAt,
+		&i.Operator.PasswordChangedAt,
+		&i.Operator.DeletedAt,
+		&i.Operator.DeletedBy,
+		&i.Operator.CreatedAt,
+		&i.Operator.UpdatedAt,
+		&i.AssignedClients,
+	)
+	return i, err
+}
+
 const listOperators = `-- name: ListOperators :many
 SELECT operator_id, email, first_name, last_name, status, mfa_enabled, password_hash, salt, last_login_at, password_changed_at, deleted_at, deleted_by, created_at, updated_at FROM operators
 WHERE deleted_at IS NULL
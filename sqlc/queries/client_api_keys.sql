@@ -0,0 +1,61 @@
+-- name: GetApiKeyByHash :one
+SELECT * FROM client_api_keys
+WHERE key_hash = $1
+  AND revoked_at IS NULL;
+
+-- name: GetApiKey :one
+SELECT * FROM client_api_keys
+WHERE client_id = $1
+  AND api_key_id = $2;
+
+-- name: ListApiKeys :many
+SELECT * FROM client_api_keys
+WHERE client_id = $1
+  AND service_account_id = $2
+ORDER BY created_at DESC;
+
+-- name: CreateApiKey :one
+INSERT INTO client_api_keys (
+    api_key_id,
+    client_id,
+    service_account_id,
+    key_hash,
+    key_prefix,
+    expires_at,
+    rotated_from,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: TouchApiKey :exec
+UPDATE client_api_keys
+SET
+    last_used_at = now()
+WHERE api_key_id = $1;
+
+-- name: SetApiKeyExpiry :execrows
+-- ローテーション時の猶予期間の設定に使用
+UPDATE client_api_keys
+SET
+    expires_at = $3
+WHERE client_id = $1
+  AND api_key_id = $2
+  AND revoked_at IS NULL;
+
+-- name: RevokeApiKey :execrows
+UPDATE client_api_keys
+SET
+    revoked_at = now()
+WHERE client_id = $1
+  AND api_key_id = $2
+  AND revoked_at IS NULL;
+
+-- name: RevokeServiceAccountApiKeys :exec
+UPDATE client_api_keys
+SET
+    revoked_at = now()
+WHERE client_id = $1
+  AND service_account_id = $2
+  AND revoked_at IS NULL;
@@ -0,0 +1,68 @@
+-- name: GetIdentityProviderByIssuer :one
+SELECT * FROM client_identity_providers
+WHERE issuer = $1
+  AND deleted_at IS NULL;
+
+-- name: GetIdentityProvider :one
+SELECT * FROM client_identity_providers
+WHERE client_id = $1
+  AND provider_id = $2
+  AND deleted_at IS NULL;
+
+-- name: ListIdentityProvidersByClientID :many
+SELECT * FROM client_identity_providers
+WHERE client_id = $1
+  AND deleted_at IS NULL
+ORDER BY created_at ASC;
+
+-- name: CreateIdentityProvider :one
+INSERT INTO client_identity_providers (
+    client_id,
+    name,
+    issuer,
+    audience,
+    jwks_uri,
+    jit_provisioning,
+    default_role_code,
+    status
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: UpdateIdentityProvider :one
+-- issuerは紐付け済みの外部ID（client_user_identities）のキーのため変更しない
+UPDATE client_identity_providers
+SET
+    name = $3,
+    audience = $4,
+    jwks_uri = $5,
+    jit_provisioning = $6,
+    default_role_code = $7,
+    status = $8
+WHERE client_id = $1
+  AND provider_id = $2
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteIdentityProvider :one
+-- IdP設定と紐付け済みの外部IDを論理削除し、削除したIdP設定の件数を返す
+-- （同じissuerで再登録した場合に、以前の紐付けで認証されないようにする）
+WITH deleted AS (
+    UPDATE client_identity_providers
+    SET
+        deleted_at = now(),
+        deleted_by = $3
+    WHERE client_identity_providers.client_id = $1
+      AND client_identity_providers.provider_id = $2
+      AND client_identity_providers.deleted_at IS NULL
+    RETURNING client_identity_providers.provider_id
+), unlinked AS (
+    UPDATE client_user_identities
+    SET
+        deleted_at = now(),
+        deleted_by = $3
+    WHERE client_user_identities.provider_id IN (SELECT provider_id FROM deleted)
+      AND client_user_identities.deleted_at IS NULL
+)
+SELECT count(*) FROM deleted;
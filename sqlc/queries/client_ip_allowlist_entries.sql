@@ -0,0 +1,21 @@
+-- name: ListIpAllowlistEntries :many
+SELECT * FROM client_ip_allowlist_entries
+WHERE client_id = $1
+ORDER BY created_at ASC;
+
+-- name: CreateIpAllowlistEntry :one
+INSERT INTO client_ip_allowlist_entries (
+    entry_id,
+    client_id,
+    cidr,
+    description,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: DeleteIpAllowlistEntry :execrows
+DELETE FROM client_ip_allowlist_entries
+WHERE client_id = $1
+  AND entry_id = $2;
@@ -0,0 +1,32 @@
+-- name: GetScimTokenByHash :one
+SELECT * FROM client_scim_tokens
+WHERE token_hash = $1
+  AND revoked_at IS NULL;
+
+-- name: CreateScimToken :one
+INSERT INTO client_scim_tokens (
+    token_id,
+    client_id,
+    token_hash,
+    token_prefix,
+    description,
+    expires_at,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: TouchScimToken :exec
+UPDATE client_scim_tokens
+SET
+    last_used_at = now()
+WHERE token_id = $1;
+
+-- name: RevokeScimToken :execrows
+UPDATE client_scim_tokens
+SET
+    revoked_at = now()
+WHERE token_id = $1
+  AND client_id = $2
+  AND revoked_at IS NULL;
@@ -0,0 +1,35 @@
+-- name: GetServiceAccount :one
+SELECT * FROM client_service_accounts
+WHERE client_id = $1
+  AND service_account_id = $2
+  AND deleted_at IS NULL;
+
+-- name: ListServiceAccounts :many
+SELECT * FROM client_service_accounts
+WHERE client_id = $1
+  AND deleted_at IS NULL
+ORDER BY created_at ASC;
+
+-- name: CreateServiceAccount :one
+INSERT INTO client_service_accounts (
+    service_account_id,
+    client_id,
+    name,
+    description,
+    role_id,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: DeleteServiceAccount :execrows
+UPDATE client_service_accounts
+SET
+    status = 'INACTIVE',
+    deleted_at = now(),
+    deleted_by = $3,
+    updated_at = now()
+WHERE client_id = $1
+  AND service_account_id = $2
+  AND deleted_at IS NULL;
@@ -0,0 +1,33 @@
+-- name: CreateSignupVerification :one
+INSERT INTO client_signup_verifications (
+    client_id,
+    admin_user_id,
+    email,
+    token_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetSignupVerificationByTokenHash :one
+SELECT * FROM client_signup_verifications
+WHERE token_hash = $1
+  AND verified_at IS NULL
+FOR UPDATE;
+
+-- name: MarkSignupVerified :exec
+UPDATE client_signup_verifications
+SET verified_at = now()
+WHERE client_id = $1;
+
+-- name: RenewSignupVerification :one
+-- 確認トークンを再発行（確認メールの送信に失敗した登録の再試行用、管理者メールアドレスが一致する未検証のトークンのみ）
+UPDATE client_signup_verifications
+SET
+    token_hash = $3,
+    expires_at = $4
+WHERE client_id = $1
+  AND email = $2
+  AND verified_at IS NULL
+RETURNING *;
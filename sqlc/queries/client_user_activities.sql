@@ -0,0 +1,19 @@
+-- name: TouchClientUserActivity :exec
+-- 最終アクティビティ日時を記録（書き込みを抑えるため5分以内の更新は省略）
+INSERT INTO client_user_activities (
+    client_user_id,
+    client_id,
+    last_active_at
+) VALUES (
+    $1, $2, now()
+)
+ON CONFLICT (client_user_id) DO UPDATE
+SET
+    last_active_at = EXCLUDED.last_active_at
+WHERE client_user_activities.last_active_at < EXCLUDED.last_active_at - interval '5 minutes';
+
+-- name: ListClientUserActivitiesByUserIDs :many
+-- 複数ユーザーの最終アクティビティ日時をまとめて取得
+SELECT * FROM client_user_activities
+WHERE client_id = sqlc.arg(client_id)
+  AND client_user_id = ANY(sqlc.arg(client_user_ids)::uuid[]);
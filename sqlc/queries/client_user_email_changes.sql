@@ -0,0 +1,26 @@
+-- name: UpsertEmailChange :one
+INSERT INTO client_user_email_changes (
+    client_user_id,
+    client_id,
+    new_email,
+    token_hash,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (client_user_id) DO UPDATE
+SET
+    new_email = EXCLUDED.new_email,
+    token_hash = EXCLUDED.token_hash,
+    expires_at = EXCLUDED.expires_at,
+    created_at = now()
+RETURNING *;
+
+-- name: GetEmailChangeByTokenHash :one
+SELECT * FROM client_user_email_changes
+WHERE token_hash = $1
+FOR UPDATE;
+
+-- name: DeleteEmailChange :exec
+DELETE FROM client_user_email_changes
+WHERE client_user_id = $1;
@@ -0,0 +1,29 @@
+-- name: GetClientUserIdentity :one
+SELECT * FROM client_user_identities
+WHERE issuer = $1
+  AND subject = $2
+  AND deleted_at IS NULL;
+
+-- name: CreateClientUserIdentity :one
+INSERT INTO client_user_identities (
+    issuer,
+    subject,
+    provider_id,
+    client_id,
+    client_user_id,
+    email,
+    last_login_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: TouchClientUserIdentity :exec
+-- 最終ログイン日時を記録（外部IdPのトークンによるリクエストごとに呼ばれるため、書き込みを抑えるため5分以内の更新は省略）
+UPDATE client_user_identities
+SET
+    last_login_at = now()
+WHERE issuer = $1
+  AND subject = $2
+  AND deleted_at IS NULL
+  AND (last_login_at IS NULL OR last_login_at < now() - interval '5 minutes');
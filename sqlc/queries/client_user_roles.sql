@@ -52,3 +52,44 @@ WHERE client_id = $1
   AND role_id = $3
   AND deleted_at IS NULL;
 
+
+-- name: AssignClientUserRole :one
+-- 取り消し・削除済みの割り当てがある場合は再有効化する
+INSERT INTO client_user_roles (
+    client_id,
+    client_user_id,
+    role_id,
+    assigned_at
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (client_id, client_user_id, role_id) DO UPDATE
+SET
+    assigned_at = EXCLUDED.assigned_at,
+    revoked_at = NULL,
+    deleted_at = NULL,
+    deleted_by = NULL
+RETURNING *;
+
+-- name: ListActiveClientUserRolesByUserIDs :many
+-- 複数ユーザーの有効なロールをまとめて取得（エクスポート・v2 API用）
+SELECT
+    ur.client_user_id,
+    r.role_id,
+    r.code,
+    r.name
+FROM client_user_roles ur
+JOIN client_roles r ON r.role_id = ur.role_id AND r.client_id = ur.client_id AND r.deleted_at IS NULL
+WHERE ur.client_id = sqlc.arg(client_id)
+  AND ur.client_user_id = ANY(sqlc.arg(client_user_ids)::uuid[])
+  AND ur.deleted_at IS NULL
+  AND ur.revoked_at IS NULL
+ORDER BY ur.client_user_id, r.code;
+
+-- name: ListActiveClientUserRolesByClient :many
+-- クライアントの有効なロール割り当てをまとめて取得（SCIMのグループ一覧用）
+SELECT * FROM client_user_roles
+WHERE client_id = $1
+  AND deleted_at IS NULL
+  AND revoked_at IS NULL
+ORDER BY assigned_at DESC;
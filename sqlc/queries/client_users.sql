@@ -19,9 +19,21 @@ WHERE client_user_id = $1
 SELECT * FROM client_users
 WHERE client_id = $1
   AND deleted_at IS NULL
-ORDER BY created_at DESC
+ORDER BY created_at DESC, client_user_id DESC
 LIMIT $2 OFFSET $3;
 
+-- name: ListClientUsersPage :many
+-- キーセットページネーション（created_at, client_user_idの降順、カーソル未指定の場合は先頭から）
+SELECT * FROM client_users
+WHERE client_id = sqlc.arg(client_id)
+  AND deleted_at IS NULL
+  AND (
+    sqlc.narg(cursor_created_at)::timestamptz IS NULL
+    OR (created_at, client_user_id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_client_user_id)::uuid)
+  )
+ORDER BY created_at DESC, client_user_id DESC
+LIMIT sqlc.arg(page_size);
+
 -- name: CreateClientUser :one
 INSERT INTO client_users (
     client_user_id,
@@ -51,16 +63,175 @@ SET
     updated_at = now()
 WHERE client_user_id = $1
   AND client_id = $2
+  AND updated_at = $10
   AND deleted_at IS NULL
 RETURNING *;
 
--- name: DeleteClientUser :exec
+-- name: DeleteClientUser :execrows
 UPDATE client_users
 SET
     deleted_at = now(),
     deleted_by = $3,
     updated_at = now()
+WHERE client_user_id = $1
+  AND client_id = $2
+  AND updated_at = $4
+  AND deleted_at IS NULL;
+
+
+-- name: ListAllClientUsers :many
+SELECT * FROM client_users
+WHERE client_id = $1
+  AND deleted_at IS NULL
+ORDER BY created_at ASC;
+
+-- name: CountClientUsers :one
+SELECT count(*) FROM client_users
+WHERE client_id = $1
+  AND deleted_at IS NULL;
+
+-- name: UpdateClientUserPasswordChangedAt :exec
+UPDATE client_users
+SET
+    password_changed_at = now(),
+    updated_at = now()
 WHERE client_user_id = $1
   AND client_id = $2
   AND deleted_at IS NULL;
 
+-- name: UpdateClientUserEmail :one
+UPDATE client_users
+SET
+    email = $3,
+    updated_at = now()
+WHERE client_user_id = $1
+  AND client_id = $2
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetClientUserProfile :one
+-- GetMe用: ユーザー・所属クライアント・有効なロール・実効権限を1回のクエリで取得
+SELECT
+    sqlc.embed(client_users),
+    sqlc.embed(clients),
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object('role_id', r.role_id, 'code', r.code, 'name', r.name) ORDER BY r.code)
+        FROM client_user_roles ur
+        JOIN client_roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+        WHERE ur.client_id = client_users.client_id
+          AND ur.client_user_id = client_users.client_user_id
+          AND ur.deleted_at IS NULL
+          AND ur.revoked_at IS NULL
+    ), '[]')::jsonb AS roles,
+    COALESCE((
+        SELECT jsonb_agg(DISTINCT jsonb_build_object('feature', p.feature, 'action', p.action))
+        FROM client_user_roles ur
+        JOIN client_roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+        JOIN client_role_permissions p ON p.role_id = r.role_id AND p.deleted_at IS NULL AND p.granted
+        WHERE ur.client_id = client_users.client_id
+          AND ur.client_user_id = client_users.client_user_id
+          AND ur.deleted_at IS NULL
+          AND ur.revoked_at IS NULL
+    ), '[]')::jsonb AS permissions
+FROM client_users
+JOIN clients ON clients.client_id = client_users.client_id
+WHERE client_users.client_user_id = $1
+  AND client_users.client_id = $2
+  AND client_users.deleted_at IS NULL;
+
+-- name: SearchClientUsers :many
+-- 検索・絞り込み・並び替え（sort_field: created_at・last_active_at・name、同順位はclient_user_idで並べる）
+-- キーセットページネーション（カーソル未指定の場合は先頭から）、SCIMのstartIndex/countはpage_offsetで指定する
+-- 最終アクティビティ日時は未アクティビティを1970-01-01扱い、姓 名はバイト順（COLLATE "C"）で並べる
+-- 絞り込み条件はCountSearchClientUsersと同じ（sqlc/client_users_test.goで確認）
+SELECT
+    sqlc.embed(client_users),
+    a.last_active_at
+FROM client_users
+LEFT JOIN client_user_activities a ON a.client_user_id = client_users.client_user_id
+WHERE client_users.client_id = sqlc.arg(client_id)
+  AND client_users.deleted_at IS NULL
+  AND (
+    sqlc.narg(query_pattern)::text IS NULL
+    OR client_users.email::text ILIKE sqlc.narg(query_pattern)
+    OR (client_users.last_name || ' ' || client_users.first_name) ILIKE sqlc.narg(query_pattern)
+    OR (client_users.first_name || ' ' || client_users.last_name) ILIKE sqlc.narg(query_pattern)
+  )
+  AND (sqlc.narg(email_pattern)::text IS NULL OR client_users.email::text ILIKE sqlc.narg(email_pattern))
+  AND (sqlc.narg(active)::bool IS NULL OR (client_users.status = 'ACTIVE') = sqlc.narg(active))
+  AND (sqlc.narg(status)::text IS NULL OR client_users.status = sqlc.narg(status))
+  AND (sqlc.narg(department)::text IS NULL OR client_users.department = sqlc.narg(department))
+  AND (sqlc.narg(position)::text IS NULL OR client_users.position = sqlc.narg(position))
+  AND (
+    sqlc.narg(role_code)::text IS NULL
+    OR EXISTS (
+        SELECT 1
+        FROM client_user_roles ur
+        JOIN client_roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+        WHERE ur.client_id = client_users.client_id
+          AND ur.client_user_id = client_users.client_user_id
+          AND ur.deleted_at IS NULL
+          AND ur.revoked_at IS NULL
+          AND r.code = sqlc.narg(role_code)
+    )
+  )
+  AND (
+    sqlc.narg(cursor_client_user_id)::uuid IS NULL
+    OR CASE sqlc.arg(sort_field)::text
+        WHEN 'created_at' THEN
+            CASE WHEN sqlc.arg(sort_desc)::bool
+                THEN (client_users.created_at, client_users.client_user_id) < (sqlc.narg(cursor_time)::timestamptz, sqlc.narg(cursor_client_user_id)::uuid)
+                ELSE (client_users.created_at, client_users.client_user_id) > (sqlc.narg(cursor_time)::timestamptz, sqlc.narg(cursor_client_user_id)::uuid)
+            END
+        WHEN 'last_active_at' THEN
+            CASE WHEN sqlc.arg(sort_desc)::bool
+                THEN (COALESCE(a.last_active_at, 'epoch'::timestamptz), client_users.client_user_id) < (sqlc.narg(cursor_time)::timestamptz, sqlc.narg(cursor_client_user_id)::uuid)
+                ELSE (COALESCE(a.last_active_at, 'epoch'::timestamptz), client_users.client_user_id) > (sqlc.narg(cursor_time)::timestamptz, sqlc.narg(cursor_client_user_id)::uuid)
+            END
+        WHEN 'name' THEN
+            CASE WHEN sqlc.arg(sort_desc)::bool
+                THEN ((client_users.last_name || ' ' || client_users.first_name) COLLATE "C", client_users.client_user_id) < (sqlc.narg(cursor_name)::text COLLATE "C", sqlc.narg(cursor_client_user_id)::uuid)
+                ELSE ((client_users.last_name || ' ' || client_users.first_name) COLLATE "C", client_users.client_user_id) > (sqlc.narg(cursor_name)::text COLLATE "C", sqlc.narg(cursor_client_user_id)::uuid)
+            END
+    END
+  )
+ORDER BY
+    CASE WHEN sqlc.arg(sort_field)::text = 'created_at' AND NOT sqlc.arg(sort_desc)::bool THEN client_users.created_at END ASC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'created_at' AND sqlc.arg(sort_desc)::bool THEN client_users.created_at END DESC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'last_active_at' AND NOT sqlc.arg(sort_desc)::bool THEN COALESCE(a.last_active_at, 'epoch'::timestamptz) END ASC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'last_active_at' AND sqlc.arg(sort_desc)::bool THEN COALESCE(a.last_active_at, 'epoch'::timestamptz) END DESC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'name' AND NOT sqlc.arg(sort_desc)::bool THEN (client_users.last_name || ' ' || client_users.first_name) COLLATE "C" END ASC,
+    CASE WHEN sqlc.arg(sort_field)::text = 'name' AND sqlc.arg(sort_desc)::bool THEN (client_users.last_name || ' ' || client_users.first_name) COLLATE "C" END DESC,
+    CASE WHEN NOT sqlc.arg(sort_desc)::bool THEN client_users.client_user_id END ASC,
+    CASE WHEN sqlc.arg(sort_desc)::bool THEN client_users.client_user_id END DESC
+LIMIT sqlc.arg(page_size) OFFSET sqlc.arg(page_offset);
+
+-- name: CountSearchClientUsers :one
+-- SearchClientUsersと同じ条件の総件数
+SELECT count(*) FROM client_users
+WHERE client_users.client_id = sqlc.arg(client_id)
+  AND client_users.deleted_at IS NULL
+  AND (
+    sqlc.narg(query_pattern)::text IS NULL
+    OR client_users.email::text ILIKE sqlc.narg(query_pattern)
+    OR (client_users.last_name || ' ' || client_users.first_name) ILIKE sqlc.narg(query_pattern)
+    OR (client_users.first_name || ' ' || client_users.last_name) ILIKE sqlc.narg(query_pattern)
+  )
+  AND (sqlc.narg(email_pattern)::text IS NULL OR client_users.email::text ILIKE sqlc.narg(email_pattern))
+  AND (sqlc.narg(active)::bool IS NULL OR (client_users.status = 'ACTIVE') = sqlc.narg(active))
+  AND (sqlc.narg(status)::text IS NULL OR client_users.status = sqlc.narg(status))
+  AND (sqlc.narg(department)::text IS NULL OR client_users.department = sqlc.narg(department))
+  AND (sqlc.narg(position)::text IS NULL OR client_users.position = sqlc.narg(position))
+  AND (
+    sqlc.narg(role_code)::text IS NULL
+    OR EXISTS (
+        SELECT 1
+        FROM client_user_roles ur
+        JOIN client_roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+        WHERE ur.client_id = client_users.client_id
+          AND ur.client_user_id = client_users.client_user_id
+          AND ur.deleted_at IS NULL
+          AND ur.revoked_at IS NULL
+          AND r.code = sqlc.narg(role_code)
+    )
+  );
@@ -45,15 +45,26 @@ SET
     settings = COALESCE($8, settings),
     updated_at = now()
 WHERE client_id = $1
+  AND updated_at = $9
   AND deleted_at IS NULL
 RETURNING *;
 
--- name: DeleteClient :exec
+-- name: DeleteClient :execrows
 UPDATE clients
 SET
     deleted_at = now(),
     deleted_by = $2,
     updated_at = now()
 WHERE client_id = $1
+  AND updated_at = $3
+  AND deleted_at IS NULL;
+
+-- name: ActivateClient :execrows
+UPDATE clients
+SET
+    status = 'ACTIVE',
+    updated_at = now()
+WHERE client_id = $1
+  AND status = 'PENDING_VERIFICATION'
   AND deleted_at IS NULL;
 
@@ -51,3 +51,26 @@ SET
 WHERE operator_id = $1
   AND deleted_at IS NULL;
 
+
+-- name: GetOperatorProfile :one
+-- GetMe用: オペレーターと割り当て済みクライアント（有効な割り当てのみ、新しい順）を1回のクエリで取得
+SELECT
+    sqlc.embed(operators),
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'client_id', c.client_id,
+            'name', c.name,
+            'slug', c.slug,
+            'e_sign_mode', c.e_sign_mode,
+            'status', c.status,
+            'role', oa.role
+        ) ORDER BY oa.assigned_at DESC)
+        FROM operator_assignments oa
+        JOIN clients c ON c.client_id = oa.client_id AND c.deleted_at IS NULL
+        WHERE oa.operator_id = operators.operator_id
+          AND oa.deleted_at IS NULL
+          AND oa.status = 'ACTIVE'
+    ), '[]')::jsonb AS assigned_clients
+FROM operators
+WHERE operators.operator_id = $1
+  AND operators.deleted_at IS NULL;
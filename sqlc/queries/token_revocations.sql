@@ -0,0 +1,44 @@
+-- name: ListTokenRevocationWatermarks :many
+-- ユーザー単位とクライアント単位の失効基準日時をまとめて取得
+SELECT * FROM token_revocation_watermarks
+WHERE (subject_type = 'USER' AND subject_id = sqlc.arg(user_id))
+   OR (subject_type = 'CLIENT' AND subject_id = sqlc.arg(client_id));
+
+-- name: BumpTokenRevocationWatermark :exec
+-- 失効基準日時は後退させない
+INSERT INTO token_revocation_watermarks (
+    subject_type,
+    subject_id,
+    revoked_before,
+    reason
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (subject_type, subject_id) DO UPDATE
+SET
+    revoked_before = GREATEST(token_revocation_watermarks.revoked_before, EXCLUDED.revoked_before),
+    reason = EXCLUDED.reason,
+    updated_at = now();
+
+-- name: IsTokenRevoked :one
+SELECT EXISTS (
+    SELECT 1 FROM revoked_tokens
+    WHERE jti = $1
+      AND expires_at > now()
+);
+
+-- name: RevokeToken :exec
+INSERT INTO revoked_tokens (
+    jti,
+    client_id,
+    user_id,
+    expires_at,
+    revoked_by
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (jti) DO NOTHING;
+
+-- name: DeleteExpiredRevokedTokens :execrows
+DELETE FROM revoked_tokens
+WHERE expires_at <= now();
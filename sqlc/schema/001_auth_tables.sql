@@ -7,6 +7,9 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 -- citext拡張機能を有効化（大文字小文字を区別しないテキスト型）
 CREATE EXTENSION IF NOT EXISTS "citext";
 
+-- pg_trgm拡張機能を有効化（部分一致検索用）
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
 -- clients（クライアント）テーブル
 CREATE TABLE clients (
     client_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
@@ -15,7 +18,7 @@ CREATE TABLE clients (
     name text NOT NULL,
     e_sign_mode text NOT NULL DEFAULT 'WITNESS_OTP' CHECK (e_sign_mode IN ('WITNESS_OTP', 'OTP_ONLY', 'CERTIFICATE', 'BIOMETRIC', 'SIMPLE_CLICK')),
     retention_default_months integer NOT NULL DEFAULT 84 CHECK (retention_default_months >= 12 AND retention_default_months <= 240),
-    status text NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'SUSPENDED', 'TERMINATED')),
+    status text NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('PENDING_VERIFICATION', 'ACTIVE', 'SUSPENDED', 'TERMINATED')),
     settings jsonb NOT NULL DEFAULT '{}',
     deleted_at timestamptz,
     deleted_by uuid,
@@ -65,6 +68,7 @@ CREATE TABLE client_users (
     deleted_by uuid,
     created_at timestamptz NOT NULL DEFAULT now(),
     updated_at timestamptz NOT NULL DEFAULT now(),
+    password_changed_at timestamptz,
     -- クライアント内でemailの一意性を保証
     UNIQUE(client_id, email)
 );
@@ -73,6 +77,12 @@ CREATE TABLE client_users (
 CREATE INDEX idx_client_users_client_id ON client_users(client_id) WHERE deleted_at IS NULL;
 CREATE INDEX idx_client_users_email ON client_users(email) WHERE deleted_at IS NULL;
 CREATE INDEX idx_client_users_status ON client_users(status) WHERE deleted_at IS NULL;
+CREATE INDEX idx_client_users_client_created ON client_users(client_id, created_at DESC, client_user_id DESC) WHERE deleted_at IS NULL;  -- キーセットページネーション用
+CREATE INDEX idx_client_users_email_trgm ON client_users USING gin ((email::text) gin_trgm_ops) WHERE deleted_at IS NULL;  -- 部分一致検索用
+CREATE INDEX idx_client_users_last_first_name_trgm ON client_users USING gin ((last_name || ' ' || first_name) gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX idx_client_users_first_last_name_trgm ON client_users USING gin ((first_name || ' ' || last_name) gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX idx_client_users_client_department ON client_users(client_id, department) WHERE deleted_at IS NULL;
+CREATE INDEX idx_client_users_client_position ON client_users(client_id, position) WHERE deleted_at IS NULL;
 
 -- updated_atを自動更新するトリガー関数
 CREATE OR REPLACE FUNCTION update_updated_at_column()
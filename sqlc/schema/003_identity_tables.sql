@@ -0,0 +1,35 @@
+-- 外部IdP（OIDC）連携テーブルのスキーマ定義
+
+-- client_identity_providers（クライアントIdP設定）テーブル
+CREATE TABLE client_identity_providers (
+    provider_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    name text NOT NULL,
+    issuer text NOT NULL,
+    audience text NOT NULL,
+    jwks_uri text,
+    jit_provisioning boolean NOT NULL DEFAULT false,
+    default_role_code text NOT NULL DEFAULT 'member',
+    status text NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    deleted_at timestamptz,
+    deleted_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- client_user_identities（外部IDとクライアントユーザーの紐付け）テーブル
+CREATE TABLE client_user_identities (
+    issuer text NOT NULL,
+    subject text NOT NULL,
+    provider_id uuid NOT NULL REFERENCES client_identity_providers(provider_id) ON DELETE RESTRICT,
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    client_user_id uuid NOT NULL REFERENCES client_users(client_user_id) ON DELETE RESTRICT,
+    email citext,
+    last_login_at timestamptz,
+    deleted_at timestamptz,
+    deleted_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    identity_id uuid PRIMARY KEY DEFAULT uuid_generate_v4()
+);
+
+CREATE UNIQUE INDEX idx_client_user_identities_issuer_subject ON client_user_identities(issuer, subject) WHERE deleted_at IS NULL;
@@ -0,0 +1,18 @@
+-- SCIMプロビジョニング関連テーブルのスキーマ定義
+
+-- client_scim_tokens（SCIMトークン）テーブル
+CREATE TABLE client_scim_tokens (
+    token_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    token_hash text NOT NULL,
+    token_prefix text NOT NULL,
+    description text,
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    revoked_at timestamptz,
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_client_scim_tokens_token_hash ON client_scim_tokens(token_hash);
+CREATE INDEX idx_client_scim_tokens_client_id ON client_scim_tokens(client_id) WHERE revoked_at IS NULL;
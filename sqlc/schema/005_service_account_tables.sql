@@ -0,0 +1,36 @@
+-- サービスアカウント・APIキー関連テーブルのスキーマ定義
+
+-- client_service_accounts（サービスアカウント）テーブル
+CREATE TABLE client_service_accounts (
+    service_account_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    name text NOT NULL,
+    description text,
+    role_id uuid NOT NULL REFERENCES client_roles(role_id) ON DELETE RESTRICT,
+    status text NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'INACTIVE')),
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    deleted_at timestamptz,
+    deleted_by uuid
+);
+
+CREATE UNIQUE INDEX idx_client_service_accounts_client_name ON client_service_accounts(client_id, name) WHERE deleted_at IS NULL;
+
+-- client_api_keys（APIキー）テーブル
+CREATE TABLE client_api_keys (
+    api_key_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    service_account_id uuid NOT NULL REFERENCES client_service_accounts(service_account_id) ON DELETE RESTRICT,
+    key_hash text NOT NULL,
+    key_prefix text NOT NULL,
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    revoked_at timestamptz,
+    rotated_from uuid REFERENCES client_api_keys(api_key_id),
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_client_api_keys_key_hash ON client_api_keys(key_hash);
+CREATE INDEX idx_client_api_keys_service_account_id ON client_api_keys(client_id, service_account_id);
@@ -0,0 +1,23 @@
+-- トークン失効関連テーブルのスキーマ定義
+
+-- token_revocation_watermarks（失効基準日時）テーブル
+CREATE TABLE token_revocation_watermarks (
+    subject_type text NOT NULL CHECK (subject_type IN ('USER', 'CLIENT')),
+    subject_id uuid NOT NULL,
+    revoked_before timestamptz NOT NULL,
+    reason text,
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (subject_type, subject_id)
+);
+
+-- revoked_tokens（トークン失効リスト）テーブル
+CREATE TABLE revoked_tokens (
+    jti text PRIMARY KEY,
+    client_id uuid REFERENCES clients(client_id) ON DELETE CASCADE,
+    user_id uuid,
+    expires_at timestamptz NOT NULL,
+    revoked_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
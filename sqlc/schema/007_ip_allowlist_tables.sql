@@ -0,0 +1,12 @@
+-- IPアドレス許可リスト関連テーブルのスキーマ定義
+
+-- client_ip_allowlist_entries（IPアドレス許可リスト）テーブル
+CREATE TABLE client_ip_allowlist_entries (
+    entry_id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE RESTRICT,
+    cidr cidr NOT NULL,
+    description text,
+    created_by uuid,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE(client_id, cidr)
+);
@@ -0,0 +1,12 @@
+-- サインアップ登録確認関連テーブルのスキーマ定義
+
+-- client_signup_verifications（登録確認トークン）テーブル
+CREATE TABLE client_signup_verifications (
+    client_id uuid PRIMARY KEY REFERENCES clients(client_id) ON DELETE CASCADE,
+    admin_user_id uuid NOT NULL,
+    email citext NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    expires_at timestamptz NOT NULL,
+    verified_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
@@ -0,0 +1,11 @@
+-- メールアドレス変更関連テーブルのスキーマ定義
+
+-- client_user_email_changes（メールアドレス変更の確認待ち）テーブル
+CREATE TABLE client_user_email_changes (
+    client_user_id uuid PRIMARY KEY REFERENCES client_users(client_user_id) ON DELETE CASCADE,
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE CASCADE,
+    new_email citext NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
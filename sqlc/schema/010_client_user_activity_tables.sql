@@ -0,0 +1,10 @@
+-- クライアントユーザーの最終アクティビティ関連テーブルのスキーマ定義
+
+-- client_user_activities（クライアントユーザーの最終アクティビティ）テーブル
+CREATE TABLE client_user_activities (
+    client_user_id uuid PRIMARY KEY REFERENCES client_users(client_user_id) ON DELETE CASCADE,
+    client_id uuid NOT NULL REFERENCES clients(client_id) ON DELETE CASCADE,
+    last_active_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_client_user_activities_client_last_active ON client_user_activities(client_id, last_active_at DESC);